@@ -22,6 +22,11 @@ import (
 //   "7" : QuorumSignature  : []string
 //   "8" : PledgeDetails    : map[string][]PledgeDetail
 //   "9" : SmartContractData : string
+//  "14" : ConsensusPolicy  : ConsensusPolicy
+//  "15" : TokensMerkleRoot : string
+//  "16" : SmartContractStateHash : string
+//  "17" : SmartContractEvents : string
+//  "18" : SmartContractCode : string
 //
 // }
 
@@ -44,6 +49,11 @@ const (
 	TCInitiatorSignatureKey string = "12"
 	TCEpochKey              string = "epoch"
 	TCNFTDataKey            string = "13"
+	TCConsensusPolicyKey    string = "14"
+	TCTokensMerkleRootKey   string = "15"
+	TCSCStateHashKey        string = "16"
+	TCSCEventsKey           string = "17"
+	TCSCCodeKey             string = "18"
 )
 
 const (
@@ -60,6 +70,7 @@ const (
 	TokenContractCommited string = "11"
 	TokenPinnedAsService  string = "12"
 	TokenIsBurntForFT     string = "13"
+	TokenUpgradedType     string = "14"
 )
 
 const (
@@ -87,12 +98,16 @@ type TokenChainBlock struct {
 	QuorumSignature    []CreditSignature   `json:"quorumSignature"`
 	SmartContract      []byte              `json:"smartContract"`
 	SmartContractData  string              `json:"smartContractData"`
+	SCStateHash        string              `json:"scStateHash"`
+	SCEvents           string              `json:"scEvents"`
+	SCCode             string              `json:"scCode"`
 	TokenValue         float64             `json:"tokenValue"`
 	ChildTokens        []string            `json:"childTokens"`
 	InitiatorSignature *InitiatorSignature `json:"initiatorSignature"`
 	NFT                []byte              `json:"nft"`
 	NFTData            string              `json:"nftData"`
 	Epoch              int                 `json:"epoch"`
+	ConsensusPolicy    *ConsensusPolicy    `json:"consensusPolicy"`
 }
 
 type PledgeDetail struct {
@@ -180,6 +195,7 @@ func CreateNewBlock(ctcb map[string]*Block, tcb *TokenChainBlock) *Block {
 		return nil
 	}
 	ntcb[TCTransInfoKey] = ntib
+	ntcb[TCTokensMerkleRootKey] = tokensMerkleRoot(ntib[TITokensKey])
 	pdib := newPledgeDetails(tcb.PledgeDetails)
 	if pdib != nil {
 		ntcb[TCPledgeDetailsKey] = pdib
@@ -193,6 +209,15 @@ func CreateNewBlock(ctcb map[string]*Block, tcb *TokenChainBlock) *Block {
 	if tcb.SmartContractData != "" {
 		ntcb[TCSmartContractDataKey] = tcb.SmartContractData
 	}
+	if tcb.SCStateHash != "" {
+		ntcb[TCSCStateHashKey] = tcb.SCStateHash
+	}
+	if tcb.SCEvents != "" {
+		ntcb[TCSCEventsKey] = tcb.SCEvents
+	}
+	if tcb.SCCode != "" {
+		ntcb[TCSCCodeKey] = tcb.SCCode
+	}
 	if tcb.NFTData != "" {
 		ntcb[TCNFTDataKey] = tcb.NFTData
 	}
@@ -214,6 +239,10 @@ func CreateNewBlock(ctcb map[string]*Block, tcb *TokenChainBlock) *Block {
 		ntcb[TCEpochKey] = tcb.Epoch
 	}
 
+	if tcb.ConsensusPolicy != nil {
+		ntcb[TCConsensusPolicyKey] = newConsensusPolicy(tcb.ConsensusPolicy)
+	}
+
 	blk := InitBlock(nil, ntcb)
 	return blk
 }
@@ -725,6 +754,12 @@ func (b *Block) GetSmartContractData() string {
 	return b.getBlkString(TCSmartContractDataKey)
 }
 
+// GetSCStateHash returns the hash of the smart contract state, the blocks
+// created before the state index do not have the hash
+func (b *Block) GetSCStateHash() string {
+	return b.getBlkString(TCSCStateHashKey)
+}
+
 func (b *Block) GetNFTData() string {
 	return b.getBlkString(TCNFTDataKey)
 }
@@ -739,6 +774,16 @@ func (b *Block) GetSmartContractValue(t string) (float64, error) {
 	return result, nil
 }
 
+// GetNFTRoyalty returns the royalty percentage and the royalty recipient
+// declared in the genesis block of the NFT
+func (b *Block) GetNFTRoyalty(t string) (float64, string, error) {
+	gtm := b.getGenesisTokenMap(t)
+	if gtm == nil {
+		return 0, "", fmt.Errorf("invalid token chain block, missing genesis block")
+	}
+	return util.GetFloatFromMap(gtm, GINFTRoyaltyKey), util.GetStringFromMap(gtm, GINFTRoyaltyDIDKey), nil
+}
+
 func (b *Block) GetTokenValue() float64 {
 	tokenValue := util.GetFloatFromMap(b.bm, TCTokenValueKey)
 	return floatPrecisionToMaxDecimalPlaces(tokenValue)
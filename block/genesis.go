@@ -34,6 +34,8 @@ const (
 	GIGrandParentIDKey      string = "6"
 	GICommitedTokensKey     string = "7"
 	GISmartContractValueKey string = "8"
+	GINFTRoyaltyKey         string = "9"
+	GINFTRoyaltyDIDKey      string = "10"
 )
 
 type GenesisTokenInfo struct {
@@ -48,6 +50,8 @@ type GenesisTokenInfo struct {
 	SmartContractValue float64       `json:"smartContractValue"`
 	NFTValue           float64       `json:"nftValue"`
 	NFTData            string        `json:"nftData"`
+	NFTRoyalty         float64       `json:"nftRoyalty"`
+	NFTRoyaltyDID      string        `json:"nftRoyaltyDID"`
 }
 
 type GenesisBlock struct {
@@ -84,6 +88,10 @@ func newGenesisInfo(gi *GenesisTokenInfo) map[string]interface{} {
 	if gi.SmartContractValue != 0 {
 		ngib[GISmartContractValueKey] = gi.SmartContractValue
 	}
+	if gi.NFTRoyalty != 0 {
+		ngib[GINFTRoyaltyKey] = gi.NFTRoyalty
+		ngib[GINFTRoyaltyDIDKey] = gi.NFTRoyaltyDID
+	}
 	return ngib
 }
 
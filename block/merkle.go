@@ -0,0 +1,252 @@
+package block
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rubixchain/rubixgoplatform/util"
+)
+
+// The tokens merkle root is calculated over the TransInfo tokens sorted by token id,
+// leaf  : SHA3-256(0x00 | token | token type | block number | previous block id | unpledged id | commited did)
+// node  : SHA3-256(0x01 | left | right)
+// an odd node at the end of a level is promoted to the next level as it is
+
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// TokenInclusionProof proves that the token was part of the transaction block
+type TokenInclusionProof struct {
+	Token       string      `json:"token"`
+	TokenType   int         `json:"token_type"`
+	BlockNumber string      `json:"block_number"`
+	PrevBlockID string      `json:"prev_block_id"`
+	UnpledgedID string      `json:"unpledged_id,omitempty"`
+	CommitedDID string      `json:"commited_did,omitempty"`
+	Path        []ProofNode `json:"path"`
+	Root        string      `json:"root"`
+	BlockHash   string      `json:"block_hash"`
+	Block       []byte      `json:"block"`
+}
+
+// ProofNode is the sibling hash on the path to the root, Left is set if
+// the sibling is the left node
+type ProofNode struct {
+	Hash string `json:"hash"`
+	Left bool   `json:"left"`
+}
+
+type merkleLeaf struct {
+	token       string
+	tokenType   int
+	blockNumber string
+	prevBlockID string
+	unpledgedID string
+	commitedDID string
+}
+
+func (l *merkleLeaf) hash() []byte {
+	data := fmt.Sprintf("%s|%d|%s|%s|%s|%s", l.token, l.tokenType, l.blockNumber, l.prevBlockID, l.unpledgedID, l.commitedDID)
+	return util.CalculateHash(append([]byte{merkleLeafPrefix}, []byte(data)...), "SHA3-256")
+}
+
+func merkleNode(l []byte, r []byte) []byte {
+	data := make([]byte, 0, 1+len(l)+len(r))
+	data = append(data, merkleNodePrefix)
+	data = append(data, l...)
+	data = append(data, r...)
+	return util.CalculateHash(data, "SHA3-256")
+}
+
+// merkleLeaves returns the leaves of the trans tokens map sorted by token id
+func merkleLeaves(tm interface{}) []*merkleLeaf {
+	tokens := make([]string, 0)
+	switch m := tm.(type) {
+	case map[string]interface{}:
+		for k := range m {
+			tokens = append(tokens, k)
+		}
+	case map[interface{}]interface{}:
+		for k := range m {
+			t, ok := k.(string)
+			if ok {
+				tokens = append(tokens, t)
+			}
+		}
+	default:
+		return nil
+	}
+	sort.Strings(tokens)
+	leaves := make([]*merkleLeaf, 0, len(tokens))
+	for _, t := range tokens {
+		ttm := util.GetFromMap(tm, t)
+		leaves = append(leaves, &merkleLeaf{
+			token:       t,
+			tokenType:   util.GetIntFromMap(ttm, TTTokenTypeKey),
+			blockNumber: util.GetStringFromMap(ttm, TTBlockNumberKey),
+			prevBlockID: util.GetStringFromMap(ttm, TTPreviousBlockIDKey),
+			unpledgedID: util.GetStringFromMap(ttm, TTUnpledgedIDKey),
+			commitedDID: util.GetStringFromMap(ttm, TTCommitedDIDKey),
+		})
+	}
+	return leaves
+}
+
+// merkleTree builds the tree and returns the root along with the proof path of the index
+func merkleTree(leaves []*merkleLeaf, index int) ([]byte, []ProofNode) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	level := make([][]byte, 0, len(leaves))
+	for _, l := range leaves {
+		level = append(level, l.hash())
+	}
+	path := make([]ProofNode, 0)
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			if index == i {
+				path = append(path, ProofNode{Hash: util.HexToStr(level[i+1])})
+			} else if index == i+1 {
+				path = append(path, ProofNode{Hash: util.HexToStr(level[i]), Left: true})
+			}
+			next = append(next, merkleNode(level[i], level[i+1]))
+		}
+		index = index / 2
+		level = next
+	}
+	return level[0], path
+}
+
+func tokensMerkleRoot(tm interface{}) string {
+	root, _ := merkleTree(merkleLeaves(tm), 0)
+	if root == nil {
+		return ""
+	}
+	return util.HexToStr(root)
+}
+
+// GetTokensMerkleRoot returns the merkle root of the trans tokens stored in the block,
+// blocks created before the root was recorded will return empty string
+func (b *Block) GetTokensMerkleRoot() string {
+	return b.getBlkString(TCTokensMerkleRootKey)
+}
+
+// GetTokenInclusionProof returns the inclusion proof of the token in the block
+func (b *Block) GetTokenInclusionProof(token string) (*TokenInclusionProof, error) {
+	root := b.GetTokensMerkleRoot()
+	if root == "" {
+		return nil, fmt.Errorf("block does not have tokens merkle root")
+	}
+	bh, err := b.GetHash()
+	if err != nil {
+		return nil, err
+	}
+	tim := util.GetFromMap(b.bm, TCTransInfoKey)
+	if tim == nil {
+		return nil, fmt.Errorf("invalid token chain block, missing transaction info")
+	}
+	leaves := merkleLeaves(util.GetFromMap(tim, TITokensKey))
+	index := -1
+	for i, l := range leaves {
+		if l.token == token {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("token is not part of the block")
+	}
+	cr, path := merkleTree(leaves, index)
+	if util.HexToStr(cr) != root {
+		return nil, fmt.Errorf("tokens merkle root does not match the block")
+	}
+	l := leaves[index]
+	return &TokenInclusionProof{
+		Token:       l.token,
+		TokenType:   l.tokenType,
+		BlockNumber: l.blockNumber,
+		PrevBlockID: l.prevBlockID,
+		UnpledgedID: l.unpledgedID,
+		CommitedDID: l.commitedDID,
+		Path:        path,
+		Root:        root,
+		BlockHash:   bh,
+		Block:       b.GetBlock(),
+	}, nil
+}
+
+// VerifyTokenInclusionProof verifies the proof against the signed block shipped with it,
+// the block hash is recalculated from the block data & the merkle root is taken from the
+// block and not from the proof, so the proof can not bring its own root. The verifier
+// should check the proof block hash against the token chain it trusts.
+func VerifyTokenInclusionProof(p *TokenInclusionProof) error {
+	if p == nil {
+		return fmt.Errorf("invalid proof")
+	}
+	b := InitBlock(p.Block, nil)
+	if b == nil {
+		return fmt.Errorf("invalid proof block")
+	}
+	bh, err := b.GetHash()
+	if err != nil {
+		return err
+	}
+	if p.BlockHash != bh {
+		return fmt.Errorf("block hash does not match")
+	}
+	err = b.VerifyTokensMerkleRoot()
+	if err != nil {
+		return err
+	}
+	if p.Root != b.GetTokensMerkleRoot() {
+		return fmt.Errorf("proof root does not match the block")
+	}
+	l := &merkleLeaf{
+		token:       p.Token,
+		tokenType:   p.TokenType,
+		blockNumber: p.BlockNumber,
+		prevBlockID: p.PrevBlockID,
+		unpledgedID: p.UnpledgedID,
+		commitedDID: p.CommitedDID,
+	}
+	h := l.hash()
+	for _, n := range p.Path {
+		sb := util.StrToHex(n.Hash)
+		if len(sb) != len(h) {
+			return fmt.Errorf("invalid proof node")
+		}
+		if n.Left {
+			h = merkleNode(sb, h)
+		} else {
+			h = merkleNode(h, sb)
+		}
+	}
+	if util.HexToStr(h) != p.Root {
+		return fmt.Errorf("token is not included in the merkle root")
+	}
+	return nil
+}
+
+// VerifyTokensMerkleRoot verifies the stored merkle root against the trans tokens of the block,
+// a block without trans tokens has no root
+func (b *Block) VerifyTokensMerkleRoot() error {
+	tim := util.GetFromMap(b.bm, TCTransInfoKey)
+	if tim == nil {
+		return fmt.Errorf("invalid token chain block, missing transaction info")
+	}
+	root := b.GetTokensMerkleRoot()
+	if tokensMerkleRoot(util.GetFromMap(tim, TITokensKey)) != root {
+		if root == "" {
+			return fmt.Errorf("block does not have tokens merkle root")
+		}
+		return fmt.Errorf("tokens merkle root does not match")
+	}
+	return nil
+}
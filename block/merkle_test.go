@@ -0,0 +1,80 @@
+package block
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTokenInclusionProof(t *testing.T) {
+	for n := 1; n <= 7; n++ {
+		tm := make(map[string]interface{})
+		for i := 0; i < n; i++ {
+			tm[fmt.Sprintf("token%d", i)] = map[string]interface{}{
+				TTTokenTypeKey:       0,
+				TTBlockNumberKey:     fmt.Sprintf("%d", i+1),
+				TTPreviousBlockIDKey: fmt.Sprintf("%d-prevhash", i),
+			}
+		}
+		b := InitBlock(nil, map[string]interface{}{
+			TCTransInfoKey:        map[string]interface{}{TITokensKey: tm},
+			TCTokensMerkleRootKey: tokensMerkleRoot(tm),
+			TCSignatureKey:        map[string]interface{}{"owner": "signature"},
+		})
+		err := b.VerifyTokensMerkleRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			p, err := b.GetTokenInclusionProof(fmt.Sprintf("token%d", i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = VerifyTokenInclusionProof(p)
+			if err != nil {
+				t.Fatalf("failed to verify proof of token%d of %d tokens: %v", i, n, err)
+			}
+			p.BlockNumber = "100"
+			if VerifyTokenInclusionProof(p) == nil {
+				t.Fatal("tampered proof verified")
+			}
+			p.BlockNumber = fmt.Sprintf("%d", i+1)
+			p.Root = "00"
+			if VerifyTokenInclusionProof(p) == nil {
+				t.Fatal("proof with its own root verified")
+			}
+			p.Root = b.GetTokensMerkleRoot()
+			p.BlockHash = "00"
+			if VerifyTokenInclusionProof(p) == nil {
+				t.Fatal("proof with the other block hash verified")
+			}
+			bh, _ := b.GetHash()
+			p.BlockHash = bh
+			p.Block = tamperedBlock(t, b)
+			if VerifyTokenInclusionProof(p) == nil {
+				t.Fatal("proof with the tampered block verified")
+			}
+			p.Block = nil
+			if VerifyTokenInclusionProof(p) == nil {
+				t.Fatal("proof without the block verified")
+			}
+		}
+		_, err = b.GetTokenInclusionProof("unknown")
+		if err == nil {
+			t.Fatal("proof generated for unknown token")
+		}
+	}
+}
+
+// tamperedBlock returns the block data with the different tokens merkle root
+func tamperedBlock(t *testing.T, b *Block) []byte {
+	bm := make(map[string]interface{})
+	for k, v := range b.bm {
+		bm[k] = v
+	}
+	bm[TCTokensMerkleRootKey] = "00"
+	tb := InitBlock(nil, bm)
+	if tb == nil {
+		t.Fatal("failed to create the tampered block")
+	}
+	return tb.GetBlock()
+}
@@ -0,0 +1,70 @@
+package block
+
+import (
+	"github.com/rubixchain/rubixgoplatform/util"
+)
+
+// ----------ConsensusPolicy--------------------
+// {
+//   "1" : QuorumCount  : int
+//   "2" : MinQuorum    : int
+//   "3" : MinConsensus : int
+//   "4" : AlphaCount   : int
+//   "5" : BetaCount    : int
+//   "6" : GammaCount   : int
+//   "7" : Mode         : string
+// }
+
+const (
+	CPQuorumCountKey  string = "1"
+	CPMinQuorumKey    string = "2"
+	CPMinConsensusKey string = "3"
+	CPAlphaCountKey   string = "4"
+	CPBetaCountKey    string = "5"
+	CPGammaCountKey   string = "6"
+	CPModeKey         string = "7"
+)
+
+// ConsensusPolicy is the quorum policy which was in force when the block was created,
+// Mode is the name of the consensus mode the policy was configured for
+type ConsensusPolicy struct {
+	QuorumCount  int    `json:"quorumCount"`
+	MinQuorum    int    `json:"minQuorum"`
+	MinConsensus int    `json:"minConsensus"`
+	AlphaCount   int    `json:"alphaCount"`
+	BetaCount    int    `json:"betaCount"`
+	GammaCount   int    `json:"gammaCount"`
+	Mode         string `json:"mode"`
+}
+
+func newConsensusPolicy(cp *ConsensusPolicy) map[string]interface{} {
+	ncp := make(map[string]interface{})
+	ncp[CPQuorumCountKey] = cp.QuorumCount
+	ncp[CPMinQuorumKey] = cp.MinQuorum
+	ncp[CPMinConsensusKey] = cp.MinConsensus
+	ncp[CPAlphaCountKey] = cp.AlphaCount
+	ncp[CPBetaCountKey] = cp.BetaCount
+	ncp[CPGammaCountKey] = cp.GammaCount
+	if cp.Mode != "" {
+		ncp[CPModeKey] = cp.Mode
+	}
+	return ncp
+}
+
+// GetConsensusPolicy returns the consensus policy recorded in the block,
+// blocks created before the policy was recorded will return nil
+func (b *Block) GetConsensusPolicy() *ConsensusPolicy {
+	cpm := util.GetFromMap(b.bm, TCConsensusPolicyKey)
+	if cpm == nil {
+		return nil
+	}
+	return &ConsensusPolicy{
+		QuorumCount:  util.GetIntFromMap(cpm, CPQuorumCountKey),
+		MinQuorum:    util.GetIntFromMap(cpm, CPMinQuorumKey),
+		MinConsensus: util.GetIntFromMap(cpm, CPMinConsensusKey),
+		AlphaCount:   util.GetIntFromMap(cpm, CPAlphaCountKey),
+		BetaCount:    util.GetIntFromMap(cpm, CPBetaCountKey),
+		GammaCount:   util.GetIntFromMap(cpm, CPGammaCountKey),
+		Mode:         util.GetStringFromMap(cpm, CPModeKey),
+	}
+}
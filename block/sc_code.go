@@ -0,0 +1,50 @@
+package block
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SCCode is the code of the smart contract set by the upgrade, the code token
+// is the smart contract token generated for the new code. The first version
+// is the code of the deployed smart contract token.
+type SCCode struct {
+	Version        int    `json:"version"`
+	CodeToken      string `json:"codeToken"`
+	BinaryCodeHash string `json:"binaryCodeHash"`
+	RawCodeHash    string `json:"rawCodeHash"`
+	SchemaCodeHash string `json:"schemaCodeHash"`
+}
+
+// EncodeSCCode encodes the code as recorded in the contract and the block
+func EncodeSCCode(code *SCCode) (string, error) {
+	if code.Version < 2 || code.CodeToken == "" || code.BinaryCodeHash == "" {
+		return "", fmt.Errorf("invalid smart contract code")
+	}
+	b, err := json.Marshal(code)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeSCCode decodes the encoded code, nil if the code is not set
+func DecodeSCCode(s string) (*SCCode, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var code SCCode
+	err := json.Unmarshal([]byte(s), &code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smart contract code, %v", err)
+	}
+	if code.Version < 2 || code.CodeToken == "" || code.BinaryCodeHash == "" {
+		return nil, fmt.Errorf("invalid smart contract code")
+	}
+	return &code, nil
+}
+
+// GetSCCode returns the code set by the upgrade block, nil for the other blocks
+func (b *Block) GetSCCode() (*SCCode, error) {
+	return DecodeSCCode(b.getBlkString(TCSCCodeKey))
+}
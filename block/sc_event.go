@@ -0,0 +1,90 @@
+package block
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Limits of the smart contract events of one execution
+const (
+	MaxSCEvents        int = 64
+	MaxSCEventTopics   int = 4
+	MaxSCEventNameSize int = 64
+	MaxSCEventDataSize int = 16 << 10
+)
+
+// SCEvent is the event emitted by the smart contract execution, the topics
+// are indexed so the events can be filtered by them
+type SCEvent struct {
+	Name   string   `json:"name"`
+	Topics []string `json:"topics,omitempty"`
+	Data   string   `json:"data,omitempty"`
+}
+
+// Validate checks the event against the limits
+func (ev *SCEvent) Validate() error {
+	if ev.Name == "" || len(ev.Name) > MaxSCEventNameSize {
+		return fmt.Errorf("invalid smart contract event name")
+	}
+	if len(ev.Topics) > MaxSCEventTopics {
+		return fmt.Errorf("smart contract event %s has more than %d topics", ev.Name, MaxSCEventTopics)
+	}
+	for _, t := range ev.Topics {
+		if t == "" || len(t) > MaxSCEventNameSize {
+			return fmt.Errorf("invalid topic of the smart contract event %s", ev.Name)
+		}
+	}
+	if len(ev.Data) > MaxSCEventDataSize {
+		return fmt.Errorf("data of the smart contract event %s is too large", ev.Name)
+	}
+	return nil
+}
+
+// EncodeSCEvents validates the events and encodes them as recorded in the
+// contract and the block, no events is the empty string
+func EncodeSCEvents(evs []SCEvent) (string, error) {
+	if len(evs) == 0 {
+		return "", nil
+	}
+	if len(evs) > MaxSCEvents {
+		return "", fmt.Errorf("smart contract emitted more than %d events", MaxSCEvents)
+	}
+	for i := range evs {
+		err := evs[i].Validate()
+		if err != nil {
+			return "", err
+		}
+	}
+	b, err := json.Marshal(evs)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeSCEvents decodes and validates the encoded events
+func DecodeSCEvents(s string) ([]SCEvent, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var evs []SCEvent
+	err := json.Unmarshal([]byte(s), &evs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smart contract events, %v", err)
+	}
+	if len(evs) > MaxSCEvents {
+		return nil, fmt.Errorf("smart contract emitted more than %d events", MaxSCEvents)
+	}
+	for i := range evs {
+		err = evs[i].Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evs, nil
+}
+
+// GetSCEvents returns the events emitted by the smart contract execution
+func (b *Block) GetSCEvents() ([]SCEvent, error) {
+	return DecodeSCEvents(b.getBlkString(TCSCEventsKey))
+}
@@ -0,0 +1,1770 @@
+// Code generated by apigen from the OpenAPI spec of the node. DO NOT EDIT.
+
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/did"
+)
+
+// API is the typed client of the node API
+type API struct {
+	c *Client
+}
+
+// API returns the typed client of the node API
+func (c *Client) API() *API {
+	return &API{c: c}
+}
+
+// AddAPIKey calls POST /api/api-keys - Add API key
+func (a *API) AddAPIKey(in *model.APIKeyRequest, timeout ...time.Duration) (*model.APIKeyReply, error) {
+	var out model.APIKeyReply
+	err := a.c.sendJSONRequest("POST", "/api/api-keys", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddBootstrap calls POST /api/add-bootstrap - Add bootstrap peers
+func (a *API) AddBootstrap(in *model.BootStrapPeers, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/add-bootstrap", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddExplorer calls POST /api/add-explorer - Add explorer URLs
+func (a *API) AddExplorer(in *model.ExplorerLinks, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/add-explorer", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddPeerDetails calls POST /api/add-peer-details - Add Peer
+func (a *API) AddPeerDetails(in *model.PeerDetails, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/add-peer-details", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddQuorum calls POST /api/addquorum - Add the quorum list
+func (a *API) AddQuorum(in []core.QuorumData, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/addquorum", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddUserAPIKeyParams is the query of AddUserAPIKey
+type AddUserAPIKeyParams struct {
+	// DID
+	DID string
+	// API key
+	ApiKey string
+}
+
+// AddUserAPIKey calls POST /api/add-user-api-key - Add the API key of the DID
+func (a *API) AddUserAPIKey(p *AddUserAPIKeyParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.ApiKey != "" {
+			q["apiKey"] = p.ApiKey
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/add-user-api-key", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddWebhook calls POST /api/webhooks - Add webhook
+func (a *API) AddWebhook(in *model.WebhookRequest, timeout ...time.Duration) (*model.WebhookReply, error) {
+	var out model.WebhookReply
+	err := a.c.sendJSONRequest("POST", "/api/webhooks", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckDataTokenParams is the query of CheckDataToken
+type CheckDataTokenParams struct {
+	// Data token
+	DataToken string
+}
+
+// CheckDataToken calls POST /api/check-data-token - Check the data token is committed
+func (a *API) CheckDataToken(p *CheckDataTokenParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DataToken != "" {
+			q["data_token"] = p.DataToken
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/check-data-token", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckPinnedStateParams is the query of CheckPinnedState
+type CheckPinnedStateParams struct {
+	// Token State Hash
+	Tokenstatehash string
+}
+
+// CheckPinnedState calls DELETE /api/check-pinned-state - Check for exhausted token state hash
+func (a *API) CheckPinnedState(p *CheckPinnedStateParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Tokenstatehash != "" {
+			q["tokenstatehash"] = p.Tokenstatehash
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("DELETE", "/api/check-pinned-state", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckQuorumStatusParams is the query of CheckQuorumStatus
+type CheckQuorumStatusParams struct {
+	// Quorum DID
+	QuorumAddress string
+}
+
+// CheckQuorumStatus calls GET /api/check-quorum-status - Check the quorum status
+func (a *API) CheckQuorumStatus(p *CheckQuorumStatusParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.QuorumAddress != "" {
+			q["quorumAddress"] = p.QuorumAddress
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/check-quorum-status", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckpointTokenChain calls POST /api/checkpoint-token-chain - Checkpoint the token chain
+func (a *API) CheckpointTokenChain(in *model.TokenChainCheckpointRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/checkpoint-token-chain", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CommitDataTokenParams is the query of CommitDataToken
+type CommitDataTokenParams struct {
+	// DID
+	DID string
+	// Batch ID
+	BatchID string
+}
+
+// CommitDataToken calls POST /api/commit-data-token - Commit Data Token
+func (a *API) CommitDataToken(p *CommitDataTokenParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.BatchID != "" {
+			q["batchID"] = p.BatchID
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/commit-data-token", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateDataTokenParams is the query of CreateDataToken
+type CreateDataTokenParams struct {
+	// DID
+	DID string
+}
+
+// CreateDataToken calls POST /api/create-data-token - Create Data Token
+func (a *API) CreateDataToken(p *CreateDataTokenParams, fields map[string]string, files map[string]string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendMutiFormRequest("POST", "/api/create-data-token", q, fields, files, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateDID calls POST /api/createdid - Create DID
+func (a *API) CreateDID(fields map[string]string, files map[string]string, timeout ...time.Duration) (*model.DIDResponse, error) {
+	var out model.DIDResponse
+	err := a.c.sendMutiFormRequest("POST", "/api/createdid", nil, fields, files, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateFT calls POST /api/create-ft - Create FT
+func (a *API) CreateFT(in *model.CreateFTReq, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/create-ft", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateNFT calls POST /api/create-nft - Create NFT
+func (a *API) CreateNFT(fields map[string]string, files map[string]string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendMutiFormRequest("POST", "/api/create-nft", nil, fields, files, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeployNFT calls POST /api/deploy-nft - Deploy NFT
+func (a *API) DeployNFT(in *model.DeployNFTRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/deploy-nft", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeploySmartContract calls POST /api/deploy-smart-contract - Deploy Smart Contract
+func (a *API) DeploySmartContract(in *model.DeploySmartContractRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/deploy-smart-contract", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DumpFTTokenChain calls POST /api/dump-ft-token-chain - Dump the FT token chain
+func (a *API) DumpFTTokenChain(in *model.TCDumpRequest, timeout ...time.Duration) (*model.TCDumpReply, error) {
+	var out model.TCDumpReply
+	err := a.c.sendJSONRequest("POST", "/api/dump-ft-token-chain", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DumpNFTTokenChainParams is the query of DumpNFTTokenChain
+type DumpNFTTokenChainParams struct {
+	// NFT
+	NFT string
+	// Block ID to start from
+	BlockId string
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// block
+	Sort string
+}
+
+// DumpNFTTokenChain calls GET /api/dump-nft-token-chain - Dump the NFT token chain
+func (a *API) DumpNFTTokenChain(p *DumpNFTTokenChainParams, timeout ...time.Duration) (*model.TCDumpReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.NFT != "" {
+			q["nft"] = p.NFT
+		}
+		if p.BlockId != "" {
+			q["blockId"] = p.BlockId
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.TCDumpReply
+	err := a.c.sendJSONRequest("GET", "/api/dump-nft-token-chain", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DumpSmartContractTokenChain calls POST /api/dump-smart-contract-token-chain - Dump the smart contract token chain
+func (a *API) DumpSmartContractTokenChain(in *model.TCDumpRequest, timeout ...time.Duration) (*model.TCDumpReply, error) {
+	var out model.TCDumpReply
+	err := a.c.sendJSONRequest("POST", "/api/dump-smart-contract-token-chain", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DumpTokenChain calls POST /api/dump-token-chain - Dump the token chain
+func (a *API) DumpTokenChain(in *model.TCDumpRequest, timeout ...time.Duration) (*model.TCDumpReply, error) {
+	var out model.TCDumpReply
+	err := a.c.sendJSONRequest("POST", "/api/dump-token-chain", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExecuteNFT calls POST /api/execute-nft - Execution of NFT
+func (a *API) ExecuteNFT(in *model.ExecuteNFTRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/execute-nft", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExecuteSmartContract calls POST /api/execute-smart-contract - Execute Smart Contract
+func (a *API) ExecuteSmartContract(in *model.ExecuteSmartContractRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/execute-smart-contract", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportToken calls POST /api/export-token - Export the token
+func (a *API) ExportToken(in *model.ExportTokenRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/export-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FaucetTokenCheckParams is the query of FaucetTokenCheck
+type FaucetTokenCheckParams struct {
+	// Token
+	Token string
+	// DID
+	DID string
+}
+
+// FaucetTokenCheck calls GET /api/faucet-token-check - Check the faucet token
+func (a *API) FaucetTokenCheck(p *FaucetTokenCheckParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/faucet-token-check", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FetchNFTParams is the query of FetchNFT
+type FetchNFTParams struct {
+	// NFT
+	NFT string
+}
+
+// FetchNFT calls GET /api/fetch-nft - Fetch NFT
+func (a *API) FetchNFT(p *FetchNFTParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.NFT != "" {
+			q["nft"] = p.NFT
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/fetch-nft", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FetchSmartContractParams is the query of FetchSmartContract
+type FetchSmartContractParams struct {
+	// Smart contract token
+	SmartContractToken string
+}
+
+// FetchSmartContract calls GET /api/fetch-smart-contract - Fetch Smart Contract
+func (a *API) FetchSmartContract(p *FetchSmartContractParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.SmartContractToken != "" {
+			q["smartContractToken"] = p.SmartContractToken
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/fetch-smart-contract", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateFaucetTestToken calls POST /api/generate-faucettest-token - Generate the faucet test tokens
+func (a *API) GenerateFaucetTestToken(in *model.FaucetRBTGenerateRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/generate-faucettest-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateSmartContract calls POST /api/generate-smart-contract - Generate Smart Contract
+func (a *API) GenerateSmartContract(fields map[string]string, files map[string]string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendMutiFormRequest("POST", "/api/generate-smart-contract", nil, fields, files, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateTestToken calls POST /api/generate-test-token - Generate the test tokens
+func (a *API) GenerateTestToken(in *model.RBTGenerateRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/generate-test-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAccountInfoParams is the query of GetAccountInfo
+type GetAccountInfoParams struct {
+	// DID
+	DID string
+}
+
+// GetAccountInfo calls GET /api/get-account-info - Check account balance
+func (a *API) GetAccountInfo(p *GetAccountInfoParams, timeout ...time.Duration) (*model.GetAccountInfo, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.GetAccountInfo
+	err := a.c.sendJSONRequest("GET", "/api/get-account-info", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllBootstrap calls GET /api/get-all-bootstrap - Get all bootstrap peers
+func (a *API) GetAllBootstrap(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/get-all-bootstrap", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllDIDParams is the query of GetAllDID
+type GetAllDIDParams struct {
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// did
+	Sort string
+}
+
+// GetAllDID calls GET /api/getalldid - Get all DIDs
+func (a *API) GetAllDID(p *GetAllDIDParams, timeout ...time.Duration) (*model.GetAccountInfo, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.GetAccountInfo
+	err := a.c.sendJSONRequest("GET", "/api/getalldid", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllExplorer calls GET /api/get-all-explorer - Get all explorer URLs
+func (a *API) GetAllExplorer(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/get-all-explorer", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllQuorum calls GET /api/getallquorum - Get the quorum list
+func (a *API) GetAllQuorum(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/getallquorum", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllTokensParams is the query of GetAllTokens
+type GetAllTokensParams struct {
+	// DID
+	DID string
+	// Token type
+	Type string
+	// Token type filter of the RBT tokens, whole or part
+	TokenType string
+	// Comma separated token status
+	Status string
+	// Minimum token value
+	MinValue float64
+	// Maximum token value
+	MaxValue float64
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// token, value or status, prefix with - for descending order
+	Sort string
+}
+
+// GetAllTokens calls GET /api/getalltokens - Get the tokens of the DID
+func (a *API) GetAllTokens(p *GetAllTokensParams, timeout ...time.Duration) (*model.TokenResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.Type != "" {
+			q["type"] = p.Type
+		}
+		if p.TokenType != "" {
+			q["token_type"] = p.TokenType
+		}
+		if p.Status != "" {
+			q["status"] = p.Status
+		}
+		if p.MinValue != 0 {
+			q["min_value"] = strconv.FormatFloat(p.MinValue, 'f', -1, 64)
+		}
+		if p.MaxValue != 0 {
+			q["max_value"] = strconv.FormatFloat(p.MaxValue, 'f', -1, 64)
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.TokenResponse
+	err := a.c.sendJSONRequest("GET", "/api/getalltokens", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAPIKeys calls GET /api/api-keys - List API keys
+func (a *API) GetAPIKeys(timeout ...time.Duration) (*model.APIKeyListReply, error) {
+	var out model.APIKeyListReply
+	err := a.c.sendJSONRequest("GET", "/api/api-keys", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetByCommentParams is the query of GetByComment
+type GetByCommentParams struct {
+	// Transaction comment
+	Comment string
+}
+
+// GetByComment calls GET /api/get-by-comment - Get transaction details by Transcation Comment
+func (a *API) GetByComment(p *GetByCommentParams, timeout ...time.Duration) (*model.TxnDetails, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Comment != "" {
+			q["Comment"] = p.Comment
+		}
+	}
+	var out model.TxnDetails
+	err := a.c.sendJSONRequest("GET", "/api/get-by-comment", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetByDIDParams is the query of GetByDID
+type GetByDIDParams struct {
+	// DID
+	DID string
+	// Sender or Receiver
+	Role string
+	// Start date of the date range, YYYY-MM-DD
+	StartDate string
+	// End date of the date range, YYYY-MM-DD
+	EndDate string
+	// Minimum amount
+	MinAmount float64
+	// Maximum amount
+	MaxAmount float64
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// date or amount, prefix with - for descending order, default -date
+	Sort string
+}
+
+// GetByDID calls GET /api/get-by-did - Get transaction details by dID
+func (a *API) GetByDID(p *GetByDIDParams, timeout ...time.Duration) (*model.TxnDetails, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["DID"] = p.DID
+		}
+		if p.Role != "" {
+			q["Role"] = p.Role
+		}
+		if p.StartDate != "" {
+			q["StartDate"] = p.StartDate
+		}
+		if p.EndDate != "" {
+			q["EndDate"] = p.EndDate
+		}
+		if p.MinAmount != 0 {
+			q["min_amount"] = strconv.FormatFloat(p.MinAmount, 'f', -1, 64)
+		}
+		if p.MaxAmount != 0 {
+			q["max_amount"] = strconv.FormatFloat(p.MaxAmount, 'f', -1, 64)
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.TxnDetails
+	err := a.c.sendJSONRequest("GET", "/api/get-by-did", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetConsensusJournal calls GET /api/consensus-journal - List the interrupted transactions
+func (a *API) GetConsensusJournal(timeout ...time.Duration) (*model.ConsensusJournalListReply, error) {
+	var out model.ConsensusJournalListReply
+	err := a.c.sendJSONRequest("GET", "/api/consensus-journal", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDataTokenParams is the query of GetDataToken
+type GetDataTokenParams struct {
+	// DID
+	DID string
+}
+
+// GetDataToken calls GET /api/get-data-token - Get Data Token
+func (a *API) GetDataToken(p *GetDataTokenParams, timeout ...time.Duration) (*model.DataTokenResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.DataTokenResponse
+	err := a.c.sendJSONRequest("GET", "/api/get-data-token", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDIDChallengeParams is the query of GetDIDChallenge
+type GetDIDChallengeParams struct {
+	// DID
+	DID string
+}
+
+// GetDIDChallenge calls GET /api/getdidchallenge - Get the login challenge of the DID
+func (a *API) GetDIDChallenge(p *GetDIDChallengeParams, timeout ...time.Duration) (*model.DIDAccessResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.DIDAccessResponse
+	err := a.c.sendJSONRequest("GET", "/api/getdidchallenge", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFTInfoByDIDParams is the query of GetFTInfoByDID
+type GetFTInfoByDIDParams struct {
+	// DID
+	DID string
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// name, prefix with - for descending order
+	Sort string
+}
+
+// GetFTInfoByDID calls GET /api/get-ft-info-by-did - Get FT balance information for a given DID
+func (a *API) GetFTInfoByDID(p *GetFTInfoByDIDParams, timeout ...time.Duration) (*model.GetFTInfo, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.GetFTInfo
+	err := a.c.sendJSONRequest("GET", "/api/get-ft-info-by-did", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFTTokenChainParams is the query of GetFTTokenChain
+type GetFTTokenChainParams struct {
+	// FT Token ID
+	TokenID string
+}
+
+// GetFTTokenChain calls GET /api/get-ft-token-chain - Get FT Token Chain Data
+func (a *API) GetFTTokenChain(p *GetFTTokenChainParams, timeout ...time.Duration) (*model.GetFTTokenChainReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.TokenID != "" {
+			q["tokenID"] = p.TokenID
+		}
+	}
+	var out model.GetFTTokenChainReply
+	err := a.c.sendJSONRequest("GET", "/api/get-ft-token-chain", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetJob calls GET /api/jobs/{id} - Get the job
+func (a *API) GetJob(id string, timeout ...time.Duration) (*model.JobReply, error) {
+	path := "/api/jobs/{id}"
+	path = strings.Replace(path, "{id}", url.PathEscape(id), 1)
+	var out model.JobReply
+	err := a.c.sendJSONRequest("GET", path, nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetJobsParams is the query of GetJobs
+type GetJobsParams struct {
+	// DID
+	DID string
+	// Job type
+	Type string
+	// Job status
+	Status string
+	// Maximum number of jobs
+	Limit int
+}
+
+// GetJobs calls GET /api/jobs - List the jobs
+func (a *API) GetJobs(p *GetJobsParams, timeout ...time.Duration) (*model.JobListReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.Type != "" {
+			q["type"] = p.Type
+		}
+		if p.Status != "" {
+			q["status"] = p.Status
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+	}
+	var out model.JobListReply
+	err := a.c.sendJSONRequest("GET", "/api/jobs", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNFTTokenChainDataParams is the query of GetNFTTokenChainData
+type GetNFTTokenChainDataParams struct {
+	// NFT
+	NFT string
+	// Set to true if you only need the latest token block
+	Latest string
+}
+
+// GetNFTTokenChainData calls GET /api/get-nft-token-chain-data - Get NFT Token Chain Data
+func (a *API) GetNFTTokenChainData(p *GetNFTTokenChainDataParams, timeout ...time.Duration) (*model.NFTDataReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.NFT != "" {
+			q["nft"] = p.NFT
+		}
+		if p.Latest != "" {
+			q["latest"] = p.Latest
+		}
+	}
+	var out model.NFTDataReply
+	err := a.c.sendJSONRequest("GET", "/api/get-nft-token-chain-data", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNFTsByDIDParams is the query of GetNFTsByDID
+type GetNFTsByDIDParams struct {
+	// DID
+	DID string
+	// Comma separated token status
+	Status string
+	// Minimum NFT value
+	MinValue float64
+	// Maximum NFT value
+	MaxValue float64
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// nft or value, prefix with - for descending order
+	Sort string
+}
+
+// GetNFTsByDID calls GET /api/get-nfts-by-did - Get NFTs owned by the particular did
+func (a *API) GetNFTsByDID(p *GetNFTsByDIDParams, timeout ...time.Duration) (*model.NFTList, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.Status != "" {
+			q["status"] = p.Status
+		}
+		if p.MinValue != 0 {
+			q["min_value"] = strconv.FormatFloat(p.MinValue, 'f', -1, 64)
+		}
+		if p.MaxValue != 0 {
+			q["max_value"] = strconv.FormatFloat(p.MaxValue, 'f', -1, 64)
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.NFTList
+	err := a.c.sendJSONRequest("GET", "/api/get-nfts-by-did", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPeerID calls GET /api/get-peer-id - Get the peer ID of the node
+func (a *API) GetPeerID(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/get-peer-id", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPledgedTokenDetails calls GET /api/get-pledgedtoken-details - Get details about the pledged tokens
+func (a *API) GetPledgedTokenDetails(timeout ...time.Duration) (*model.TokenStateResponse, error) {
+	var out model.TokenStateResponse
+	err := a.c.sendJSONRequest("GET", "/api/get-pledgedtoken-details", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSmartContractEventsParams is the query of GetSmartContractEvents
+type GetSmartContractEventsParams struct {
+	// Smart contract token
+	Token string
+	// Event name
+	Name string
+	// Event topic
+	Topic string
+	// First block of the events
+	FromBlock int
+	// Last block of the events, latest block if not set
+	ToBlock int
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// block, prefix with - for descending order
+	Sort string
+}
+
+// GetSmartContractEvents calls GET /api/get-smart-contract-events - Get the smart contract events
+func (a *API) GetSmartContractEvents(p *GetSmartContractEventsParams, timeout ...time.Duration) (*model.SmartContractEventsReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+		if p.Name != "" {
+			q["name"] = p.Name
+		}
+		if p.Topic != "" {
+			q["topic"] = p.Topic
+		}
+		if p.FromBlock != 0 {
+			q["fromBlock"] = strconv.Itoa(p.FromBlock)
+		}
+		if p.ToBlock != 0 {
+			q["toBlock"] = strconv.Itoa(p.ToBlock)
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.SmartContractEventsReply
+	err := a.c.sendJSONRequest("GET", "/api/get-smart-contract-events", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSmartContractTokenChainData calls POST /api/get-smart-contract-token-chain-data - Get Smart Contract Token Chain Data
+func (a *API) GetSmartContractTokenChainData(in *model.SmartContractTokenChainDataReq, timeout ...time.Duration) (*model.SmartContractDataReply, error) {
+	var out model.SmartContractDataReply
+	err := a.c.sendJSONRequest("POST", "/api/get-smart-contract-token-chain-data", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSmartContractVersionsParams is the query of GetSmartContractVersions
+type GetSmartContractVersionsParams struct {
+	// Smart contract token
+	Token string
+}
+
+// GetSmartContractVersions calls GET /api/get-smart-contract-versions - Get the code versions of the smart contract
+func (a *API) GetSmartContractVersions(p *GetSmartContractVersionsParams, timeout ...time.Duration) (*model.SmartContractVersionsReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+	}
+	var out model.SmartContractVersionsReply
+	err := a.c.sendJSONRequest("GET", "/api/get-smart-contract-versions", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTokenProofParams is the query of GetTokenProof
+type GetTokenProofParams struct {
+	// Token
+	Token string
+	// Block ID, latest block if not set
+	BlockID string
+}
+
+// GetTokenProof calls GET /api/get-token-proof - Get the inclusion proof of the token chain block
+func (a *API) GetTokenProof(p *GetTokenProofParams, timeout ...time.Duration) (*model.TokenProofReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+		if p.BlockID != "" {
+			q["blockID"] = p.BlockID
+		}
+	}
+	var out model.TokenProofReply
+	err := a.c.sendJSONRequest("GET", "/api/get-token-proof", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTxnDetailsByIDParams is the query of GetTxnDetailsByID
+type GetTxnDetailsByIDParams struct {
+	// The ID of the transaction to retrieve
+	TxnID string
+}
+
+// GetTxnDetailsByID calls GET /api/get-by-txnId - Get transaction details by Transcation ID
+func (a *API) GetTxnDetailsByID(p *GetTxnDetailsByIDParams, timeout ...time.Duration) (*model.TxnDetails, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.TxnID != "" {
+			q["txnID"] = p.TxnID
+		}
+	}
+	var out model.TxnDetails
+	err := a.c.sendJSONRequest("GET", "/api/get-by-txnId", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTxnDetailsByNode calls GET /api/get-by-node - Get count of incoming and outgoing txns of the DID ins a node
+func (a *API) GetTxnDetailsByNode(timeout ...time.Duration) (*model.TxnCountForDID, error) {
+	var out model.TxnCountForDID
+	err := a.c.sendJSONRequest("GET", "/api/get-by-node", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWebhookDeliveriesParams is the query of GetWebhookDeliveries
+type GetWebhookDeliveriesParams struct {
+	// Webhook ID
+	WebhookID string
+	// Delivery status
+	Status string
+	// Maximum number of deliveries
+	Limit int
+}
+
+// GetWebhookDeliveries calls GET /api/webhook-deliveries - List webhook deliveries
+func (a *API) GetWebhookDeliveries(p *GetWebhookDeliveriesParams, timeout ...time.Duration) (*model.WebhookDeliveryListReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.WebhookID != "" {
+			q["webhook_id"] = p.WebhookID
+		}
+		if p.Status != "" {
+			q["status"] = p.Status
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+	}
+	var out model.WebhookDeliveryListReply
+	err := a.c.sendJSONRequest("GET", "/api/webhook-deliveries", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWebhooksParams is the query of GetWebhooks
+type GetWebhooksParams struct {
+	// DID
+	DID string
+}
+
+// GetWebhooks calls GET /api/webhooks - List webhooks
+func (a *API) GetWebhooks(p *GetWebhooksParams, timeout ...time.Duration) (*model.WebhookListReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+	}
+	var out model.WebhookListReply
+	err := a.c.sendJSONRequest("GET", "/api/webhooks", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportToken calls POST /api/import-token - Import the token
+func (a *API) ImportToken(in *model.ImportTokenRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/import-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InitiateFTTransfer calls POST /api/initiate-ft-transfer - Initiate an FT transfer
+func (a *API) InitiateFTTransfer(in *model.TransferFTReq, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/initiate-ft-transfer", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InitiatePinToken calls POST /api/initiate-pin-token - Initiate Pin Token
+func (a *API) InitiatePinToken(in *model.RBTPinRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/initiate-pin-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InitiateRBTTransfer calls POST /api/initiate-rbt-transfer - Initiate RBT Transfer
+func (a *API) InitiateRBTTransfer(in *model.RBTTransferRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/initiate-rbt-transfer", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InitiateSelfTransfer calls POST /api/initiate-self-transfer - Initiate Self Transfer
+func (a *API) InitiateSelfTransfer(in *model.RBTTransferRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/initiate-self-transfer", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListNFTsParams is the query of ListNFTs
+type ListNFTsParams struct {
+	// Comma separated token status
+	Status string
+	// Minimum NFT value
+	MinValue float64
+	// Maximum NFT value
+	MaxValue float64
+	// Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor
+	Limit int
+	// Next cursor of the previous page
+	Cursor string
+	// nft or value, prefix with - for descending order
+	Sort string
+}
+
+// ListNFTs calls GET /api/list-nfts - Get ALL NFTs
+func (a *API) ListNFTs(p *ListNFTsParams, timeout ...time.Duration) (*model.NFTList, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Status != "" {
+			q["status"] = p.Status
+		}
+		if p.MinValue != 0 {
+			q["min_value"] = strconv.FormatFloat(p.MinValue, 'f', -1, 64)
+		}
+		if p.MaxValue != 0 {
+			q["max_value"] = strconv.FormatFloat(p.MaxValue, 'f', -1, 64)
+		}
+		if p.Limit != 0 {
+			q["limit"] = strconv.Itoa(p.Limit)
+		}
+		if p.Cursor != "" {
+			q["cursor"] = p.Cursor
+		}
+		if p.Sort != "" {
+			q["sort"] = p.Sort
+		}
+	}
+	var out model.NFTList
+	err := a.c.sendJSONRequest("GET", "/api/list-nfts", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LockTokens calls POST /api/lock-tokens - Lock the tokens
+func (a *API) LockTokens(in []string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/lock-tokens", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LoginDID calls POST /api/logindid - Get the access token of the DID
+func (a *API) LoginDID(in *model.GetDIDAccess, timeout ...time.Duration) (*model.DIDAccessResponse, error) {
+	var out model.DIDAccessResponse
+	err := a.c.sendJSONRequest("POST", "/api/logindid", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MigrateNode calls POST /api/migrate-node - Migrate the node
+func (a *API) MigrateNode(in *core.MigrateRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/migrate-node", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// NodeStatus calls GET /api/node-status - Check the node status
+func (a *API) NodeStatus(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/node-status", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OpenAPI calls GET /api/openapi.json - Get the OpenAPI spec of the node API
+func (a *API) OpenAPI(timeout ...time.Duration) (map[string]interface{}, error) {
+	var out map[string]interface{}
+	err := a.c.sendJSONRequest("GET", "/api/openapi.json", nil, nil, &out, timeout...)
+	return out, err
+}
+
+// PingParams is the query of Ping
+type PingParams struct {
+	// Peer ID
+	PeerID string
+}
+
+// Ping calls GET /api/ping - Ping the peer
+func (a *API) Ping(p *PingParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.PeerID != "" {
+			q["peerID"] = p.PeerID
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/ping", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PublishSmartContract calls POST /api/publish-smart-contract - Publish the smart contract event
+func (a *API) PublishSmartContract(in *model.NewContractEvent, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/publish-smart-contract", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// QuerySmartContractStateParams is the query of QuerySmartContractState
+type QuerySmartContractStateParams struct {
+	// Smart contract token
+	Token string
+	// State key
+	Key string
+	// Block number, latest block if not set
+	BlockNumber int
+}
+
+// QuerySmartContractState calls GET /api/query-smart-contract-state - Get the value of the smart contract state key
+func (a *API) QuerySmartContractState(p *QuerySmartContractStateParams, timeout ...time.Duration) (*model.SmartContractStateReply, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+		if p.Key != "" {
+			q["key"] = p.Key
+		}
+		if p.BlockNumber != 0 {
+			q["blockNumber"] = strconv.Itoa(p.BlockNumber)
+		}
+	}
+	var out model.SmartContractStateReply
+	err := a.c.sendJSONRequest("GET", "/api/query-smart-contract-state", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecoverToken calls POST /api/recover-token - Recover Token and Tokenchain from the pinning node
+func (a *API) RecoverToken(in *model.RBTRecoverRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/recover-token", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RegisterCallbackURL calls POST /api/register-callback-url - Register the callback URL of the smart contract
+func (a *API) RegisterCallbackURL(in *model.RegisterCallBackUrlReq, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/register-callback-url", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RegisterDID calls POST /api/register-did - Register the DID
+func (a *API) RegisterDID(in map[string]interface{}, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/register-did", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseAllLockedTokens calls GET /api/release-all-locked-tokens - Release all the locked tokens
+func (a *API) ReleaseAllLockedTokens(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/release-all-locked-tokens", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveAllBootstrap calls POST /api/remove-all-bootstrap - Remove all bootstrap peers
+func (a *API) RemoveAllBootstrap(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/remove-all-bootstrap", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveAllQuorum calls GET /api/removeallquorum - Remove the quorum list
+func (a *API) RemoveAllQuorum(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/removeallquorum", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveAPIKey calls DELETE /api/api-keys/{id} - Remove API key
+func (a *API) RemoveAPIKey(id string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	path := "/api/api-keys/{id}"
+	path = strings.Replace(path, "{id}", url.PathEscape(id), 1)
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("DELETE", path, nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveBootstrap calls POST /api/remove-bootstrap - Remove bootstrap peers
+func (a *API) RemoveBootstrap(in *model.BootStrapPeers, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/remove-bootstrap", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveExplorer calls POST /api/remove-explorer - Remove explorer URLs
+func (a *API) RemoveExplorer(in *model.ExplorerLinks, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/remove-explorer", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveTokenChainBlock calls POST /api/remove-token-chain-block - Remove the token chain block
+func (a *API) RemoveTokenChainBlock(in *model.TCRemoveRequest, timeout ...time.Duration) (*model.TCRemoveReply, error) {
+	var out model.TCRemoveReply
+	err := a.c.sendJSONRequest("POST", "/api/remove-token-chain-block", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveWebhook calls DELETE /api/webhooks/{id} - Remove webhook
+func (a *API) RemoveWebhook(id string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	path := "/api/webhooks/{id}"
+	path = strings.Replace(path, "{id}", url.PathEscape(id), 1)
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("DELETE", path, nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReplayWebhookDelivery calls POST /api/webhook-deliveries/{id}/replay - Replay webhook delivery
+func (a *API) ReplayWebhookDelivery(id string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	path := "/api/webhook-deliveries/{id}/replay"
+	path = strings.Replace(path, "{id}", url.PathEscape(id), 1)
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", path, nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestDIDForPubKey calls POST /api/request-did-for-pubkey - Returns DID for corresponding public key
+func (a *API) RequestDIDForPubKey(in *model.DIDFromPubKeyRequest, timeout ...time.Duration) (*model.DIDFromPubKeyResponse, error) {
+	var out model.DIDFromPubKeyResponse
+	err := a.c.sendJSONRequest("POST", "/api/request-did-for-pubkey", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResolveConsensusJournal calls POST /api/consensus-journal/resolve - Resolve the interrupted transaction
+func (a *API) ResolveConsensusJournal(in *model.ConsensusJournalRequest, timeout ...time.Duration) (*model.ConsensusJournalReply, error) {
+	var out model.ConsensusJournalReply
+	err := a.c.sendJSONRequest("POST", "/api/consensus-journal/resolve", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreTokenChainParams is the query of RestoreTokenChain
+type RestoreTokenChainParams struct {
+	// Token
+	Token string
+}
+
+// RestoreTokenChain calls POST /api/restore-token-chain - Restore the token chain from the checkpoint
+func (a *API) RestoreTokenChain(p *RestoreTokenChainParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/restore-token-chain", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunUnpledge calls POST /api/run-unpledge - Run Unpledge
+func (a *API) RunUnpledge(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/run-unpledge", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendJWTFromWallet calls POST /api/send-jwt-from-wallet - Authenticate the wallet JWT
+func (a *API) SendJWTFromWallet(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/send-jwt-from-wallet", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupDBParams is the query of SetupDB
+type SetupDBParams struct {
+	// Migrate the data to the DB
+	Migrate bool
+}
+
+// SetupDB calls POST /api/setup-db - Setup the DB
+func (a *API) SetupDB(p *SetupDBParams, in *config.StorageConfig, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Migrate {
+			q["migrate"] = "true"
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/setup-db", q, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupDID calls POST /api/setup-did - Setup the existing DID
+func (a *API) SetupDID(fields map[string]string, files map[string]string, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendMutiFormRequest("POST", "/api/setup-did", nil, fields, files, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupQuorum calls POST /api/setup-quorum - Setup the DID as quorum
+func (a *API) SetupQuorum(in *model.QuorumSetup, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/setup-quorum", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupService calls POST /api/setup-service - Setup the service
+func (a *API) SetupService(in *config.ServiceConfig, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/setup-service", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Shutdown calls POST /api/shutdown - Shutdown the node
+func (a *API) Shutdown(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/shutdown", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SignatureResponse calls POST /api/signature-response - Signature Response
+func (a *API) SignatureResponse(in *did.SignRespData, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/signature-response", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Start calls GET /api/start - Start Core
+func (a *API) Start(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/start", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubscribeNFT calls POST /api/subscribe-nft - Subscribe to the NFT
+func (a *API) SubscribeNFT(in *model.NewNFTSubscription, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/subscribe-nft", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubscribeSmartContract calls POST /api/subscribe-smart-contract - Subscribe to the smart contract
+func (a *API) SubscribeSmartContract(in *model.NewSubscription, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/subscribe-smart-contract", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnpledgePowUnpledgeTokens calls POST /api/unpledge-pow-unpledge-tokens - Unpledge POW Based pledge Tokens
+func (a *API) UnpledgePowUnpledgeTokens(timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/unpledge-pow-unpledge-tokens", nil, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpgradeSmartContract calls POST /api/upgrade-smart-contract - Upgrade Smart Contract
+func (a *API) UpgradeSmartContract(in *model.UpgradeSmartContractRequest, timeout ...time.Duration) (*model.BasicResponse, error) {
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("POST", "/api/upgrade-smart-contract", nil, in, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ValidateTokenParams is the query of ValidateToken
+type ValidateTokenParams struct {
+	// Token
+	Token string
+}
+
+// ValidateToken calls GET /api/validate-token - Validate the token
+func (a *API) ValidateToken(p *ValidateTokenParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/validate-token", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ValidateTokenChainParams is the query of ValidateTokenChain
+type ValidateTokenChainParams struct {
+	// DID
+	DID string
+	// Token
+	Token string
+	// Number of blocks to validate
+	Blockcount int
+	// Validate the smart contract token chain
+	SCChainValidation bool
+}
+
+// ValidateTokenChain calls GET /api/validate-token-chain - Validate the token chain
+func (a *API) ValidateTokenChain(p *ValidateTokenChainParams, timeout ...time.Duration) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	if p != nil {
+		if p.DID != "" {
+			q["did"] = p.DID
+		}
+		if p.Token != "" {
+			q["token"] = p.Token
+		}
+		if p.Blockcount != 0 {
+			q["blockcount"] = strconv.Itoa(p.Blockcount)
+		}
+		if p.SCChainValidation {
+			q["SCChainValidation"] = "true"
+		}
+	}
+	var out model.BasicResponse
+	err := a.c.sendJSONRequest("GET", "/api/validate-token-chain", q, nil, &out, timeout...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func (c *Client) AddAPIKey(req *model.APIKeyRequest) (*model.APIKeyReply, error) {
+	var rp model.APIKeyReply
+	err := c.sendJSONRequest("POST", setup.APIAPIKeys, nil, req, &rp)
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+func (c *Client) GetAPIKeys() (*model.APIKeyListReply, error) {
+	var rp model.APIKeyListReply
+	err := c.sendJSONRequest("GET", setup.APIAPIKeys, nil, nil, &rp)
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+func (c *Client) RemoveAPIKey(id string) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("DELETE", setup.APIAPIKeys+"/"+id, nil, nil, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
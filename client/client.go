@@ -1,5 +1,7 @@
 package client
 
+//go:generate go run ../server/apigen -o api_gen.go
+
 import (
 	"fmt"
 	"net/http"
@@ -17,6 +19,8 @@ type Client struct {
 	log       logger.Logger
 	setAuth   bool
 	authToken string
+	apiKey    string
+	reqID     string
 }
 
 func NewClient(cfg *srvcfg.Config, log logger.Logger, timeout ...time.Duration) (*Client, error) {
@@ -41,6 +45,17 @@ func (c *Client) SetAuthToken(token string) {
 	c.setAuth = true
 }
 
+// SetAPIKey sets the API key sent in the X-API-Key header
+func (c *Client) SetAPIKey(key string) {
+	c.apiKey = key
+}
+
+// SetRequestID sets the request id sent in the X-Request-ID header, the
+// events of the request can be followed from the start with it
+func (c *Client) SetRequestID(id string) {
+	c.reqID = id
+}
+
 func (c *Client) basicRequest(method string, path string, model interface{}) (*http.Request, error) {
 	r, err := c.JSONRequest(method, path, model)
 	if err != nil {
@@ -49,6 +64,12 @@ func (c *Client) basicRequest(method string, path string, model interface{}) (*h
 	if c.setAuth {
 		c.SetAuthorization(r, c.authToken)
 	}
+	if c.apiKey != "" {
+		r.Header.Set(ensweb.APIKeyHeader, c.apiKey)
+	}
+	if c.reqID != "" {
+		r.Header.Set(ensweb.RequestIDHeader, c.reqID)
+	}
 	return r, nil
 }
 
@@ -60,6 +81,12 @@ func (c *Client) multiFormRequest(method string, path string, field map[string]s
 	if c.setAuth {
 		c.SetAuthorization(r, c.authToken)
 	}
+	if c.apiKey != "" {
+		r.Header.Set(ensweb.APIKeyHeader, c.apiKey)
+	}
+	if c.reqID != "" {
+		r.Header.Set(ensweb.RequestIDHeader, c.reqID)
+	}
 	return r, nil
 }
 
@@ -6,9 +6,13 @@ import (
 	"github.com/rubixchain/rubixgoplatform/setup"
 )
 
-func (c *Client) SetupDB(sc *config.StorageConfig) (string, bool) {
+func (c *Client) SetupDB(sc *config.StorageConfig, migrate bool) (string, bool) {
 	var br model.BasicResponse
-	err := c.sendJSONRequest("POST", setup.APISetupDB, nil, sc, &br)
+	q := make(map[string]string)
+	if migrate {
+		q["migrate"] = "true"
+	}
+	err := c.sendJSONRequest("POST", setup.APISetupDB, q, sc, &br)
 	if err != nil {
 		return "Failed to setup DB, " + err.Error(), false
 	}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func (c *Client) GetConsensusJournal() (*model.ConsensusJournalListReply, error) {
+	var jr model.ConsensusJournalListReply
+	err := c.sendJSONRequest("GET", setup.APIConsensusJournal, nil, nil, &jr)
+	if err != nil {
+		return nil, err
+	}
+	return &jr, nil
+}
+
+func (c *Client) ResolveConsensusJournal(id string, action string) (*model.ConsensusJournalReply, error) {
+	req := model.ConsensusJournalRequest{
+		ID:     id,
+		Action: action,
+	}
+	var jr model.ConsensusJournalReply
+	err := c.sendJSONRequest("POST", setup.APIResolveConsensusJournal, nil, &req, &jr)
+	if err != nil {
+		return nil, err
+	}
+	return &jr, nil
+}
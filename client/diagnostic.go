@@ -1,15 +1,21 @@
 package client
 
 import (
+	"strconv"
+
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/setup"
 )
 
-func (c *Client) DumpTokenChain(token string, blockID string) (*model.TCDumpReply, error) {
+func (c *Client) DumpTokenChain(token string, blockID string, pr *model.PageRequest) (*model.TCDumpReply, error) {
 	dr := &model.TCDumpRequest{
 		Token:   token,
 		BlockID: blockID,
 	}
+	if pr != nil {
+		dr.Limit = pr.Limit
+		dr.Cursor = pr.Cursor
+	}
 	var drep model.TCDumpReply
 	err := c.sendJSONRequest("POST", setup.APIDumpTokenChainBlock, nil, dr, &drep)
 	if err != nil {
@@ -18,11 +24,27 @@ func (c *Client) DumpTokenChain(token string, blockID string) (*model.TCDumpRepl
 	return &drep, nil
 }
 
-func (c *Client) DumpFTTokenChain(token string, blockID string) (*model.TCDumpReply, error) {
+func (c *Client) GetTokenProof(token string, blockID string) (*model.TokenProofReply, error) {
+	q := make(map[string]string)
+	q["token"] = token
+	q["blockID"] = blockID
+	var pr model.TokenProofReply
+	err := c.sendJSONRequest("GET", setup.APIGetTokenProof, q, nil, &pr)
+	if err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+func (c *Client) DumpFTTokenChain(token string, blockID string, pr *model.PageRequest) (*model.TCDumpReply, error) {
 	dr := &model.TCDumpRequest{
 		Token:   token,
 		BlockID: blockID,
 	}
+	if pr != nil {
+		dr.Limit = pr.Limit
+		dr.Cursor = pr.Cursor
+	}
 	var drep model.TCDumpReply
 	err := c.sendJSONRequest("POST", setup.APIDumpFTTokenChainBlock, nil, dr, &drep)
 	if err != nil {
@@ -31,11 +53,15 @@ func (c *Client) DumpFTTokenChain(token string, blockID string) (*model.TCDumpRe
 	return &drep, nil
 }
 
-func (c *Client) DumpSmartContractTokenChain(token string, blockID string) (*model.TCDumpReply, error) {
+func (c *Client) DumpSmartContractTokenChain(token string, blockID string, pr *model.PageRequest) (*model.TCDumpReply, error) {
 	dr := &model.TCDumpRequest{
 		Token:   token,
 		BlockID: blockID,
 	}
+	if pr != nil {
+		dr.Limit = pr.Limit
+		dr.Cursor = pr.Cursor
+	}
 	var drep model.TCDumpReply
 	err := c.sendJSONRequest("POST", setup.APIDumpSmartContractTokenChainBlock, nil, dr, &drep)
 	if err != nil {
@@ -44,10 +70,11 @@ func (c *Client) DumpSmartContractTokenChain(token string, blockID string) (*mod
 	return &drep, nil
 }
 
-func (c *Client) DumpNFTTokenChain(token string, blockID string) (*model.TCDumpReply, error) {
+func (c *Client) DumpNFTTokenChain(token string, blockID string, pr *model.PageRequest) (*model.TCDumpReply, error) {
 	q := make(map[string]string)
 	q["nft"] = token
 	q["blockId"] = blockID
+	addPageQuery(q, pr)
 	var drep model.TCDumpReply
 	err := c.sendJSONRequest("GET", setup.APIDumpNFTTokenChain, q, nil, &drep)
 	if err != nil {
@@ -70,6 +97,23 @@ func (c *Client) GetSmartContractTokenData(token string, latest bool) (*model.Sm
 
 }
 
+// QuerySmartContractState gets the value of the state key at the block, the
+// latest block is used if the block number is negative
+func (c *Client) QuerySmartContractState(token string, key string, blockNumber int64) (*model.SmartContractStateReply, error) {
+	q := make(map[string]string)
+	q["token"] = token
+	q["key"] = key
+	if blockNumber >= 0 {
+		q["blockNumber"] = strconv.FormatInt(blockNumber, 10)
+	}
+	var sr model.SmartContractStateReply
+	err := c.sendJSONRequest("GET", setup.APIQuerySmartContractState, q, nil, &sr)
+	if err != nil {
+		return nil, err
+	}
+	return &sr, nil
+}
+
 func (c *Client) GetNFTTokenData(token string, latest bool) (*model.NFTDataReply, error) {
 	getReq := &model.SmartContractTokenChainDataReq{
 		Token:  token,
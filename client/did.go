@@ -38,9 +38,11 @@ func (c *Client) GetDIDAccess(req *model.GetDIDAccess) (string, error) {
 	return resp.Token, nil
 }
 
-func (c *Client) GetAllDIDs() (*model.GetAccountInfo, error) {
+func (c *Client) GetAllDIDs(pr *model.PageRequest) (*model.GetAccountInfo, error) {
+	q := make(map[string]string)
+	addPageQuery(q, pr)
 	var ac model.GetAccountInfo
-	err := c.sendJSONRequest("GET", setup.APIGetAllDID, nil, nil, &ac)
+	err := c.sendJSONRequest("GET", setup.APIGetAllDID, q, nil, &ac)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// readEventStream opens the server-sent event stream and calls fn with the
+// data of every event, it returns true if fn stopped the stream and false if
+// the node closed it
+func (c *Client) readEventStream(path string, q map[string]string, fn func(data []byte) (bool, error)) (bool, error) {
+	req, err := c.basicRequest("GET", path, nil)
+	if err != nil {
+		return false, err
+	}
+	qv := req.URL.Query()
+	for k, v := range q {
+		qv.Add(k, v)
+	}
+	req.URL.RawQuery = qv.Encode()
+	req.Header.Set("Accept", "text/event-stream")
+	// the stream is open till it is stopped
+	resp, err := c.Do(req, 0)
+	if err != nil {
+		c.log.Error("Failed to get response from the server, " + err.Error())
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("http request failed with status %d", resp.StatusCode)
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		var br struct {
+			Message string `json:"message"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&br)
+		if err != nil {
+			return false, fmt.Errorf("invalid response from the node, %v", err)
+		}
+		return false, fmt.Errorf("%s", br.Message)
+	}
+	sc := bufio.NewScanner(resp.Body)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var data strings.Builder
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "data:") {
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+			continue
+		}
+		if line != "" || data.Len() == 0 {
+			// id, event and keepalive lines, the event is in the data
+			continue
+		}
+		stop, err := fn([]byte(data.String()))
+		data.Reset()
+		if err != nil || stop {
+			return stop, err
+		}
+	}
+	return false, sc.Err()
+}
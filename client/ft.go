@@ -7,12 +7,13 @@ import (
 	"github.com/rubixchain/rubixgoplatform/setup"
 )
 
-func (c *Client) CreateFT(did string, ftName string, ftCount int, wholeToken int) (*model.BasicResponse, error) {
+func (c *Client) CreateFT(did string, ftName string, ftCount int, wholeToken int, idempotencyKey string) (*model.BasicResponse, error) {
 	createFTReq := model.CreateFTReq{
-		DID:        did,
-		FTName:     ftName,
-		FTCount:    ftCount,
-		TokenCount: wholeToken,
+		DID:            did,
+		FTName:         ftName,
+		FTCount:        ftCount,
+		TokenCount:     wholeToken,
+		IdempotencyKey: idempotencyKey,
 	}
 	var basicresponse model.BasicResponse
 	err := c.sendJSONRequest("POST", setup.APICreateFT, nil, &createFTReq, &basicresponse)
@@ -32,9 +33,10 @@ func (c *Client) TransferFT(rt *model.TransferFTReq) (*model.BasicResponse, erro
 	return &br, nil
 }
 
-func (c *Client) GetFTInfo(didStr string) (*model.GetFTInfo, error) {
+func (c *Client) GetFTInfo(didStr string, pr *model.PageRequest) (*model.GetFTInfo, error) {
 	m := make(map[string]string)
 	m["did"] = didStr
+	addPageQuery(m, pr)
 	var info model.GetFTInfo
 	err := c.sendJSONRequest("GET", setup.APIGetFTInfo, m, nil, &info)
 	if err != nil {
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func (c *Client) GetJobs(did string, jobType string, status string, limit int) (*model.JobListReply, error) {
+	q := make(map[string]string)
+	if did != "" {
+		q["did"] = did
+	}
+	if jobType != "" {
+		q["type"] = jobType
+	}
+	if status != "" {
+		q["status"] = status
+	}
+	if limit > 0 {
+		q["limit"] = strconv.Itoa(limit)
+	}
+	var jr model.JobListReply
+	err := c.sendJSONRequest("GET", setup.APIJobs, q, nil, &jr)
+	if err != nil {
+		return nil, err
+	}
+	return &jr, nil
+}
+
+func (c *Client) GetJob(id string) (*model.JobReply, error) {
+	var jr model.JobReply
+	err := c.sendJSONRequest("GET", setup.APIJobs+"/"+id, nil, nil, &jr)
+	if err != nil {
+		return nil, err
+	}
+	return &jr, nil
+}
@@ -76,10 +76,12 @@ func (c *Client) SubscribeNFT(nft string) (*model.BasicResponse, error) {
 	return &response, nil
 }
 
-func (c *Client) GetAllNFTs(did string) (*model.NFTTokens, error) {
+func (c *Client) GetAllNFTs(did string, f *model.TokenFilter, pr *model.PageRequest) (*model.NFTList, error) {
 	q := make(map[string]string)
 	q["did"] = did
-	var tkns model.NFTTokens
+	addTokenFilterQuery(q, f)
+	addPageQuery(q, pr)
+	var tkns model.NFTList
 	err := c.sendJSONRequest("GET", setup.APIGetAllNFT, q, nil, &tkns)
 	if err != nil {
 		return nil, err
@@ -87,10 +89,12 @@ func (c *Client) GetAllNFTs(did string) (*model.NFTTokens, error) {
 	return &tkns, nil
 }
 
-func (c *Client) GetNFTsByDid(did string) (*model.NFTTokens, error) {
+func (c *Client) GetNFTsByDid(did string, f *model.TokenFilter, pr *model.PageRequest) (*model.NFTList, error) {
 	q := make(map[string]string)
 	q["did"] = did
-	var tkns model.NFTTokens
+	addTokenFilterQuery(q, f)
+	addPageQuery(q, pr)
+	var tkns model.NFTList
 	err := c.sendJSONRequest("GET", setup.APIGetNftsByDid, q, nil, &tkns)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+// addPageQuery adds the page of the list to the query params
+func addPageQuery(q map[string]string, pr *model.PageRequest) {
+	if pr == nil {
+		return
+	}
+	if pr.Limit > 0 {
+		q["limit"] = strconv.Itoa(pr.Limit)
+	}
+	if pr.Cursor != "" {
+		q["cursor"] = pr.Cursor
+	}
+	if pr.Sort != "" {
+		q["sort"] = pr.Sort
+	}
+}
+
+func addTokenFilterQuery(q map[string]string, f *model.TokenFilter) {
+	if f == nil {
+		return
+	}
+	if len(f.Status) > 0 {
+		status := make([]string, 0, len(f.Status))
+		for _, s := range f.Status {
+			status = append(status, strconv.Itoa(s))
+		}
+		q["status"] = strings.Join(status, ",")
+	}
+	if f.MinValue > 0 {
+		q["min_value"] = strconv.FormatFloat(f.MinValue, 'f', -1, 64)
+	}
+	if f.MaxValue > 0 {
+		q["max_value"] = strconv.FormatFloat(f.MaxValue, 'f', -1, 64)
+	}
+	if f.TokenType != "" {
+		q["token_type"] = f.TokenType
+	}
+}
@@ -28,6 +28,11 @@ func (c *Client) AddQuorum(quorumList string) (string, bool) {
 		c.log.Error("Invalid file, failed to add quorum list", "err", err)
 		return "Invalid file, failed to add quorum list", false
 	}
+	return c.AddQuorumList(ql)
+}
+
+// AddQuorumList adds the quorums, atleast 5 quorums are required
+func (c *Client) AddQuorumList(ql []core.QuorumData) (string, bool) {
 	if len(ql) < 5 {
 		c.log.Error("Length of Quorum list should be atleast 5")
 		return "Length of Quorum list should be atleast 5", false
@@ -40,7 +45,7 @@ func (c *Client) AddQuorum(quorumList string) (string, bool) {
 		}
 	}
 	var resp model.BasicResponse
-	err = c.sendJSONRequest("POST", setup.APIAddQuorum, nil, &ql, &resp)
+	err := c.sendJSONRequest("POST", setup.APIAddQuorum, nil, &ql, &resp)
 	if err != nil {
 		c.log.Error("Failed to add quorum list", "err", err)
 		return "Failed to add quorum list, " + err.Error(), false
@@ -106,3 +106,24 @@ func (c *Client) ExecuteSmartContract(executeRequest *model.ExecuteSmartContract
 	}
 	return &basicResponse, nil
 }
+
+func (c *Client) UpgradeSmartContract(upgradeRequest *model.UpgradeSmartContractRequest) (*model.BasicResponse, error) {
+	var basicResponse model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APIUpgradeSmartContract, nil, upgradeRequest, &basicResponse, time.Minute*2)
+	if err != nil {
+		c.log.Error("Failed to Upgrade Smart Contract", "err", err)
+		return nil, err
+	}
+	return &basicResponse, nil
+}
+
+// GetSmartContractVersions gets the code versions of the smart contract
+func (c *Client) GetSmartContractVersions(token string) (*model.SmartContractVersionsReply, error) {
+	q := map[string]string{"token": token}
+	var vr model.SmartContractVersionsReply
+	err := c.sendJSONRequest("GET", setup.APIGetSmartContractVersions, q, nil, &vr)
+	if err != nil {
+		return nil, err
+	}
+	return &vr, nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func scEventFilterQuery(f *model.SmartContractEventFilter) map[string]string {
+	q := make(map[string]string)
+	if f == nil {
+		return q
+	}
+	if f.SmartContractToken != "" {
+		q["token"] = f.SmartContractToken
+	}
+	if f.Name != "" {
+		q["name"] = f.Name
+	}
+	if f.Topic != "" {
+		q["topic"] = f.Topic
+	}
+	if f.FromBlock > 0 {
+		q["fromBlock"] = strconv.FormatUint(f.FromBlock, 10)
+	}
+	if f.ToBlock > 0 {
+		q["toBlock"] = strconv.FormatUint(f.ToBlock, 10)
+	}
+	return q
+}
+
+// GetSmartContractEvents gets the page of the smart contract events matching
+// the filter
+func (c *Client) GetSmartContractEvents(f *model.SmartContractEventFilter, pr *model.PageRequest) (*model.SmartContractEventsReply, error) {
+	q := scEventFilterQuery(f)
+	addPageQuery(q, pr)
+	var er model.SmartContractEventsReply
+	err := c.sendJSONRequest("GET", setup.APIGetSmartContractEvents, q, nil, &er)
+	if err != nil {
+		return nil, err
+	}
+	return &er, nil
+}
+
+// WatchSmartContractEvents follows the smart contract events matching the
+// filter till the callback returns false, the events from the from block
+// are sent before the new events
+func (c *Client) WatchSmartContractEvents(f *model.SmartContractEventFilter, cb func(ev *model.SmartContractEventLog) bool) error {
+	stopped, err := c.readEventStream(setup.APISmartContractEventStream, scEventFilterQuery(f), func(data []byte) (bool, error) {
+		var ev model.SmartContractEventLog
+		err := json.Unmarshal(data, &ev)
+		if err != nil {
+			return false, fmt.Errorf("invalid event from the node, %v", err)
+		}
+		return !cb(&ev), nil
+	})
+	if err != nil || stopped {
+		return err
+	}
+	return fmt.Errorf("event stream closed by the node")
+}
@@ -22,10 +22,12 @@ func (c *Client) GenerateTestRBT(numTokens int, didStr string) (*model.BasicResp
 	return &rm, nil
 }
 
-func (c *Client) GetAllTokens(didStr string, tokenType string) (*model.TokenResponse, error) {
+func (c *Client) GetAllTokens(didStr string, tokenType string, f *model.TokenFilter, pr *model.PageRequest) (*model.TokenResponse, error) {
 	q := make(map[string]string)
 	q["type"] = tokenType
 	q["did"] = didStr
+	addTokenFilterQuery(q, f)
+	addPageQuery(q, pr)
 	var tr model.TokenResponse
 	err := c.sendJSONRequest("GET", setup.APIGetAllTokens, q, nil, &tr)
 	if err != nil {
@@ -71,6 +73,44 @@ func (c *Client) ValidateTokenchain(userDID string, smartContractChainValidation
 	return &br, nil
 }
 
+func (c *Client) CheckpointTokenChain(cr *model.TokenChainCheckpointRequest) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APICheckpointTokenChain, nil, cr, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
+
+func (c *Client) RestoreTokenChain(token string) (*model.BasicResponse, error) {
+	q := make(map[string]string)
+	q["token"] = token
+	var br model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APIRestoreTokenChain, q, nil, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
+
+func (c *Client) ExportToken(er *model.ExportTokenRequest) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APIExportToken, nil, er, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
+
+func (c *Client) ImportToken(ir *model.ImportTokenRequest) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APIImportToken, nil, ir, &br, time.Minute*10)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
+
 func (c *Client) GenerateFaucetTestRBT(numTokens int, didStr string) (*model.BasicResponse, error) {
 	m := model.FaucetRBTGenerateRequest{
 		TokenCount: numTokens,
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+// WatchTxnEvents follows the lifecycle events of the request after the
+// sequence number, it returns once the final status is received or the
+// callback returns false
+func (c *Client) WatchTxnEvents(reqID string, from int, cb func(ev *model.TxnEvent) bool) error {
+	q := map[string]string{"req_id": reqID, "from": strconv.Itoa(from)}
+	stopped, err := c.readEventStream(setup.APITxnEvents, q, func(data []byte) (bool, error) {
+		var ev model.TxnEvent
+		err := json.Unmarshal(data, &ev)
+		if err != nil {
+			return false, fmt.Errorf("invalid event from the node, %v", err)
+		}
+		return !cb(&ev) || ev.Type == model.TxnEventFinalStatus, nil
+	})
+	if err != nil || stopped {
+		return err
+	}
+	return fmt.Errorf("event stream closed before the final status")
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"strconv"
+
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/setup"
 )
@@ -16,10 +18,25 @@ func (c *Client) GetTxnByID(txnID string) (*model.TxnDetails, error) {
 	return &td, nil
 }
 
-func (c *Client) GetTxnByDID(DID string, role string) (*model.TxnDetails, error) {
+func (c *Client) GetTxnByDID(DID string, f *model.TxnFilter, pr *model.PageRequest) (*model.TxnDetails, error) {
 	q := make(map[string]string)
 	q["DID"] = DID
-	q["Role"] = role
+	if f != nil {
+		q["Role"] = f.Role
+		if !f.StartDate.IsZero() {
+			q["StartDate"] = f.StartDate.Format("2006-01-02")
+		}
+		if !f.EndDate.IsZero() {
+			q["EndDate"] = f.EndDate.Format("2006-01-02")
+		}
+		if f.MinAmount > 0 {
+			q["min_amount"] = strconv.FormatFloat(f.MinAmount, 'f', -1, 64)
+		}
+		if f.MaxAmount > 0 {
+			q["max_amount"] = strconv.FormatFloat(f.MaxAmount, 'f', -1, 64)
+		}
+	}
+	addPageQuery(q, pr)
 	var td model.TxnDetails
 	err := c.sendJSONRequest("GET", setup.APIGetTxnByDID, q, nil, &td)
 	if err != nil {
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func (c *Client) AddWebhook(req *model.WebhookRequest) (*model.WebhookReply, error) {
+	var rp model.WebhookReply
+	err := c.sendJSONRequest("POST", setup.APIWebhooks, nil, req, &rp)
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+func (c *Client) RemoveWebhook(id string) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("DELETE", setup.APIWebhooks+"/"+id, nil, nil, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
+
+func (c *Client) GetWebhooks(did string) (*model.WebhookListReply, error) {
+	q := make(map[string]string)
+	if did != "" {
+		q["did"] = did
+	}
+	var rp model.WebhookListReply
+	err := c.sendJSONRequest("GET", setup.APIWebhooks, q, nil, &rp)
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+func (c *Client) GetWebhookDeliveries(webhookID string, status string, limit int) (*model.WebhookDeliveryListReply, error) {
+	q := make(map[string]string)
+	if webhookID != "" {
+		q["webhook_id"] = webhookID
+	}
+	if status != "" {
+		q["status"] = status
+	}
+	if limit > 0 {
+		q["limit"] = strconv.Itoa(limit)
+	}
+	var rp model.WebhookDeliveryListReply
+	err := c.sendJSONRequest("GET", setup.APIWebhookDeliveries, q, nil, &rp)
+	if err != nil {
+		return nil, err
+	}
+	return &rp, nil
+}
+
+func (c *Client) ReplayWebhookDelivery(id string) (*model.BasicResponse, error) {
+	var br model.BasicResponse
+	err := c.sendJSONRequest("POST", setup.APIWebhookDeliveries+"/"+id+"/replay", nil, nil, &br)
+	if err != nil {
+		return nil, err
+	}
+	return &br, nil
+}
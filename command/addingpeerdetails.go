@@ -16,7 +16,7 @@ func (cmd *Command) AddPeerDetails() {
 		fmt.Print("Enter PeerID : ")
 		_, err = fmt.Scan(&peerID)
 		if err != nil {
-			cmd.log.Error("Failed to get PeerID")
+			cmd.fail("Failed to get PeerID")
 			return
 		}
 	} else {
@@ -24,7 +24,7 @@ func (cmd *Command) AddPeerDetails() {
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.peerID)
 	if !strings.HasPrefix(cmd.peerID, "12D3KooW") || len(cmd.peerID) != 52 || !isAlphanumeric {
-		cmd.log.Error("Invalid PeerID")
+		cmd.fail("Invalid PeerID")
 		return
 	}
 
@@ -32,7 +32,7 @@ func (cmd *Command) AddPeerDetails() {
 		fmt.Print("Enter DID : ")
 		_, err = fmt.Scan(&did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	} else {
@@ -40,13 +40,13 @@ func (cmd *Command) AddPeerDetails() {
 	}
 	isAlphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 
 	// didType = cmd.didType
 	if cmd.didType < 0 || cmd.didType > 4 {
-		cmd.log.Error("DID Type should be between 0 and 4")
+		cmd.fail("DID Type should be between 0 and 4")
 		return
 	}
 
@@ -56,8 +56,11 @@ func (cmd *Command) AddPeerDetails() {
 		DIDType: &cmd.didType,
 	}
 	msg, status := cmd.c.AddPeer(&peerDetail)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to add peer in DB", "message", msg)
+		cmd.fail("Failed to add peer in DB", "message", msg)
 		return
 	}
 	cmd.log.Info("Peer added successfully")
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+func (cmd *Command) addAPIKey() {
+	if cmd.keyRole == "" {
+		cmd.fail("API key role is required")
+		return
+	}
+	req := model.APIKeyRequest{
+		Name: cmd.keyName,
+		Role: cmd.keyRole,
+	}
+	if cmd.keyDIDs != "" {
+		req.DIDs = strings.Split(cmd.keyDIDs, ",")
+	}
+	rp, err := cmd.c.AddAPIKey(&req)
+	if err != nil {
+		cmd.fail("Failed to add the api key", "err", err)
+		return
+	}
+	if cmd.render(rp) {
+		return
+	}
+	if !rp.Status {
+		cmd.fail("Failed to add the api key", "msg", rp.Message)
+		return
+	}
+	fmt.Printf("API key ID : %s\n", rp.APIKey.ID)
+	fmt.Printf("API key    : %s\n", rp.Key)
+	cmd.log.Info("API key added successfully, the key is not shown again")
+}
+
+func (cmd *Command) listAPIKeys() {
+	rp, err := cmd.c.GetAPIKeys()
+	if err != nil {
+		cmd.fail("Failed to get the api keys", "err", err)
+		return
+	}
+	if cmd.render(rp) {
+		return
+	}
+	if !rp.Status {
+		cmd.fail("Failed to get the api keys", "msg", rp.Message)
+		return
+	}
+	for _, ak := range rp.APIKeys {
+		dids := ak.DIDs
+		if dids == "" {
+			dids = "all"
+		}
+		fmt.Printf("%s  %-16s %-10s dids: %s\n", ak.ID, ak.Name, ak.Role, dids)
+	}
+	cmd.log.Info("API keys listed", "count", len(rp.APIKeys))
+}
+
+func (cmd *Command) removeAPIKey() {
+	if cmd.keyID == "" {
+		cmd.fail("API key ID is required")
+		return
+	}
+	br, err := cmd.c.RemoveAPIKey(cmd.keyID)
+	if err != nil {
+		cmd.fail("Failed to remove the api key", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("Failed to remove the api key", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("API key removed successfully")
+}
@@ -3,21 +3,25 @@ package command
 import (
 	"fmt"
 	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
 )
 
 func (cmd *Command) addBootStrap() {
 	if len(cmd.peers) == 0 {
-		cmd.log.Error("Peers required for bootstrap. Use flag -peers to provide peers separated by a ','")
+		cmd.fail("Peers required for bootstrap. Use flag -peers to provide peers separated by a ','")
 		return
 	}
 	for _, peer := range cmd.peers {
 		if !strings.HasSuffix(peer, "/") {
-			cmd.log.Error(fmt.Sprintf("Invalid bootstrap peer : %s", peer))
+			cmd.fail(fmt.Sprintf("Invalid bootstrap peer : %s", peer))
 			return
 		}
 	}
 	msg, status := cmd.c.AddBootStrap(cmd.peers)
-
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Add bootstrap command failed, " + msg)
 	} else {
@@ -27,16 +31,19 @@ func (cmd *Command) addBootStrap() {
 
 func (cmd *Command) removeBootStrap() {
 	if len(cmd.peers) == 0 {
-		cmd.log.Error("Peers required for bootstrap. Use flag -peers to provide peers separated by a ','")
+		cmd.fail("Peers required for bootstrap. Use flag -peers to provide peers separated by a ','")
 		return
 	}
 	for _, peer := range cmd.peers {
 		if !strings.HasSuffix(peer, "/") {
-			cmd.log.Error(fmt.Sprintf("Invalid bootstrap peer : %s", peer))
+			cmd.fail(fmt.Sprintf("Invalid bootstrap peer : %s", peer))
 			return
 		}
 	}
 	msg, status := cmd.c.RemoveBootStrap(cmd.peers)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Remove bootstrap command failed, " + msg)
 	} else {
@@ -46,6 +53,9 @@ func (cmd *Command) removeBootStrap() {
 
 func (cmd *Command) removeAllBootStrap() {
 	msg, status := cmd.c.RemoveAllBootStrap()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Remove all bootstrap command failed, " + msg)
 	} else {
@@ -55,6 +65,9 @@ func (cmd *Command) removeAllBootStrap() {
 
 func (cmd *Command) getAllBootStrap() {
 	peers, msg, status := cmd.c.GetAllBootStrap()
+	if cmd.render(&model.BootStrapResponse{Status: status, Message: msg, Result: model.BootStrapPeers{Peers: peers}}) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Get all bootstrap command failed, " + msg)
 	} else {
@@ -19,8 +19,8 @@ import (
 	"github.com/rubixchain/rubixgoplatform/core/config"
 	"github.com/rubixchain/rubixgoplatform/core/storage"
 	"github.com/rubixchain/rubixgoplatform/did"
-	_ "github.com/rubixchain/rubixgoplatform/docs"
 	"github.com/rubixchain/rubixgoplatform/server"
+	"github.com/rubixchain/rubixgoplatform/setup"
 	"github.com/rubixchain/rubixgoplatform/wrapper/apiconfig"
 	srvcfg "github.com/rubixchain/rubixgoplatform/wrapper/config"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
@@ -108,6 +108,28 @@ const (
 	GetNftsByDidCmd                string = "get-nfts-by-did"
 	CreateDIDFromPubKeyCmd         string = "createdidfrompubkey"
 	AddUserAPIKeyCmd               string = "adduserapikey"
+	CheckpointTokenChainCmd        string = "checkpointtokenchain"
+	RestoreTokenChainCmd           string = "restoretokenchain"
+	GetTokenProofCmd               string = "gettokenproof"
+	ExportTokenCmd                 string = "exporttoken"
+	ImportTokenCmd                 string = "importtoken"
+	WatchTxnCmd                    string = "watchtxn"
+	JobsCmd                        string = "jobs"
+	AddWebhookCmd                  string = "addwebhook"
+	RemoveWebhookCmd               string = "removewebhook"
+	ListWebhooksCmd                string = "listwebhooks"
+	WebhookDeliveriesCmd           string = "webhookdeliveries"
+	ReplayWebhookDeliveryCmd       string = "replaywebhookdelivery"
+	AddAPIKeyCmd                   string = "addapikey"
+	ListAPIKeysCmd                 string = "listapikeys"
+	RemoveAPIKeyCmd                string = "removeapikey"
+	QuerySmartContractStateCmd     string = "query-smart-contract-state"
+	GetSmartContractEventsCmd      string = "get-smart-contract-events"
+	UpgradeSmartContractCmd        string = "upgrade-smart-contract"
+	GetSmartContractVersionsCmd    string = "get-smart-contract-versions"
+	ConsensusJournalCmd            string = "consensusjournal"
+	ResolveConsensusJournalCmd     string = "resolveconsensusjournal"
+	GetAllTokensCmd                string = "getalltokens"
 )
 
 var commands = []string{VersionCmd,
@@ -174,6 +196,28 @@ var commands = []string{VersionCmd,
 	FetchNftCmd,
 	GetNftsByDidCmd,
 	CreateDIDFromPubKeyCmd,
+	CheckpointTokenChainCmd,
+	RestoreTokenChainCmd,
+	GetTokenProofCmd,
+	ExportTokenCmd,
+	ImportTokenCmd,
+	WatchTxnCmd,
+	JobsCmd,
+	AddWebhookCmd,
+	RemoveWebhookCmd,
+	ListWebhooksCmd,
+	WebhookDeliveriesCmd,
+	ReplayWebhookDeliveryCmd,
+	AddAPIKeyCmd,
+	ListAPIKeysCmd,
+	RemoveAPIKeyCmd,
+	QuerySmartContractStateCmd,
+	GetSmartContractEventsCmd,
+	UpgradeSmartContractCmd,
+	GetSmartContractVersionsCmd,
+	ConsensusJournalCmd,
+	ResolveConsensusJournalCmd,
+	GetAllTokensCmd,
 }
 
 var commandsHelp = []string{"To get tool version",
@@ -238,6 +282,28 @@ var commandsHelp = []string{"To get tool version",
 	"This command will subscribe NFT",
 	"This command will fetch NFT",
 	"This command will get all NFTs owned by the did",
+	"This command will checkpoint the token chain and prune older blocks",
+	"This command will restore the archived blocks of the token chain",
+	"This command will get the merkle inclusion proof of the token",
+	"This command will export the token chain as a signed bundle file",
+	"This command will validate the token bundle file and import the token",
+	"This command will follow the lifecycle events of the request",
+	"This command will list the jobs or get the job with -reqID",
+	"This command will register the webhook URL of the DID for the events",
+	"This command will remove the webhook",
+	"This command will list the webhooks of the DID",
+	"This command will list the webhook deliveries",
+	"This command will replay the webhook delivery",
+	"This command will add the API key with the role, use -keyDIDs to limit the key to the DIDs",
+	"This command will list the API keys",
+	"This command will remove the API key",
+	"This command will get the value of the smart contract state key, use -blockNumber for the state at the block",
+	"This command will list the smart contract events, use -follow to stream the new events",
+	"This command will upgrade the smart contract to the code of the generated -codeToken, use -migrationInput for the migrate function",
+	"This command will list the code versions of the smart contract",
+	"This command will list the interrupted transactions of the consensus journal",
+	"This command will resolve the interrupted transaction -reqID with the -journalAction (rollback, rollforward)",
+	"This command will list the RBT tokens of the DID, use -tokenType (whole, part) to filter the tokens",
 }
 
 type Command struct {
@@ -279,6 +345,11 @@ type Command struct {
 	dbPort                       string
 	dbUserName                   string
 	dbPassword                   string
+	dbSSLMode                    string
+	dbMaxOpenConns               int
+	dbMaxIdleConns               int
+	dbConnLifetime               int
+	migrateDB                    bool
 	senderAddr                   string
 	receiverAddr                 string
 	rbtAmount                    float64
@@ -310,6 +381,10 @@ type Command struct {
 	newContractBlock             string
 	publishType                  int
 	smartContractData            string
+	smartContractInput           string
+	codeToken                    string
+	migrationInput               string
+	smartContractEvents          string
 	executorAddr                 string
 	latest                       bool
 	quorumAddr                   string
@@ -331,6 +406,51 @@ type Command struct {
 	defaultSetup                 bool
 	apiKey                       string
 	nftValue                     float64
+	royaltyPercent               float64
+	royaltyRecipient             string
+	keepBlocks                   int
+	prune                        bool
+	archive                      bool
+	blockID                      string
+	blockNumber                  int64
+	stateKey                     string
+	eventName                    string
+	eventTopic                   string
+	fromBlock                    uint64
+	toBlock                      uint64
+	follow                       bool
+	bundleFile                   string
+	reqID                        string
+	eventSeq                     int
+	jobType                      string
+	jobStatus                    string
+	limit                        int
+	cursor                       string
+	sort                         string
+	tokenStatus                  string
+	tokenType                    string
+	minValue                     float64
+	maxValue                     float64
+	startDate                    string
+	endDate                      string
+	idempotencyKey               string
+	webhookURL                   string
+	webhookEvents                string
+	webhookSecret                string
+	webhookID                    string
+	deliveryID                   string
+	deliveryStatus               string
+	nodeAPIKey                   string
+	keyName                      string
+	keyRole                      string
+	keyDIDs                      string
+	keyID                        string
+	journalAction                string
+	output                       string
+	out                          io.Writer
+	rendered                     bool
+	failed                       bool
+	errMsg                       string
 }
 
 func showVersion() {
@@ -349,6 +469,7 @@ func showHelp() {
 	for i := range commands {
 		fmt.Printf("     %20s : %s\n\n", commands[i], commandsHelp[i])
 	}
+	fmt.Printf("Use -output json, yaml or table to get the response of the command in the format, the command exits with the non-zero code on failure\n\n")
 }
 
 // Get preferred outbound ip of this machine
@@ -374,7 +495,7 @@ func (cmd *Command) runApp() {
 	err := apiconfig.LoadAPIConfig(cmd.runDir+cmd.cfgFile, cmd.encKey, &cmd.cfg)
 
 	if err != nil {
-		cmd.log.Error("Configfile is either currupted or cipher is wrong", "err", err)
+		cmd.fail("Configfile is either currupted or cipher is wrong", "err", err)
 		return
 	}
 
@@ -383,7 +504,7 @@ func (cmd *Command) runApp() {
 	sc := make(chan bool, 1)
 	c, err := core.NewCore(&cmd.cfg, cmd.runDir+cmd.cfgFile, cmd.encKey, cmd.log, cmd.testNet, cmd.testNetKey, cmd.arbitaryMode, cmd.defaultSetup)
 	if err != nil {
-		cmd.log.Error("failed to create core")
+		cmd.fail("failed to create core")
 		return
 	}
 	addr := fmt.Sprintf(cmd.grpcAddr+":%d", cmd.grpcPort)
@@ -398,6 +519,7 @@ func (cmd *Command) runApp() {
 	}
 	scfg.EnableAuth = cmd.enableAuth
 	if cmd.enableAuth {
+		scfg.APIKey = cmd.nodeAPIKey
 		scfg.DBType = "Sqlite3"
 		scfg.DBAddress = cmd.cfg.DirPath + "rubix.db"
 	}
@@ -407,10 +529,10 @@ func (cmd *Command) runApp() {
 	// }
 	s, err := server.NewServer(c, scfg, cmd.log, cmd.start, sc, cmd.timeout)
 	if err != nil {
-		cmd.log.Error("Failed to create server")
+		cmd.fail("Failed to create server")
 		return
 	}
-	s.EnableSWagger(cmd.getURL(s.GetServerURL()))
+	s.EnableSWagger(cmd.getURL(s.GetServerURL()) + setup.APIOpenAPI)
 	cmd.log.Info("Core version : " + version)
 	cmd.log.Info("Starting server...")
 	go s.Start()
@@ -502,6 +624,11 @@ func Run(args []string) {
 	flag.StringVar(&cmd.dbPort, "dbPort", "1433", "Database port number")
 	flag.StringVar(&cmd.dbUserName, "dbUsername", "sa", "Database username")
 	flag.StringVar(&cmd.dbPassword, "dbPassword", "password", "Database password")
+	flag.StringVar(&cmd.dbSSLMode, "dbSSLMode", "", "Database SSL mode for PostgressSQL")
+	flag.IntVar(&cmd.dbMaxOpenConns, "dbMaxOpenConns", 0, "Maximum number of open database connections")
+	flag.IntVar(&cmd.dbMaxIdleConns, "dbMaxIdleConns", 0, "Maximum number of idle database connections")
+	flag.IntVar(&cmd.dbConnLifetime, "dbConnLifetime", 0, "Maximum life time of the database connection in seconds")
+	flag.BoolVar(&cmd.migrateDB, "migrateDB", false, "Migrate the existing records to the new database")
 	flag.StringVar(&cmd.senderAddr, "senderAddr", "", "Sender address")
 	flag.StringVar(&cmd.receiverAddr, "receiverAddr", "", "Receiver address")
 	flag.Float64Var(&cmd.rbtAmount, "rbtAmount", 0.0, "RBT amount")
@@ -532,6 +659,10 @@ func Run(args []string) {
 	flag.StringVar(&cmd.newContractBlock, "sctBlockHash", "", "Contract block hash")
 	flag.IntVar(&cmd.publishType, "pubType", 0, "Smart contract event publishing type(Deploy & Execute)")
 	flag.StringVar(&cmd.smartContractData, "sctData", "data", "Smart contract execution info")
+	flag.StringVar(&cmd.smartContractInput, "sctInput", "", "Smart contract execution input, the contract is executed by the node")
+	flag.StringVar(&cmd.codeToken, "codeToken", "", "Smart contract token generated for the new code of the upgrade")
+	flag.StringVar(&cmd.migrationInput, "migrationInput", "", "Input of the migrate function of the new smart contract code")
+	flag.StringVar(&cmd.smartContractEvents, "sctEvents", "", "Smart contract events of the execution as the JSON array of {name, topics, data}")
 	flag.StringVar(&cmd.executorAddr, "executorAddr", "", "Smart contract Executor Address")
 	flag.BoolVar(&cmd.latest, "latest", false, "flag to set latest")
 	flag.StringVar(&cmd.quorumAddr, "quorumAddr", "", "Quorum Node Address to check the status of the Quorum")
@@ -551,6 +682,47 @@ func Run(args []string) {
 	flag.BoolVar(&cmd.defaultSetup, "defaultSetup", false, "Add Faucet Quorums")
 	flag.StringVar(&cmd.apiKey, "apikey", "", "Give the API Key corresponding to the DID")
 	flag.Float64Var(&cmd.nftValue, "nftValue", 0.0, "Value of the NFT")
+	flag.Float64Var(&cmd.royaltyPercent, "royaltyPercent", 0.0, "Royalty percentage of the NFT sale value paid to the royalty recipient")
+	flag.StringVar(&cmd.royaltyRecipient, "royaltyRecipient", "", "Royalty recipient DID of the NFT, defaults to the deployer")
+	flag.IntVar(&cmd.keepBlocks, "keepBlocks", 10, "Number of latest blocks to keep after the checkpoint")
+	flag.BoolVar(&cmd.prune, "prune", false, "Prune the token chain blocks older than the checkpoint")
+	flag.BoolVar(&cmd.archive, "archive", false, "Archive the pruned token chain blocks")
+	flag.StringVar(&cmd.blockID, "blockID", "", "Token chain block ID")
+	flag.Int64Var(&cmd.blockNumber, "blockNumber", -1, "Token chain block number, latest block if not set")
+	flag.StringVar(&cmd.stateKey, "stateKey", "", "Smart contract state key")
+	flag.StringVar(&cmd.eventName, "eventName", "", "Smart contract event name to filter the events")
+	flag.StringVar(&cmd.eventTopic, "topic", "", "Smart contract event topic to filter the events")
+	flag.Uint64Var(&cmd.fromBlock, "fromBlock", 0, "First block number of the events")
+	flag.Uint64Var(&cmd.toBlock, "toBlock", 0, "Last block number of the events, latest block if not set")
+	flag.BoolVar(&cmd.follow, "follow", false, "Follow the new events")
+	flag.StringVar(&cmd.bundleFile, "bundleFile", "", "Token bundle file")
+	flag.StringVar(&cmd.reqID, "reqID", "", "Request ID")
+	flag.IntVar(&cmd.eventSeq, "eventSeq", 0, "Sequence number of the last received event")
+	flag.StringVar(&cmd.jobType, "jobType", "", "Job type")
+	flag.StringVar(&cmd.jobStatus, "jobStatus", "", "Job status (running, succeeded, failed, interrupted)")
+	flag.IntVar(&cmd.limit, "limit", 0, "Maximum number of records")
+	flag.StringVar(&cmd.cursor, "cursor", "", "Cursor of the next page from the previous list")
+	flag.StringVar(&cmd.sort, "sort", "", "Sort field of the list, prefix with - for descending order")
+	flag.StringVar(&cmd.tokenStatus, "tokenStatus", "", "Comma separated token status to filter the list")
+	flag.StringVar(&cmd.tokenType, "tokenType", "", "Token type to filter the list (whole, part)")
+	flag.Float64Var(&cmd.minValue, "minValue", 0, "Minimum token value or transaction amount to filter the list")
+	flag.Float64Var(&cmd.maxValue, "maxValue", 0, "Maximum token value or transaction amount to filter the list")
+	flag.StringVar(&cmd.startDate, "startDate", "", "Start date of the transactions (YYYY-MM-DD)")
+	flag.StringVar(&cmd.endDate, "endDate", "", "End date of the transactions, not included (YYYY-MM-DD)")
+	flag.StringVar(&cmd.idempotencyKey, "idempotencyKey", "", "Idempotency key, retry with the same key returns the earlier result")
+	flag.StringVar(&cmd.webhookURL, "webhookURL", "", "Webhook URL")
+	flag.StringVar(&cmd.webhookEvents, "webhookEvents", "", "Comma separated webhook events, empty for all the events")
+	flag.StringVar(&cmd.webhookSecret, "webhookSecret", "", "Webhook signing secret, generated if not given")
+	flag.StringVar(&cmd.webhookID, "webhookID", "", "Webhook ID")
+	flag.StringVar(&cmd.deliveryID, "deliveryID", "", "Webhook delivery ID")
+	flag.StringVar(&cmd.deliveryStatus, "deliveryStatus", "", "Webhook delivery status (pending, delivered, dead)")
+	flag.StringVar(&cmd.nodeAPIKey, "nodeAPIKey", "", "API key of the node, on run it is the admin key when the authentication is enabled")
+	flag.StringVar(&cmd.keyName, "keyName", "", "API key name")
+	flag.StringVar(&cmd.keyRole, "keyRole", "", "API key role (admin, operator, did_owner, read_only)")
+	flag.StringVar(&cmd.keyDIDs, "keyDIDs", "", "Comma separated DIDs of the API key")
+	flag.StringVar(&cmd.keyID, "keyID", "", "API key ID")
+	flag.StringVar(&cmd.journalAction, "journalAction", "", "Consensus journal action (rollback, rollforward)")
+	flag.StringVar(&cmd.output, "output", OutputText, "Output format of the command (text, json, yaml, table)")
 
 	if len(os.Args) < 2 {
 		fmt.Println("Invalid Command")
@@ -613,15 +785,26 @@ func Run(args []string) {
 
 	cmd.log = logger.New(logOptions)
 
+	if !cmd.initOutput() {
+		os.Exit(ExitInvalidUsage)
+	}
+	// commands set the failure from their result, the node runs till it is stopped
+	if cmdName != RunCmd {
+		defer cmd.finishOutput()
+	}
+
 	cmd.c, err = client.NewClient(&srvcfg.Config{ServerAddress: cmd.addr, ServerPort: cmd.port}, cmd.log, cmd.timeout)
 	if err != nil {
-		cmd.log.Error("Failed to create client")
+		cmd.fail("Failed to create client")
 		return
 	}
+	cmd.c.SetAPIKey(cmd.nodeAPIKey)
 
 	switch cmdName {
 	case VersionCmd:
-		showVersion()
+		if !cmd.render(map[string]string{"version": version}) {
+			showVersion()
+		}
 	case HelpCmd:
 		showHelp()
 	case RunCmd:
@@ -758,8 +941,52 @@ func Run(args []string) {
 		cmd.CreateDIDFromPubKey()
 	case AddUserAPIKeyCmd:
 		cmd.addUserAPIKey()
+	case CheckpointTokenChainCmd:
+		cmd.checkpointTokenChain()
+	case RestoreTokenChainCmd:
+		cmd.restoreTokenChain()
+	case GetTokenProofCmd:
+		cmd.getTokenProof()
+	case ExportTokenCmd:
+		cmd.exportToken()
+	case ImportTokenCmd:
+		cmd.importToken()
+	case WatchTxnCmd:
+		cmd.watchTxn()
+	case JobsCmd:
+		cmd.jobs()
+	case AddWebhookCmd:
+		cmd.addWebhook()
+	case RemoveWebhookCmd:
+		cmd.removeWebhook()
+	case ListWebhooksCmd:
+		cmd.listWebhooks()
+	case WebhookDeliveriesCmd:
+		cmd.webhookDeliveries()
+	case ReplayWebhookDeliveryCmd:
+		cmd.replayWebhookDelivery()
+	case AddAPIKeyCmd:
+		cmd.addAPIKey()
+	case ListAPIKeysCmd:
+		cmd.listAPIKeys()
+	case RemoveAPIKeyCmd:
+		cmd.removeAPIKey()
+	case QuerySmartContractStateCmd:
+		cmd.querySmartContractState()
+	case GetSmartContractEventsCmd:
+		cmd.getSmartContractEvents()
+	case UpgradeSmartContractCmd:
+		cmd.upgradeSmartContract()
+	case GetSmartContractVersionsCmd:
+		cmd.getSmartContractVersions()
+	case ConsensusJournalCmd:
+		cmd.consensusJournal()
+	case ResolveConsensusJournalCmd:
+		cmd.resolveConsensusJournal()
+	case GetAllTokensCmd:
+		cmd.getAllTokens()
 	default:
-		cmd.log.Error("Invalid command")
+		cmd.fail("Invalid command")
 	}
 }
 
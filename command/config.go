@@ -4,17 +4,24 @@ import "github.com/rubixchain/rubixgoplatform/core/config"
 
 func (cmd *Command) setupDB() {
 	sc := &config.StorageConfig{
-		StorageType: cmd.storageType,
-		DBName:      cmd.dbName,
-		DBAddress:   cmd.dbAddress,
-		DBUserName:  cmd.dbUserName,
-		DBPassword:  cmd.dbPassword,
-		DBPort:      cmd.dbPort,
-		DBType:      cmd.dbType,
+		StorageType:  cmd.storageType,
+		DBName:       cmd.dbName,
+		DBAddress:    cmd.dbAddress,
+		DBUserName:   cmd.dbUserName,
+		DBPassword:   cmd.dbPassword,
+		DBPort:       cmd.dbPort,
+		DBType:       cmd.dbType,
+		DBSSLMode:    cmd.dbSSLMode,
+		MaxOpenConns: cmd.dbMaxOpenConns,
+		MaxIdleConns: cmd.dbMaxIdleConns,
+		ConnLifetime: cmd.dbConnLifetime,
+	}
+	msg, ok := cmd.c.SetupDB(sc, cmd.migrateDB)
+	if cmd.renderStatus(ok, msg) {
+		return
 	}
-	msg, ok := cmd.c.SetupDB(sc)
 	if !ok {
-		cmd.log.Error("Failed to setup DB", "msg", msg)
+		cmd.fail("Failed to setup DB", "msg", msg)
 		return
 	}
 	cmd.log.Info("DB setup done successfully")
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+func printJournalEntry(e *model.ConsensusJournalEntry) {
+	fmt.Printf("%s  %-22s %-16s %s  %s -> %s\n", e.ID, e.Mode, e.State, e.UpdatedAt.Format("2006-01-02 15:04:05"), e.SenderDID, e.ReceiverDID)
+	fmt.Printf("    transaction  : %s\n", e.TransactionID)
+	fmt.Printf("    tokens       : %d, receiver ack : %v\n", len(e.Tokens), e.ReceiverAck)
+	if e.Message != "" {
+		fmt.Printf("    message      : %s\n", e.Message)
+	}
+}
+
+func (cmd *Command) consensusJournal() {
+	jr, err := cmd.c.GetConsensusJournal()
+	if err != nil {
+		cmd.fail("Failed to get the consensus journal", "err", err)
+		return
+	}
+	if cmd.render(jr) {
+		return
+	}
+	if !jr.Status {
+		cmd.fail("Failed to get the consensus journal", "msg", jr.Message)
+		return
+	}
+	for i := range jr.Entries {
+		printJournalEntry(&jr.Entries[i])
+	}
+	cmd.log.Info("Consensus journal listed", "count", len(jr.Entries))
+}
+
+func (cmd *Command) resolveConsensusJournal() {
+	if cmd.reqID == "" || cmd.journalAction == "" {
+		cmd.fail("Request ID and journal action are required")
+		return
+	}
+	jr, err := cmd.c.ResolveConsensusJournal(cmd.reqID, cmd.journalAction)
+	if err != nil {
+		cmd.fail("Failed to resolve the consensus journal", "err", err)
+		return
+	}
+	if cmd.render(jr) {
+		return
+	}
+	if !jr.Status {
+		cmd.fail("Failed to resolve the consensus journal", "msg", jr.Message)
+		return
+	}
+	printJournalEntry(jr.Entry)
+	cmd.log.Info("Consensus journal resolved", "state", jr.Entry.State)
+}
@@ -19,13 +19,13 @@ func (cmd *Command) createDataToken() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	dt := client.DataTokenReq{
@@ -40,7 +40,7 @@ func (cmd *Command) createDataToken() {
 	} else {
 		fd, err := ioutil.ReadFile(cmd.file)
 		if err != nil {
-			cmd.log.Error("Failed to read file", "err", err)
+			cmd.fail("Failed to read file", "err", err)
 			return
 		}
 		hb := util.CalculateHash(fd, "SHA3-256")
@@ -51,23 +51,26 @@ func (cmd *Command) createDataToken() {
 		fi[fn] = info
 		jb, err := json.Marshal(fi)
 		if err != nil {
-			cmd.log.Error("Failed to marshal json input", "err", err)
+			cmd.fail("Failed to marshal json input", "err", err)
 			return
 		}
 		dt.FileInfo = string(jb)
 	}
 	br, err := cmd.c.CreateDataToken(&dt)
 	if err != nil {
-		cmd.log.Error("Failed to create data token", "err", err)
+		cmd.fail("Failed to create data token", "err", err)
 		return
 	}
 	if !br.Status {
-		cmd.log.Error("Failed to create data token", "msg", br.Message)
+		cmd.fail("Failed to create data token", "msg", br.Message)
 		return
 	}
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to create data token, " + msg)
+		cmd.fail("Failed to create data token, " + msg)
 		return
 	}
 	cmd.log.Info(fmt.Sprintf("Data Token : %s", msg))
@@ -80,30 +83,33 @@ func (cmd *Command) commitDataToken() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	br, err := cmd.c.CommitDataToken(cmd.did, cmd.batchID)
 	if err != nil {
-		cmd.log.Error("Failed to commit data token", "err", err)
+		cmd.fail("Failed to commit data token", "err", err)
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("Failed to commit data token", "msg", br.Message)
+		cmd.fail("Failed to commit data token", "msg", br.Message)
 		return
 	}
 
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to commit data token, " + msg)
+		cmd.fail("Failed to commit data token, " + msg)
 		return
 	}
 	cmd.log.Info("Data tokens committed successfully")
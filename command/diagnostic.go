@@ -9,9 +9,15 @@ import (
 	"strings"
 
 	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/util"
 )
 
+// renderBlocks writes the dumped blocks in the output format instead of the dump file
+func (cmd *Command) renderBlocks(str string) bool {
+	return cmd.render(&model.BasicResponse{Status: true, Message: "Token chain dumped successfully", Result: json.RawMessage(str)})
+}
+
 func tcMarshal(str string, m interface{}) (string, error) {
 	var err error
 	switch mt := m.(type) {
@@ -142,27 +148,28 @@ func (cmd *Command) dumpTokenChain() {
 		fmt.Print("Enter Token Id : ")
 		_, err := fmt.Scan(&cmd.token)
 		if err != nil {
-			cmd.log.Error("Failed to get Token ID")
+			cmd.fail("Failed to get Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.token)
 
 	if len(cmd.token) != 46 || !strings.HasPrefix(cmd.token, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid token")
+		cmd.fail("Invalid token")
 		return
 	}
 
 	blocks := make([]map[string]interface{}, 0)
 	blockID := ""
+	pr := cmd.pageRequest()
 	for {
-		ds, err := cmd.c.DumpTokenChain(cmd.token, blockID)
+		ds, err := cmd.c.DumpTokenChain(cmd.token, blockID, pr)
 		if err != nil {
-			cmd.log.Error("Failed to dump token chain", "err", err)
+			cmd.fail("Failed to dump token chain", "err", err)
 			return
 		}
 		if !ds.Status {
-			cmd.log.Error("Failed to dump token chain", "msg", ds.Message)
+			cmd.fail("Failed to dump token chain", "msg", ds.Message)
 			return
 		}
 		for _, blk := range ds.Blocks {
@@ -175,17 +182,21 @@ func (cmd *Command) dumpTokenChain() {
 		}
 		blockID = ds.NextBlockID
 		if ds.NextBlockID == "" {
+			cmd.showNextCursor(ds.NextCursor)
 			break
 		}
 	}
 	str, err := tcMarshal("", blocks)
 	if err != nil {
-		cmd.log.Error("Failed to dump token chain", "err", err)
+		cmd.fail("Failed to dump token chain", "err", err)
+		return
+	}
+	if cmd.renderBlocks(str) {
 		return
 	}
 	f, err := os.Create("dump.json")
 	if err != nil {
-		cmd.log.Error("Failed to dump token chain", "err", err)
+		cmd.fail("Failed to dump token chain", "err", err)
 		return
 	}
 	f.WriteString(str)
@@ -196,14 +207,15 @@ func (cmd *Command) dumpTokenChain() {
 func (cmd *Command) dumpFTTokenchain() {
 	blocks := make([]map[string]interface{}, 0)
 	blockID := ""
+	pr := cmd.pageRequest()
 	for {
-		ds, err := cmd.c.DumpFTTokenChain(cmd.token, blockID)
+		ds, err := cmd.c.DumpFTTokenChain(cmd.token, blockID, pr)
 		if err != nil {
-			cmd.log.Error("Failed to dump token chain", "err", err)
+			cmd.fail("Failed to dump token chain", "err", err)
 			return
 		}
 		if !ds.Status {
-			cmd.log.Error("Failed to dump token chain", "msg", ds.Message)
+			cmd.fail("Failed to dump token chain", "msg", ds.Message)
 			return
 		}
 		for _, blk := range ds.Blocks {
@@ -216,17 +228,21 @@ func (cmd *Command) dumpFTTokenchain() {
 		}
 		blockID = ds.NextBlockID
 		if ds.NextBlockID == "" {
+			cmd.showNextCursor(ds.NextCursor)
 			break
 		}
 	}
 	str, err := tcMarshal("", blocks)
 	if err != nil {
-		cmd.log.Error("Failed to dump token chain", "err", err)
+		cmd.fail("Failed to dump token chain", "err", err)
+		return
+	}
+	if cmd.renderBlocks(str) {
 		return
 	}
 	f, err := os.Create("dump.json")
 	if err != nil {
-		cmd.log.Error("Failed to dump token chain", "err", err)
+		cmd.fail("Failed to dump token chain", "err", err)
 		return
 	}
 	f.WriteString(str)
@@ -240,26 +256,27 @@ func (cmd *Command) dumpSmartContractTokenChain() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 	blocks := make([]map[string]interface{}, 0)
 	blockID := ""
+	pr := cmd.pageRequest()
 	for {
-		ds, err := cmd.c.DumpSmartContractTokenChain(cmd.smartContractToken, blockID)
+		ds, err := cmd.c.DumpSmartContractTokenChain(cmd.smartContractToken, blockID, pr)
 		if err != nil {
-			cmd.log.Error("Failed to dump smart contract token chain", "err", err)
+			cmd.fail("Failed to dump smart contract token chain", "err", err)
 			return
 		}
 		if !ds.Status {
-			cmd.log.Error("Failed to dump smart contract token chain", "msg", ds.Message)
+			cmd.fail("Failed to dump smart contract token chain", "msg", ds.Message)
 			return
 		}
 		for _, blk := range ds.Blocks {
@@ -272,17 +289,21 @@ func (cmd *Command) dumpSmartContractTokenChain() {
 		}
 		blockID = ds.NextBlockID
 		if ds.NextBlockID == "" {
+			cmd.showNextCursor(ds.NextCursor)
 			break
 		}
 	}
 	str, err := tcMarshal("", blocks)
 	if err != nil {
-		cmd.log.Error("Failed to dump smart contract token chain", "err", err)
+		cmd.fail("Failed to dump smart contract token chain", "err", err)
+		return
+	}
+	if cmd.renderBlocks(str) {
 		return
 	}
 	f, err := os.Create("dump.json")
 	if err != nil {
-		cmd.log.Error("Failed to dump smart contract token chain", "err", err)
+		cmd.fail("Failed to dump smart contract token chain", "err", err)
 		return
 	}
 	f.WriteString(str)
@@ -296,26 +317,27 @@ func (cmd *Command) dumpNFTTokenChain() {
 		fmt.Print("Enter NFT Id : ")
 		_, err := fmt.Scan(&cmd.nft)
 		if err != nil {
-			cmd.log.Error("Failed to get NFT Token ID")
+			cmd.fail("Failed to get NFT Token ID")
 			return
 		}
 	}
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 
 	if len(cmd.nft) != 46 || !strings.HasPrefix(cmd.nft, "Qm") || !is_alphanumeric {
-		cmd.log.Error("Invalid nft")
+		cmd.fail("Invalid nft")
 		return
 	}
 	blocks := make([]map[string]interface{}, 0)
 	blockID := ""
+	pr := cmd.pageRequest()
 	for {
-		ds, err := cmd.c.DumpNFTTokenChain(cmd.nft, blockID)
+		ds, err := cmd.c.DumpNFTTokenChain(cmd.nft, blockID, pr)
 		if err != nil {
-			cmd.log.Error("Failed to get nft token chain", "err", err)
+			cmd.fail("Failed to get nft token chain", "err", err)
 			return
 		}
 		if !ds.Status {
-			cmd.log.Error("Failed to get nft token chain", "msg", ds.Message)
+			cmd.fail("Failed to get nft token chain", "msg", ds.Message)
 			return
 		}
 		for _, blk := range ds.Blocks {
@@ -328,17 +350,21 @@ func (cmd *Command) dumpNFTTokenChain() {
 		}
 		blockID = ds.NextBlockID
 		if ds.NextBlockID == "" {
+			cmd.showNextCursor(ds.NextCursor)
 			break
 		}
 	}
 	str, err := tcMarshal("", blocks)
 	if err != nil {
-		cmd.log.Error("Failed to get nft token chain", "err", err)
+		cmd.fail("Failed to get nft token chain", "err", err)
+		return
+	}
+	if cmd.renderBlocks(str) {
 		return
 	}
 	f, err := os.Create("nft.json")
 	if err != nil {
-		cmd.log.Error("Failed to write nft token chain to file", "err", err)
+		cmd.fail("Failed to write nft token chain to file", "err", err)
 		return
 	}
 	f.WriteString(str)
@@ -351,7 +377,7 @@ func (cmd *Command) decodeTokenChain() {
 	// Open the input JSON file
 	file, err := os.Open("dump.json")
 	if err != nil {
-		fmt.Println("Error opening file:", err)
+		cmd.fail("Failed to open dump.json", "err", err)
 		return
 	}
 	defer file.Close()
@@ -359,7 +385,7 @@ func (cmd *Command) decodeTokenChain() {
 	// Read the JSON file
 	byteValue, err := ioutil.ReadAll(file)
 	if err != nil {
-		fmt.Println("Error reading file:", err)
+		cmd.fail("Failed to read dump.json", "err", err)
 		return
 	}
 
@@ -367,7 +393,7 @@ func (cmd *Command) decodeTokenChain() {
 	var data []interface{}
 	err = json.Unmarshal(byteValue, &data)
 	if err != nil {
-		fmt.Println("Error parsing JSON:", err)
+		cmd.fail("Failed to parse dump.json", "err", err)
 		return
 	}
 
@@ -381,20 +407,59 @@ func (cmd *Command) decodeTokenChain() {
 	// Convert the transformed data back to JSON
 	output, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
-		fmt.Println("Error marshaling JSON:", err)
+		cmd.fail("Failed to marshal the decoded token chain", "err", err)
 		return
 	}
 
 	// Write the output to a file
 	err = ioutil.WriteFile("output.json", output, 0644)
 	if err != nil {
-		fmt.Println("Error writing file:", err)
+		cmd.fail("Failed to write output.json", "err", err)
 		return
 	}
 
+	if cmd.renderStatus(true, "Token chain decoded to output.json") {
+		return
+	}
 	fmt.Println("Transformation complete. Check output.json for results.")
 }
 
+func (cmd *Command) getTokenProof() {
+	if cmd.token == "" {
+		cmd.log.Info("token id cannot be empty")
+		fmt.Print("Enter Token Id : ")
+		_, err := fmt.Scan(&cmd.token)
+		if err != nil {
+			cmd.fail("Failed to get Token ID")
+			return
+		}
+	}
+	pr, err := cmd.c.GetTokenProof(cmd.token, cmd.blockID)
+	if err != nil {
+		cmd.fail("Failed to get token proof", "err", err)
+		return
+	}
+	if cmd.render(pr) {
+		return
+	}
+	if !pr.Status {
+		cmd.fail("Failed to get token proof", "msg", pr.Message)
+		return
+	}
+	err = block.VerifyTokenInclusionProof(pr.Proof)
+	if err != nil {
+		cmd.fail("Invalid token proof", "err", err)
+		return
+	}
+	str, err := json.MarshalIndent(pr.Proof, "", "   ")
+	if err != nil {
+		cmd.fail("Failed to marshal token proof", "err", err)
+		return
+	}
+	fmt.Println(string(str))
+	cmd.log.Info("Token proof verified successfully")
+}
+
 func (cmd *Command) getTokenBlock() {
 
 }
@@ -412,14 +477,41 @@ func (cmd *Command) getSmartContractData() {
 
 }
 
+func (cmd *Command) querySmartContractState() {
+	if cmd.smartContractToken == "" || cmd.stateKey == "" {
+		cmd.fail("Smart contract token and state key are required, use -sct and -stateKey")
+		return
+	}
+	sr, err := cmd.c.QuerySmartContractState(cmd.smartContractToken, cmd.stateKey, cmd.blockNumber)
+	if err != nil {
+		cmd.fail("Failed to query smart contract state", "err", err)
+		return
+	}
+	if cmd.render(sr) {
+		return
+	}
+	if !sr.Status {
+		cmd.fail("Failed to query smart contract state", "msg", sr.Message)
+		return
+	}
+	if !sr.Found {
+		cmd.log.Info("State key is not set", "key", sr.Key, "block", sr.BlockNumber)
+		return
+	}
+	cmd.log.Info("Smart contract state", "key", sr.Key, "block", sr.BlockNumber, "value", sr.Value)
+}
+
 func (cmd *Command) removeTokenChainBlock() {
 	response, err := cmd.c.RemoveTokenChainBlock(cmd.token, cmd.latest)
 	if err != nil {
-		cmd.log.Error("Failed to remove token chain", "err", err)
+		cmd.fail("Failed to remove token chain", "err", err)
+		return
+	}
+	if cmd.render(response) {
 		return
 	}
 	if !response.Status {
-		cmd.log.Error("Failed to remove token chain", "msg", response.Message)
+		cmd.fail("Failed to remove token chain", "msg", response.Message)
 		return
 	}
 	cmd.log.Info("Token chain removed successfully!")
@@ -428,11 +520,14 @@ func (cmd *Command) removeTokenChainBlock() {
 func (cmd *Command) releaseAllLockedTokens() {
 	resp, err := cmd.c.ReleaseAllLockedTokens()
 	if err != nil {
-		cmd.log.Error("Failed to release the locked tokens", "err", err)
+		cmd.fail("Failed to release the locked tokens", "err", err)
+		return
+	}
+	if cmd.render(resp) {
 		return
 	}
 	if !resp.Status {
-		cmd.log.Error("Failed to release the locked tokens", "msg", resp.Message)
+		cmd.fail("Failed to release the locked tokens", "msg", resp.Message)
 		return
 	}
 	cmd.log.Info("Locked Tokens released successfully Or No Locked Tokens found to be released")
@@ -22,16 +22,16 @@ func (cmd *Command) CreateDID() {
 	if cmd.forcePWD {
 		pwd, err := getpassword("Set private key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		npwd, err := getpassword("Re-enter private key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		if pwd != npwd {
-			cmd.log.Error("Password mismatch")
+			cmd.fail("Password mismatch")
 			return
 		}
 		cmd.privPWD = pwd
@@ -39,46 +39,46 @@ func (cmd *Command) CreateDID() {
 	if cmd.forcePWD {
 		pwd, err := getpassword("Set quorum key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		npwd, err := getpassword("Re-enter quorum key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		if pwd != npwd {
-			cmd.log.Error("Password mismatch")
+			cmd.fail("Password mismatch")
 			return
 		}
 		cmd.quorumPWD = pwd
 	}
 	if cmd.didType < 0 || cmd.didType > 4 {
-		cmd.log.Error("DID Type should be between 0 and 4")
+		cmd.fail("DID Type should be between 0 and 4")
 		return
 	}
 	if cmd.didType == did.LiteDIDMode {
 		if cmd.privKeyFile == "" || cmd.pubKeyFile == "" {
-			cmd.log.Error("private key & public key file names required")
+			cmd.fail("private key & public key file names required")
 			return
 		}
 	} else if cmd.didType == did.WalletDIDMode {
 		f, err := os.Open(cmd.imgFile)
 		if err != nil {
-			cmd.log.Error("failed to open image", "err", err)
+			cmd.fail("failed to open image", "err", err)
 			return
 		}
 		defer f.Close()
 		img, _, err := image.Decode(f)
 		if err != nil {
-			cmd.log.Error("failed to decode image", "err", err)
+			cmd.fail("failed to decode image", "err", err)
 			return
 		}
 		bounds := img.Bounds()
 		w, h := bounds.Max.X, bounds.Max.Y
 
 		if w != 256 || h != 256 {
-			cmd.log.Error("invalid image size", "err", err)
+			cmd.fail("invalid image size", "err", err)
 			return
 		}
 		pixels := make([]byte, 0)
@@ -106,7 +106,7 @@ func (cmd *Command) CreateDID() {
 
 		err = util.CreatePNGImage(outPixels, w, h, cmd.didImgFile)
 		if err != nil {
-			cmd.log.Error("failed to create image", "err", err)
+			cmd.fail("failed to create image", "err", err)
 			return
 		}
 		pvtShare := make([]byte, 0)
@@ -119,33 +119,33 @@ func (cmd *Command) CreateDID() {
 		}
 		err = util.CreatePNGImage(pvtShare, w*4, h*2, cmd.privImgFile)
 		if err != nil {
-			cmd.log.Error("failed to create image", "err", err)
+			cmd.fail("failed to create image", "err", err)
 			return
 		}
 		err = util.CreatePNGImage(pubShare, w*4, h*2, cmd.pubImgFile)
 		if err != nil {
-			cmd.log.Error("failed to create image", "err", err)
+			cmd.fail("failed to create image", "err", err)
 			return
 		}
 	}
 	if cmd.didType != did.BasicDIDMode && cmd.didType != did.LiteDIDMode {
 		if cmd.privKeyFile == "" || cmd.pubKeyFile == "" {
-			cmd.log.Error("private key & public key file names required")
+			cmd.fail("private key & public key file names required")
 			return
 		}
 		pvtKey, pubKey, err := crypto.GenerateKeyPair(&crypto.CryptoConfig{Alg: crypto.ECDSAP256, Pwd: cmd.privPWD})
 		if err != nil {
-			cmd.log.Error("failed to create keypair", "err", err)
+			cmd.fail("failed to create keypair", "err", err)
 			return
 		}
 		err = util.FileWrite(cmd.privKeyFile, pvtKey)
 		if err != nil {
-			cmd.log.Error("failed to write private key file", "err", err)
+			cmd.fail("failed to write private key file", "err", err)
 			return
 		}
 		err = util.FileWrite(cmd.pubKeyFile, pubKey)
 		if err != nil {
-			cmd.log.Error("failed to write public key file", "err", err)
+			cmd.fail("failed to write public key file", "err", err)
 			return
 		}
 	}
@@ -163,26 +163,33 @@ func (cmd *Command) CreateDID() {
 		ChildPath:      cmd.ChildPath,
 	}
 	msg, status := cmd.c.CreateDID(&cfg)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to create DID", "message", msg)
+		cmd.fail("Failed to create DID", "message", msg)
 		return
 	}
 	cmd.log.Info(fmt.Sprintf("DID %v created successfully", msg))
 }
 
 func (cmd *Command) GetAllDID() {
-	response, err := cmd.c.GetAllDIDs()
+	response, err := cmd.c.GetAllDIDs(cmd.pageRequest())
 	if err != nil {
-		cmd.log.Error("Invalid response from the node", "err", err)
+		cmd.fail("Invalid response from the node", "err", err)
+		return
+	}
+	if cmd.render(response) {
 		return
 	}
 	if !response.Status {
-		cmd.log.Error("Failed to get DIDs", "message", response.Message)
+		cmd.fail("Failed to get DIDs", "message", response.Message)
 		return
 	}
 	for i := range response.AccountInfo {
 		fmt.Printf("Address : %s\n", response.AccountInfo[i].DID)
 	}
+	cmd.showNextCursor(response.NextCursor)
 	cmd.log.Info("Got all DID successfully")
 }
 
@@ -192,31 +199,34 @@ func (cmd *Command) RegsiterDIDCmd() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	br, err := cmd.c.RegisterDID(cmd.did)
 
 	if err != nil {
-		cmd.log.Error("Failed to register DID", "err", err)
+		cmd.fail("Failed to register DID", "err", err)
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("Failed to register DID", "msg", br.Message)
+		cmd.fail("Failed to register DID", "msg", br.Message)
 		return
 	}
 
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to register DID, " + msg)
+		cmd.fail("Failed to register DID, " + msg)
 		return
 	}
 	cmd.log.Info("DID registered successfully")
@@ -225,25 +235,28 @@ func (cmd *Command) RegsiterDIDCmd() {
 func (cmd *Command) SetupDIDCmd() {
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	br, err := cmd.c.RegisterDID(cmd.did)
 
 	if err != nil {
-		cmd.log.Error("Failed to register DID", "err", err)
+		cmd.fail("Failed to register DID", "err", err)
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("Failed to register DID", "msg", br.Message)
+		cmd.fail("Failed to register DID", "msg", br.Message)
 		return
 	}
 
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to register DID, " + msg)
+		cmd.fail("Failed to register DID, " + msg)
 		return
 	}
 	cmd.log.Info("DID registered successfully")
@@ -353,18 +366,21 @@ func (cmd *Command) GetAccountInfo() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	info, err := cmd.c.GetAccountInfo(cmd.did)
 	if err != nil {
-		cmd.log.Error("Invalid response from the node", "err", err)
+		cmd.fail("Invalid response from the node", "err", err)
+		return
+	}
+	if cmd.render(info) {
 		return
 	}
 	fmt.Printf("Response : %v\n", info)
@@ -382,5 +398,8 @@ func (cmd *Command) CreateDIDFromPubKey() {
 	if err != nil {
 		cmd.log.Error("err", err)
 	}
+	if cmd.render(did) {
+		return
+	}
 	cmd.log.Debug("received did", did)
 }
@@ -4,15 +4,19 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
 )
 
 func (cmd *Command) addExplorer() {
 	if len(cmd.links) == 0 {
-		cmd.log.Error("provide explorer links required to add")
+		cmd.fail("provide explorer links required to add")
 		return
 	}
 	msg, status := cmd.c.AddExplorer(cmd.links)
-
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Add Explorer command failed, " + msg)
 	} else {
@@ -22,10 +26,13 @@ func (cmd *Command) addExplorer() {
 
 func (cmd *Command) removeExplorer() {
 	if len(cmd.links) == 0 {
-		cmd.log.Error("provide explorer links required to remove")
+		cmd.fail("provide explorer links required to remove")
 		return
 	}
 	msg, status := cmd.c.RemoveExplorer(cmd.links)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Remove Explorer command failed, " + msg)
 	} else {
@@ -35,6 +42,9 @@ func (cmd *Command) removeExplorer() {
 
 func (cmd *Command) getAllExplorer() {
 	links, msg, status := cmd.c.GetAllExplorer()
+	if cmd.render(&model.ExplorerResponse{Status: status, Message: msg, Result: model.ExplorerLinks{Links: links}}) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Get all Explorer command failed, " + msg)
 	} else {
@@ -49,19 +59,21 @@ func (cmd *Command) getAllExplorer() {
 func (cmd *Command) addUserAPIKey() {
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !isAlphanumeric {
-		cmd.log.Error("Invalid DID. Please provide valid DID")
+		cmd.fail("Invalid DID. Please provide valid DID")
 		return
 	}
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	if cmd.apiKey == "" {
-		cmd.log.Error("API Key cannot be empty")
+		cmd.fail("API Key cannot be empty")
 		return
 	}
 	msg, status := cmd.c.AddUserAPIKey(cmd.did, cmd.apiKey)
-
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("API Key could not be added, " + msg)
 	} else {
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+func printJob(job *model.Job) {
+	fmt.Printf("%s  %-28s %-12s %s  %s\n", job.ID, job.Type, job.Status, job.CreatedAt.Format("2006-01-02 15:04:05"), job.DID)
+	if job.Error != "" {
+		fmt.Printf("    error  : %s\n", job.Error)
+	}
+}
+
+func (cmd *Command) jobs() {
+	if cmd.reqID != "" {
+		jr, err := cmd.c.GetJob(cmd.reqID)
+		if err != nil {
+			cmd.fail("Failed to get the job", "err", err)
+			return
+		}
+		if cmd.render(jr) {
+			return
+		}
+		if !jr.Status {
+			cmd.fail("Failed to get the job", "msg", jr.Message)
+			return
+		}
+		printJob(jr.Job)
+		if jr.Job.Result != "" {
+			fmt.Printf("    result : %s\n", jr.Job.Result)
+		}
+		fmt.Printf("    updated: %s\n", jr.Job.UpdatedAt.Format("2006-01-02 15:04:05"))
+		return
+	}
+	jr, err := cmd.c.GetJobs(cmd.did, cmd.jobType, cmd.jobStatus, cmd.limit)
+	if err != nil {
+		cmd.fail("Failed to get the jobs", "err", err)
+		return
+	}
+	if cmd.render(jr) {
+		return
+	}
+	if !jr.Status {
+		cmd.fail("Failed to get the jobs", "msg", jr.Message)
+		return
+	}
+	for i := range jr.Jobs {
+		printJob(&jr.Jobs[i])
+	}
+	cmd.log.Info("Jobs listed", "count", len(jr.Jobs))
+}
@@ -11,16 +11,16 @@ func (cmd *Command) MigrateNodeCmd() {
 	if cmd.forcePWD {
 		pwd, err := getpassword("Set private key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		npwd, err := getpassword("Re-enter private key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		if pwd != npwd {
-			cmd.log.Error("Password mismatch")
+			cmd.fail("Password mismatch")
 			return
 		}
 		cmd.privPWD = pwd
@@ -28,22 +28,22 @@ func (cmd *Command) MigrateNodeCmd() {
 	if cmd.forcePWD {
 		pwd, err := getpassword("Set quorum key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		npwd, err := getpassword("Re-enter quorum key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		if pwd != npwd {
-			cmd.log.Error("Password mismatch")
+			cmd.fail("Password mismatch")
 			return
 		}
 		cmd.quorumPWD = pwd
 	}
 	if cmd.didType < 0 || cmd.didType > 4 {
-		cmd.log.Error("DID Type should be between 0 and 4")
+		cmd.fail("DID Type should be between 0 and 4")
 		return
 	}
 	r := core.MigrateRequest{
@@ -53,16 +53,19 @@ func (cmd *Command) MigrateNodeCmd() {
 	}
 	br, err := cmd.c.MigrateNode(&r, cmd.timeout)
 	if err != nil {
-		cmd.log.Error("Failed to migrate node", "err", err)
+		cmd.fail("Failed to migrate node", "err", err)
 		return
 	}
 	if !br.Status {
-		cmd.log.Error("Failed to migrate node", "msg", br.Message)
+		cmd.fail("Failed to migrate node", "msg", br.Message)
 		return
 	}
 	msg, status := cmd.SignatureResponse(br, cmd.timeout)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to migrate node, " + msg)
+		cmd.fail("Failed to migrate node, " + msg)
 		return
 	}
 	cmd.log.Info("Node migrated successfully, " + msg)
@@ -71,22 +74,25 @@ func (cmd *Command) MigrateNodeCmd() {
 func (cmd *Command) LockedTokensCmd() {
 	fb, err := ioutil.ReadFile(cmd.tokenList)
 	if err != nil {
-		cmd.log.Error("Failed to read token list", "err", err)
+		cmd.fail("Failed to read token list", "err", err)
 		return
 	}
 	var ts []string
 	err = json.Unmarshal(fb, &ts)
 	if err != nil {
-		cmd.log.Error("Invalid token list", "err", err)
+		cmd.fail("Invalid token list", "err", err)
 		return
 	}
 	br, err := cmd.c.LockToknes(ts)
 	if err != nil {
-		cmd.log.Error("Failed to lock tokens", "err", err)
+		cmd.fail("Failed to lock tokens", "err", err)
+		return
+	}
+	if cmd.render(br) {
 		return
 	}
 	if !br.Status {
-		cmd.log.Error("Failed to lock tokens", "msg", br.Message)
+		cmd.fail("Failed to lock tokens", "msg", br.Message)
 		return
 	}
 	cmd.log.Info("Tokens lokced sucessfully")
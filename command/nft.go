@@ -12,23 +12,23 @@ import (
 
 func (cmd *Command) createNFT() {
 	if cmd.did == "" {
-		cmd.log.Error("Failed to create NFT, DID is required to create NFT")
+		cmd.fail("Failed to create NFT, DID is required to create NFT")
 		return
 	}
 
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 
 	if cmd.metadata == "" {
-		cmd.log.Error("Failed to create NFT, NFT metadata is required to create NFT")
+		cmd.fail("Failed to create NFT, NFT metadata is required to create NFT")
 		return
 	}
 
 	if cmd.artifact == "" {
-		cmd.log.Error("Failed to create NFT, NFT artifact is required to create NFT")
+		cmd.fail("Failed to create NFT, NFT artifact is required to create NFT")
 		return
 	}
 
@@ -40,11 +40,14 @@ func (cmd *Command) createNFT() {
 
 	br, err := cmd.c.CreateNFT(&request)
 	if err != nil {
-		cmd.log.Error("Failed to create NFT", "err", err)
+		cmd.fail("Failed to create NFT", "err", err)
+		return
+	}
+	if cmd.render(br) {
 		return
 	}
 	if !br.Status {
-		cmd.log.Error("Failed to create NFT", "msg", br.Message)
+		cmd.fail("Failed to create NFT", "msg", br.Message)
 		return
 	}
 	cmd.log.Info(fmt.Sprintf("NFT info : %s", br.Message))
@@ -57,39 +60,48 @@ func (cmd *Command) deployNFT() {
 		fmt.Print("Enter NFT Id : ")
 		_, err := fmt.Scan(&cmd.nft)
 		if err != nil {
-			cmd.log.Error("Failed to get NFT")
+			cmd.fail("Failed to get NFT")
 			return
 		}
 	}
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.nft)
 	if len(cmd.nft) != 46 || !strings.HasPrefix(cmd.nft, "Qm") || !is_alphanumeric {
-		cmd.log.Error("Invalid NFT")
+		cmd.fail("Invalid NFT")
 		return
 	}
 	is_alphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.deployerAddr)
 	if !strings.HasPrefix(cmd.deployerAddr, "bafybmi") || len(cmd.deployerAddr) != 59 || !is_alphanumeric {
-		cmd.log.Error("Invalid deployer DID")
+		cmd.fail("Invalid deployer DID")
 		return
 	}
 	if cmd.transType < 1 || cmd.transType > 2 {
-		cmd.log.Error("Invalid trans type")
+		cmd.fail("Invalid trans type")
+		return
+	}
+	if cmd.royaltyPercent < 0 || cmd.royaltyPercent > 100 {
+		cmd.fail("Invalid royalty percent")
 		return
 	}
 	deployRequest := model.DeployNFTRequest{
-		NFT:        cmd.nft,
-		DID:        cmd.deployerAddr,
-		QuorumType: cmd.transType,
-		NFTValue:   cmd.nftValue,
-		NFTData:    cmd.nftData,
+		NFT:              cmd.nft,
+		DID:              cmd.deployerAddr,
+		QuorumType:       cmd.transType,
+		NFTValue:         cmd.nftValue,
+		NFTData:          cmd.nftData,
+		RoyaltyPercent:   cmd.royaltyPercent,
+		RoyaltyRecipient: cmd.royaltyRecipient,
 	}
 	response, err := cmd.c.DeployNFT(&deployRequest)
 	if err != nil {
-		cmd.log.Error("Failed to deploy NFT, Token ", cmd.nft, "err", err)
+		cmd.fail("Failed to deploy NFT, Token ", cmd.nft, "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(response)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to deploy NFT, Token ", cmd.nft, "msg", msg)
+		cmd.fail("Failed to deploy NFT, Token ", cmd.nft, "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -102,43 +114,47 @@ func (cmd *Command) executeNFT() {
 		fmt.Print("Enter NFT Id : ")
 		_, err := fmt.Scan(&cmd.nft)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.nft)
 	if len(cmd.nft) != 46 || !strings.HasPrefix(cmd.nft, "Qm") || !is_alphanumeric {
-		cmd.log.Error("Invalid nft")
+		cmd.fail("Invalid nft")
 		return
 	}
 
 	is_alphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.executorAddr)
 	if !strings.HasPrefix(cmd.executorAddr, "bafybmi") || len(cmd.executorAddr) != 59 || !is_alphanumeric {
-		cmd.log.Error("Invalid executer DID")
+		cmd.fail("Invalid executer DID")
 		return
 	}
 	if cmd.transType < 1 || cmd.transType > 2 {
-		cmd.log.Error("Invalid trans type")
+		cmd.fail("Invalid trans type")
 		return
 	}
 
 	executeRequest := model.ExecuteNFTRequest{
-		NFT:        cmd.nft,
-		Owner:      cmd.executorAddr,
-		Receiver:   cmd.receiverAddr,
-		QuorumType: cmd.transType,
-		Comment:    cmd.transComment,
-		NFTValue:   cmd.rbtAmount,
+		NFT:            cmd.nft,
+		Owner:          cmd.executorAddr,
+		Receiver:       cmd.receiverAddr,
+		QuorumType:     cmd.transType,
+		Comment:        cmd.transComment,
+		NFTValue:       cmd.rbtAmount,
+		IdempotencyKey: cmd.idempotencyKey,
 	}
 	response, err := cmd.c.ExecuteNFT(&executeRequest)
 	if err != nil {
-		cmd.log.Error("Failed to execute NFT, Token ", cmd.nft, "err", err)
+		cmd.fail("Failed to execute NFT, Token ", cmd.nft, "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(response)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to execute nft, Token ", cmd.nft, "msg", msg)
+		cmd.fail("Failed to execute nft, Token ", cmd.nft, "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -152,30 +168,33 @@ func (cmd *Command) SubscribeNFT() {
 		fmt.Print("Enter nft id : ")
 		_, err := fmt.Scan(&cmd.nft)
 		if err != nil {
-			cmd.log.Error("Failed to get nft")
+			cmd.fail("Failed to get nft")
 			return
 		}
 	}
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.nft)
 	if len(cmd.nft) != 46 || !strings.HasPrefix(cmd.nft, "Qm") || !is_alphanumeric {
-		cmd.log.Error("Invalid in subscribe nft ")
+		cmd.fail("Invalid in subscribe nft ")
 		return
 	}
 
 	basicResponse, err := cmd.c.SubscribeNFT(cmd.nft)
 
 	if err != nil {
-		cmd.log.Error("Failed to subscribe nft", "err", err)
+		cmd.fail("Failed to subscribe nft", "err", err)
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to subscribe nft", "msg", basicResponse.Message)
+		cmd.fail("Failed to subscribe nft", "msg", basicResponse.Message)
 		return
 	}
 	message, status := cmd.SignatureResponse(basicResponse)
+	if cmd.renderStatus(status, message) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to subscribe nft, " + message)
+		cmd.fail("Failed to subscribe nft, " + message)
 		return
 	}
 	cmd.log.Info("New event subscribed successfully")
@@ -183,33 +202,59 @@ func (cmd *Command) SubscribeNFT() {
 
 func (cmd *Command) getAllNFTs() {
 	if cmd.did == "" {
-		cmd.log.Error("Failed to get NFTs, DID is required to get NFTs")
+		cmd.fail("Failed to get NFTs, DID is required to get NFTs")
+		return
+	}
+	f, err := cmd.tokenFilter()
+	if err != nil {
+		cmd.fail("Failed to get NFTs, " + err.Error())
 		return
 	}
-	tkns, err := cmd.c.GetAllNFTs(cmd.did)
+	tkns, err := cmd.c.GetAllNFTs(cmd.did, f, cmd.pageRequest())
 	if err != nil {
-		cmd.log.Error("Failed to get NFTs, " + err.Error())
+		cmd.fail("Failed to get NFTs, " + err.Error())
 		return
 	}
-	for _, tkn := range tkns.Tokens {
-		fmt.Printf("NFT : %s, Status : %d\n", tkn.Token, tkn.TokenStatus)
+	if cmd.render(tkns) {
+		return
+	}
+	if !tkns.Status {
+		cmd.fail("Failed to get NFTs, " + tkns.Message)
+		return
+	}
+	for _, tkn := range tkns.NFTs {
+		fmt.Printf("NFT : %s, Owner : %s, Value : %v, Status : %d\n", tkn.NFTId, tkn.Owner, tkn.Value, tkn.Status)
 	}
+	cmd.showNextCursor(tkns.NextCursor)
 	cmd.log.Info("Got all NFTs successfully")
 }
 
 func (cmd *Command) getNFTsByDid() {
 	if cmd.did == "" {
-		cmd.log.Error("Failed to get NFTs, DID is required to get NFTs")
+		cmd.fail("Failed to get NFTs, DID is required to get NFTs")
 		return
 	}
-	tkns, err := cmd.c.GetNFTsByDid(cmd.did)
+	f, err := cmd.tokenFilter()
 	if err != nil {
-		cmd.log.Error("Failed to get NFTs, " + err.Error())
+		cmd.fail("Failed to get NFTs, " + err.Error())
 		return
 	}
-	for _, tkn := range tkns.Tokens {
-		fmt.Printf("NFT : %s, Status : %d\n", tkn.Token, tkn.TokenStatus)
+	tkns, err := cmd.c.GetNFTsByDid(cmd.did, f, cmd.pageRequest())
+	if err != nil {
+		cmd.fail("Failed to get NFTs, " + err.Error())
+		return
 	}
+	if cmd.render(tkns) {
+		return
+	}
+	if !tkns.Status {
+		cmd.fail("Failed to get NFTs, " + tkns.Message)
+		return
+	}
+	for _, tkn := range tkns.NFTs {
+		fmt.Printf("NFT : %s, Owner : %s, Value : %v, Status : %d\n", tkn.NFTId, tkn.Owner, tkn.Value, tkn.Status)
+	}
+	cmd.showNextCursor(tkns.NextCursor)
 	cmd.log.Info("Got all NFTs successfully")
 }
 
@@ -219,14 +264,14 @@ func (cmd *Command) fetchNFT() {
 		fmt.Print("Enter NFT Token Id : ")
 		_, err := fmt.Scan(&cmd.nft)
 		if err != nil {
-			cmd.log.Error("Failed to get NFT Token ID")
+			cmd.fail("Failed to get NFT Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.nft)
 
 	if len(cmd.nft) != 46 || !strings.HasPrefix(cmd.nft, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 	nftRequest := core.FetchNFTRequest{
@@ -239,11 +284,14 @@ func (cmd *Command) fetchNFT() {
 
 	basicResponse, err := cmd.c.FetchNFT(&request)
 	if err != nil {
-		cmd.log.Error("Failed to fetch nft", "err", err)
+		cmd.fail("Failed to fetch nft", "err", err)
+		return
+	}
+	if cmd.render(basicResponse) {
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to fetch nft", "err", err)
+		cmd.fail("Failed to fetch nft", "err", err)
 		return
 	}
 	cmd.log.Info("NFT fetched successfully")
@@ -7,8 +7,11 @@ import (
 
 func (cmd *Command) ShutDownCmd() {
 	msg, status := cmd.c.Shutdown()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to shutdown", "msg", msg)
+		cmd.fail("Failed to shutdown", "msg", msg)
 		return
 	}
 	cmd.log.Info("Shutdown initiated successfully, " + msg)
@@ -16,8 +19,11 @@ func (cmd *Command) ShutDownCmd() {
 
 func (cmd *Command) peerIDCmd() {
 	msg, status := cmd.c.PeerID()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to fetch peer ID of the node", "msg", msg)
+		cmd.fail("Failed to fetch peer ID of the node", "msg", msg)
 		return
 	}
 	_, err := fmt.Fprint(os.Stdout, msg, "\n")
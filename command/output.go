@@ -0,0 +1,207 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of the commands, text is the log output of the command
+const (
+	OutputText  string = "text"
+	OutputJSON  string = "json"
+	OutputYAML  string = "yaml"
+	OutputTable string = "table"
+)
+
+// Exit codes of the commands
+const (
+	ExitFailure      int = 1
+	ExitInvalidUsage int = 2
+)
+
+// fail logs the error and marks the command as failed, the message is the
+// result of the command which failed before getting the response
+func (cmd *Command) fail(msg string, args ...interface{}) {
+	cmd.setFailed(msg, args...)
+	cmd.log.Error(msg, args...)
+}
+
+func (cmd *Command) setFailed(msg string, args ...interface{}) {
+	if cmd.failed {
+		return
+	}
+	cmd.failed = true
+	cmd.errMsg = msg
+	for i := 0; i+1 < len(args); i += 2 {
+		cmd.errMsg = cmd.errMsg + fmt.Sprintf(", %v: %v", args[i], args[i+1])
+	}
+}
+
+func (cmd *Command) structuredOutput() bool {
+	return cmd.output != "" && cmd.output != OutputText
+}
+
+// initOutput validates the output format, the response is written to the
+// stdout and the logs go to the stderr
+func (cmd *Command) initOutput() bool {
+	cmd.output = strings.ToLower(cmd.output)
+	switch cmd.output {
+	case "", OutputText, OutputJSON, OutputYAML, OutputTable:
+	default:
+		fmt.Fprintf(os.Stderr, "Invalid output format %s, use text, json, yaml or table\n", cmd.output)
+		return false
+	}
+	cmd.out = os.Stdout
+	return true
+}
+
+// render records the response as the result of the command, the command
+// fails if the status of the response is false. The response is written in
+// the output format, it returns false for the text output and the command
+// prints the response as usual.
+func (cmd *Command) render(v interface{}) bool {
+	cmd.rendered = true
+	var doc interface{}
+	jb, err := json.Marshal(v)
+	if err == nil {
+		err = json.Unmarshal(jb, &doc)
+	}
+	if err != nil {
+		cmd.fail("Failed to render the response", "err", err)
+		return cmd.structuredOutput()
+	}
+	if m, ok := doc.(map[string]interface{}); ok {
+		if st, ok := m["status"].(bool); ok && !st {
+			msg, _ := m["message"].(string)
+			cmd.setFailed(msg)
+		}
+	}
+	if !cmd.structuredOutput() {
+		return false
+	}
+	switch cmd.output {
+	case OutputJSON:
+		enc := json.NewEncoder(cmd.out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(doc)
+	case OutputYAML:
+		enc := yaml.NewEncoder(cmd.out)
+		enc.SetIndent(2)
+		err = enc.Encode(doc)
+		enc.Close()
+	case OutputTable:
+		err = writeTable(cmd.out, doc)
+	}
+	if err != nil {
+		cmd.fail("Failed to write the response", "err", err)
+	}
+	return true
+}
+
+// renderStatus renders the status of the commands without the response model
+func (cmd *Command) renderStatus(status bool, msg string) bool {
+	return cmd.render(&model.BasicResponse{Status: status, Message: msg})
+}
+
+// finishOutput writes the failure of the command which failed before getting
+// the response and exits with the failure code if the command failed
+func (cmd *Command) finishOutput() {
+	if cmd.structuredOutput() && !cmd.rendered {
+		cmd.render(&model.BasicResponse{Status: !cmd.failed, Message: cmd.errMsg})
+	}
+	if cmd.failed {
+		os.Exit(ExitFailure)
+	}
+}
+
+// writeTable writes the fields of the response as the key value rows and
+// the list of the response as the table
+func writeTable(w io.Writer, doc interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch d := doc.(type) {
+	case map[string]interface{}:
+		keys := sortedKeys(d)
+		lists := make([]string, 0)
+		for _, k := range keys {
+			if _, ok := d[k].([]interface{}); ok {
+				lists = append(lists, k)
+				continue
+			}
+			fmt.Fprintf(tw, "%s\t%s\n", strings.ToUpper(k), tableValue(d[k]))
+		}
+		for _, k := range lists {
+			fmt.Fprintf(tw, "\n%s\n", strings.ToUpper(k))
+			writeRows(tw, d[k].([]interface{}))
+		}
+	case []interface{}:
+		writeRows(tw, d)
+	default:
+		fmt.Fprintf(tw, "%s\n", tableValue(d))
+	}
+	return tw.Flush()
+}
+
+func writeRows(tw *tabwriter.Writer, rows []interface{}) {
+	cols := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, r := range rows {
+		if m, ok := r.(map[string]interface{}); ok {
+			for _, k := range sortedKeys(m) {
+				if !seen[k] {
+					seen[k] = true
+					cols = append(cols, k)
+				}
+			}
+		}
+	}
+	if len(cols) == 0 {
+		for _, r := range rows {
+			fmt.Fprintf(tw, "%s\n", tableValue(r))
+		}
+		return
+	}
+	hdr := make([]string, 0, len(cols))
+	for _, c := range cols {
+		hdr = append(hdr, strings.ToUpper(c))
+	}
+	fmt.Fprintln(tw, strings.Join(hdr, "\t"))
+	for _, r := range rows {
+		m, _ := r.(map[string]interface{})
+		vals := make([]string, 0, len(cols))
+		for _, c := range cols {
+			vals = append(vals, tableValue(m[c]))
+		}
+		fmt.Fprintln(tw, strings.Join(vals, "\t"))
+	}
+}
+
+func tableValue(v interface{}) string {
+	switch vt := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vt
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(vt)
+		return string(b)
+	default:
+		return fmt.Sprint(vt)
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
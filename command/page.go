@@ -0,0 +1,68 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+// pageRequest returns the page of the list from the flags, nil if the list is not paged
+func (cmd *Command) pageRequest() *model.PageRequest {
+	if cmd.limit <= 0 && cmd.cursor == "" && cmd.sort == "" {
+		return nil
+	}
+	return &model.PageRequest{
+		Limit:  cmd.limit,
+		Cursor: cmd.cursor,
+		Sort:   cmd.sort,
+	}
+}
+
+func (cmd *Command) tokenFilter() (*model.TokenFilter, error) {
+	f := &model.TokenFilter{
+		MinValue:  cmd.minValue,
+		MaxValue:  cmd.maxValue,
+		TokenType: cmd.tokenType,
+	}
+	if cmd.tokenStatus != "" {
+		for _, s := range strings.Split(cmd.tokenStatus, ",") {
+			v, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("invalid token status %s", s)
+			}
+			f.Status = append(f.Status, v)
+		}
+	}
+	return f, nil
+}
+
+func (cmd *Command) txnFilter() (*model.TxnFilter, error) {
+	f := &model.TxnFilter{
+		Role:      cmd.role,
+		MinAmount: cmd.minValue,
+		MaxAmount: cmd.maxValue,
+	}
+	var err error
+	if cmd.startDate != "" {
+		f.StartDate, err = time.ParseInLocation("2006-01-02", cmd.startDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date, expected YYYY-MM-DD")
+		}
+	}
+	if cmd.endDate != "" {
+		f.EndDate, err = time.ParseInLocation("2006-01-02", cmd.endDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end date, expected YYYY-MM-DD")
+		}
+	}
+	return f, nil
+}
+
+func (cmd *Command) showNextCursor(next string) {
+	if next != "" {
+		cmd.log.Info("More records available, use -cursor " + next + " to get the next page")
+	}
+}
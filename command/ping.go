@@ -8,15 +8,18 @@ import (
 
 func (cmd *Command) ping() {
 	if cmd.peerID == "" {
-		cmd.log.Error("PeerID cannot be empty. Please use flag peerId")
+		cmd.fail("PeerID cannot be empty. Please use flag peerId")
 		return
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.peerID)
 	if !strings.HasPrefix(cmd.peerID, "12D3KooW") || len(cmd.peerID) != 52 || !isAlphanumeric {
-		cmd.log.Error("Invalid PeerID")
+		cmd.fail("Invalid PeerID")
 		return
 	}
 	msg, status := cmd.c.Ping(cmd.peerID)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
 		cmd.log.Error("Ping failed", "message", msg)
 	} else {
@@ -30,17 +33,20 @@ func (cmd *Command) checkQuorumStatus() {
 		fmt.Print("Enter Quorum Address : ")
 		_, err := fmt.Scan(&cmd.quorumAddr)
 		if err != nil {
-			cmd.log.Error("Failed to get Quorum Address")
+			cmd.fail("Failed to get Quorum Address")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.quorumAddr)
 	if !strings.HasPrefix(cmd.quorumAddr, "bafybmi") || len(cmd.quorumAddr) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID of the quorum")
+		cmd.fail("Invalid DID of the quorum")
 		return
 	}
 	msg, _ := cmd.c.CheckQuorumStatus(cmd.quorumAddr)
 	//Verification with "status" pending !
+	if cmd.renderStatus(strings.Contains(msg, "Quorum is setup"), msg) {
+		return
+	}
 	if strings.Contains(msg, "Quorum is setup") {
 		cmd.log.Info("Quorum is setup in", cmd.quorumAddr, "message", msg)
 	} else {
@@ -8,8 +8,11 @@ import (
 
 func (cmd *Command) AddQuorurm() {
 	msg, status := cmd.c.AddQuorum(cmd.quorumList)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to add quorum list to node", "msg", msg)
+		cmd.fail("Failed to add quorum list to node", "msg", msg)
 		return
 	}
 	cmd.log.Info("Quorum list added successfully")
@@ -18,11 +21,14 @@ func (cmd *Command) AddQuorurm() {
 func (cmd *Command) GetAllQuorum() {
 	response, err := cmd.c.GettAllQuorum()
 	if err != nil {
-		cmd.log.Error("Invalid response from the node", "err", err)
+		cmd.fail("Invalid response from the node", "err", err)
+		return
+	}
+	if cmd.render(response) {
 		return
 	}
 	if !response.Status {
-		cmd.log.Error("Failed to get quorum list from node", "msg", response.Message)
+		cmd.fail("Failed to get quorum list from node", "msg", response.Message)
 		return
 	}
 	for _, q := range response.Result {
@@ -33,8 +39,11 @@ func (cmd *Command) GetAllQuorum() {
 
 func (cmd *Command) RemoveAllQuorum() {
 	msg, status := cmd.c.RemoveAllQuorum()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to remove quorum list", "msg", msg)
+		cmd.fail("Failed to remove quorum list", "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -44,7 +53,7 @@ func (cmd *Command) SetupQuorum() {
 	if cmd.forcePWD {
 		pwd, err := getpassword("Enter quorum key password: ")
 		if err != nil {
-			cmd.log.Error("Failed to get password")
+			cmd.fail("Failed to get password")
 			return
 		}
 		cmd.quorumPWD = pwd
@@ -55,19 +64,22 @@ func (cmd *Command) SetupQuorum() {
 		fmt.Print("Enter Quorum DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get Quorum DID")
+			cmd.fail("Failed to get Quorum DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	msg, status := cmd.c.SetupQuorum(cmd.did, cmd.quorumPWD, cmd.privPWD)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to setup quorum", "msg", msg)
+		cmd.fail("Failed to setup quorum", "msg", msg)
 		return
 	}
 	cmd.log.Info("Quorum setup successfully")
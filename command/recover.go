@@ -13,7 +13,10 @@ func (cmd *Command) RecoverTokens() {
 
 	br, err := cmd.c.RecoverRBT(&rt)
 	if err != nil {
-		cmd.log.Error("Failed to Recover the Tokens", "err", err)
+		cmd.fail("Failed to Recover the Tokens", "err", err)
+		return
+	}
+	if cmd.render(br) {
 		return
 	}
 	if !br.Status {
@@ -15,8 +15,11 @@ func (cmd *Command) SetupService() {
 		DBPassword:  cmd.dbPassword,
 	}
 	msg, status := cmd.c.SetupService(&scfg)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to setup service", "message", msg)
+		cmd.fail("Failed to setup service", "message", msg)
 		return
 	}
 	cmd.log.Info("Service setup successfully")
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -16,25 +17,25 @@ func (cmd *Command) generateSmartContractToken() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	if cmd.binaryCodePath == "" {
-		cmd.log.Error("Please provide Binary code file")
+		cmd.fail("Please provide Binary code file")
 		return
 	}
 	if cmd.rawCodePath == "" {
-		cmd.log.Error("Please provide Raw code file")
+		cmd.fail("Please provide Raw code file")
 		return
 	}
 	if cmd.schemaFilePath == "" {
-		cmd.log.Error("Please provide Schema file")
+		cmd.fail("Please provide Schema file")
 		return
 	}
 	smartContractTokenRequest := core.GenerateSmartContractRequest{
@@ -53,11 +54,14 @@ func (cmd *Command) generateSmartContractToken() {
 
 	basicResponse, err := cmd.c.GenerateSmartContractToken(&request)
 	if err != nil {
-		cmd.log.Error("Failed to generate smart contract token", "err", err)
+		cmd.fail("Failed to generate smart contract token", "err", err)
+		return
+	}
+	if cmd.render(basicResponse) {
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to generate smart contract token", "err", basicResponse.Message)
+		cmd.fail("Failed to generate smart contract token", "err", basicResponse.Message)
 		return
 	}
 	cmd.log.Info(fmt.Sprintf("Smart contract token %v generated successfully", basicResponse.Result))
@@ -70,14 +74,14 @@ func (cmd *Command) fetchSmartContract() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 	smartContractTokenRequest := core.FetchSmartContractRequest{
@@ -90,11 +94,14 @@ func (cmd *Command) fetchSmartContract() {
 
 	basicResponse, err := cmd.c.FetchSmartContract(&request)
 	if err != nil {
-		cmd.log.Error("Failed to fetch smart contract token", "err", err)
+		cmd.fail("Failed to fetch smart contract token", "err", err)
+		return
+	}
+	if cmd.render(basicResponse) {
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to fetch smart contract token", "err", err)
+		cmd.fail("Failed to fetch smart contract token", "err", err)
 		return
 	}
 	cmd.log.Info("Smart contract token fetched successfully")
@@ -105,38 +112,41 @@ func (cmd *Command) PublishContract() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 	isAlphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	if cmd.publishType < 1 || cmd.publishType > 2 {
-		cmd.log.Error("Invalid publish type")
+		cmd.fail("Invalid publish type")
 		return
 	}
 	basicResponse, err := cmd.c.PublishNewEvent(cmd.smartContractToken, cmd.did, cmd.publishType, cmd.newContractBlock)
 
 	if err != nil {
-		cmd.log.Error("Failed to publish new event", "err", err)
+		cmd.fail("Failed to publish new event", "err", err)
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to publish new event", "msg", basicResponse.Message)
+		cmd.fail("Failed to publish new event", "msg", basicResponse.Message)
 		return
 	}
 	message, status := cmd.SignatureResponse(basicResponse)
+	if cmd.renderStatus(status, message) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to publish new event, " + message)
+		cmd.fail("Failed to publish new event, " + message)
 		return
 	}
 	cmd.log.Info("New event published successfully")
@@ -147,30 +157,33 @@ func (cmd *Command) SubscribeContract() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 
 	basicResponse, err := cmd.c.SubscribeContract(cmd.smartContractToken)
 
 	if err != nil {
-		cmd.log.Error("Failed to subscribe contract", "err", err)
+		cmd.fail("Failed to subscribe contract", "err", err)
 		return
 	}
 	if !basicResponse.Status {
-		cmd.log.Error("Failed to subscribe contract", "msg", basicResponse.Message)
+		cmd.fail("Failed to subscribe contract", "msg", basicResponse.Message)
 		return
 	}
 	message, status := cmd.SignatureResponse(basicResponse)
+	if cmd.renderStatus(status, message) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to subscribe contract, " + message)
+		cmd.fail("Failed to subscribe contract, " + message)
 		return
 	}
 	cmd.log.Info("New event subscribed successfully")
@@ -182,26 +195,26 @@ func (cmd *Command) deploySmartcontract() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 	isAlphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.deployerAddr)
 	if !strings.HasPrefix(cmd.deployerAddr, "bafybmi") || len(cmd.deployerAddr) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid deployer DID")
+		cmd.fail("Invalid deployer DID")
 		return
 	}
 	if cmd.rbtAmount < 0.001 {
-		cmd.log.Error("Invalid RBT amount. Minimum RBT amount should be 0.001")
+		cmd.fail("Invalid RBT amount. Minimum RBT amount should be 0.001")
 		return
 	}
 	if cmd.transType < 1 || cmd.transType > 2 {
-		cmd.log.Error("Invalid trans type")
+		cmd.fail("Invalid trans type")
 		return
 	}
 	deployRequest := model.DeploySmartContractRequest{
@@ -213,12 +226,15 @@ func (cmd *Command) deploySmartcontract() {
 	}
 	response, err := cmd.c.DeploySmartContract(&deployRequest)
 	if err != nil {
-		cmd.log.Error("Failed to deploy Smart contract, Token ", cmd.smartContractToken, "err", err)
+		cmd.fail("Failed to deploy Smart contract, Token ", cmd.smartContractToken, "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(response)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to deploy Smart contract, Token ", cmd.smartContractToken, "msg", msg)
+		cmd.fail("Failed to deploy Smart contract, Token ", cmd.smartContractToken, "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -231,31 +247,31 @@ func (cmd *Command) executeSmartcontract() {
 		fmt.Print("Enter SC Token Id : ")
 		_, err := fmt.Scan(&cmd.smartContractToken)
 		if err != nil {
-			cmd.log.Error("Failed to get SC Token ID")
+			cmd.fail("Failed to get SC Token ID")
 			return
 		}
 	}
 
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.smartContractToken)
 	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric {
-		cmd.log.Error("Invalid smart contract token")
+		cmd.fail("Invalid smart contract token")
 		return
 	}
 
 	isAlphanumeric = regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.executorAddr)
 	if !strings.HasPrefix(cmd.executorAddr, "bafybmi") || len(cmd.executorAddr) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid executer DID")
+		cmd.fail("Invalid executer DID")
 		return
 	}
 	if cmd.transType < 1 || cmd.transType > 2 {
-		cmd.log.Error("Invalid trans type")
+		cmd.fail("Invalid trans type")
 		return
 	}
-	if cmd.smartContractData == "" {
+	if cmd.smartContractData == "" && cmd.smartContractInput == "" {
 		fmt.Print("Enter Data to be executed : ")
 		_, err := fmt.Scan(&cmd.smartContractData)
 		if err != nil {
-			cmd.log.Error("Failed to get data")
+			cmd.fail("Failed to get data")
 			return
 		}
 	}
@@ -265,18 +281,129 @@ func (cmd *Command) executeSmartcontract() {
 		QuorumType:         cmd.transType,
 		Comment:            cmd.transComment,
 		SmartContractData:  cmd.smartContractData,
+		SmartContractInput: cmd.smartContractInput,
+		IdempotencyKey:     cmd.idempotencyKey,
+	}
+	if cmd.smartContractEvents != "" {
+		err := json.Unmarshal([]byte(cmd.smartContractEvents), &executorRequest.SmartContractEvents)
+		if err != nil {
+			cmd.fail("Invalid smart contract events, expected the JSON array of the events", "err", err)
+			return
+		}
 	}
 	response, err := cmd.c.ExecuteSmartContract(&executorRequest)
 	if err != nil {
-		cmd.log.Error("Failed to execute Smart contract, Token ", cmd.smartContractToken, "err", err)
+		cmd.fail("Failed to execute Smart contract, Token ", cmd.smartContractToken, "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(response)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to execute Smart contract, Token ", cmd.smartContractToken, "msg", msg)
+		cmd.fail("Failed to execute Smart contract, Token ", cmd.smartContractToken, "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
 	cmd.log.Info("Smart Contract executed successfully")
 
 }
+
+func (cmd *Command) getSmartContractEvents() {
+	f := &model.SmartContractEventFilter{
+		SmartContractToken: cmd.smartContractToken,
+		Name:               cmd.eventName,
+		Topic:              cmd.eventTopic,
+		FromBlock:          cmd.fromBlock,
+		ToBlock:            cmd.toBlock,
+	}
+	if cmd.follow {
+		err := cmd.c.WatchSmartContractEvents(f, func(ev *model.SmartContractEventLog) bool {
+			if !cmd.render(ev) {
+				cmd.log.Info("Smart contract event", "token", ev.SmartContractToken, "block", ev.BlockNumber, "name", ev.Name, "topics", ev.Topics, "data", ev.Data)
+			}
+			return true
+		})
+		if err != nil {
+			cmd.fail("Failed to follow smart contract events", "err", err)
+		}
+		return
+	}
+	er, err := cmd.c.GetSmartContractEvents(f, cmd.pageRequest())
+	if err != nil {
+		cmd.fail("Failed to get smart contract events", "err", err)
+		return
+	}
+	if !er.Status {
+		cmd.fail("Failed to get smart contract events", "msg", er.Message)
+		return
+	}
+	if cmd.render(er) {
+		return
+	}
+	for _, ev := range er.Events {
+		cmd.log.Info("Smart contract event", "token", ev.SmartContractToken, "block", ev.BlockNumber, "name", ev.Name, "topics", ev.Topics, "data", ev.Data)
+	}
+	cmd.showNextCursor(er.NextCursor)
+}
+
+func (cmd *Command) upgradeSmartContract() {
+	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+	if len(cmd.smartContractToken) != 46 || !strings.HasPrefix(cmd.smartContractToken, "Qm") || !isAlphanumeric.MatchString(cmd.smartContractToken) {
+		cmd.fail("Invalid smart contract token")
+		return
+	}
+	if len(cmd.codeToken) != 46 || !strings.HasPrefix(cmd.codeToken, "Qm") || !isAlphanumeric.MatchString(cmd.codeToken) {
+		cmd.fail("Invalid code token")
+		return
+	}
+	if !strings.HasPrefix(cmd.deployerAddr, "bafybmi") || len(cmd.deployerAddr) != 59 || !isAlphanumeric.MatchString(cmd.deployerAddr) {
+		cmd.fail("Invalid deployer DID")
+		return
+	}
+	if cmd.transType < 1 || cmd.transType > 2 {
+		cmd.fail("Invalid trans type")
+		return
+	}
+	upgradeRequest := model.UpgradeSmartContractRequest{
+		SmartContractToken: cmd.smartContractToken,
+		CodeToken:          cmd.codeToken,
+		DeployerAddress:    cmd.deployerAddr,
+		QuorumType:         cmd.transType,
+		Comment:            cmd.transComment,
+		MigrationInput:     cmd.migrationInput,
+	}
+	response, err := cmd.c.UpgradeSmartContract(&upgradeRequest)
+	if err != nil {
+		cmd.fail("Failed to upgrade Smart contract, Token ", cmd.smartContractToken, "err", err)
+		return
+	}
+	msg, status := cmd.SignatureResponse(response)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
+	if !status {
+		cmd.fail("Failed to upgrade Smart contract, Token ", cmd.smartContractToken, "msg", msg)
+		return
+	}
+	cmd.log.Info(msg)
+	cmd.log.Info("Smart Contract Upgraded successfully")
+}
+
+func (cmd *Command) getSmartContractVersions() {
+	vr, err := cmd.c.GetSmartContractVersions(cmd.smartContractToken)
+	if err != nil {
+		cmd.fail("Failed to get smart contract versions", "err", err)
+		return
+	}
+	if !vr.Status {
+		cmd.fail("Failed to get smart contract versions", "msg", vr.Message)
+		return
+	}
+	if cmd.render(vr) {
+		return
+	}
+	for _, v := range vr.Versions {
+		cmd.log.Info("Smart contract version", "version", v.Version, "block", v.BlockNumber, "codeToken", v.CodeToken, "binaryCodeHash", v.BinaryCodeHash)
+	}
+}
@@ -2,8 +2,12 @@ package command
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
 )
 
 func (cmd *Command) GenerateTestRBT() {
@@ -12,36 +16,39 @@ func (cmd *Command) GenerateTestRBT() {
 		fmt.Print("Enter DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get DID")
+			cmd.fail("Failed to get DID")
 			return
 		}
 	}
 	isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	if cmd.numTokens <= 0 {
-		cmd.log.Error("Invalid RBT amount, tokens generated should be a whole number and greater than 0")
+		cmd.fail("Invalid RBT amount, tokens generated should be a whole number and greater than 0")
 		return
 	}
 
 	br, err := cmd.c.GenerateTestRBT(cmd.numTokens, cmd.did)
 
 	if err != nil {
-		cmd.log.Error("Failed to generate RBT", "err", err)
+		cmd.fail("Failed to generate RBT", "err", err)
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("Failed to generate RBT", "msg", br.Message)
+		cmd.fail("Failed to generate RBT", "msg", br.Message)
 		return
 	}
 
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to generate test RBT, " + msg)
+		cmd.fail("Failed to generate test RBT, " + msg)
 		return
 	}
 	cmd.log.Info("Test RBT generated successfully")
@@ -53,43 +60,158 @@ func (cmd *Command) ValidateTokenchain() {
 		fmt.Print("Enter tokenchain-validator DID : ")
 		_, err := fmt.Scan(&cmd.did)
 		if err != nil {
-			cmd.log.Error("Failed to get tokenchain-validator DID")
+			cmd.fail("Failed to get tokenchain-validator DID")
 			return
 		}
 	}
 	br, err := cmd.c.ValidateTokenchain(cmd.did, cmd.smartContractChainValidation, cmd.token, cmd.blockCount)
 	if err != nil {
-		cmd.log.Error("failed to validate token chain", "err", err)
+		cmd.fail("failed to validate token chain", "err", err)
+		return
+	}
+	if cmd.render(br) {
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("failed to validate token chain", "msg", br.Message)
+		cmd.fail("failed to validate token chain", "msg", br.Message)
 		return
 	}
 
 	cmd.log.Info("Tokenchain validated successfully", "msg", br.Message)
 }
 
+func (cmd *Command) checkpointTokenChain() {
+	if cmd.did == "" || cmd.token == "" {
+		cmd.fail("did and token are required to checkpoint the token chain")
+		return
+	}
+	cr := model.TokenChainCheckpointRequest{
+		DID:        cmd.did,
+		Token:      cmd.token,
+		KeepBlocks: cmd.keepBlocks,
+		Prune:      cmd.prune,
+		Archive:    cmd.archive,
+	}
+	br, err := cmd.c.CheckpointTokenChain(&cr)
+	if err != nil {
+		cmd.fail("failed to checkpoint token chain", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("failed to checkpoint token chain", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("Token chain checkpointed successfully", "msg", br.Message)
+}
+
+func (cmd *Command) restoreTokenChain() {
+	if cmd.token == "" {
+		cmd.fail("token is required to restore the token chain")
+		return
+	}
+	br, err := cmd.c.RestoreTokenChain(cmd.token)
+	if err != nil {
+		cmd.fail("failed to restore token chain", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("failed to restore token chain", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("Token chain restored successfully", "msg", br.Message)
+}
+
+func (cmd *Command) exportToken() {
+	if cmd.did == "" || cmd.token == "" {
+		cmd.fail("did and token are required to export the token")
+		return
+	}
+	er := model.ExportTokenRequest{
+		DID:   cmd.did,
+		Token: cmd.token,
+	}
+	if cmd.bundleFile != "" {
+		fp, err := filepath.Abs(cmd.bundleFile)
+		if err != nil {
+			cmd.fail("invalid bundle file path", "err", err)
+			return
+		}
+		er.BundlePath = fp
+	}
+	br, err := cmd.c.ExportToken(&er)
+	if err != nil {
+		cmd.fail("failed to export token", "err", err)
+		return
+	}
+	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
+	if !status {
+		cmd.fail("failed to export token", "msg", msg)
+		return
+	}
+	cmd.log.Info("Token exported successfully", "msg", msg)
+}
+
+func (cmd *Command) importToken() {
+	if cmd.did == "" || cmd.bundleFile == "" {
+		cmd.fail("did and bundle file are required to import the token")
+		return
+	}
+	bundle, err := ioutil.ReadFile(cmd.bundleFile)
+	if err != nil {
+		cmd.fail("failed to read bundle file", "err", err)
+		return
+	}
+	ir := model.ImportTokenRequest{
+		DID:    cmd.did,
+		Bundle: bundle,
+	}
+	br, err := cmd.c.ImportToken(&ir)
+	if err != nil {
+		cmd.fail("failed to import token", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("failed to import token", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("Token imported successfully", "msg", br.Message)
+}
+
 func (cmd *Command) ValidateToken() {
 	if cmd.token == "" {
 		cmd.log.Info("Token cannot be empty")
 		fmt.Print("Enter Token : ")
 		_, err := fmt.Scan(&cmd.token)
 		if err != nil {
-			cmd.log.Error("Failed to get tokenhash")
+			cmd.fail("Failed to get tokenhash")
 			return
 		}
 	}
 	br, err := cmd.c.ValidateToken(cmd.token)
 	if err != nil {
-		cmd.log.Error("failed to validate token", "err", err)
+		cmd.fail("failed to validate token", "err", err)
+		return
+	}
+	if cmd.render(br) {
 		return
 	}
 
 	if !br.Status {
 
-		cmd.log.Error("failed to validate token %s", cmd.token, "msg", br.Message)
+		cmd.fail("failed to validate token %s", cmd.token, "msg", br.Message)
 		return
 	}
 	cmd.log.Info("Token %s validated successfully ", cmd.token, "msg", br.Message)
@@ -98,30 +220,33 @@ func (cmd *Command) ValidateToken() {
 func (cmd *Command) GenerateFaucetTestRBT() {
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 	if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !is_alphanumeric {
-		cmd.log.Error("Invalid DID")
+		cmd.fail("Invalid DID")
 		return
 	}
 	if cmd.numTokens <= 0 {
-		cmd.log.Error("Invalid RBT amount, tokens generated should be a whole number and greater than 0")
+		cmd.fail("Invalid RBT amount, tokens generated should be a whole number and greater than 0")
 		return
 	}
 
 	br, err := cmd.c.GenerateFaucetTestRBT(cmd.numTokens, cmd.did)
 
 	if err != nil {
-		cmd.log.Error("Failed to generate RBT", "err", err)
+		cmd.fail("Failed to generate RBT", "err", err)
 		return
 	}
 
 	if !br.Status {
-		cmd.log.Error("Failed to generate RBT", "msg", br.Message)
+		cmd.fail("Failed to generate RBT", "msg", br.Message)
 		return
 	}
 
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 
 	if !status {
-		cmd.log.Error("Failed to generate test RBT, " + msg)
+		cmd.fail("Failed to generate test RBT, " + msg)
 		return
 	}
 	cmd.log.Info("Test RBT generated successfully")
@@ -131,7 +256,7 @@ func (cmd *Command) FaucetTokenCheck() {
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.token)
 
 	if len(cmd.token) != 46 || !strings.HasPrefix(cmd.token, "Qm") || !is_alphanumeric {
-		cmd.log.Error("Invalid token")
+		cmd.fail("Invalid token")
 		return
 	}
 
@@ -140,7 +265,39 @@ func (cmd *Command) FaucetTokenCheck() {
 		cmd.log.Info("Cannot get token details")
 		return
 	}
+	if cmd.render(br) {
+		return
+	}
 	fmt.Println(br.Message)
 
 	cmd.log.Info("Validated token details successfully")
 }
+
+func (cmd *Command) getAllTokens() {
+	if cmd.did == "" {
+		cmd.fail("Failed to get tokens, DID is required to get tokens")
+		return
+	}
+	f, err := cmd.tokenFilter()
+	if err != nil {
+		cmd.fail("Failed to get tokens, " + err.Error())
+		return
+	}
+	tr, err := cmd.c.GetAllTokens(cmd.did, model.RBTType, f, cmd.pageRequest())
+	if err != nil {
+		cmd.fail("Failed to get tokens, " + err.Error())
+		return
+	}
+	if cmd.render(tr) {
+		return
+	}
+	if !tr.Status {
+		cmd.fail("Failed to get tokens, " + tr.Message)
+		return
+	}
+	for _, t := range tr.TokenDetails {
+		fmt.Printf("Token : %s, Value : %v, Status : %d\n", t.Token, t.Value, t.Status)
+	}
+	cmd.showNextCursor(tr.NextCursor)
+	cmd.log.Info("Got all tokens successfully")
+}
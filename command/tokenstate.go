@@ -5,7 +5,10 @@ import "fmt"
 func (cmd *Command) GetPledgedTokenDetails() {
 	info, err := cmd.c.GetPledgedTokenDetails()
 	if err != nil {
-		cmd.log.Error("Invalid response from the node", "err", err)
+		cmd.fail("Invalid response from the node", "err", err)
+		return
+	}
+	if cmd.render(info) {
 		return
 	}
 	fmt.Printf("Response : %v\n", info)
@@ -25,7 +28,10 @@ func (cmd *Command) GetPledgedTokenDetails() {
 func (cmd *Command) CheckPinnedState() {
 	info, err := cmd.c.GetPinnedInfo(cmd.TokenState)
 	if err != nil {
-		cmd.log.Error("Invalid response from the node", "err", err)
+		cmd.fail("Invalid response from the node", "err", err)
+		return
+	}
+	if cmd.render(info) {
 		return
 	}
 	fmt.Printf("Response : %v\n", info)
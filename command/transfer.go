@@ -15,7 +15,7 @@ func (cmd *Command) TransferRBT() {
 		fmt.Print("Enter Sender DID : ")
 		_, err := fmt.Scan(&cmd.senderAddr)
 		if err != nil {
-			cmd.log.Error("Failed to get Sender DID")
+			cmd.fail("Failed to get Sender DID")
 			return
 		}
 	}
@@ -30,7 +30,7 @@ func (cmd *Command) TransferRBT() {
 		fmt.Print("Enter Receiver DID : ")
 		_, err := fmt.Scan(&cmd.receiverAddr)
 		if err != nil {
-			cmd.log.Error("Failed to get Receiver DID")
+			cmd.fail("Failed to get Receiver DID")
 			return
 		}
 	}
@@ -44,37 +44,41 @@ func (cmd *Command) TransferRBT() {
 	isAlphanumericSender := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.senderAddr)
 	isAlphanumericReceiver := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.receiverAddr)
 	if !isAlphanumericSender || !isAlphanumericReceiver {
-		cmd.log.Error("Invalid sender or receiver address. Please provide valid DID")
+		cmd.fail("Invalid sender or receiver address. Please provide valid DID")
 		return
 	}
 	if !strings.HasPrefix(cmd.senderAddr, "bafybmi") || len(cmd.senderAddr) != 59 || !strings.HasPrefix(cmd.receiverAddr, "bafybmi") || len(cmd.receiverAddr) != 59 {
-		cmd.log.Error("Invalid sender or receiver DID")
+		cmd.fail("Invalid sender or receiver DID")
 		return
 	}
 	if cmd.rbtAmount < 0.001 {
-		cmd.log.Error("Invalid RBT amount. RBT amount should be atlease 0.001")
+		cmd.fail("Invalid RBT amount. RBT amount should be atlease 0.001")
 		return
 	}
 	if cmd.transType < 1 || cmd.transType > 2 {
-		cmd.log.Error("Invalid trans type. TransType should be 1 or 2")
+		cmd.fail("Invalid trans type. TransType should be 1 or 2")
 		return
 	}
 	rt := model.RBTTransferRequest{
-		Receiver:   cmd.receiverAddr,
-		Sender:     cmd.senderAddr,
-		TokenCount: cmd.rbtAmount,
-		Type:       cmd.transType,
-		Comment:    cmd.transComment,
+		Receiver:       cmd.receiverAddr,
+		Sender:         cmd.senderAddr,
+		TokenCount:     cmd.rbtAmount,
+		Type:           cmd.transType,
+		Comment:        cmd.transComment,
+		IdempotencyKey: cmd.idempotencyKey,
 	}
 
 	br, err := cmd.c.TransferRBT(&rt)
 	if err != nil {
-		cmd.log.Error("Failed RBT transfer", "err", err)
+		cmd.fail("Failed RBT transfer", "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to trasnfer RBT", "msg", msg)
+		cmd.fail("Failed to trasnfer RBT", "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -92,12 +96,15 @@ func (cmd *Command) PinRBT() {
 
 	br, err := cmd.c.PinRBT(&rt)
 	if err != nil {
-		cmd.log.Error("Failed to Pin the Token", "err", err)
+		cmd.fail("Failed to Pin the Token", "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to Pin RBT", "msg", msg)
+		cmd.fail("Failed to Pin RBT", "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
@@ -106,19 +113,23 @@ func (cmd *Command) PinRBT() {
 
 func (cmd *Command) SelfTransferRBT() {
 	rt := model.RBTTransferRequest{
-		Sender:   cmd.senderAddr,
-		Receiver: cmd.senderAddr,
-		Type:     cmd.transType,
+		Sender:         cmd.senderAddr,
+		Receiver:       cmd.senderAddr,
+		Type:           cmd.transType,
+		IdempotencyKey: cmd.idempotencyKey,
 	}
 
 	br, err := cmd.c.SelfTransferRBT(&rt)
 	if err != nil {
-		cmd.log.Error("Failed to self RBT transfer", "err", err)
+		cmd.fail("Failed to self RBT transfer", "err", err)
 		return
 	}
 	msg, status := cmd.SignatureResponse(br)
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error("Failed to self transfer RBT", "msg", msg)
+		cmd.fail("Failed to self transfer RBT", "msg", msg)
 		return
 	}
 	cmd.log.Info(msg)
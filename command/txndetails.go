@@ -4,61 +4,119 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
 )
 
 func (cmd *Command) getTxnDetails() {
 	if cmd.did == "" && cmd.txnID == "" && cmd.transComment == "" {
-		cmd.log.Error("Please provide did or transaction id or transaction comment to get transaction details")
+		cmd.fail("Please provide did or transaction id or transaction comment to get transaction details")
 		return
 	}
+	out := &model.TxnDetails{
+		BasicResponse: model.BasicResponse{Status: true, Message: "Retrieved Txn Details"},
+		TxnDetails:    make([]model.TransactionDetails, 0),
+	}
 
 	if cmd.txnID != "" {
 		res, err := cmd.c.GetTxnByID(cmd.txnID)
 		if err != nil {
-			cmd.log.Error("Invalid response from the node", "err", err)
+			cmd.fail("Invalid response from the node", "err", err)
 			return
 		}
 		if !res.BasicResponse.Status {
-			cmd.log.Error("Failed to get Txn details for TxnID", cmd.txnID, " err", err)
+			cmd.fail("Failed to get Txn details for TxnID", "txnID", cmd.txnID, "msg", res.Message)
 		}
-		for i := range res.TxnDetails {
-			td := res.TxnDetails[i]
-			fmt.Printf("%+v", td)
+		out.TxnDetails = append(out.TxnDetails, res.TxnDetails...)
+		if !cmd.structuredOutput() {
+			for i := range res.TxnDetails {
+				td := res.TxnDetails[i]
+				fmt.Printf("%+v", td)
+			}
 		}
 	}
 
 	if cmd.did != "" {
 		isAlphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(cmd.did)
 		if !strings.HasPrefix(cmd.did, "bafybmi") || len(cmd.did) != 59 || !isAlphanumeric {
-			cmd.log.Error("Invalid DID")
+			cmd.fail("Invalid DID")
+			return
+		}
+		f, err := cmd.txnFilter()
+		if err != nil {
+			cmd.fail("Failed to get Txn details", "err", err)
 			return
 		}
-		res, err := cmd.c.GetTxnByDID(cmd.did, cmd.role)
+		res, err := cmd.c.GetTxnByDID(cmd.did, f, cmd.pageRequest())
 		if err != nil {
-			cmd.log.Error("Invalid response from the node", "err", err)
+			cmd.fail("Invalid response from the node", "err", err)
 			return
 		}
 		if !res.BasicResponse.Status {
-			cmd.log.Error("Failed to get Txn details for Did", cmd.did, " err", err)
+			cmd.fail("Failed to get Txn details for Did", "did", cmd.did, "msg", res.Message)
 		}
-		for i := range res.TxnDetails {
-			td := res.TxnDetails[i]
-			fmt.Printf("%+v", td)
+		out.TxnDetails = append(out.TxnDetails, res.TxnDetails...)
+		if !cmd.structuredOutput() {
+			for i := range res.TxnDetails {
+				td := res.TxnDetails[i]
+				fmt.Printf("%+v", td)
+			}
 		}
+		out.NextCursor = res.NextCursor
+		cmd.showNextCursor(res.NextCursor)
 	}
 
 	if cmd.transComment != "" {
 		res, err := cmd.c.GetTxnByComment(cmd.transComment)
 		if err != nil {
-			cmd.log.Error("Invalid response from the node", "err", err)
+			cmd.fail("Invalid response from the node", "err", err)
 			return
 		}
 		if !res.BasicResponse.Status {
-			cmd.log.Error("Failed to get Txn details for comment", cmd.transComment, " err", err)
+			cmd.fail("Failed to get Txn details for comment", "comment", cmd.transComment, "msg", res.Message)
+		}
+		out.TxnDetails = append(out.TxnDetails, res.TxnDetails...)
+		if !cmd.structuredOutput() {
+			for i := range res.TxnDetails {
+				td := res.TxnDetails[i]
+				fmt.Printf("%+v", td)
+			}
+		}
+	}
+	if cmd.failed {
+		out.Status = false
+		out.Message = cmd.errMsg
+	}
+	cmd.render(out)
+}
+
+func (cmd *Command) watchTxn() {
+	if cmd.reqID == "" {
+		cmd.fail("Please provide the request id to follow")
+		return
+	}
+	evs := make([]model.TxnEvent, 0)
+	err := cmd.c.WatchTxnEvents(cmd.reqID, cmd.eventSeq, func(ev *model.TxnEvent) bool {
+		evs = append(evs, *ev)
+		if ev.Type == model.TxnEventFinalStatus && !ev.Status {
+			cmd.setFailed("Request failed", "msg", ev.Message)
 		}
-		for i := range res.TxnDetails {
-			td := res.TxnDetails[i]
-			fmt.Printf("%+v", td)
+		switch ev.Type {
+		case model.TxnEventQuorumResult:
+			cmd.log.Info("Quorum result", "seq", ev.Seq, "quorum", ev.Quorum, "status", ev.Status)
+		case model.TxnEventQuorumsSelected:
+			cmd.log.Info("Quorums selected", "seq", ev.Seq, "quorums", ev.Quorums)
+		default:
+			cmd.log.Info("Txn event", "seq", ev.Seq, "type", ev.Type, "status", ev.Status, "msg", ev.Message, "txnID", ev.TransactionID)
 		}
+		return true
+	})
+	if err != nil {
+		cmd.fail("Failed to follow the request", "err", err)
+		return
+	}
+	if cmd.render(evs) {
+		return
 	}
+	cmd.log.Info("Request finished")
 }
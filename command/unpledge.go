@@ -2,10 +2,13 @@ package command
 
 func (cmd *Command) RunUnpledge() {
 	msg, status := cmd.c.RunUnpledge()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	cmd.log.Info("Unpledging of pledged tokens has started")
 	if !status {
 
-		cmd.log.Error(msg)
+		cmd.fail(msg)
 		return
 	}
 
@@ -15,8 +18,11 @@ func (cmd *Command) RunUnpledge() {
 func (cmd *Command) UnpledgePOWBasedPledgedTokens() {
 	cmd.log.Info("Unpledging of POW-based pledged tokens has started")
 	msg, status := cmd.c.UnpledgePOWBasedPledgedTokens()
+	if cmd.renderStatus(status, msg) {
+		return
+	}
 	if !status {
-		cmd.log.Error(msg)
+		cmd.fail(msg)
 		return
 	}
 
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+func (cmd *Command) addWebhook() {
+	if cmd.did == "" || cmd.webhookURL == "" {
+		cmd.fail("DID and webhook URL are required")
+		return
+	}
+	req := model.WebhookRequest{
+		DID:    cmd.did,
+		URL:    cmd.webhookURL,
+		Secret: cmd.webhookSecret,
+	}
+	if cmd.webhookEvents != "" {
+		req.Events = strings.Split(cmd.webhookEvents, ",")
+	}
+	rp, err := cmd.c.AddWebhook(&req)
+	if err != nil {
+		cmd.fail("Failed to add the webhook", "err", err)
+		return
+	}
+	if cmd.render(rp) {
+		return
+	}
+	if !rp.Status {
+		cmd.fail("Failed to add the webhook", "msg", rp.Message)
+		return
+	}
+	fmt.Printf("Webhook ID : %s\n", rp.Webhook.ID)
+	fmt.Printf("Secret     : %s\n", rp.Webhook.Secret)
+	cmd.log.Info("Webhook added successfully")
+}
+
+func (cmd *Command) removeWebhook() {
+	if cmd.webhookID == "" {
+		cmd.fail("Webhook ID is required")
+		return
+	}
+	br, err := cmd.c.RemoveWebhook(cmd.webhookID)
+	if err != nil {
+		cmd.fail("Failed to remove the webhook", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("Failed to remove the webhook", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("Webhook removed successfully")
+}
+
+func (cmd *Command) listWebhooks() {
+	rp, err := cmd.c.GetWebhooks(cmd.did)
+	if err != nil {
+		cmd.fail("Failed to get the webhooks", "err", err)
+		return
+	}
+	if cmd.render(rp) {
+		return
+	}
+	if !rp.Status {
+		cmd.fail("Failed to get the webhooks", "msg", rp.Message)
+		return
+	}
+	for _, wh := range rp.Webhooks {
+		events := wh.Events
+		if events == "" {
+			events = "all"
+		}
+		fmt.Printf("%s  %s  %s  events: %s\n", wh.ID, wh.DID, wh.URL, events)
+	}
+	cmd.log.Info("Webhooks listed", "count", len(rp.Webhooks))
+}
+
+func (cmd *Command) webhookDeliveries() {
+	rp, err := cmd.c.GetWebhookDeliveries(cmd.webhookID, cmd.deliveryStatus, cmd.limit)
+	if err != nil {
+		cmd.fail("Failed to get the webhook deliveries", "err", err)
+		return
+	}
+	if cmd.render(rp) {
+		return
+	}
+	if !rp.Status {
+		cmd.fail("Failed to get the webhook deliveries", "msg", rp.Message)
+		return
+	}
+	for _, d := range rp.Deliveries {
+		fmt.Printf("%s  %-18s %-9s attempts: %d  %s\n", d.ID, d.Event, d.Status, d.Attempts, d.CreatedAt.Format("2006-01-02 15:04:05"))
+		if d.LastError != "" {
+			fmt.Printf("    error  : %s\n", d.LastError)
+		}
+	}
+	cmd.log.Info("Webhook deliveries listed", "count", len(rp.Deliveries))
+}
+
+func (cmd *Command) replayWebhookDelivery() {
+	if cmd.deliveryID == "" {
+		cmd.fail("Delivery ID is required")
+		return
+	}
+	br, err := cmd.c.ReplayWebhookDelivery(cmd.deliveryID)
+	if err != nil {
+		cmd.fail("Failed to replay the webhook delivery", "err", err)
+		return
+	}
+	if cmd.render(br) {
+		return
+	}
+	if !br.Status {
+		cmd.fail("Failed to replay the webhook delivery", "msg", br.Message)
+		return
+	}
+	cmd.log.Info("Webhook delivery queued")
+}
@@ -293,6 +293,18 @@ func (c *Contract) GetSmartContractData() string {
 	return c.getTransInfoString(TSSmartContractDataKey)
 }
 
+func (c *Contract) GetSmartContractInput() string {
+	return c.getTransInfoString(TSSmartContractInputKey)
+}
+
+func (c *Contract) GetSmartContractEvents() string {
+	return c.getTransInfoString(TSSCEventsKey)
+}
+
+func (c *Contract) GetSmartContractCode() string {
+	return c.getTransInfoString(TSSCCodeKey)
+}
+
 func (c *Contract) GetNFTData() string {
 	return c.getTransInfoString(TSNFTDataKey)
 }
@@ -391,6 +403,51 @@ func (c *Contract) GetCommitedTokensInfo() []TokenInfo {
 
 }
 
+// GetRoyaltyTokensInfo returns the RBT tokens paid as the royalty of the NFT
+func (c *Contract) GetRoyaltyTokensInfo() []TokenInfo {
+	tim := util.GetFromMap(c.sm, SCTransInfoKey)
+	if tim == nil {
+		return nil
+	}
+	tsm := util.GetFromMap(tim, TSRoyaltyTokenInfoKey)
+	if tsm == nil {
+		return nil
+	}
+	ti := make([]TokenInfo, 0)
+	add := func(k interface{}, v interface{}) {
+		ti = append(ti, TokenInfo{
+			Token:      util.GetString(k),
+			TokenType:  util.GetIntFromMap(v, TITokenTypeKey),
+			OwnerDID:   util.GetStringFromMap(v, TIOwnerDIDKey),
+			BlockID:    util.GetStringFromMap(v, TIBlockIDKey),
+			TokenValue: util.GetFloatFromMap(v, TITokenValueKey),
+		})
+	}
+	switch tsmi := tsm.(type) {
+	case map[string]interface{}:
+		for k, v := range tsmi {
+			add(k, v)
+		}
+	case map[interface{}]interface{}:
+		for k, v := range tsmi {
+			add(k, v)
+		}
+	default:
+		return nil
+	}
+	return ti
+}
+
+// GetNFTRoyalty returns the royalty percentage and the royalty recipient of
+// the NFT
+func (c *Contract) GetNFTRoyalty() (float64, string) {
+	tim := util.GetFromMap(c.sm, SCTransInfoKey)
+	if tim == nil {
+		return 0, ""
+	}
+	return util.GetFloatFromMap(tim, TSNFTRoyaltyKey), util.GetStringFromMap(tim, TSNFTRoyaltyDIDKey)
+}
+
 func (c *Contract) UpdateSignature(dc did.DIDCrypto) error {
 	did := dc.GetDID()
 	hash, err := c.GetHash()
@@ -36,6 +36,12 @@ const (
 	TSPinningDIDKey         string = "11"
 	TSNFTKey                string = "12"
 	TSNFTDataKey            string = "13"
+	TSSmartContractInputKey string = "14"
+	TSSCEventsKey           string = "15"
+	TSSCCodeKey             string = "16"
+	TSNFTRoyaltyKey         string = "17"
+	TSNFTRoyaltyDIDKey      string = "18"
+	TSRoyaltyTokenInfoKey   string = "19"
 )
 
 const (
@@ -76,6 +82,12 @@ type TransInfo struct {
 	NFT                  string      `json:"nft"`
 	NFTValue             float64     `json:"nftValue"`
 	NFTData              string      `json:"nftData"`
+	SmartContractInput   string      `json:"smartContractInput"`
+	SmartContractEvents  string      `json:"smartContractEvents"`
+	SmartContractCode    string      `json:"smartContractCode"`
+	NFTRoyalty           float64     `json:"nftRoyalty"`
+	NFTRoyaltyDID        string      `json:"nftRoyaltyDID"`
+	RoyaltyTokens        []TokenInfo `json:"royaltyTokens"`
 }
 
 func newTokenInfoBlock(ti *TokenInfo) map[string]interface{} {
@@ -123,6 +135,21 @@ func newTransInfoBlock(ts *TransInfo) map[string]interface{} {
 	if ts.NFTData != "" {
 		ntsb[TSNFTDataKey] = ts.NFTData
 	}
+	if ts.SmartContractInput != "" {
+		ntsb[TSSmartContractInputKey] = ts.SmartContractInput
+	}
+	if ts.SmartContractEvents != "" {
+		ntsb[TSSCEventsKey] = ts.SmartContractEvents
+	}
+	if ts.SmartContractCode != "" {
+		ntsb[TSSCCodeKey] = ts.SmartContractCode
+	}
+	if ts.NFTRoyalty != 0 {
+		ntsb[TSNFTRoyaltyKey] = ts.NFTRoyalty
+	}
+	if ts.NFTRoyaltyDID != "" {
+		ntsb[TSNFTRoyaltyDIDKey] = ts.NFTRoyaltyDID
+	}
 
 	if ts.CommitedTokens != nil && len(ts.CommitedTokens) > 0 {
 		ntibs := make(map[string]interface{})
@@ -147,6 +174,17 @@ func newTransInfoBlock(ts *TransInfo) map[string]interface{} {
 		}
 		ntsb[TSTransInfoKey] = ntibs
 	}
+	if len(ts.RoyaltyTokens) > 0 {
+		ntibs := make(map[string]interface{})
+		for _, ti := range ts.RoyaltyTokens {
+			ntib := newTokenInfoBlock(&ti)
+			if ntib == nil {
+				return nil
+			}
+			ntibs[ti.Token] = ntib
+		}
+		ntsb[TSRoyaltyTokenInfoKey] = ntibs
+	}
 	if ts.ExchangeTokens != nil && len(ts.ExchangeTokens) > 0 {
 		ntibs := make(map[string]interface{})
 		for _, ti := range ts.ExchangeTokens {
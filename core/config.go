@@ -1,8 +1,74 @@
 package core
 
-import "github.com/rubixchain/rubixgoplatform/core/config"
+import (
+	"fmt"
 
-func (c *Core) SetupDB(sc *config.StorageConfig) error {
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	econfig "github.com/rubixchain/rubixgoplatform/wrapper/config"
+)
+
+var supportedDBTypes = []string{"SQLServer", "PostgressSQL", "MySQL", "Sqlite3"}
+
+func newStorageDBConfig(sc *config.StorageConfig) *econfig.Config {
+	return &econfig.Config{
+		DBName:       sc.DBName,
+		DBAddress:    sc.DBAddress,
+		DBPort:       sc.DBPort,
+		DBType:       sc.DBType,
+		DBUserName:   sc.DBUserName,
+		DBPassword:   sc.DBPassword,
+		DBSSLMode:    sc.DBSSLMode,
+		MaxOpenConns: sc.MaxOpenConns,
+		MaxIdleConns: sc.MaxIdleConns,
+		ConnLifetime: sc.ConnLifetime,
+	}
+}
+
+// coreTables returns all the tables of the core stored in the storage DB
+func coreTables() []storage.Table {
+	return []storage.Table{
+		{Name: QuorumStorage, Value: &QuorumData{}},
+		{Name: QuorumHealthStorage, Value: &QuorumHealth{}},
+		{Name: ConsensusJournalStorage, Value: &ConsensusJournal{}},
+		{Name: ExplorerURLTable, Value: &ExplorerURL{}},
+		{Name: ExplorerUserDetailsTable, Value: &ExplorerUser{}},
+		{Name: JobStorage, Value: &model.Job{}},
+		{Name: WebhookStorage, Value: &model.Webhook{}},
+		{Name: WebhookDeliveryStorage, Value: &model.WebhookDelivery{}},
+		{Name: APIKeyStorage, Value: &model.APIKey{}},
+	}
+}
+
+// SetupDB updates the storage configuration, if migrate is set the records
+// of the current storage are copied to the new storage. Node has to be
+// restarted to use the new storage.
+func (c *Core) SetupDB(sc *config.StorageConfig, migrate bool) error {
+	if sc.StorageType == 0 {
+		sc.StorageType = storage.StorageDBType
+	}
+	if sc.StorageType != storage.StorageDBType {
+		return fmt.Errorf("unsupported storage type %d", sc.StorageType)
+	}
+	found := false
+	for _, t := range supportedDBTypes {
+		if t == sc.DBType {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("unsupported DB type %s, supported DB types are %v", sc.DBType, supportedDBTypes)
+	}
+	if migrate {
+		err := c.migrateDB(sc)
+		if err != nil {
+			c.log.Error("Failed to migrate DB", "err", err)
+			return err
+		}
+	}
 	c.cfg.CfgData.StorageConfig.DBAddress = sc.DBAddress
 	c.cfg.CfgData.StorageConfig.DBName = sc.DBName
 	c.cfg.CfgData.StorageConfig.DBUserName = sc.DBUserName
@@ -10,5 +76,27 @@ func (c *Core) SetupDB(sc *config.StorageConfig) error {
 	c.cfg.CfgData.StorageConfig.DBPort = sc.DBPort
 	c.cfg.CfgData.StorageConfig.DBType = sc.DBType
 	c.cfg.CfgData.StorageConfig.StorageType = sc.StorageType
+	c.cfg.CfgData.StorageConfig.DBSSLMode = sc.DBSSLMode
+	c.cfg.CfgData.StorageConfig.MaxOpenConns = sc.MaxOpenConns
+	c.cfg.CfgData.StorageConfig.MaxIdleConns = sc.MaxIdleConns
+	c.cfg.CfgData.StorageConfig.ConnLifetime = sc.ConnLifetime
 	return c.updateConfig()
 }
+
+// migrateDB copies the wallet & core tables from the current storage to the new storage
+func (c *Core) migrateDB(sc *config.StorageConfig) error {
+	ns, err := storage.NewStorageDB(newStorageDBConfig(sc))
+	if err != nil {
+		return fmt.Errorf("failed to connect new storage, %v", err)
+	}
+	defer ns.Close()
+	tables := append(wallet.WalletTables(), coreTables()...)
+	n, err := storage.MigrateTables(c.s, ns, tables, storage.DefaultMigrationBatchSize)
+	if err != nil {
+		return err
+	}
+	for i, t := range tables {
+		c.log.Info("Migrated table", "table", t.Name, "records", n[i])
+	}
+	return nil
+}
@@ -15,13 +15,50 @@ type DIDConfigType struct {
 }
 
 type StorageConfig struct {
-	StorageType int    `json:"stroage_type"`
-	DBName      string `json:"db_name"`
-	DBAddress   string `json:"db_address"`
-	DBPort      string `json:"db_port"`
-	DBType      string `json:"db_type"`
-	DBUserName  string `json:"db_user_name"`
-	DBPassword  string `json:"db_password"`
+	StorageType  int    `json:"stroage_type"`
+	DBName       string `json:"db_name"`
+	DBAddress    string `json:"db_address"`
+	DBPort       string `json:"db_port"`
+	DBType       string `json:"db_type"`
+	DBUserName   string `json:"db_user_name"`
+	DBPassword   string `json:"db_password"`
+	DBSSLMode    string `json:"db_ssl_mode,omitempty"`
+	MaxOpenConns int    `json:"max_open_conns,omitempty"`
+	MaxIdleConns int    `json:"max_idle_conns,omitempty"`
+	ConnLifetime int    `json:"conn_lifetime,omitempty"`
+}
+
+// ConsensusPolicy defines the quorum policy for a consensus,
+// QuorumCount quorums are contacted, MinQuorum of them must be available
+// and MinConsensus signatures are required to finalise the block.
+// AlphaCount, BetaCount & GammaCount split the quorum count between
+// the alpha, beta & gamma quorums
+type ConsensusPolicy struct {
+	QuorumCount  int `json:"quorum_count"`
+	MinQuorum    int `json:"min_quorum"`
+	MinConsensus int `json:"min_consensus"`
+	AlphaCount   int `json:"alpha_count"`
+	BetaCount    int `json:"beta_count"`
+	GammaCount   int `json:"gamma_count"`
+}
+
+// ConsensusConfig defines the node consensus policy, ModePolicy is keyed
+// by the transaction mode name and overrides the default policy.
+// QuorumSelector names the quorum selection strategy, quorums which failed
+// MaxQuorumFailures times in a row are excluded for QuorumExclusionTime seconds
+type ConsensusConfig struct {
+	DefaultPolicy       ConsensusPolicy            `json:"default_policy"`
+	ModePolicy          map[string]ConsensusPolicy `json:"mode_policy"`
+	QuorumSelector      string                     `json:"quorum_selector"`
+	MaxQuorumFailures   int                        `json:"max_quorum_failures"`
+	QuorumExclusionTime int                        `json:"quorum_exclusion_time"`
+}
+
+// TokenSyncConfig defines the token chain sync settings, PageSize blocks are
+// fetched per request and up to Workers token chains are synced in parallel
+type TokenSyncConfig struct {
+	PageSize int `json:"page_size,omitempty"`
+	Workers  int `json:"workers,omitempty"`
 }
 
 // ConfigData defines configuration data
@@ -32,6 +69,8 @@ type ConfigData struct {
 	Services          map[string]string `json:"services"`
 	StorageConfig     StorageConfig     `json:"storage_config"`
 	TestStorageConfig StorageConfig     `json:"test_storage_config"`
+	ConsensusConfig   ConsensusConfig   `json:"consensus_config"`
+	TokenSyncConfig   TokenSyncConfig   `json:"token_sync_config"`
 }
 
 type Config struct {
@@ -0,0 +1,637 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/util"
+)
+
+const (
+	ConsensusJournalStorage string = "consensusjournal"
+)
+
+// Consensus journal states, the order of the states is the order
+// in which the transaction progress
+const (
+	JournalTokensLocked int = iota + 1
+	JournalContractSigned
+	JournalQuorumsPledged
+	JournalBlockAdded
+	JournalReceiverUpdated
+	JournalFinality
+	JournalCompleted
+	JournalRolledBack
+	JournalNeedsReview
+)
+
+var journalStateName = map[int]string{
+	JournalTokensLocked:    "tokens_locked",
+	JournalContractSigned:  "contract_signed",
+	JournalQuorumsPledged:  "quorums_pledged",
+	JournalBlockAdded:      "block_added",
+	JournalReceiverUpdated: "receiver_updated",
+	JournalFinality:        "finality",
+	JournalCompleted:       "completed",
+	JournalRolledBack:      "rolled_back",
+	JournalNeedsReview:     "needs_review",
+}
+
+// ConsensusJournal is the persisted state of the transaction initiated by the node,
+// it is used to roll forward or roll back the transaction after the node restart
+type ConsensusJournal struct {
+	ID               string    `gorm:"column:id;primaryKey" json:"id"`
+	Mode             int       `gorm:"column:mode" json:"mode"`
+	State            int       `gorm:"column:state" json:"state"`
+	SenderDID        string    `gorm:"column:sender_did" json:"sender_did"`
+	ReceiverDID      string    `gorm:"column:receiver_did" json:"receiver_did"`
+	ReceiverPeerID   string    `gorm:"column:receiver_peer_id" json:"receiver_peer_id"`
+	TransactionID    string    `gorm:"column:transaction_id" json:"transaction_id"`
+	TransactionEpoch int       `gorm:"column:transaction_epoch" json:"transaction_epoch"`
+	Tokens           string    `gorm:"column:tokens" json:"tokens"`
+	QuorumList       string    `gorm:"column:quorum_list" json:"quorum_list"`
+	PledgedTokens    string    `gorm:"column:pledged_tokens" json:"pledged_tokens"`
+	ContractBlock    string    `gorm:"column:contract_block" json:"contract_block"`
+	TokenChainBlock  string    `gorm:"column:token_chain_block" json:"token_chain_block"`
+	RoyaltyBlock     string    `gorm:"column:royalty_block" json:"royalty_block"`
+	TokenStateHashes string    `gorm:"column:token_state_hashes" json:"token_state_hashes"`
+	Message          string    `gorm:"column:message" json:"message"`
+	UpdatedAt        time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+func (c *Core) initConsensusJournal() error {
+	err := c.s.Init(ConsensusJournalStorage, &ConsensusJournal{}, true)
+	if err != nil {
+		c.log.Error("Failed to initialise storage consensus journal", "err", err)
+		return err
+	}
+	return nil
+}
+
+func journalEncode(v interface{}) string {
+	jb, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(jb)
+}
+
+func journalDecode(s string, v interface{}) error {
+	if s == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(s), v)
+}
+
+// writeJournal adds or updates the journal entry
+func (c *Core) writeJournal(cj *ConsensusJournal) {
+	cj.UpdatedAt = time.Now()
+	var ecj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &ecj, "id=?", cj.ID)
+	if err != nil {
+		err = c.s.Write(ConsensusJournalStorage, cj)
+	} else {
+		err = c.s.Update(ConsensusJournalStorage, cj, "id=?", cj.ID)
+	}
+	if err != nil {
+		c.log.Error("Failed to update consensus journal", "id", cj.ID, "state", journalStateName[cj.State], "err", err)
+	}
+}
+
+// updateJournal moves the journal entry to the new state
+func (c *Core) updateJournal(id string, state int, update func(cj *ConsensusJournal)) {
+	var cj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cj, "id=?", id)
+	if err != nil {
+		// transaction is not journaled
+		return
+	}
+	cj.State = state
+	if update != nil {
+		update(&cj)
+	}
+	c.writeJournal(&cj)
+}
+
+// journalTokensLocked records the RBT tokens locked for the transaction
+func (c *Core) journalTokensLocked(id string, mode int, did string, wt []wallet.Token) {
+	tl := make([]string, 0)
+	for i := range wt {
+		tl = append(tl, wt[i].TokenID)
+	}
+	c.journalLocked(id, mode, did, tl)
+}
+
+// journalFTsLocked records the FTs locked for the transaction
+func (c *Core) journalFTsLocked(id string, did string, ft []wallet.FTToken) {
+	tl := make([]string, 0)
+	for i := range ft {
+		tl = append(tl, ft[i].TokenID)
+	}
+	c.journalLocked(id, FTTransferMode, did, tl)
+}
+
+func (c *Core) journalLocked(id string, mode int, did string, tl []string) {
+	cj := ConsensusJournal{
+		ID:        id,
+		Mode:      mode,
+		State:     JournalTokensLocked,
+		SenderDID: did,
+		Tokens:    journalEncode(tl),
+	}
+	c.writeJournal(&cj)
+}
+
+// journalContractSigned records the signed contract of the consensus request,
+// the transactions which did not lock any token are journaled without tokens
+func (c *Core) journalContractSigned(cr *ConensusRequest, sc *contract.Contract) {
+	var cj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cj, "id=?", cr.ReqID)
+	if err != nil {
+		cj = ConsensusJournal{
+			ID:     cr.ReqID,
+			Tokens: journalEncode([]string{}),
+		}
+	}
+	cj.Mode = cr.Mode
+	cj.State = JournalContractSigned
+	cj.SenderDID = sc.GetSenderDID()
+	cj.ReceiverDID = sc.GetReceiverDID()
+	cj.ReceiverPeerID = cr.ReceiverPeerID
+	if cr.Mode == PinningServiceMode {
+		cj.ReceiverDID = sc.GetPinningServiceDID()
+		cj.ReceiverPeerID = cr.PinningNodePeerID
+	}
+	if cr.Mode == NFTExecuteMode {
+		// the royalty recipient is the only peer receiving the tokens
+		cj.SenderDID = sc.GetExecutorDID()
+		cj.ReceiverPeerID = cr.RoyaltyPeerID
+	}
+	cj.TransactionID = cr.TransactionID
+	cj.TransactionEpoch = cr.TransactionEpoch
+	cj.ContractBlock = base64.StdEncoding.EncodeToString(sc.GetBlock())
+	c.writeJournal(&cj)
+}
+
+// journalQuorumsPledged records the quorums which pledged for the consensus request
+func (c *Core) journalQuorumsPledged(cr *ConensusRequest) {
+	c.qlock.Lock()
+	pd, ok := c.pd[cr.ReqID]
+	var pt map[string][]string
+	if ok {
+		pt = make(map[string][]string)
+		for k, v := range pd.PledgedTokens {
+			pt[k] = v
+		}
+	}
+	c.qlock.Unlock()
+	c.updateJournal(cr.ReqID, JournalQuorumsPledged, func(cj *ConsensusJournal) {
+		cj.QuorumList = journalEncode(cr.QuorumList)
+		cj.PledgedTokens = journalEncode(pt)
+	})
+}
+
+// journalBlockAdded records the new token chain block signed by the quorums
+func (c *Core) journalBlockAdded(cr *ConensusRequest, nb *block.Block) {
+	c.updateJournal(cr.ReqID, JournalBlockAdded, func(cj *ConsensusJournal) {
+		cj.TokenChainBlock = base64.StdEncoding.EncodeToString(nb.GetBlock())
+	})
+}
+
+// journalRoyaltyBlock records the royalty block of the NFT execution before it
+// is sent to the royalty recipient
+func (c *Core) journalRoyaltyBlock(cr *ConensusRequest, rb *block.Block) {
+	c.updateJournal(cr.ReqID, JournalBlockAdded, func(cj *ConsensusJournal) {
+		cj.RoyaltyBlock = base64.StdEncoding.EncodeToString(rb.GetBlock())
+	})
+}
+
+// journalReceiverUpdated records the new token state hashes returned by the receiver
+func (c *Core) journalReceiverUpdated(cr *ConensusRequest, hashes []string) {
+	c.updateJournal(cr.ReqID, JournalReceiverUpdated, func(cj *ConsensusJournal) {
+		cj.TokenStateHashes = journalEncode(hashes)
+	})
+}
+
+// journalFinality records the pledge finality of the quorums
+func (c *Core) journalFinality(cr *ConensusRequest) {
+	c.updateJournal(cr.ReqID, JournalFinality, nil)
+}
+
+// finishJournal closes the journal entry once the consensus returns, failed
+// transactions which already reached the receiver are left for the replay
+func (c *Core) finishJournal(id string, err error) {
+	var cj ConsensusJournal
+	rerr := c.s.Read(ConsensusJournalStorage, &cj, "id=?", id)
+	if rerr != nil {
+		return
+	}
+	if err == nil {
+		cj.State = JournalCompleted
+		cj.Message = ""
+	} else if cj.State < JournalBlockAdded {
+		cj.State = JournalRolledBack
+		cj.Message = err.Error()
+	} else {
+		cj.Message = err.Error()
+	}
+	c.writeJournal(&cj)
+}
+
+// journalKeepsLock checks whether the tokens of the transaction have to be
+// kept locked, the receiver may have accepted the block of the failed transaction
+func (c *Core) journalKeepsLock(id string) bool {
+	var cj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cj, "id=?", id)
+	if err != nil {
+		return false
+	}
+	return cj.State >= JournalBlockAdded && cj.State != JournalCompleted && cj.State != JournalRolledBack
+}
+
+// releaseConsensusTokens releases the RBT tokens locked for the consensus
+// unless the journal keeps them locked for the replay
+func (c *Core) releaseConsensusTokens(id string, wt []wallet.Token) {
+	if c.journalKeepsLock(id) {
+		c.log.Error("Transaction failed after the block was added, tokens are kept locked", "id", id)
+		return
+	}
+	c.w.ReleaseTokens(wt)
+}
+
+// GetConsensusJournal returns the journal entries which are not completed or rolled back
+func (c *Core) GetConsensusJournal() ([]ConsensusJournal, error) {
+	var cjs []ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cjs, "state!=? AND state!=?", JournalCompleted, JournalRolledBack)
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []ConsensusJournal{}, nil
+		}
+		return nil, err
+	}
+	return cjs, nil
+}
+
+// replayConsensusJournal rolls forward or rolls back the transactions
+// which were interrupted by the node shutdown
+func (c *Core) replayConsensusJournal() {
+	cjs, err := c.GetConsensusJournal()
+	if err != nil {
+		c.log.Error("Failed to read consensus journal", "err", err)
+		return
+	}
+	for i := range cjs {
+		cj := &cjs[i]
+		c.log.Info("Replaying interrupted transaction", "id", cj.ID, "state", journalStateName[cj.State])
+		switch cj.State {
+		case JournalTokensLocked, JournalContractSigned:
+			err = c.journalReleaseTokens(cj)
+			if err == nil {
+				cj.State = JournalRolledBack
+			}
+		case JournalQuorumsPledged:
+			// block is not sent to the receiver yet, so it is safe to unlock
+			err = c.journalUnlockQuorums(cj)
+			if err == nil {
+				err = c.journalReleaseTokens(cj)
+			}
+			if err == nil {
+				cj.State = JournalRolledBack
+			}
+		case JournalBlockAdded:
+			// receiver may or may not have accepted the block, keep the tokens locked
+			err = fmt.Errorf("transaction interrupted after the block was sent to receiver, tokens are kept locked")
+			cj.State = JournalNeedsReview
+		case JournalReceiverUpdated, JournalFinality:
+			if !journalRollForwardModes[cj.Mode] {
+				err = fmt.Errorf("transaction of mode %s can not be rolled forward, tokens are kept locked", consensusModeName[cj.Mode])
+				cj.State = JournalNeedsReview
+				break
+			}
+			err = c.journalRollForward(cj)
+			if err == nil {
+				cj.State = JournalCompleted
+			}
+		case JournalNeedsReview:
+			c.log.Error("Transaction needs manual review, tokens are kept locked", "id", cj.ID, "transaction_id", cj.TransactionID)
+			continue
+		}
+		if err != nil {
+			c.log.Error("Failed to replay interrupted transaction", "id", cj.ID, "err", err)
+			cj.Message = err.Error()
+		} else {
+			c.log.Info("Interrupted transaction replayed", "id", cj.ID, "state", journalStateName[cj.State])
+			cj.Message = ""
+		}
+		c.writeJournal(cj)
+	}
+}
+
+func (c *Core) journalReleaseTokens(cj *ConsensusJournal) error {
+	var tl []string
+	err := journalDecode(cj.Tokens, &tl)
+	if err != nil {
+		return err
+	}
+	if cj.Mode == FTTransferMode {
+		return c.w.ReleaseFTTokens(tl)
+	}
+	wt := make([]wallet.Token, 0)
+	for _, t := range tl {
+		wt = append(wt, wallet.Token{TokenID: t})
+	}
+	return c.w.ReleaseTokens(wt)
+}
+
+func (c *Core) journalQuorumAddress(cj *ConsensusJournal, did string) (string, error) {
+	var ql []string
+	err := journalDecode(cj.QuorumList, &ql)
+	if err != nil {
+		return "", err
+	}
+	for _, addr := range ql {
+		_, qdid, ok := util.ParseAddress(addr)
+		if ok && qdid == did {
+			return addr, nil
+		}
+	}
+	return "", fmt.Errorf("quorum %s not found in the quorum list", did)
+}
+
+// journalUnlockQuorums requests the pledging quorums to unlock the pledged tokens
+func (c *Core) journalUnlockQuorums(cj *ConsensusJournal) error {
+	var pt map[string][]string
+	err := journalDecode(cj.PledgedTokens, &pt)
+	if err != nil {
+		return err
+	}
+	for did, tokens := range pt {
+		addr, err := c.journalQuorumAddress(cj, did)
+		if err != nil {
+			return err
+		}
+		p, err := c.getPeer(addr, "")
+		if err != nil {
+			return err
+		}
+		var br model.BasicResponse
+		tokenList := TokenList{
+			Tokens: tokens,
+			DID:    did,
+		}
+		err = p.SendJSONRequest("POST", APIUnlockTokens, nil, &tokenList, &br, true)
+		p.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalRollForwardModes are the modes whose transaction can be completed
+// from the journal once the receiver accepted the block
+var journalRollForwardModes = map[int]bool{
+	RBTTransferMode:    true,
+	PinningServiceMode: true,
+	SelfTransferMode:   true,
+	FTTransferMode:     true,
+	NFTExecuteMode:     true,
+}
+
+// journalRollForward completes the transaction which was accepted by the receiver
+func (c *Core) journalRollForward(cj *ConsensusJournal) error {
+	if !journalRollForwardModes[cj.Mode] {
+		return fmt.Errorf("transaction of mode %s can not be rolled forward", consensusModeName[cj.Mode])
+	}
+	cb, err := base64.StdEncoding.DecodeString(cj.ContractBlock)
+	if err != nil {
+		return err
+	}
+	sc := contract.InitContract(cb, nil)
+	if sc == nil {
+		return fmt.Errorf("invalid contract in the journal")
+	}
+	bb, err := base64.StdEncoding.DecodeString(cj.TokenChainBlock)
+	if err != nil {
+		return err
+	}
+	nb := block.InitBlock(bb, nil)
+	if nb == nil {
+		return fmt.Errorf("invalid token chain block in the journal")
+	}
+	var pt map[string][]string
+	err = journalDecode(cj.PledgedTokens, &pt)
+	if err != nil {
+		return err
+	}
+	if cj.State == JournalReceiverUpdated {
+		var hashes []string
+		err = journalDecode(cj.TokenStateHashes, &hashes)
+		if err != nil {
+			return err
+		}
+		for did, tokens := range pt {
+			addr, err := c.journalQuorumAddress(cj, did)
+			if err != nil {
+				return err
+			}
+			p, err := c.getPeer(addr, "")
+			if err != nil {
+				return err
+			}
+			var br model.BasicResponse
+			ur := UpdatePledgeRequest{
+				Mode:                        cj.Mode,
+				PledgedTokens:               tokens,
+				TokenChainBlock:             nb.GetBlock(),
+				TransactionID:               cj.TransactionID,
+				TransferredTokenStateHashes: hashes,
+				TransactionEpoch:            cj.TransactionEpoch,
+			}
+			err = p.SendJSONRequest("POST", APIUpdatePledgeToken, nil, &ur, &br, true)
+			p.Close()
+			if err != nil {
+				return err
+			}
+			if !br.Status {
+				return fmt.Errorf("failed to update pledge token status, %s", br.Message)
+			}
+		}
+		cj.State = JournalFinality
+		c.writeJournal(cj)
+	}
+	ti := sc.GetTransTokenInfo()
+	switch cj.Mode {
+	case RBTTransferMode:
+		err = c.w.TokensTransferred(cj.SenderDID, ti, nb, cj.ReceiverPeerID == c.peerID, false)
+	case PinningServiceMode:
+		err = c.w.TokensTransferred(cj.SenderDID, ti, nb, cj.ReceiverPeerID == c.peerID, true)
+	case SelfTransferMode:
+		// self transfer updates the token chain while updating the receiver
+	case FTTransferMode:
+		err = c.w.FTTokensTransffered(cj.SenderDID, ti, nb, cj.ReceiverPeerID == c.peerID)
+	case NFTExecuteMode:
+		var rb *block.Block
+		if cj.RoyaltyBlock != "" {
+			rbb, err := base64.StdEncoding.DecodeString(cj.RoyaltyBlock)
+			if err != nil {
+				return err
+			}
+			rb = block.InitBlock(rbb, nil)
+			if rb == nil {
+				return fmt.Errorf("invalid royalty block in the journal")
+			}
+		}
+		err = c.nftExecuted(ti[0].Token, sc, nb, rb, cj.ReceiverPeerID == c.peerID)
+	}
+	if err != nil {
+		return err
+	}
+	nbid, err := nb.GetBlockID(ti[0].Token)
+	if err != nil {
+		return err
+	}
+	for did, tokens := range pt {
+		addr, err := c.journalQuorumAddress(cj, did)
+		if err != nil {
+			return err
+		}
+		p, err := c.getPeer(addr, "")
+		if err != nil {
+			return err
+		}
+		var br model.BasicResponse
+		ur := &model.AddUnpledgeDetailsRequest{
+			TransactionHash:   cj.TransactionID,
+			QuorumDID:         did,
+			PledgeTokenHashes: tokens,
+			TransactionEpoch:  int64(cj.TransactionEpoch),
+		}
+		err = p.SendJSONRequest("POST", APIAddUnpledgeDetails, nil, ur, &br, true)
+		p.Close()
+		if err != nil {
+			return err
+		}
+		if !br.Status {
+			return fmt.Errorf("failed to add unpledge details, %s", br.Message)
+		}
+	}
+	td := model.TransactionDetails{
+		TransactionID:   cj.TransactionID,
+		TransactionType: nb.GetTransType(),
+		BlockID:         nbid,
+		Mode:            wallet.SendMode,
+		SenderDID:       cj.SenderDID,
+		ReceiverDID:     cj.ReceiverDID,
+		Amount:          sc.GetTotalRBTs(),
+		Comment:         sc.GetComment(),
+		DateTime:        time.Now(),
+		Status:          true,
+		Epoch:           int64(cj.TransactionEpoch),
+	}
+	if cj.Mode == NFTExecuteMode {
+		// same as the history of the NFT execution
+		td.Mode = wallet.ExecuteMode
+		td.SenderDID = ""
+		td.ReceiverDID = ""
+		td.Amount = 0
+		td.DeployerDID = cj.SenderDID
+	}
+	return c.w.AddTransactionHistory(&td)
+}
+
+func journalEntry(cj *ConsensusJournal) model.ConsensusJournalEntry {
+	var tl []string
+	journalDecode(cj.Tokens, &tl)
+	return model.ConsensusJournalEntry{
+		ID:            cj.ID,
+		Mode:          consensusModeName[cj.Mode],
+		State:         journalStateName[cj.State],
+		SenderDID:     cj.SenderDID,
+		ReceiverDID:   cj.ReceiverDID,
+		TransactionID: cj.TransactionID,
+		Tokens:        tl,
+		ReceiverAck:   cj.TokenStateHashes != "",
+		Message:       cj.Message,
+		UpdatedAt:     cj.UpdatedAt,
+	}
+}
+
+// GetConsensusJournalEntries returns the journal entries which are not completed or rolled back
+func (c *Core) GetConsensusJournalEntries() ([]model.ConsensusJournalEntry, error) {
+	cjs, err := c.GetConsensusJournal()
+	if err != nil {
+		c.log.Error("Failed to read consensus journal", "err", err)
+		return nil, err
+	}
+	el := make([]model.ConsensusJournalEntry, 0)
+	for i := range cjs {
+		el = append(el, journalEntry(&cjs[i]))
+	}
+	return el, nil
+}
+
+// ResolveConsensusJournal resolves the journal entry of the interrupted transaction,
+// rollback releases the tokens & the quorum pledges of the transaction which was not
+// acknowledged by the receiver, rollforward completes the acknowledged transaction
+func (c *Core) ResolveConsensusJournal(id string, action string) (*model.ConsensusJournalEntry, error) {
+	c.qlock.Lock()
+	_, running := c.quorumRequest[id]
+	c.qlock.Unlock()
+	if running {
+		return nil, fmt.Errorf("transaction is in progress")
+	}
+	var cj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cj, "id=?", id)
+	if err != nil {
+		return nil, fmt.Errorf("journal entry not found")
+	}
+	if cj.State == JournalCompleted || cj.State == JournalRolledBack {
+		return nil, fmt.Errorf("journal entry is already %s", journalStateName[cj.State])
+	}
+	switch action {
+	case model.JournalRollBack:
+		if cj.TokenStateHashes != "" {
+			return nil, fmt.Errorf("receiver acknowledged the transaction, it can only be rolled forward")
+		}
+		err = c.journalUnlockQuorums(&cj)
+		if err == nil {
+			err = c.journalReleaseTokens(&cj)
+		}
+		if err == nil {
+			cj.State = JournalRolledBack
+		}
+	case model.JournalRollForward:
+		if cj.TokenStateHashes == "" {
+			return nil, fmt.Errorf("receiver did not acknowledge the transaction, it can only be rolled back")
+		}
+		if cj.State == JournalNeedsReview {
+			// finality is sent again as the state before the review is not known
+			cj.State = JournalReceiverUpdated
+		}
+		err = c.journalRollForward(&cj)
+		if err == nil {
+			cj.State = JournalCompleted
+		}
+	default:
+		return nil, fmt.Errorf("invalid action %s", action)
+	}
+	if err != nil {
+		c.log.Error("Failed to resolve consensus journal", "id", id, "action", action, "err", err)
+		cj.Message = err.Error()
+		c.writeJournal(&cj)
+		return nil, err
+	}
+	c.log.Info("Consensus journal resolved", "id", id, "state", journalStateName[cj.State])
+	cj.Message = "resolved by " + action
+	c.writeJournal(&cj)
+	e := journalEntry(&cj)
+	return &e, nil
+}
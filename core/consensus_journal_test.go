@@ -0,0 +1,256 @@
+package core
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/token"
+)
+
+func newTestJournalCore(t *testing.T) *Core {
+	dir := t.TempDir() + "/"
+	log := newTestLogger()
+	s, err := storage.NewStorageDB(newStorageDBConfig(&config.StorageConfig{DBAddress: dir + "test.db", DBType: "Sqlite3"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	c := &Core{
+		cfg:           &config.Config{},
+		log:           log,
+		s:             s,
+		quorumRequest: make(map[string]*ConsensusStatus),
+	}
+	c.w, err = wallet.InitWallet(s, dir, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.initConsensusJournal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func checkTokenStatus(t *testing.T, c *Core, token string, status int) {
+	var wt wallet.Token
+	err := c.s.Read(wallet.TokenStorage, &wt, "token_id=?", token)
+	if err != nil || wt.TokenStatus != status {
+		t.Fatalf("token %s status mismatch, expected %d, got %d, %v", token, status, wt.TokenStatus, err)
+	}
+}
+
+func checkJournalState(t *testing.T, c *Core, id string, state int) {
+	var cj ConsensusJournal
+	err := c.s.Read(ConsensusJournalStorage, &cj, "id=?", id)
+	if err != nil || cj.State != state {
+		t.Fatalf("journal %s state mismatch, expected %s, got %s, %v", id, journalStateName[state], journalStateName[cj.State], err)
+	}
+}
+
+func TestReplayConsensusJournal(t *testing.T) {
+	c := newTestJournalCore(t)
+	for _, tk := range []string{"t1", "t2", "t3", "t4"} {
+		err := c.w.CreateToken(&wallet.Token{TokenID: tk, DID: "sender", TokenValue: 1, TokenStatus: wallet.TokenIsLocked})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := c.w.CreateFT(&wallet.FTToken{TokenID: "f1", DID: "sender", TokenValue: 1, TokenStatus: wallet.TokenIsLocked})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.journalLocked("locked", RBTTransferMode, "sender", []string{"t1", "t2"})
+	c.journalLocked("ft", FTTransferMode, "sender", []string{"f1"})
+	c.journalLocked("sent", RBTTransferMode, "sender", []string{"t3"})
+	c.updateJournal("sent", JournalBlockAdded, nil)
+	c.journalLocked("sc", SmartContractExecuteMode, "sender", []string{"t4"})
+	c.updateJournal("sc", JournalFinality, func(cj *ConsensusJournal) {
+		cj.TokenStateHashes = journalEncode([]string{"hash"})
+	})
+	c.journalLocked("done", RBTTransferMode, "sender", []string{})
+	c.finishJournal("done", nil)
+
+	c.replayConsensusJournal()
+	// tokens of the transaction which did not reach the receiver are released
+	checkJournalState(t, c, "locked", JournalRolledBack)
+	checkTokenStatus(t, c, "t1", wallet.TokenIsFree)
+	checkTokenStatus(t, c, "t2", wallet.TokenIsFree)
+	checkJournalState(t, c, "ft", JournalRolledBack)
+	ft, err := c.w.ReadFTToken("f1")
+	if err != nil || ft.TokenStatus != wallet.TokenIsFree {
+		t.Fatalf("FT is not released, %v", err)
+	}
+	// receiver may have the block, tokens are kept locked for the review
+	checkJournalState(t, c, "sent", JournalNeedsReview)
+	checkTokenStatus(t, c, "t3", wallet.TokenIsLocked)
+	// smart contract transactions can not be rolled forward
+	checkJournalState(t, c, "sc", JournalNeedsReview)
+	checkTokenStatus(t, c, "t4", wallet.TokenIsLocked)
+	checkJournalState(t, c, "done", JournalCompleted)
+
+	el, err := c.GetConsensusJournalEntries()
+	if err != nil || len(el) != 2 {
+		t.Fatalf("journal entries mismatch, %v, %v", el, err)
+	}
+	for _, e := range el {
+		if e.State != "needs_review" || e.Message == "" {
+			t.Fatalf("journal entry mismatch, %+v", e)
+		}
+	}
+
+	// a second replay leaves the entries for the review
+	c.replayConsensusJournal()
+	checkJournalState(t, c, "sent", JournalNeedsReview)
+	checkTokenStatus(t, c, "t3", wallet.TokenIsLocked)
+}
+
+func TestResolveConsensusJournal(t *testing.T) {
+	c := newTestJournalCore(t)
+	err := c.w.CreateToken(&wallet.Token{TokenID: "t1", DID: "sender", TokenValue: 1, TokenStatus: wallet.TokenIsLocked})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.journalLocked("sent", RBTTransferMode, "sender", []string{"t1"})
+	c.updateJournal("sent", JournalBlockAdded, nil)
+	c.journalLocked("sc", SmartContractExecuteMode, "sender", []string{})
+	c.updateJournal("sc", JournalNeedsReview, func(cj *ConsensusJournal) {
+		cj.TokenStateHashes = journalEncode([]string{"hash"})
+	})
+
+	cases := []struct {
+		id     string
+		action string
+	}{
+		{"missing", model.JournalRollBack},
+		{"sent", "abort"},
+		// receiver did not acknowledge the block
+		{"sent", model.JournalRollForward},
+		// receiver acknowledged the block
+		{"sc", model.JournalRollBack},
+		{"sc", model.JournalRollForward},
+	}
+	for _, tc := range cases {
+		_, err := c.ResolveConsensusJournal(tc.id, tc.action)
+		if err == nil {
+			t.Fatalf("journal %s is resolved with %s", tc.id, tc.action)
+		}
+	}
+	checkTokenStatus(t, c, "t1", wallet.TokenIsLocked)
+
+	c.quorumRequest["sent"] = &ConsensusStatus{}
+	_, err = c.ResolveConsensusJournal("sent", model.JournalRollBack)
+	if err == nil {
+		t.Fatal("journal of the running transaction is resolved")
+	}
+	delete(c.quorumRequest, "sent")
+
+	e, err := c.ResolveConsensusJournal("sent", model.JournalRollBack)
+	if err != nil || e.State != "rolled_back" {
+		t.Fatalf("failed to roll back the journal, %v", err)
+	}
+	checkTokenStatus(t, c, "t1", wallet.TokenIsFree)
+	_, err = c.ResolveConsensusJournal("sent", model.JournalRollBack)
+	if err == nil {
+		t.Fatal("rolled back journal is resolved again")
+	}
+	el, err := c.GetConsensusJournalEntries()
+	if err != nil || len(el) != 1 || el[0].ID != "sc" || !el[0].ReceiverAck {
+		t.Fatalf("journal entries mismatch, %v, %v", el, err)
+	}
+}
+
+func newJournalBlock(t *testing.T, prev *block.Block, tk string, tt int, owner string, sc []byte) *block.Block {
+	ctcb := make(map[string]*block.Block)
+	ctcb[tk] = prev
+	nb := block.CreateNewBlock(ctcb, &block.TokenChainBlock{
+		TransactionType: block.TokenTransferredType,
+		TokenOwner:      owner,
+		TransInfo:       &block.TransInfo{Tokens: []block.TransTokens{{Token: tk, TokenType: tt}}},
+		SmartContract:   sc,
+	})
+	if nb == nil {
+		t.Fatal("failed to create the block")
+	}
+	bm := make(map[string]interface{})
+	for k, v := range nb.GetBlockMap() {
+		bm[k] = v
+	}
+	bm[block.TCSignatureKey] = map[string]interface{}{owner: "signature"}
+	return block.InitBlock(nil, bm)
+}
+
+func checkLatestBlock(t *testing.T, c *Core, b *block.Block) {
+	if !c.w.IsLatestTokenBlock(b) {
+		t.Fatalf("block of %v is not added", b.GetTransTokens())
+	}
+}
+
+func TestReplayNFTExecution(t *testing.T) {
+	c := newTestJournalCore(t)
+	nft := "Qm" + strings.Repeat("n", 44)
+	rt := "Qm" + strings.Repeat("r", 44)
+	ngb := newJournalBlock(t, nil, nft, token.NFTTokenType, "executor", nil)
+	rgb := newJournalBlock(t, nil, rt, token.RBTTokenType, "executor", nil)
+	for _, b := range []*block.Block{ngb, rgb} {
+		err := c.w.CreateTokenBlock(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := c.w.CreateToken(&wallet.Token{TokenID: rt, DID: "executor", TokenValue: 1, TokenStatus: wallet.TokenIsLocked})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rbid, err := rgb.GetBlockID(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := contract.CreateNewContract(&contract.ContractType{
+		Type: contract.NFTExecuteType,
+		TransInfo: &contract.TransInfo{
+			ExecutorDID:   "executor",
+			ReceiverDID:   "buyer",
+			NFT:           nft,
+			TransTokens:   []contract.TokenInfo{{Token: nft, TokenType: token.NFTTokenType, OwnerDID: "buyer"}},
+			NFTRoyaltyDID: "recipient",
+			RoyaltyTokens: []contract.TokenInfo{{Token: rt, TokenType: token.RBTTokenType, TokenValue: 1, OwnerDID: "executor", BlockID: rbid}},
+		},
+	})
+	if sc == nil {
+		t.Fatal("failed to create the contract")
+	}
+	sm := sc.GetMap()
+	sm[contract.SCShareSignatureKey] = map[string]interface{}{"executor": "signature"}
+	sm[contract.SCKeySignatureKey] = map[string]interface{}{"executor": "signature"}
+	sc = contract.InitContract(nil, sm)
+	nb := newJournalBlock(t, ngb, nft, token.NFTTokenType, "buyer", sc.GetBlock())
+	rb := newJournalBlock(t, rgb, rt, token.RBTTokenType, "recipient", sc.GetBlock())
+	c.journalLocked("nft", NFTExecuteMode, "executor", []string{rt})
+	c.updateJournal("nft", JournalFinality, func(cj *ConsensusJournal) {
+		cj.ReceiverPeerID = "recipientpeer"
+		cj.TransactionID = "tid"
+		cj.ContractBlock = base64.StdEncoding.EncodeToString(sc.GetBlock())
+		cj.TokenChainBlock = base64.StdEncoding.EncodeToString(nb.GetBlock())
+		cj.RoyaltyBlock = base64.StdEncoding.EncodeToString(rb.GetBlock())
+		cj.TokenStateHashes = journalEncode([]string{"nfthash", "royaltyhash"})
+	})
+	// the node stopped after the royalty block was added
+	err = c.w.CreateTokenBlock(rb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.replayConsensusJournal()
+	checkJournalState(t, c, "nft", JournalCompleted)
+	checkLatestBlock(t, c, rb)
+	checkLatestBlock(t, c, nb)
+	checkTokenStatus(t, c, rt, wallet.TokenIsTransferred)
+}
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+)
+
+// consensusModeName maps the consensus mode to the name used
+// as key in the mode policy of the consensus configuration
+var consensusModeName = map[int]string{
+	RBTTransferMode:          "rbt_transfer",
+	NFTDeployMode:            "nft_deploy",
+	DTCommitMode:             "data_token_commit",
+	NFTSaleContractMode:      "nft_sale_contract",
+	SmartContractDeployMode:  "smart_contract_deploy",
+	SmartContractExecuteMode: "smart_contract_execute",
+	SelfTransferMode:         "self_transfer",
+	PinningServiceMode:       "pinning_service",
+	NFTExecuteMode:           "nft_execute",
+	FTTransferMode:           "ft_transfer",
+	SmartContractUpgradeMode: "smart_contract_upgrade",
+}
+
+// DefaultConsensusPolicy returns the policy used when the node has no
+// consensus configuration, it is also applied to blocks created before
+// the policy was recorded in the token chain
+func DefaultConsensusPolicy() config.ConsensusPolicy {
+	return config.ConsensusPolicy{
+		QuorumCount:  QuorumRequired,
+		MinQuorum:    MinQuorumRequired,
+		MinConsensus: MinConsensusRequired,
+		AlphaCount:   QuorumRequired,
+	}
+}
+
+// fillConsensusPolicy fills the missing values of the policy from the default policy
+func fillConsensusPolicy(cp config.ConsensusPolicy, dp config.ConsensusPolicy) config.ConsensusPolicy {
+	if cp.QuorumCount == 0 {
+		cp.QuorumCount = dp.QuorumCount
+	}
+	if cp.MinQuorum == 0 {
+		cp.MinQuorum = dp.MinQuorum
+	}
+	if cp.MinConsensus == 0 {
+		cp.MinConsensus = dp.MinConsensus
+	}
+	if cp.AlphaCount == 0 && cp.BetaCount == 0 && cp.GammaCount == 0 {
+		cp.AlphaCount = cp.QuorumCount
+	}
+	return cp
+}
+
+// ValidateConsensusPolicy checks whether the consensus policy is consistent
+func ValidateConsensusPolicy(cp *config.ConsensusPolicy) error {
+	if cp.QuorumCount <= 0 {
+		return fmt.Errorf("invalid consensus policy, quorum count should be greater than zero")
+	}
+	if cp.MinConsensus <= 0 || cp.MinConsensus > cp.QuorumCount {
+		return fmt.Errorf("invalid consensus policy, minimum consensus %d should be between 1 and quorum count %d", cp.MinConsensus, cp.QuorumCount)
+	}
+	if cp.MinQuorum < cp.MinConsensus || cp.MinQuorum > cp.QuorumCount {
+		return fmt.Errorf("invalid consensus policy, minimum quorum %d should be between minimum consensus %d and quorum count %d", cp.MinQuorum, cp.MinConsensus, cp.QuorumCount)
+	}
+	if cp.AlphaCount < 0 || cp.BetaCount < 0 || cp.GammaCount < 0 {
+		return fmt.Errorf("invalid consensus policy, quorum split can not be negative")
+	}
+	if cp.AlphaCount+cp.BetaCount+cp.GammaCount != cp.QuorumCount {
+		return fmt.Errorf("invalid consensus policy, alpha, beta & gamma split should add up to quorum count %d", cp.QuorumCount)
+	}
+	return nil
+}
+
+// validateConsensusConfig validates the default policy and all the mode policies
+func validateConsensusConfig(cc *config.ConsensusConfig) error {
+	dp := fillConsensusPolicy(cc.DefaultPolicy, DefaultConsensusPolicy())
+	err := ValidateConsensusPolicy(&dp)
+	if err != nil {
+		return fmt.Errorf("default %v", err)
+	}
+	_, err = NewQuorumSelector(cc.QuorumSelector)
+	if err != nil {
+		return err
+	}
+	if cc.MaxQuorumFailures < 0 || cc.QuorumExclusionTime < 0 {
+		return fmt.Errorf("invalid quorum exclusion configuration")
+	}
+	for k, v := range cc.ModePolicy {
+		found := false
+		for _, n := range consensusModeName {
+			if n == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid consensus mode %s in consensus configuration", k)
+		}
+		mp := fillConsensusPolicy(v, dp)
+		err = ValidateConsensusPolicy(&mp)
+		if err != nil {
+			return fmt.Errorf("%s %v", k, err)
+		}
+	}
+	return nil
+}
+
+// getConsensusPolicy returns the consensus policy configured for the mode
+func (c *Core) getConsensusPolicy(mode int) config.ConsensusPolicy {
+	cc := c.cfg.CfgData.ConsensusConfig
+	dp := fillConsensusPolicy(cc.DefaultPolicy, DefaultConsensusPolicy())
+	mp, ok := cc.ModePolicy[consensusModeName[mode]]
+	if !ok {
+		return dp
+	}
+	return fillConsensusPolicy(mp, dp)
+}
+
+func newBlockConsensusPolicy(mode int, cp *config.ConsensusPolicy) *block.ConsensusPolicy {
+	return &block.ConsensusPolicy{
+		QuorumCount:  cp.QuorumCount,
+		MinQuorum:    cp.MinQuorum,
+		MinConsensus: cp.MinConsensus,
+		AlphaCount:   cp.AlphaCount,
+		BetaCount:    cp.BetaCount,
+		GammaCount:   cp.GammaCount,
+		Mode:         consensusModeName[mode],
+	}
+}
+
+// blockTransModes lists the consensus modes which create a block of the transaction type
+var blockTransModes = map[string][]int{
+	block.TokenTransferredType: {RBTTransferMode, SelfTransferMode, FTTransferMode, NFTSaleContractMode},
+	block.TokenCommittedType:   {DTCommitMode},
+	block.TokenDeployedType:    {SmartContractDeployMode, NFTDeployMode},
+	block.TokenExecutedType:    {SmartContractExecuteMode, NFTExecuteMode},
+	block.TokenUpgradedType:    {SmartContractUpgradeMode},
+	block.TokenPinnedAsService: {PinningServiceMode},
+}
+
+// blockConsensusMinimum returns the consensus the node requires for the block,
+// it is the minimum consensus of the mode recorded in the block. The mode has to
+// match the block transaction type, blocks which did not record the mode
+// are held to the strictest of the modes creating that transaction type
+func (c *Core) blockConsensusMinimum(b *block.Block, mode string) (int, error) {
+	modes, ok := blockTransModes[b.GetTransType()]
+	if !ok {
+		return c.getConsensusPolicy(RBTTransferMode).MinConsensus, nil
+	}
+	if mode != "" {
+		for _, m := range modes {
+			if consensusModeName[m] == mode {
+				return c.getConsensusPolicy(m).MinConsensus, nil
+			}
+		}
+		return 0, fmt.Errorf("block consensus mode %s does not match the transaction type %s", mode, b.GetTransType())
+	}
+	min := 0
+	for _, m := range modes {
+		mc := c.getConsensusPolicy(m).MinConsensus
+		if mc > min {
+			min = mc
+		}
+	}
+	return min, nil
+}
+
+// checkBlockConsensusPolicy returns the policy recorded in the block after
+// checking it against the node policy for the block mode
+func (c *Core) checkBlockConsensusPolicy(b *block.Block) (config.ConsensusPolicy, error) {
+	bcp := b.GetConsensusPolicy()
+	cp := getBlockConsensusPolicy(b)
+	if bcp == nil {
+		return cp, nil
+	}
+	err := ValidateConsensusPolicy(&cp)
+	if err != nil {
+		return cp, err
+	}
+	min, err := c.blockConsensusMinimum(b, bcp.Mode)
+	if err != nil {
+		return cp, err
+	}
+	if cp.MinConsensus < min {
+		return cp, fmt.Errorf("block consensus policy requires %d quorum signatures, node requires at least %d", cp.MinConsensus, min)
+	}
+	return cp, nil
+}
+
+// getBlockConsensusPolicy returns the policy which was in force when
+// the block was created, older blocks are validated against the default policy
+func getBlockConsensusPolicy(b *block.Block) config.ConsensusPolicy {
+	bcp := b.GetConsensusPolicy()
+	if bcp == nil {
+		return DefaultConsensusPolicy()
+	}
+	return config.ConsensusPolicy{
+		QuorumCount:  bcp.QuorumCount,
+		MinQuorum:    bcp.MinQuorum,
+		MinConsensus: bcp.MinConsensus,
+		AlphaCount:   bcp.AlphaCount,
+		BetaCount:    bcp.BetaCount,
+		GammaCount:   bcp.GammaCount,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	ipfsnode "github.com/ipfs/go-ipfs-api"
 	"github.com/rubixchain/rubixgoplatform/core/config"
 	"github.com/rubixchain/rubixgoplatform/core/ipfsport"
+	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/core/pubsub"
 	"github.com/rubixchain/rubixgoplatform/core/service"
 	"github.com/rubixchain/rubixgoplatform/core/storage"
@@ -22,7 +23,6 @@ import (
 	didm "github.com/rubixchain/rubixgoplatform/did"
 	"github.com/rubixchain/rubixgoplatform/util"
 	"github.com/rubixchain/rubixgoplatform/wrapper/apiconfig"
-	econfig "github.com/rubixchain/rubixgoplatform/wrapper/config"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
 	"github.com/rubixchain/rubixgoplatform/wrapper/uuid"
@@ -122,6 +122,14 @@ type Core struct {
 	quorumCount          int
 	noBalanceQuorumCount int
 	defaultSetup         bool
+	tsLock               sync.Map
+	scModules            sync.Map
+	te                   *txnEvents
+	sce                  *scEventSubs
+	jobLock              sync.Mutex
+	whSignal             chan struct{}
+	whStop               chan struct{}
+	metrics              *coreMetrics
 }
 
 func InitConfig(configFile string, encKey string, node uint16, addr string) error {
@@ -173,6 +181,11 @@ func NewCore(cfg *config.Config, cfgFile string, encKey string, log logger.Logge
 		update = true
 	}
 
+	err = validateConsensusConfig(&cfg.CfgData.ConsensusConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &Core{
 		cfg:           cfg,
 		cfgFile:       cfgFile,
@@ -188,7 +201,10 @@ func NewCore(cfg *config.Config, cfgFile string, encKey string, log logger.Logge
 		arbitaryMode:  am,
 		secret:        util.GetRandBytes(32),
 		defaultSetup:  defaultSetup,
+		te:            newTxnEvents(),
+		sce:           newSCEventSubs(),
 	}
+	c.initMetrics()
 	c.didDir = c.cfg.DirPath + RubixRootDir
 	if c.testNet {
 		c.didDir = c.cfg.DirPath + RubixRootDir + TestNetDIDDir
@@ -241,14 +257,7 @@ func NewCore(cfg *config.Config, cfgFile string, encKey string, log logger.Logge
 	switch sc.StorageType {
 
 	case storage.StorageDBType:
-		scfg := &econfig.Config{
-			DBName:     sc.DBName,
-			DBAddress:  sc.DBAddress,
-			DBPort:     sc.DBPort,
-			DBType:     sc.DBType,
-			DBUserName: sc.DBUserName,
-			DBPassword: sc.DBPassword,
-		}
+		scfg := newStorageDBConfig(&sc)
 		c.s, err = storage.NewStorageDB(scfg)
 		if err != nil {
 			c.log.Error("Failed to create storage DB", "err", err)
@@ -272,6 +281,7 @@ func NewCore(cfg *config.Config, cfgFile string, encKey string, log logger.Logge
 		c.log.Error("Failed to setup wallet", "err", err)
 		return nil, err
 	}
+	c.w.SetSCEventHandler(c.publishSCEvents)
 	c.qm, err = NewQuorumManager(c.s, c.log)
 	if err != nil {
 		c.log.Error("Failed to setup quorum manager", "err", err)
@@ -285,6 +295,26 @@ func NewCore(cfg *config.Config, cfgFile string, encKey string, log logger.Logge
 		}
 		c.log.Info("Arbitary mode is enabled")
 	}
+	err = c.initConsensusJournal()
+	if err != nil {
+		c.log.Error("Failed to init consensus journal", "err", err)
+		return nil, err
+	}
+	err = c.initJobs()
+	if err != nil {
+		c.log.Error("Failed to init jobs", "err", err)
+		return nil, err
+	}
+	err = c.initWebhooks()
+	if err != nil {
+		c.log.Error("Failed to init webhooks", "err", err)
+		return nil, err
+	}
+	err = c.initAPIKeys()
+	if err != nil {
+		c.log.Error("Failed to init api keys", "err", err)
+		return nil, err
+	}
 	err = c.InitRubixExplorer()
 	if err != nil {
 		c.log.Error("Failed to init explorer", "err", err)
@@ -360,6 +390,10 @@ func (c *Core) Start() (bool, string) {
 		c.log.Error("failed to start ping port", "err", err)
 		return false, "Failed to start ping port"
 	}
+	// roll forward or roll back the transactions interrupted by the previous
+	// shutdown before the node takes the new transactions
+	c.replayConsensusJournal()
+	go c.runWebhookDeliveries()
 	//c.w.ReleaseAllLockedTokens()
 	// exp := model.ExploreModel{
 	// 	Cmd:    ExpPeerStatusCmd,
@@ -408,6 +442,7 @@ func (c *Core) StopCore() {
 	// 	return
 	// }
 	time.Sleep(time.Second)
+	close(c.whStop)
 	c.stopIPFS()
 	if c.l != nil {
 		c.l.Shutdown()
@@ -483,9 +518,19 @@ func (c *Core) updateConfig() error {
 	return nil
 }
 
-func (c *Core) AddWebReq(req *ensweb.Request) {
+// AddWebReq adds the channels of the asynchronous request, the client can set the
+// request id so the id of an in flight request or a stored job is rejected
+func (c *Core) AddWebReq(req *ensweb.Request) error {
 	c.rlock.Lock()
 	defer c.rlock.Unlock()
+	_, ok := c.webReq[req.ID]
+	if ok {
+		return fmt.Errorf("request id is already in use")
+	}
+	var job model.Job
+	if c.s.Read(JobStorage, &job, "id=?", req.ID) == nil {
+		return fmt.Errorf("request id is already used by a job")
+	}
 	c.webReq[req.ID] = &did.DIDChan{
 		ID:      req.ID,
 		InChan:  make(chan interface{}),
@@ -493,7 +538,21 @@ func (c *Core) AddWebReq(req *ensweb.Request) {
 		Finish:  make(chan bool),
 		Req:     req,
 		Timeout: 3 * time.Minute,
+		SignRequested: func(sr *did.SignResponse) {
+			c.AddTxnEvent(req.ID, model.TxnEvent{
+				Type:    model.TxnEventSignatureRequested,
+				Status:  true,
+				Message: sr.Message,
+				SignRequest: &model.TxnSignRequest{
+					ID:          sr.Result.ID,
+					Mode:        sr.Result.Mode,
+					Hash:        sr.Result.Hash,
+					OnlyPrivKey: sr.Result.OnlyPrivKey,
+				},
+			})
+		},
 	}
+	return nil
 }
 
 func (c *Core) GetWebReq(reqID string) *did.DIDChan {
@@ -557,6 +616,14 @@ func (c *Core) SetupDID(reqID string, didStr string) (did.DIDCrypto, error) {
 
 // Initializes the did in it's corresponding did mode (basic/ lite)
 func (c *Core) SetupForienDID(didStr string, selfDID string) (did.DIDCrypto, error) {
+	return c.setupForienDID(didStr, selfDID, nil)
+}
+
+// didTypeHints are the did types of the dids not known to the node and its peers,
+// they are used only by the validation they are passed to
+type didTypeHints map[string]int
+
+func (c *Core) setupForienDID(didStr string, selfDID string, dth didTypeHints) (did.DIDCrypto, error) {
 	err := c.FetchDID(didStr)
 	if err != nil {
 		c.log.Error("couldn't fetch did")
@@ -572,7 +639,11 @@ func (c *Core) SetupForienDID(didStr string, selfDID string) (did.DIDCrypto, err
 			peerId := c.w.GetPeerID(didStr)
 
 			if peerId == "" {
-				return nil, err
+				dt, ok := dth[didStr]
+				if !ok {
+					return nil, err
+				}
+				return c.InitialiseDID(didStr, dt)
 			}
 			if selfDID != "" {
 				didtype, msg, err2 := c.GetPeerdidTypeFromPeer(peerId, didStr, selfDID)
@@ -594,6 +665,10 @@ func (c *Core) SetupForienDID(didStr string, selfDID string) (did.DIDCrypto, err
 
 // Initializes the quorum in it's corresponding did mode (basic/ lite)
 func (c *Core) SetupForienDIDQuorum(didStr string, selfDID string) (did.DIDCrypto, error) {
+	return c.setupForienDIDQuorum(didStr, selfDID, nil)
+}
+
+func (c *Core) setupForienDIDQuorum(didStr string, selfDID string, dth didTypeHints) (did.DIDCrypto, error) {
 	err := c.FetchDID(didStr)
 	if err != nil {
 		return nil, err
@@ -609,17 +684,22 @@ func (c *Core) SetupForienDIDQuorum(didStr string, selfDID string) (did.DIDCrypt
 			peerId := c.w.GetPeerID(didStr)
 
 			if peerId == "" {
-				return nil, err
-			}
-			didtype_, msg, err2 := c.GetPeerdidTypeFromPeer(peerId, didStr, selfDID)
-			if err2 != nil {
-				c.log.Error(msg)
-				return nil, err2
-			}
-			didtype = didtype_
-			peerUpdateResult, err3 := c.w.UpdatePeerDIDType(didStr, didtype)
-			if !peerUpdateResult {
-				c.log.Error("couldn't update did type in peer did table", err3)
+				dt, ok := dth[didStr]
+				if !ok {
+					return nil, err
+				}
+				didtype = dt
+			} else {
+				didtype_, msg, err2 := c.GetPeerdidTypeFromPeer(peerId, didStr, selfDID)
+				if err2 != nil {
+					c.log.Error(msg)
+					return nil, err2
+				}
+				didtype = didtype_
+				peerUpdateResult, err3 := c.w.UpdatePeerDIDType(didStr, didtype)
+				if !peerUpdateResult {
+					c.log.Error("couldn't update did type in peer did table", err3)
+				}
 			}
 		} else {
 			didtype = dt.Type
@@ -36,9 +36,15 @@ type DataTokenReq struct {
 }
 
 func (c *Core) CreateDataToken(reqID string, dr *DataTokenReq) {
-	c.log.Debug("Create data token")
 	defer os.RemoveAll(dr.FolderName)
+	err := c.startJob(reqID, JobCreateDataToken, dr.DID)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	c.log.Debug("Create data token")
 	br := c.createDataToken(reqID, dr)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to create data token, failed to get did channel")
@@ -254,7 +260,13 @@ func (c *Core) createDataToken(reqID string, dr *DataTokenReq) *model.BasicRespo
 }
 
 func (c *Core) CommitDataToken(reqID string, did string, batchID string) {
+	err := c.startJob(reqID, JobCommitDataToken, did)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.commitDataToken(reqID, did, batchID)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to create data token, failed to get did channel")
@@ -322,6 +334,7 @@ func (c *Core) commitDataToken(reqID string, did string, batchID string) *model.
 		SenderPeerID:  c.peerID,
 		ContractBlock: sc.GetBlock(),
 	}
+	c.linkTxnEvents(reqID, cr.ReqID)
 	td, pl, _, err := c.initiateConsensus(cr, sc, dc)
 	if err != nil {
 		c.log.Error("Consensus failed", "err", err)
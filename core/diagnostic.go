@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,7 +28,7 @@ func (c *Core) DumpTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 	if t.TokenValue < 1.0 {
 		ts = PartString
 	}
-	blks, nextID, err := c.w.GetAllTokenBlocks(dr.Token, c.TokenType(ts), dr.BlockID)
+	blks, nextID, nextCursor, err := c.dumpBlocks(dr, c.TokenType(ts))
 	if err != nil {
 		ds.Message = "Failed to get token chain block"
 		return ds
@@ -36,9 +37,87 @@ func (c *Core) DumpTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 	ds.Message = "Successfully got the token chain block"
 	ds.Blocks = blks
 	ds.NextBlockID = nextID
+	ds.NextCursor = nextCursor
 	return ds
 }
 
+// dumpBlocks returns the blocks of the dump request, the blocks are read by
+// the block number if the request is paged
+func (c *Core) dumpBlocks(dr *model.TCDumpRequest, tt int) ([][]byte, string, string, error) {
+	if dr.Limit <= 0 && dr.Cursor == "" {
+		blks, nextID, err := c.w.GetAllTokenBlocks(dr.Token, tt, dr.BlockID)
+		return blks, nextID, "", err
+	}
+	var bn uint64
+	if dr.Cursor != "" {
+		pc, err := decodeCursor(dr.Cursor)
+		if err != nil {
+			return nil, "", "", err
+		}
+		bn, err = strconv.ParseUint(pc.Key, 10, 64)
+		if err != nil || pc.Sort != "block" {
+			return nil, "", "", fmt.Errorf("invalid cursor")
+		}
+	}
+	limit := pageLimit(&model.PageRequest{Limit: dr.Limit, Cursor: dr.Cursor})
+	blks, more, err := c.w.GetTokenBlocksFrom(dr.Token, tt, bn, limit)
+	if err != nil || !more || len(blks) == 0 {
+		return blks, "", "", err
+	}
+	b := block.InitBlock(blks[len(blks)-1], nil)
+	if b == nil {
+		return nil, "", "", fmt.Errorf("invalid token chain block")
+	}
+	n, err := b.GetBlockNumber(dr.Token)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return blks, "", encodeCursor(&pageCursor{Sort: "block", Key: strconv.FormatUint(n+1, 10)}), nil
+}
+
+// GetTokenProof returns the inclusion proof of the token in the given block,
+// latest block of the token is used if the block id is empty
+func (c *Core) GetTokenProof(token string, blockID string) *model.TokenProofReply {
+	pr := &model.TokenProofReply{
+		BasicResponse: model.BasicResponse{
+			Status: false,
+		},
+	}
+	t, err := c.w.ReadToken(token)
+	if err != nil {
+		pr.Message = "Failed to get token, token does not exist"
+		return pr
+	}
+	ts := RBTString
+	if t.TokenValue < 1.0 {
+		ts = PartString
+	}
+	tt := c.TokenType(ts)
+	var b *block.Block
+	if blockID == "" {
+		b = c.w.GetLatestTokenBlock(token, tt)
+	} else {
+		bb, err := c.w.GetTokenBlock(token, tt, blockID)
+		if err == nil {
+			b = block.InitBlock(bb, nil)
+		}
+	}
+	if b == nil {
+		pr.Message = "Failed to get token chain block"
+		return pr
+	}
+	p, err := b.GetTokenInclusionProof(token)
+	if err != nil {
+		c.log.Error("Failed to get token inclusion proof", "token", token, "err", err)
+		pr.Message = "Failed to get token inclusion proof, " + err.Error()
+		return pr
+	}
+	pr.Status = true
+	pr.Message = "Got token inclusion proof"
+	pr.Proof = p
+	return pr
+}
+
 func (c *Core) DumpFTTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 	ds := &model.TCDumpReply{
 		BasicResponse: model.BasicResponse{
@@ -48,7 +127,7 @@ func (c *Core) DumpFTTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 
 	ts := FTString
 
-	blks, nextID, err := c.w.GetAllTokenBlocks(dr.Token, c.TokenType(ts), dr.BlockID)
+	blks, nextID, nextCursor, err := c.dumpBlocks(dr, c.TokenType(ts))
 	if err != nil {
 		ds.Message = "Failed to get token chain block"
 		return ds
@@ -57,6 +136,7 @@ func (c *Core) DumpFTTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 	ds.Message = "Successfully got the token chain block"
 	ds.Blocks = blks
 	ds.NextBlockID = nextID
+	ds.NextCursor = nextCursor
 	return ds
 }
 
@@ -145,7 +225,7 @@ func (c *Core) DumpSmartContractTokenChain(dr *model.TCDumpRequest) *model.TCDum
 		return ds
 	}
 	tokenTypeString := SmartContractString
-	blks, nextID, err := c.w.GetAllTokenBlocks(dr.Token, c.TokenType(tokenTypeString), dr.BlockID)
+	blks, nextID, nextCursor, err := c.dumpBlocks(dr, c.TokenType(tokenTypeString))
 	if err != nil {
 		ds.Message = "Failed to get smart contract token chain block"
 		return ds
@@ -154,6 +234,7 @@ func (c *Core) DumpSmartContractTokenChain(dr *model.TCDumpRequest) *model.TCDum
 	ds.Message = "Successfully got the smart contract token chain block"
 	ds.Blocks = blks
 	ds.NextBlockID = nextID
+	ds.NextCursor = nextCursor
 	return ds
 }
 
@@ -169,7 +250,7 @@ func (c *Core) DumpNFTTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 		return ds
 	}
 	tokenTypeString := NFTString
-	blks, nextID, err := c.w.GetAllTokenBlocks(dr.Token, c.TokenType(tokenTypeString), dr.BlockID)
+	blks, nextID, nextCursor, err := c.dumpBlocks(dr, c.TokenType(tokenTypeString))
 	if err != nil {
 		ds.Message = "Failed to get nft token chain block"
 		return ds
@@ -178,6 +259,7 @@ func (c *Core) DumpNFTTokenChain(dr *model.TCDumpRequest) *model.TCDumpReply {
 	ds.Message = "Successfully got nft token chain block"
 	ds.Blocks = blks
 	ds.NextBlockID = nextID
+	ds.NextCursor = nextCursor
 	return ds
 }
 func (c *Core) GetSmartContractTokenChainData(getReq *model.SmartContractTokenChainDataReq) *model.SmartContractDataReply {
@@ -262,6 +344,39 @@ func (c *Core) GetSmartContractTokenChainData(getReq *model.SmartContractTokenCh
 	return reply
 }
 
+// QuerySmartContractState returns the value of the key in the smart contract
+// state at the block, the latest block is used if latest is set
+func (c *Core) QuerySmartContractState(token string, key string, blockNumber uint64, latest bool) *model.SmartContractStateReply {
+	reply := &model.SmartContractStateReply{
+		BasicResponse: model.BasicResponse{
+			Status: false,
+		},
+		Token: token,
+		Key:   key,
+	}
+	_, err := c.w.GetSmartContractToken(token)
+	if err != nil {
+		reply.Message = "Failed to get smart contract token data, token does not exist"
+		return reply
+	}
+	bn, st, err := c.w.GetSmartContractState(token, c.TokenType(SmartContractString), key, blockNumber, latest)
+	if err != nil {
+		c.log.Error("Failed to query smart contract state", "token", token, "key", key, "err", err)
+		reply.Message = "Failed to query smart contract state, " + err.Error()
+		return reply
+	}
+	reply.Status = true
+	reply.BlockNumber = bn
+	if st == nil {
+		reply.Message = "State key is not set at the block"
+		return reply
+	}
+	reply.Found = true
+	reply.Value = st.StateValue
+	reply.Message = "Got smart contract state"
+	return reply
+}
+
 func (c *Core) GetNFTTokenChainData(getReq *model.SmartContractTokenChainDataReq) *model.NFTDataReply {
 	reply := &model.NFTDataReply{
 		BasicResponse: model.BasicResponse{Status: false},
@@ -403,13 +518,13 @@ func (c *Core) ReleaseAllLockedTokens() model.BasicResponse {
 	return *response
 }
 
-func (c *Core) GetFinalQuorumList(ql []string) ([]string, error) {
+func (c *Core) GetFinalQuorumList(ql []string, groupSize int) ([]string, error) {
 	// Initialize finalQl as an empty slice to store the groups that meet the condition
 	var finalQl []string
 	var opError error
 	// Loop through ql in groups of the Minimum Quorum Required
-	for i := 0; i < len(ql); i += QuorumRequired {
-		end := i + QuorumRequired
+	for i := 0; i < len(ql); i += groupSize {
+		end := i + groupSize
 		if end > len(ql) {
 			end = len(ql)
 		}
@@ -146,6 +146,31 @@ func (c *Core) GetDIDs(dir string) []wallet.DIDType {
 	return dt
 }
 
+var didPager = &pager{
+	fields: map[string]pageField{
+		"did": {column: "did", kind: pageString},
+	},
+	key:  "did",
+	sort: "did",
+}
+
+// GetDIDsPage returns the page of the DIDs in the directory with the cursor of the next page
+func (c *Core) GetDIDsPage(dir string, pr *model.PageRequest) ([]wallet.DIDType, string, error) {
+	q, err := didPager.query(pr)
+	if err != nil {
+		return nil, "", err
+	}
+	q.Where("did_dir=?", dir)
+	dt, err := c.w.GetDIDsPage(q)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get DIDs")
+	}
+	n, next := didPager.next(pr, len(dt), func(i int, column string) (interface{}, string) {
+		return dt[i].DID, dt[i].DID
+	})
+	return dt[:n], next, nil
+}
+
 func (c *Core) IsDIDExist(dir string, did string) bool {
 	_, err := c.w.GetDIDDir(dir, did)
 	return err == nil
@@ -185,7 +210,12 @@ func (c *Core) AddDID(dc *did.DIDCreate) *model.BasicResponse {
 }
 
 func (c *Core) RegisterDID(reqID string, did string) {
-	err := c.registerDID(reqID, did)
+	err := c.startJob(reqID, JobRegisterDID, did)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	err = c.registerDID(reqID, did)
 	br := model.BasicResponse{
 		Status:  true,
 		Message: "DID registered successfully",
@@ -194,6 +224,7 @@ func (c *Core) RegisterDID(reqID string, did string) {
 		br.Status = false
 		br.Message = err.Error()
 	}
+	c.finishJob(reqID, &br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
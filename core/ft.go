@@ -7,6 +7,7 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -21,7 +22,12 @@ import (
 )
 
 func (c *Core) CreateFTs(reqID string, did string, ftcount int, ftname string, wholeToken int) {
-	err := c.createFTs(reqID, ftname, ftcount, wholeToken, did)
+	err := c.startJob(reqID, JobCreateFT, did)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	err = c.createFTs(reqID, ftname, ftcount, wholeToken, did)
 	br := model.BasicResponse{
 		Status:  true,
 		Message: "FT created successfully",
@@ -30,6 +36,7 @@ func (c *Core) CreateFTs(reqID string, did string, ftcount int, ftname string, w
 		br.Status = false
 		br.Message = err.Error()
 	}
+	c.finishJob(reqID, &br)
 	channel := c.GetWebReq(reqID)
 	if channel == nil {
 		c.log.Error("Failed to get did channels")
@@ -302,8 +309,62 @@ func (c *Core) GetFTInfo(did string) ([]model.FTInfo, error) {
 	return info, nil
 }
 
+// GetFTInfoPage returns the page of the FT info sorted by the FT name and
+// the creator, FT info is aggregated so the page is taken from the list
+func (c *Core) GetFTInfoPage(did string, pr *model.PageRequest) ([]model.FTInfo, string, error) {
+	sortBy := "name"
+	if pr != nil && pr.Sort != "" {
+		sortBy = pr.Sort
+	}
+	desc := strings.HasPrefix(sortBy, "-")
+	if strings.TrimPrefix(sortBy, "-") != "name" {
+		return nil, "", fmt.Errorf("invalid sort field %s", strings.TrimPrefix(sortBy, "-"))
+	}
+	info, err := c.GetFTInfo(did)
+	if err != nil {
+		return nil, "", err
+	}
+	key := func(fi model.FTInfo) string {
+		return fi.FTName + "/" + fi.CreatorDID
+	}
+	sortFn := func(i, j int) bool {
+		if desc {
+			return key(info[i]) > key(info[j])
+		}
+		return key(info[i]) < key(info[j])
+	}
+	sort.Slice(info, sortFn)
+	if pr != nil && pr.Cursor != "" {
+		pc, err := decodeCursor(pr.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if pc.Sort != sortBy {
+			return nil, "", fmt.Errorf("cursor does not match the sort %s", sortBy)
+		}
+		i := 0
+		for i < len(info) && ((!desc && key(info[i]) <= pc.Key) || (desc && key(info[i]) >= pc.Key)) {
+			i++
+		}
+		info = info[i:]
+	}
+	limit := pageLimit(pr)
+	if limit == 0 || len(info) <= limit {
+		return info, "", nil
+	}
+	return info[:limit], encodeCursor(&pageCursor{Sort: sortBy, Key: key(info[limit-1])}), nil
+}
+
 func (c *Core) InitiateFTTransfer(reqID string, req *model.TransferFTReq) {
+	err := c.startJob(reqID, JobFTTransfer, req.Sender)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.initiateFTTransfer(reqID, req)
+	c.finishJob(reqID, br)
+	_, did, _ := util.ParseAddress(req.Sender)
+	c.emitTransferEvent(did, reqID, "ft", req.Receiver, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -415,6 +476,26 @@ func (c *Core) initiateFTTransfer(reqID string, req *model.TransferFTReq) *model
 	}
 	FTsForTxn := AllFTs[:req.FTCount]
 	//TODO: Pinning of tokens
+	err = c.w.LockFTTokens(FTsForTxn)
+	if err != nil {
+		c.log.Error("Failed to lock FTs", "err", err)
+		resp.Message = "Failed to lock FTs, " + err.Error()
+		return resp
+	}
+	crID := uuid.New().String()
+	c.journalFTsLocked(crID, did, FTsForTxn)
+	FTTokenIDs := make([]string, 0)
+	for i := range FTsForTxn {
+		FTTokenIDs = append(FTTokenIDs, FTsForTxn[i].TokenID)
+	}
+	// release the locked FTs before exit
+	defer func() {
+		if c.journalKeepsLock(crID) {
+			c.log.Error("FT transfer failed after the block was added, FTs are kept locked", "id", crID)
+			return
+		}
+		c.w.ReleaseFTTokens(FTTokenIDs)
+	}()
 
 	rpeerid = c.w.GetPeerID(req.Receiver)
 	if rpeerid == "" {
@@ -444,10 +525,6 @@ func (c *Core) initiateFTTransfer(reqID string, req *model.TransferFTReq) *model
 		defer receiverPeerID.Close()
 	}
 
-	FTTokenIDs := make([]string, 0)
-	for i := range FTsForTxn {
-		FTTokenIDs = append(FTTokenIDs, FTsForTxn[i].TokenID)
-	}
 	TokenInfo := make([]contract.TokenInfo, 0)
 	for i := range FTsForTxn {
 		tt := c.TokenType(FTString)
@@ -496,13 +573,14 @@ func (c *Core) initiateFTTransfer(reqID string, req *model.TransferFTReq) *model
 	}
 	cr := &ConensusRequest{
 		Mode:           FTTransferMode,
-		ReqID:          uuid.New().String(),
+		ReqID:          crID,
 		Type:           req.QuorumType,
 		SenderPeerID:   c.peerID,
 		ReceiverPeerID: rpeerid,
 		ContractBlock:  sc.GetBlock(),
 		FTinfo:         FTData,
 	}
+	c.linkTxnEvents(reqID, cr.ReqID)
 	td, _, pds, err := c.initiateConsensus(cr, sc, dc)
 	if err != nil {
 		c.log.Error("Consensus failed ", "err", err)
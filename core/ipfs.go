@@ -3,8 +3,10 @@ package core
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -220,6 +222,20 @@ func (c *Core) RunIPFS() error {
 	return nil
 }
 
+// AttachIPFS uses the given IPFS shell instead of running the IPFS daemon,
+// the test network uses it to run the nodes against the in-process IPFS
+func (c *Core) AttachIPFS(sh *ipfsnode.Shell) error {
+	c.ipfs = sh
+	idoutput, err := c.ipfs.ID()
+	if err != nil {
+		c.log.Error("unable to get peer id", "err", err)
+		return err
+	}
+	c.peerID = idoutput.ID
+	c.log.Info("Node PeerID : " + idoutput.ID)
+	return nil
+}
+
 // GetIPFSState will get the IPFS running state
 func (c *Core) GetIPFSState() bool {
 	c.ipfsLock.RLock()
@@ -315,6 +331,10 @@ func (c *Core) GetAllBootStrap() []string {
 }
 
 func (c *Core) GetDHTddrs(cid string) ([]string, error) {
+	// attached IPFS does not have the binary, use the API
+	if c.ipfsApp == "" {
+		return c.findProviders(cid)
+	}
 	cmd := exec.Command(c.ipfsApp, "dht", "findprovs", cid)
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
@@ -340,7 +360,41 @@ func (c *Core) GetDHTddrs(cid string) ([]string, error) {
 	return ids, nil
 }
 
+func (c *Core) findProviders(cid string) ([]string, error) {
+	resp, err := c.ipfs.Request("dht/findprovs", cid).Send(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	ids := make([]string, 0)
+	dec := json.NewDecoder(resp.Output)
+	for {
+		var dr DHTResponse
+		err = dec.Decode(&dr)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 4 is the provider response
+		if dr.Type != 4 {
+			continue
+		}
+		for _, r := range dr.Responses {
+			ids = append(ids, r.ID)
+		}
+	}
+	return ids, nil
+}
+
 func (c *Core) ipfsRepoGc() {
+	if c.ipfsApp == "" {
+		return
+	}
 	cmd := exec.Command(c.ipfsApp, "ipfs", "repo", "gc")
 	err := cmd.Start()
 	if err != nil {
@@ -253,3 +253,16 @@ func (p *Peer) Close() error {
 func (p *Peer) GetPeerDID() string {
 	return p.did
 }
+
+// PortUsage returns the number of the peer ports in use and the size of the pool
+func (pm *PeerManager) PortUsage() (int, int) {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	used := 0
+	for _, status := range pm.ps {
+		if status {
+			used++
+		}
+	}
+	return used, len(pm.ps)
+}
@@ -0,0 +1,270 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+const (
+	JobStorage string = "jobs"
+)
+
+// Job types of the asynchronous requests
+const (
+	JobGenerateTestTokens       = "generate_test_tokens"
+	JobGenerateFaucetTestTokens = "generate_faucet_test_tokens"
+	JobRegisterDID              = "register_did"
+	JobMigrateNode              = "migrate_node"
+	JobRBTTransfer              = "rbt_transfer"
+	JobPinRBT                   = "pin_rbt"
+	JobRecoverRBT               = "recover_rbt"
+	JobCreateFT                 = "create_ft"
+	JobFTTransfer               = "ft_transfer"
+	JobCreateNFT                = "create_nft"
+	JobDeployNFT                = "deploy_nft"
+	JobExecuteNFT               = "execute_nft"
+	JobGenerateSmartContract    = "generate_smart_contract"
+	JobDeploySmartContract      = "deploy_smart_contract"
+	JobExecuteSmartContract     = "execute_smart_contract"
+	JobUpgradeSmartContract     = "upgrade_smart_contract"
+	JobCreateDataToken          = "create_data_token"
+	JobCommitDataToken          = "commit_data_token"
+	JobExportToken              = "export_token"
+)
+
+const defaultJobListLimit = 100
+
+// jobDID returns the DID of the DID address, jobs are listed by the DID
+func jobDID(addr string) string {
+	if i := strings.LastIndex(addr, "."); i >= 0 {
+		return addr[i+1:]
+	}
+	return addr
+}
+
+// initJobs initialises the job storage, jobs left running by the previous
+// run of the node are marked as interrupted
+func (c *Core) initJobs() error {
+	err := c.s.Init(JobStorage, &model.Job{}, true)
+	if err != nil {
+		c.log.Error("Failed to initialise storage jobs", "err", err)
+		return err
+	}
+	var jobs []model.Job
+	err = c.s.Read(JobStorage, &jobs, "status=?", model.JobRunning)
+	if err != nil {
+		return nil
+	}
+	for i := range jobs {
+		jobs[i].Status = model.JobInterrupted
+		jobs[i].Error = "node stopped before the job finished"
+		jobs[i].UpdatedAt = time.Now()
+		err = c.s.Update(JobStorage, &jobs[i], "id=?", jobs[i].ID)
+		if err != nil {
+			c.log.Error("Failed to update the job", "id", jobs[i].ID, "err", err)
+		}
+	}
+	return nil
+}
+
+// startJob records the asynchronous request as running, the request id which
+// is already used by a job is rejected. Only the job added by the idempotency
+// key of the same request can be started.
+func (c *Core) startJob(reqID string, jobType string, did string) error {
+	now := time.Now()
+	job := model.Job{
+		ID:        reqID,
+		Type:      jobType,
+		DID:       jobDID(did),
+		Status:    model.JobRunning,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	c.jobLock.Lock()
+	defer c.jobLock.Unlock()
+	var ej model.Job
+	var err error
+	if c.s.Read(JobStorage, &ej, "id=?", reqID) == nil {
+		if ej.IdempotencyKey == "" || ej.Type != jobType || ej.Status != model.JobRunning || ej.Result != "" {
+			return fmt.Errorf("request id is already used by a job")
+		}
+		job.DID = ej.DID
+		job.CreatedAt = ej.CreatedAt
+		job.IdempotencyKey = ej.IdempotencyKey
+		job.RequestHash = ej.RequestHash
+		err = c.s.Update(JobStorage, &job, "id=?", reqID)
+	} else {
+		err = c.s.Write(JobStorage, &job)
+	}
+	if err != nil {
+		c.log.Error("Failed to add the job", "id", reqID, "type", jobType, "err", err)
+		return fmt.Errorf("failed to add the job")
+	}
+	return nil
+}
+
+// rejectJob fails the request which could not be started
+func (c *Core) rejectJob(reqID string, err error) {
+	c.log.Error("Failed to start the request", "id", reqID, "err", err)
+	dc := c.GetWebReq(reqID)
+	if dc == nil {
+		c.log.Error("Failed to get did channels")
+		return
+	}
+	dc.OutChan <- &model.BasicResponse{Status: false, Message: err.Error()}
+}
+
+// finishJob records the response of the asynchronous request and publishes
+// the final status of the request
+func (c *Core) finishJob(reqID string, br *model.BasicResponse) {
+	ev := model.TxnEvent{Type: model.TxnEventFinalStatus, Message: "no response"}
+	if br != nil {
+		ev.Status = br.Status
+		ev.Message = br.Message
+	}
+	c.AddTxnEvent(reqID, ev)
+	var job model.Job
+	err := c.s.Read(JobStorage, &job, "id=?", reqID)
+	if err != nil {
+		c.log.Error("Failed to get the job", "id", reqID, "err", err)
+		return
+	}
+	job.Status = model.JobSucceeded
+	job.Error = ""
+	if br == nil {
+		job.Status = model.JobFailed
+		job.Error = "no response"
+	} else if !br.Status {
+		job.Status = model.JobFailed
+		job.Error = br.Message
+	}
+	if br != nil {
+		rb, err := json.Marshal(br)
+		if err == nil {
+			job.Result = string(rb)
+		}
+	}
+	job.UpdatedAt = time.Now()
+	err = c.s.Update(JobStorage, &job, "id=?", reqID)
+	if err != nil {
+		c.log.Error("Failed to update the job", "id", reqID, "err", err)
+	}
+}
+
+// GetJob returns the job of the request, jobs of the other DIDs are not
+// found for the scoped caller
+func (c *Core) GetJob(cl *Caller, id string) (*model.Job, error) {
+	var job model.Job
+	err := c.s.Read(JobStorage, &job, "id=?", id)
+	if err != nil || !cl.HasDID(job.DID) {
+		return nil, fmt.Errorf("job not found")
+	}
+	return &job, nil
+}
+
+// CheckJobAccess checks the job of the request belongs to the caller
+func (c *Core) CheckJobAccess(cl *Caller, id string) error {
+	if !cl.Scoped() {
+		return nil
+	}
+	_, err := c.GetJob(cl, id)
+	return err
+}
+
+// CheckWatchAccess checks the caller can watch the events of the request.
+// Request not started yet can be watched to follow it from the start as its
+// id is chosen by the client, the stream should check the job access before
+// sending the events.
+func (c *Core) CheckWatchAccess(cl *Caller, id string) error {
+	if !cl.Scoped() {
+		return nil
+	}
+	var job model.Job
+	if c.s.Read(JobStorage, &job, "id=?", id) != nil {
+		return nil
+	}
+	if !cl.HasDID(job.DID) {
+		return fmt.Errorf("job not found")
+	}
+	return nil
+}
+
+// GetJobs returns the latest jobs of the caller matching the filters, empty
+// filters are ignored
+func (c *Core) GetJobs(cl *Caller, did string, jobType string, status string, limit int) ([]model.Job, error) {
+	conds, args, err := cl.didFilter(did, make([]string, 0), make([]interface{}, 0))
+	if err != nil {
+		return nil, err
+	}
+	if jobType != "" {
+		conds = append(conds, "type=?")
+		args = append(args, jobType)
+	}
+	if status != "" {
+		conds = append(conds, "status=?")
+		args = append(args, status)
+	}
+	if len(conds) == 0 {
+		conds = append(conds, "1=1")
+	}
+	if limit <= 0 {
+		limit = defaultJobListLimit
+	}
+	jobs := make([]model.Job, 0)
+	err = c.s.ReadPage(JobStorage, &jobs, "created_at desc", limit, strings.Join(conds, " AND "), args...)
+	if err != nil {
+		c.log.Error("Failed to read the jobs", "err", err)
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ClaimIdempotencyKey adds the job of the request with the idempotency key of
+// the DID. If the key is already used, the request should not be started and
+// the response of the earlier request is returned.
+func (c *Core) ClaimIdempotencyKey(reqID string, did string, key string, jobType string, reqHash string) (*model.BasicResponse, bool) {
+	did = jobDID(did)
+	c.jobLock.Lock()
+	defer c.jobLock.Unlock()
+	var job model.Job
+	err := c.s.Read(JobStorage, &job, "did=? AND idempotency_key=?", did, key)
+	if err != nil {
+		now := time.Now()
+		job = model.Job{
+			ID:             reqID,
+			Type:           jobType,
+			DID:            did,
+			Status:         model.JobRunning,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+			IdempotencyKey: key,
+			RequestHash:    reqHash,
+		}
+		err = c.s.Write(JobStorage, &job)
+		if err != nil {
+			c.log.Error("Failed to add the job", "id", reqID, "err", err)
+			return &model.BasicResponse{Status: false, Message: "failed to record the idempotency key"}, true
+		}
+		return nil, false
+	}
+	c.log.Info("Request with the used idempotency key", "did", did, "key", key, "job", job.ID)
+	if job.Type != jobType || job.RequestHash != reqHash {
+		return &model.BasicResponse{Status: false, Message: "idempotency key is already used for a different request", Result: job.ID}, true
+	}
+	switch job.Status {
+	case model.JobRunning:
+		return &model.BasicResponse{Status: false, Message: "request with the idempotency key is in progress", Result: job.ID}, true
+	case model.JobInterrupted:
+		return &model.BasicResponse{Status: false, Message: "request with the idempotency key was interrupted, check the job", Result: job.ID}, true
+	}
+	var br model.BasicResponse
+	err = json.Unmarshal([]byte(job.Result), &br)
+	if err != nil {
+		return &model.BasicResponse{Status: false, Message: "failed to get the result of the earlier request", Result: job.ID}, true
+	}
+	return &br, true
+}
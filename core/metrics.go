@@ -0,0 +1,142 @@
+package core
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/wrapper/metrics"
+)
+
+const (
+	metricSuccess string = "success"
+	metricFailure string = "failure"
+)
+
+// tokenMetricsExpiry is how long the token gauge is reported before the
+// tokens are read again, reading the token table on every scrape is too
+// costly on the large wallets
+const tokenMetricsExpiry = time.Minute
+
+// consensusBuckets are the buckets of the consensus duration in seconds
+var consensusBuckets = []float64{1, 2, 5, 10, 20, 30, 60, 120, 300, 600}
+
+// metricTokenStates are the token states reported in the token gauge
+var metricTokenStates = []struct {
+	name   string
+	status int
+}{
+	{"free", wallet.TokenIsFree},
+	{"locked", wallet.TokenIsLocked},
+	{"pledged", wallet.TokenIsPledged},
+}
+
+// coreMetrics are the metrics of the core, the gauges are read from the
+// wallet and the node state at the scrape time
+type coreMetrics struct {
+	reg               *metrics.Registry
+	consensusDuration *metrics.HistogramVec
+	quorumResults     *metrics.CounterVec
+	tokens            *metrics.GaugeVec
+	unpledgeQueue     *metrics.GaugeVec
+	ipfsUp            *metrics.GaugeVec
+	peerPortsInUse    *metrics.GaugeVec
+	peerPortsMax      *metrics.GaugeVec
+	tokensLock        sync.Mutex
+	tokensUpdated     time.Time
+}
+
+func (c *Core) initMetrics() {
+	reg := metrics.NewRegistry()
+	c.metrics = &coreMetrics{
+		reg:               reg,
+		consensusDuration: reg.NewHistogramVec("rubix_consensus_duration_seconds", "Duration of the consensus initiated by the node", consensusBuckets, "mode", "result"),
+		quorumResults:     reg.NewCounterVec("rubix_quorum_consensus_total", "Consensus results of the quorums", "quorum", "result"),
+		tokens:            reg.NewGaugeVec("rubix_did_tokens", "RBT of the DID in the token state", "did", "state"),
+		unpledgeQueue:     reg.NewGaugeVec("rubix_unpledge_queue_length", "Transactions waiting in the unpledge queue"),
+		ipfsUp:            reg.NewGaugeVec("rubix_ipfs_up", "Whether the IPFS daemon is running"),
+		peerPortsInUse:    reg.NewGaugeVec("rubix_peer_ports_in_use", "Peer connection ports in use"),
+		peerPortsMax:      reg.NewGaugeVec("rubix_peer_ports_max", "Size of the peer connection port pool"),
+	}
+	reg.AddCollector(c.collectMetrics)
+}
+
+// Metrics returns the metrics registry of the node
+func (c *Core) Metrics() *metrics.Registry {
+	return c.metrics.reg
+}
+
+func (c *Core) collectMetrics() {
+	m := c.metrics
+	if c.GetIPFSState() {
+		m.ipfsUp.Set(1)
+	} else {
+		m.ipfsUp.Set(0)
+	}
+	if c.pm != nil {
+		used, max := c.pm.PortUsage()
+		m.peerPortsInUse.Set(float64(used))
+		m.peerPortsMax.Set(float64(max))
+	}
+	if c.w == nil {
+		return
+	}
+	us, err := c.w.GetUnpledgeSequenceDetails()
+	if err == nil {
+		m.unpledgeQueue.Set(float64(len(us)))
+	}
+	c.collectTokenMetrics()
+}
+
+// collectTokenMetrics reads the tokens of all the states at once and
+// keeps the gauge till it expires
+func (c *Core) collectTokenMetrics() {
+	m := c.metrics
+	m.tokensLock.Lock()
+	defer m.tokensLock.Unlock()
+	if time.Since(m.tokensUpdated) < tokenMetricsExpiry {
+		return
+	}
+	status := make([]int, 0, len(metricTokenStates))
+	names := make(map[int]string)
+	for _, ts := range metricTokenStates {
+		status = append(status, ts.status)
+		names[ts.status] = ts.name
+	}
+	wt, err := c.w.GetTokensByStatus(status...)
+	if err != nil {
+		c.log.Error("Failed to get tokens for metrics", "err", err)
+		return
+	}
+	amount := make(map[string]map[string]float64)
+	for _, t := range wt {
+		name := names[t.TokenStatus]
+		if amount[name] == nil {
+			amount[name] = make(map[string]float64)
+		}
+		amount[name][t.DID] = amount[name][t.DID] + t.TokenValue
+	}
+	m.tokens.Reset()
+	for name, da := range amount {
+		for did, a := range da {
+			m.tokens.Set(floatPrecision(a, MaxDecimalPlaces), did, name)
+		}
+	}
+	m.tokensUpdated = time.Now()
+}
+
+func (m *coreMetrics) observeConsensus(mode int, success bool, d time.Duration) {
+	name, ok := consensusModeName[mode]
+	if !ok {
+		name = strconv.Itoa(mode)
+	}
+	m.consensusDuration.Observe(d.Seconds(), name, metricResult(success))
+}
+
+func metricResult(success bool) string {
+	if success {
+		return metricSuccess
+	}
+	return metricFailure
+}
@@ -98,7 +98,12 @@ func (c *Core) LockTokens(ts []string) *model.BasicResponse {
 }
 
 func (c *Core) MigrateNode(reqID string, m *MigrateRequest, didDir string) {
-	err := c.migrateNode(reqID, m, didDir)
+	err := c.startJob(reqID, JobMigrateNode, "")
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	err = c.migrateNode(reqID, m, didDir)
 	br := model.BasicResponse{
 		Status:  true,
 		Message: "DID migrated successfully",
@@ -107,6 +112,7 @@ func (c *Core) MigrateNode(reqID string, m *MigrateRequest, didDir string) {
 		br.Status = false
 		br.Message = err.Error()
 	}
+	c.finishJob(reqID, &br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// Roles of the API callers
+const (
+	RoleAdmin    string = "admin"
+	RoleOperator string = "operator"
+	RoleDIDOwner string = "did_owner"
+	RoleReadOnly string = "read_only"
+)
+
+// APIKey is the role based key of the node API, DIDs is the comma separated
+// list of the DIDs the key is limited to, empty for all the DIDs
+type APIKey struct {
+	ID        string    `gorm:"column:id;primaryKey" json:"id"`
+	Name      string    `gorm:"column:name" json:"name"`
+	KeyHash   string    `gorm:"column:key_hash" json:"-"`
+	Role      string    `gorm:"column:role" json:"role"`
+	DIDs      string    `gorm:"column:dids" json:"dids"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+type APIKeyRequest struct {
+	Name string   `json:"name"`
+	Role string   `json:"role"`
+	DIDs []string `json:"dids"`
+}
+
+type APIKeyReply struct {
+	BasicResponse
+	APIKey *APIKey `json:"api_key"`
+	Key    string  `json:"key"`
+}
+
+type APIKeyListReply struct {
+	BasicResponse
+	APIKeys []APIKey `json:"api_keys"`
+}
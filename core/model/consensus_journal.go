@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// Consensus journal resolve actions
+const (
+	JournalRollBack    = "rollback"
+	JournalRollForward = "rollforward"
+)
+
+// ConsensusJournalEntry is the journaled state of the transaction initiated by
+// the node, ReceiverAck is set once the receiver accepted the block
+type ConsensusJournalEntry struct {
+	ID            string    `json:"id"`
+	Mode          string    `json:"mode"`
+	State         string    `json:"state"`
+	SenderDID     string    `json:"sender_did"`
+	ReceiverDID   string    `json:"receiver_did"`
+	TransactionID string    `json:"transaction_id"`
+	Tokens        []string  `json:"tokens"`
+	ReceiverAck   bool      `json:"receiver_ack"`
+	Message       string    `json:"message"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ConsensusJournalRequest struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+}
+
+type ConsensusJournalReply struct {
+	BasicResponse
+	Entry *ConsensusJournalEntry `json:"entry,omitempty"`
+}
+
+type ConsensusJournalListReply struct {
+	BasicResponse
+	Entries []ConsensusJournalEntry `json:"entries"`
+}
@@ -1,14 +1,26 @@
 package model
 
+import "github.com/rubixchain/rubixgoplatform/block"
+
+// TCDumpRequest dumps the blocks after the BlockID, the blocks are paged
+// by the limit and the cursor if either of them is set
 type TCDumpRequest struct {
 	Token   string `json:"token"`
 	BlockID string `json:"block_id"`
+	Limit   int    `json:"limit"`
+	Cursor  string `json:"cursor"`
 }
 
 type TCDumpReply struct {
 	BasicResponse
 	NextBlockID string   `json:"next_block_id"`
 	Blocks      [][]byte `json:"blocks"`
+	NextCursor  string   `json:"next_cursor,omitempty"`
+}
+
+type TokenProofReply struct {
+	BasicResponse
+	Proof *block.TokenInclusionProof `json:"proof"`
 }
 
 type GetFTTokenChainReply struct {
@@ -1,26 +1,29 @@
 package model
 
 type CreateFTReq struct {
-	DID        string `json:"did"`
-	FTName     string `json:"ft_name"`
-	FTCount    int    `json:"ft_count"`
-	TokenCount int    `json:"token_count"`
+	DID            string `json:"did"`
+	FTName         string `json:"ft_name"`
+	FTCount        int    `json:"ft_count"`
+	TokenCount     int    `json:"token_count"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 type TransferFTReq struct {
-	Receiver   string `json:"receiver"`
-	Sender     string `json:"sender"`
-	FTName     string `json:"ft_name"`
-	FTCount    int    `json:"ft_count"`
-	Comment    string `json:"comment"`
-	QuorumType int    `json:"quorum_type"`
-	Password   string `json:"password"`
-	CreatorDID string `json:"creatorDID"`
+	Receiver       string `json:"receiver"`
+	Sender         string `json:"sender"`
+	FTName         string `json:"ft_name"`
+	FTCount        int    `json:"ft_count"`
+	Comment        string `json:"comment"`
+	QuorumType     int    `json:"quorum_type"`
+	Password       string `json:"password"`
+	CreatorDID     string `json:"creatorDID"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 type GetFTInfo struct {
 	BasicResponse
-	FTInfo []FTInfo `json:"ft_info"`
+	FTInfo     []FTInfo `json:"ft_info"`
+	NextCursor string   `json:"next_cursor,omitempty"`
 }
 
 type FTInfo struct {
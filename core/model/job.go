@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// Job status
+const (
+	JobRunning     = "running"
+	JobSucceeded   = "succeeded"
+	JobFailed      = "failed"
+	JobInterrupted = "interrupted"
+)
+
+// Job is the persisted record of the asynchronous request, the result is
+// kept as json so the outcome can be checked after the client is gone
+type Job struct {
+	ID        string    `gorm:"column:id;primaryKey" json:"id"`
+	Type      string    `gorm:"column:type" json:"type"`
+	DID       string    `gorm:"column:did" json:"did"`
+	Status    string    `gorm:"column:status" json:"status"`
+	Result    string    `gorm:"column:result" json:"result,omitempty"`
+	Error     string    `gorm:"column:error" json:"error,omitempty"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updated_at"`
+	// IdempotencyKey is unique for the DID, RequestHash is the hash of the
+	// request made with the key
+	IdempotencyKey string `gorm:"column:idempotency_key" json:"idempotency_key,omitempty"`
+	RequestHash    string `gorm:"column:request_hash" json:"-"`
+}
+
+type JobReply struct {
+	BasicResponse
+	Job *Job `json:"job"`
+}
+
+type JobListReply struct {
+	BasicResponse
+	Jobs []Job `json:"jobs"`
+}
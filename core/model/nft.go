@@ -35,22 +35,27 @@ type NFTEvent struct {
 	NFTValue     float64 `json:"nftValue"`
 }
 
+// DeployNFTRequest deploys the NFT, the royalty percentage of the sale value
+// is paid to the royalty recipient on every sale of the NFT
 type DeployNFTRequest struct {
-	NFT        string  `json:"nft"`
-	DID        string  `json:"did"`
-	QuorumType int     `json:"quorum_type"`
-	NFTValue   float64 `json:"nft_value"`
-	NFTData    string  `json:"nft_data"`
+	NFT              string  `json:"nft"`
+	DID              string  `json:"did"`
+	QuorumType       int     `json:"quorum_type"`
+	NFTValue         float64 `json:"nft_value"`
+	NFTData          string  `json:"nft_data"`
+	RoyaltyPercent   float64 `json:"royalty_percent,omitempty"`
+	RoyaltyRecipient string  `json:"royalty_recipient,omitempty"`
 }
 
 type ExecuteNFTRequest struct {
-	NFT        string  `json:"nft"`
-	Owner      string  `json:"owner"`
-	Receiver   string  `json:"receiver"`
-	QuorumType int     `json:"quorum_type"`
-	Comment    string  `json:"comment"`
-	NFTValue   float64 `json:"nft_value"`
-	NFTData    string  `json:"nft_data"`
+	NFT            string  `json:"nft"`
+	Owner          string  `json:"owner"`
+	Receiver       string  `json:"receiver"`
+	QuorumType     int     `json:"quorum_type"`
+	Comment        string  `json:"comment"`
+	NFTValue       float64 `json:"nft_value"`
+	NFTData        string  `json:"nft_data"`
+	IdempotencyKey string  `json:"idempotency_key,omitempty"`
 }
 
 type NewNFTSubscription struct {
@@ -66,12 +71,14 @@ type NewNFTEvent struct {
 }
 
 type NFTInfo struct {
-	NFTId string  `json:"nft"`
-	Owner string  `json:"owner_did"`
-	Value float64 `json:"nft_value"`
+	NFTId  string  `json:"nft"`
+	Owner  string  `json:"owner_did"`
+	Value  float64 `json:"nft_value"`
+	Status int     `json:"nft_status"`
 }
 
 type NFTList struct {
 	BasicResponse
-	NFTs []NFTInfo `json:"nfts"`
+	NFTs       []NFTInfo `json:"nfts"`
+	NextCursor string    `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// Page limits of the list APIs
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// PageRequest is the page of the list, Cursor is the next cursor of the
+// previous page and Sort is the field to sort by, "-" prefix sorts in the
+// descending order
+type PageRequest struct {
+	Limit  int    `json:"limit"`
+	Cursor string `json:"cursor"`
+	Sort   string `json:"sort"`
+}
+
+// Token types of the token filter, the part tokens are split from the
+// parent token
+const (
+	WholeTokenType string = "whole"
+	PartTokenType  string = "part"
+)
+
+// TokenFilter filters the tokens and the NFTs of the list, zero values are
+// not applied
+type TokenFilter struct {
+	Status    []int   `json:"status"`
+	MinValue  float64 `json:"min_value"`
+	MaxValue  float64 `json:"max_value"`
+	TokenType string  `json:"token_type"`
+}
+
+// TxnFilter filters the transactions of the list, zero values are not applied
+type TxnFilter struct {
+	Role      string    `json:"role"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	MinAmount float64   `json:"min_amount"`
+	MaxAmount float64   `json:"max_amount"`
+}
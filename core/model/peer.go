@@ -4,3 +4,10 @@ type PeerStatusResponse struct {
 	Version   string `json:"version"`
 	DIDExists bool   `json:"did_exists"`
 }
+
+// PeerDetails is the peer of the DID added manually to the node
+type PeerDetails struct {
+	DID     string
+	DIDType int
+	PeerID  string
+}
@@ -0,0 +1,53 @@
+package model
+
+// SmartContractEventFilter filters the smart contract events, the empty
+// fields match all the events and zero to block is the latest block
+type SmartContractEventFilter struct {
+	SmartContractToken string `json:"smartContractToken"`
+	Name               string `json:"name"`
+	Topic              string `json:"topic"`
+	FromBlock          uint64 `json:"fromBlock"`
+	ToBlock            uint64 `json:"toBlock"`
+}
+
+// SmartContractEventLog is the event emitted in the smart contract block
+type SmartContractEventLog struct {
+	ID                 string   `json:"id"`
+	SmartContractToken string   `json:"smartContractToken"`
+	BlockNumber        uint64   `json:"blockNumber"`
+	BlockID            string   `json:"blockID"`
+	TransactionID      string   `json:"transactionID"`
+	ExecutorDID        string   `json:"executorDID"`
+	Index              int      `json:"index"`
+	Name               string   `json:"name"`
+	Topics             []string `json:"topics"`
+	Data               string   `json:"data,omitempty"`
+}
+
+type SmartContractEventsReply struct {
+	BasicResponse
+	Events     []SmartContractEventLog `json:"events"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+// Match checks the event against the filter
+func (f *SmartContractEventFilter) Match(ev *SmartContractEventLog) bool {
+	if f.SmartContractToken != "" && f.SmartContractToken != ev.SmartContractToken {
+		return false
+	}
+	if f.Name != "" && f.Name != ev.Name {
+		return false
+	}
+	if ev.BlockNumber < f.FromBlock || (f.ToBlock != 0 && ev.BlockNumber > f.ToBlock) {
+		return false
+	}
+	if f.Topic == "" {
+		return true
+	}
+	for _, t := range ev.Topics {
+		if t == f.Topic {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,7 @@
 package model
 
+import "github.com/rubixchain/rubixgoplatform/block"
+
 type DeploySmartContractRequest struct {
 	SmartContractToken string  `json:"smartContractToken"`
 	DeployerAddress    string  `json:"deployerAddr"`
@@ -8,10 +10,58 @@ type DeploySmartContractRequest struct {
 	Comment            string  `json:"comment"`
 }
 
+// ExecuteSmartContractRequest executes the smart contract, the engine
+// contracts are executed with the input and emit the events, for the other
+// contracts the data and the events are set by the executor
 type ExecuteSmartContractRequest struct {
+	SmartContractToken  string          `json:"smartContractToken"`
+	ExecutorAddress     string          `json:"executorAddr"`
+	QuorumType          int             `json:"quorumType"`
+	Comment             string          `json:"comment"`
+	SmartContractData   string          `json:"smartContractData"`
+	SmartContractInput  string          `json:"smartContractInput,omitempty"`
+	SmartContractEvents []block.SCEvent `json:"smartContractEvents,omitempty"`
+	IdempotencyKey      string          `json:"idempotency_key,omitempty"`
+}
+
+// SmartContractStateReply is the value of the state key at the block, the
+// value is the JSON value of the key
+type SmartContractStateReply struct {
+	BasicResponse
+	Token       string `json:"token"`
+	Key         string `json:"key"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Found       bool   `json:"found"`
+	Value       string `json:"value,omitempty"`
+}
+
+// UpgradeSmartContractRequest upgrades the deployed smart contract to the
+// code of the code token generated for the new code, the migrate function of
+// the new code is run with the migration input
+type UpgradeSmartContractRequest struct {
 	SmartContractToken string `json:"smartContractToken"`
-	ExecutorAddress    string `json:"executorAddr"`
+	CodeToken          string `json:"codeToken"`
+	DeployerAddress    string `json:"deployerAddr"`
 	QuorumType         int    `json:"quorumType"`
 	Comment            string `json:"comment"`
-	SmartContractData  string `json:"smartContractData"`
+	MigrationInput     string `json:"migrationInput,omitempty"`
+}
+
+// SmartContractVersion is the code of the smart contract from the block, the
+// first version is the code of the deployed smart contract token
+type SmartContractVersion struct {
+	Version        int    `json:"version"`
+	BlockNumber    uint64 `json:"blockNumber"`
+	BlockID        string `json:"blockID"`
+	TransactionID  string `json:"transactionID,omitempty"`
+	CodeToken      string `json:"codeToken"`
+	BinaryCodeHash string `json:"binaryCodeHash"`
+	RawCodeHash    string `json:"rawCodeHash"`
+	SchemaCodeHash string `json:"schemaCodeHash"`
+}
+
+type SmartContractVersionsReply struct {
+	BasicResponse
+	Token    string                 `json:"token"`
+	Versions []SmartContractVersion `json:"versions"`
 }
@@ -12,12 +12,13 @@ type RBTGenerateRequest struct {
 }
 
 type RBTTransferRequest struct {
-	Receiver   string  `json:"receiver"`
-	Sender     string  `json:"sender"`
-	TokenCount float64 `json:"tokenCOunt"`
-	Comment    string  `json:"comment"`
-	Type       int     `json:"type"`
-	Password   string  `json:"password"`
+	Receiver       string  `json:"receiver"`
+	Sender         string  `json:"sender"`
+	TokenCount     float64 `json:"tokenCOunt"`
+	Comment        string  `json:"comment"`
+	Type           int     `json:"type"`
+	Password       string  `json:"password"`
+	IdempotencyKey string  `json:"idempotency_key,omitempty"`
 }
 
 type RBTPinRequest struct {
@@ -48,6 +49,7 @@ type RBTTransferReply struct {
 type GetAccountInfo struct {
 	BasicResponse
 	AccountInfo []DIDAccountInfo `json:"account_info"`
+	NextCursor  string           `json:"next_cursor,omitempty"`
 }
 
 type DIDAccountInfo struct {
@@ -60,13 +62,15 @@ type DIDAccountInfo struct {
 }
 
 type TokenDetail struct {
-	Token  string `json:"token"`
-	Status int    `json:"status"`
+	Token  string  `json:"token"`
+	Status int     `json:"status"`
+	Value  float64 `json:"value,omitempty"`
 }
 
 type TokenResponse struct {
 	BasicResponse
 	TokenDetails []TokenDetail `json:"token_detials"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
 }
 
 type PledgedTokenStateDetails struct {
@@ -84,3 +88,22 @@ type FaucetRBTGenerateRequest struct {
 	TokenCount int    `json:"token_count"`
 	DID        string `json:"did"`
 }
+
+type TokenChainCheckpointRequest struct {
+	DID        string `json:"did"`
+	Token      string `json:"token"`
+	KeepBlocks int    `json:"keep_blocks"`
+	Prune      bool   `json:"prune"`
+	Archive    bool   `json:"archive"`
+}
+
+type ExportTokenRequest struct {
+	DID        string `json:"did"`
+	Token      string `json:"token"`
+	BundlePath string `json:"bundle_path"`
+}
+
+type ImportTokenRequest struct {
+	DID    string `json:"did"`
+	Bundle []byte `json:"bundle"`
+}
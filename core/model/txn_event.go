@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// Transaction lifecycle events streamed to the clients
+const (
+	TxnEventSignatureRequested = "signature_requested"
+	TxnEventQuorumsSelected    = "quorums_selected"
+	TxnEventQuorumResult       = "quorum_result"
+	TxnEventPledgeFinality     = "pledge_finality"
+	TxnEventReceiverAck        = "receiver_acknowledged"
+	TxnEventFinalStatus        = "final_status"
+)
+
+// TxnEvent is the lifecycle event of the request, Seq is incremented for
+// every event of the request so the client can resume the stream from it
+type TxnEvent struct {
+	Seq           int             `json:"seq"`
+	ReqID         string          `json:"req_id"`
+	Type          string          `json:"type"`
+	Time          time.Time       `json:"time"`
+	Status        bool            `json:"status"`
+	Message       string          `json:"message,omitempty"`
+	TransactionID string          `json:"transaction_id,omitempty"`
+	Quorum        string          `json:"quorum,omitempty"`
+	Quorums       []string        `json:"quorums,omitempty"`
+	SignRequest   *TxnSignRequest `json:"sign_request,omitempty"`
+}
+
+// TxnSignRequest is the signature requested from the client, the response
+// is sent to the signature response API with the ID
+type TxnSignRequest struct {
+	ID          string `json:"id"`
+	Mode        int    `json:"mode"`
+	Hash        []byte `json:"hash"`
+	OnlyPrivKey bool   `json:"only_priv_key"`
+}
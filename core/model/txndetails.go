@@ -29,6 +29,7 @@ type TransactionCount struct {
 type TxnDetails struct {
 	BasicResponse
 	TxnDetails []TransactionDetails
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type TxnCountForDID struct {
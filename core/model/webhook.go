@@ -0,0 +1,91 @@
+package model
+
+import "time"
+
+// Webhook events
+const (
+	WebhookTokensReceived    = "tokens_received"
+	WebhookTransferCompleted = "transfer_completed"
+	WebhookTransferFailed    = "transfer_failed"
+	WebhookNFTReceived       = "nft_received"
+	WebhookFTReceived        = "ft_received"
+	WebhookPledged           = "pledged"
+	WebhookUnpledged         = "unpledged"
+	WebhookCreditsStored     = "credits_stored"
+)
+
+// WebhookEvents is the list of the supported webhook events
+var WebhookEvents = []string{
+	WebhookTokensReceived,
+	WebhookTransferCompleted,
+	WebhookTransferFailed,
+	WebhookNFTReceived,
+	WebhookFTReceived,
+	WebhookPledged,
+	WebhookUnpledged,
+	WebhookCreditsStored,
+}
+
+// Webhook delivery status, dead deliveries are not retried till they are replayed
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+// Webhook is the URL registered by the DID for the events, empty Events
+// subscribes to all the events
+type Webhook struct {
+	ID        string    `gorm:"column:id;primaryKey" json:"id"`
+	DID       string    `gorm:"column:did" json:"did"`
+	URL       string    `gorm:"column:url" json:"url"`
+	Secret    string    `gorm:"column:secret" json:"secret,omitempty"`
+	Events    string    `gorm:"column:events" json:"events"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID           string    `gorm:"column:id;primaryKey" json:"id"`
+	WebhookID    string    `gorm:"column:webhook_id" json:"webhook_id"`
+	DID          string    `gorm:"column:did" json:"did"`
+	Event        string    `gorm:"column:event" json:"event"`
+	Payload      string    `gorm:"column:payload" json:"payload"`
+	Status       string    `gorm:"column:status" json:"status"`
+	Attempts     int       `gorm:"column:attempts" json:"attempts"`
+	NextAttempt  time.Time `gorm:"column:next_attempt" json:"next_attempt"`
+	ResponseCode int       `gorm:"column:response_code" json:"response_code"`
+	LastError    string    `gorm:"column:last_error" json:"last_error,omitempty"`
+	CreatedAt    time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at" json:"updated_at"`
+}
+
+// WebhookEvent is the body posted to the webhook URL
+type WebhookEvent struct {
+	ID    string      `json:"id"`
+	Event string      `json:"event"`
+	DID   string      `json:"did"`
+	Time  time.Time   `json:"time"`
+	Data  interface{} `json:"data"`
+}
+
+type WebhookRequest struct {
+	DID    string   `json:"did"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+type WebhookReply struct {
+	BasicResponse
+	Webhook *Webhook `json:"webhook"`
+}
+
+type WebhookListReply struct {
+	BasicResponse
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+type WebhookDeliveryListReply struct {
+	BasicResponse
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
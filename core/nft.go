@@ -36,7 +36,13 @@ type FetchNFTRequest struct {
 
 func (c *Core) CreateNFTRequest(requestID string, createNFTRequest NFTReq) {
 	defer os.RemoveAll(createNFTRequest.NFTPath)
+	err := c.startJob(requestID, JobCreateNFT, createNFTRequest.DID)
+	if err != nil {
+		c.rejectJob(requestID, err)
+		return
+	}
 	createNFTResponse := c.createNFT(requestID, createNFTRequest)
+	c.finishJob(requestID, createNFTResponse)
 	didChannel := c.GetWebReq(requestID)
 	if didChannel == nil {
 		c.log.Error("failed to get web request", "requestID", requestID)
@@ -104,7 +110,13 @@ func (c *Core) createNFT(requestID string, createNFTRequest NFTReq) *model.Basic
 }
 
 func (c *Core) DeployNFT(reqID string, deployReq model.DeployNFTRequest) {
+	err := c.startJob(reqID, JobDeployNFT, deployReq.DID)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.deployNFT(reqID, deployReq)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -156,6 +168,23 @@ func (c *Core) deployNFT(reqID string, deployReq model.DeployNFTRequest) *model.
 
 	c.log.Info("The nft info fetched from the db is : ", nft)
 
+	if deployReq.RoyaltyPercent < 0 || deployReq.RoyaltyPercent > 100 {
+		resp.Message = "Invalid royalty percent"
+		return resp
+	}
+	var royaltyDID string
+	if deployReq.RoyaltyPercent > 0 {
+		// the deployer is the royalty recipient by default
+		royaltyDID = did
+		if deployReq.RoyaltyRecipient != "" {
+			_, royaltyDID, ok = util.ParseAddress(deployReq.RoyaltyRecipient)
+			if !ok {
+				resp.Message = "Invalid royalty recipient"
+				return resp
+			}
+		}
+	}
+
 	nftInfoArray := make([]contract.TokenInfo, 0)
 	nftInfo := contract.TokenInfo{
 		Token:      deployReq.NFT,
@@ -170,11 +199,13 @@ func (c *Core) deployNFT(reqID string, deployReq model.DeployNFTRequest) *model.
 		PledgeMode: contract.PeriodicPledgeMode,
 		TotalRBTs:  float64(deployReq.NFTValue),
 		TransInfo: &contract.TransInfo{
-			DeployerDID: did,
-			NFT:         deployReq.NFT,
-			NFTData:     deployReq.NFTData,
-			TransTokens: nftInfoArray,
-			NFTValue:    deployReq.NFTValue,
+			DeployerDID:   did,
+			NFT:           deployReq.NFT,
+			NFTData:       deployReq.NFTData,
+			TransTokens:   nftInfoArray,
+			NFTValue:      deployReq.NFTValue,
+			NFTRoyalty:    deployReq.RoyaltyPercent,
+			NFTRoyaltyDID: royaltyDID,
 		},
 		ReqID: reqID,
 	}
@@ -207,6 +238,7 @@ func (c *Core) deployNFT(reqID string, deployReq model.DeployNFTRequest) *model.
 		TransactionEpoch: txEpoch,
 	}
 
+	c.linkTxnEvents(reqID, conensusRequest.ReqID)
 	txnDetails, _, pds, err := c.initiateConsensus(conensusRequest, consensusContract, didCryptoLib)
 
 	if err != nil {
@@ -270,7 +302,14 @@ func (c *Core) publishNewNftEvent(newEvent *model.NFTEvent) error {
 }
 
 func (c *Core) ExecuteNFT(reqID string, executeReq *model.ExecuteNFTRequest) {
+	err := c.startJob(reqID, JobExecuteNFT, executeReq.Owner)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.executeNFT(reqID, executeReq)
+	c.finishJob(reqID, br)
+	c.emitTransferEvent(executeReq.Owner, reqID, "nft", executeReq.Receiver, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -349,6 +388,35 @@ func (c *Core) executeNFT(reqID string, executeReq *model.ExecuteNFTRequest) *mo
 		receiver = executeReq.Receiver
 	}
 
+	// the executor pays the royalty on the sale of the NFT
+	royaltyDue, rd, err := nftRoyaltyDue(gensysBlock, latestBlock, executeReq.NFT, did, receiver, currentNFTValue)
+	if err != nil {
+		c.log.Error("Failed to get NFT royalty", "err", err)
+		resp.Message = err.Error()
+		return resp
+	}
+	crID := uuid.New().String()
+	var royaltyTokens []contract.TokenInfo
+	var royaltyDID, royaltyPeerID string
+	if royaltyDue > 0 {
+		royaltyDID = rd
+		royaltyPeerID, err = c.nftRoyaltyPeerID(royaltyDID)
+		if err != nil {
+			c.log.Error("Failed to get royalty recipient", "did", royaltyDID, "err", err)
+			resp.Message = err.Error()
+			return resp
+		}
+		var wt []wallet.Token
+		wt, royaltyTokens, err = c.gatherNFTRoyalty(didCryptoLib, did, royaltyDue)
+		if err != nil {
+			c.log.Error("Failed to get tokens for NFT royalty", "err", err)
+			resp.Message = "Failed to pay NFT royalty, " + err.Error()
+			return resp
+		}
+		defer c.releaseConsensusTokens(crID, wt)
+		c.journalTokensLocked(crID, NFTExecuteMode, did, wt)
+	}
+
 	nftInfoArray := make([]contract.TokenInfo, 0)
 	nftInfo := contract.TokenInfo{
 		Token:      executeReq.NFT,
@@ -364,13 +432,15 @@ func (c *Core) executeNFT(reqID string, executeReq *model.ExecuteNFTRequest) *mo
 		PledgeMode: contract.PeriodicPledgeMode,
 		TotalRBTs:  float64(currentNFTValue),
 		TransInfo: &contract.TransInfo{
-			ExecutorDID: did,
-			ReceiverDID: receiver,
-			Comment:     executeReq.Comment,
-			NFT:         executeReq.NFT,
-			TransTokens: nftInfoArray,
-			NFTValue:    executeReq.NFTValue,
-			NFTData:     executeReq.NFTData,
+			ExecutorDID:   did,
+			ReceiverDID:   receiver,
+			Comment:       executeReq.Comment,
+			NFT:           executeReq.NFT,
+			TransTokens:   nftInfoArray,
+			NFTValue:      executeReq.NFTValue,
+			NFTData:       executeReq.NFTData,
+			NFTRoyaltyDID: royaltyDID,
+			RoyaltyTokens: royaltyTokens,
 		},
 		ReqID: reqID,
 	}
@@ -395,15 +465,17 @@ func (c *Core) executeNFT(reqID string, executeReq *model.ExecuteNFTRequest) *mo
 		return resp
 	}
 	conensusRequest := &ConensusRequest{
-		ReqID:            uuid.New().String(),
+		ReqID:            crID,
 		Type:             executeReq.QuorumType,
 		ExecuterPeerID:   c.peerID,
 		ContractBlock:    consensusContract.GetBlock(),
 		NFT:              executeReq.NFT,
+		RoyaltyPeerID:    royaltyPeerID,
 		Mode:             NFTExecuteMode,
 		TransactionEpoch: txEpoch,
 	}
 
+	c.linkTxnEvents(reqID, conensusRequest.ReqID)
 	txnDetails, _, pds, err := c.initiateConsensus(conensusRequest, consensusContract, didCryptoLib)
 	if err != nil {
 		c.log.Error("Consensus failed", "err", err)
@@ -548,6 +620,15 @@ func (c *Core) NFTCallBack(peerID string, topic string, data []byte) {
 		return
 	}
 
+	if receiverDid != "" && c.w.IsDIDExist(receiverDid) {
+		c.emitWebhookEvent(receiverDid, model.WebhookNFTReceived, map[string]interface{}{
+			"nft":      nft,
+			"sender":   executorDid,
+			"block_id": newEvent.NFTBlockHash,
+			"value":    newEvent.NFTValue,
+		})
+	}
+
 	c.log.Info("Token chain of " + nft + " syncing successful")
 }
 
@@ -587,52 +668,60 @@ func (c *Core) FetchNFT(requestID string, fetchNFTRequest *FetchNFTRequest) *mod
 	return basicResponse
 }
 
-func (c *Core) GetAllNFT() model.NFTList {
-	response := model.NFTList{
-		BasicResponse: model.BasicResponse{
-			Status: false,
-		},
-	}
-	nftList, err := c.w.GetAllNFT()
-	if err != nil {
-		errorMsg := fmt.Sprintf("Failed to get NFT list", "err", err)
-		c.log.Error(errorMsg)
-		response.Message = errorMsg
-		return response
-	}
-	nftDetails := make([]model.NFTInfo, 0)
-	for _, nft := range nftList {
-		nftDetails = append(nftDetails, model.NFTInfo{NFTId: nft.TokenID, Owner: nft.DID, Value: nft.TokenValue})
-	}
-	response.NFTs = nftDetails
-	response.Status = true
-	response.Message = "Got All NFTs"
+var nftPager = &pager{
+	fields: map[string]pageField{
+		"nft":   {column: "token_id", kind: pageString},
+		"value": {column: "token_value", kind: pageNumber},
+	},
+	key:  "token_id",
+	sort: "nft",
+}
 
-	return response
+func (c *Core) GetAllNFT(f *model.TokenFilter, pr *model.PageRequest) model.NFTList {
+	return c.listNFTs("", f, pr)
+}
 
+func (c *Core) GetNFTsByDid(did string, f *model.TokenFilter, pr *model.PageRequest) model.NFTList {
+	return c.listNFTs(did, f, pr)
 }
 
-func (c *Core) GetNFTsByDid(did string) model.NFTList {
+// listNFTs returns the page of the NFTs of the DID, all the NFTs if the DID is empty
+func (c *Core) listNFTs(did string, f *model.TokenFilter, pr *model.PageRequest) model.NFTList {
 	response := model.NFTList{
 		BasicResponse: model.BasicResponse{
 			Status: false,
 		},
 	}
-	nftList, err := c.w.GetNFTsByDid(did)
+	q, err := nftPager.query(pr)
+	if err == nil {
+		if did != "" {
+			q.Where("did=?", did)
+		}
+		err = filterTokens(q, f, "token_value", "")
+	}
 	if err != nil {
-		errorMsg := fmt.Sprintf("Failed to get NFT list of the did: ", did, "err", err)
-		c.log.Error(errorMsg)
-		response.Message = errorMsg
+		response.Message = err.Error()
 		return response
 	}
+	nftList, err := c.w.GetNFTsPage(q)
+	if err != nil {
+		c.log.Error("Failed to get NFT list", "did", did, "err", err)
+		response.Message = "Failed to get NFT list"
+		return response
+	}
+	n, next := nftPager.next(pr, len(nftList), func(i int, column string) (interface{}, string) {
+		if column == "token_value" {
+			return nftList[i].TokenValue, nftList[i].TokenID
+		}
+		return nftList[i].TokenID, nftList[i].TokenID
+	})
 	nftDetails := make([]model.NFTInfo, 0)
-	for _, nft := range nftList {
-		nftDetails = append(nftDetails, model.NFTInfo{NFTId: nft.TokenID, Owner: nft.DID, Value: nft.TokenValue})
+	for _, nft := range nftList[:n] {
+		nftDetails = append(nftDetails, model.NFTInfo{NFTId: nft.TokenID, Owner: nft.DID, Value: nft.TokenValue, Status: nft.TokenStatus})
 	}
 	response.NFTs = nftDetails
+	response.NextCursor = next
 	response.Status = true
 	response.Message = "Got All NFTs"
-
 	return response
-
 }
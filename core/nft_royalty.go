@@ -0,0 +1,299 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	ipfsnode "github.com/ipfs/go-ipfs-api"
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/ipfsport"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/did"
+)
+
+// nftRoyaltyDue returns the royalty owed to the royalty recipient for the
+// execution, the royalty is owed only when the NFT is sold to the other DID
+// and the executor is not the royalty recipient. The value sent by the seller
+// can not be verified, the royalty is owed at least on the value recorded in
+// the deploy block and the latest block of the NFT.
+func nftRoyaltyDue(gb *block.Block, lb *block.Block, nft string, executor string, receiver string, value float64) (float64, string, error) {
+	royalty, royaltyDID, err := gb.GetNFTRoyalty(nft)
+	if err != nil {
+		return 0, "", err
+	}
+	if royalty == 0 || receiver == "" || receiver == executor || royaltyDID == executor {
+		return 0, royaltyDID, nil
+	}
+	value = math.Max(value, gb.GetTokenValue())
+	if lb != nil {
+		value = math.Max(value, lb.GetTokenValue())
+	}
+	due := floatPrecision(value*royalty/100, MaxDecimalPlaces)
+	if due < MinDecimalValue(MaxDecimalPlaces) {
+		return 0, royaltyDID, nil
+	}
+	return due, royaltyDID, nil
+}
+
+// gatherNFTRoyalty locks the RBT tokens of the executor paying the royalty,
+// the locked tokens need to be released once the execution is done
+func (c *Core) gatherNFTRoyalty(dc did.DIDCrypto, executor string, due float64) ([]wallet.Token, []contract.TokenInfo, error) {
+	wt, err := gatherTokensForTransaction(c, &model.RBTTransferRequest{Sender: executor, TokenCount: due}, dc, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	tis := make([]contract.TokenInfo, 0, len(wt))
+	for i := range wt {
+		tts := RBTString
+		if wt[i].TokenValue != 1 {
+			tts = PartString
+		}
+		tt := c.TokenType(tts)
+		b := c.w.GetLatestTokenBlock(wt[i].TokenID, tt)
+		if b == nil {
+			c.w.ReleaseTokens(wt)
+			return nil, nil, fmt.Errorf("failed to get latest block of royalty token %s", wt[i].TokenID)
+		}
+		bid, err := b.GetBlockID(wt[i].TokenID)
+		if err != nil {
+			c.w.ReleaseTokens(wt)
+			return nil, nil, err
+		}
+		tis = append(tis, contract.TokenInfo{
+			Token:      wt[i].TokenID,
+			TokenType:  tt,
+			TokenValue: floatPrecision(wt[i].TokenValue, MaxDecimalPlaces),
+			OwnerDID:   wt[i].DID,
+			BlockID:    bid,
+		})
+	}
+	return wt, tis, nil
+}
+
+// nftRoyaltyPeerID returns the peer ID of the royalty recipient
+func (c *Core) nftRoyaltyPeerID(royaltyDID string) (string, error) {
+	pid := c.w.GetPeerID(royaltyDID)
+	if pid != "" {
+		return pid, nil
+	}
+	// the recipient might be part of the current node
+	_, err := c.w.GetDID(royaltyDID)
+	if err != nil {
+		return "", fmt.Errorf("royalty recipient peer ID not found")
+	}
+	return c.peerID, nil
+}
+
+// validateNFTRoyalty is used by the quorums to check that the execution pays
+// the royalty declared in the genesis block of the NFT with the tokens of the
+// executor, the token chains of the royalty tokens are synced from the executor
+func (c *Core) validateNFTRoyalty(cr *ConensusRequest, sc *contract.Contract, p *ipfsport.Peer) ([]contract.TokenInfo, error) {
+	gb := c.w.GetGenesisTokenBlock(cr.NFT, c.TokenType(NFTString))
+	if gb == nil {
+		return nil, fmt.Errorf("failed to get genesis block of the NFT")
+	}
+	lb := c.w.GetLatestTokenBlock(cr.NFT, c.TokenType(NFTString))
+	executor := sc.GetExecutorDID()
+	due, royaltyDID, err := nftRoyaltyDue(gb, lb, cr.NFT, executor, sc.GetReceiverDID(), sc.GetTotalRBTs())
+	if err != nil {
+		return nil, err
+	}
+	rti := sc.GetRoyaltyTokensInfo()
+	if due == 0 {
+		if len(rti) != 0 {
+			return nil, fmt.Errorf("royalty is not owed for the execution")
+		}
+		return nil, nil
+	}
+	if len(rti) == 0 {
+		return nil, fmt.Errorf("royalty of %v RBT is not paid", due)
+	}
+	if _, rd := sc.GetNFTRoyalty(); rd != royaltyDID {
+		return nil, fmt.Errorf("royalty recipient mismatch, expected %s", royaltyDID)
+	}
+	tis := make([]TokenSyncInfo, 0, len(rti))
+	for _, ti := range rti {
+		if ti.TokenType != c.TokenType(RBTString) && ti.TokenType != c.TokenType(PartString) {
+			return nil, fmt.Errorf("invalid royalty token %s", ti.Token)
+		}
+		tis = append(tis, TokenSyncInfo{Token: ti.Token, TokenType: ti.TokenType, BlockID: ti.BlockID})
+	}
+	err = c.syncTokenChains(p, tis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync royalty token chain, %v", err)
+	}
+	var paid float64
+	for _, ti := range rti {
+		b := c.w.GetLatestTokenBlock(ti.Token, ti.TokenType)
+		if b == nil {
+			return nil, fmt.Errorf("invalid token chain of royalty token %s", ti.Token)
+		}
+		bid, err := b.GetBlockID(ti.Token)
+		if err != nil {
+			return nil, err
+		}
+		if b.GetOwner() != executor || bid != ti.BlockID || c.checkIsPledged(b) {
+			return nil, fmt.Errorf("royalty token %s is not owned by the executor", ti.Token)
+		}
+		// the value of the part token is recorded in its genesis block
+		value := float64(1)
+		if ti.TokenType == c.TokenType(PartString) {
+			fb := c.w.GetGenesisTokenBlock(ti.Token, ti.TokenType)
+			if fb == nil {
+				return nil, fmt.Errorf("invalid token chain of royalty token %s", ti.Token)
+			}
+			value = fb.GetTokenValue()
+		}
+		if value != ti.TokenValue {
+			return nil, fmt.Errorf("royalty token %s value mismatch", ti.Token)
+		}
+		paid = floatPrecision(paid+value, MaxDecimalPlaces)
+	}
+	if paid < due {
+		return nil, fmt.Errorf("royalty underpaid, expected %v RBT, paid %v RBT", due, paid)
+	}
+	return rti, nil
+}
+
+// nftRoyaltyBlock creates the block transferring the royalty tokens to the
+// royalty recipient, it carries the same contract and quorum credit as the
+// NFT block
+func (c *Core) nftRoyaltyBlock(sc *contract.Contract, tcb *block.TokenChainBlock) (*block.Block, error) {
+	_, royaltyDID := sc.GetNFTRoyalty()
+	rti := sc.GetRoyaltyTokensInfo()
+	tks := make([]block.TransTokens, 0, len(rti))
+	ctcb := make(map[string]*block.Block)
+	for _, ti := range rti {
+		tks = append(tks, block.TransTokens{Token: ti.Token, TokenType: ti.TokenType})
+		ctcb[ti.Token] = c.w.GetLatestTokenBlock(ti.Token, ti.TokenType)
+	}
+	rtcb := block.TokenChainBlock{
+		TransactionType: block.TokenTransferredType,
+		TokenOwner:      royaltyDID,
+		TransInfo: &block.TransInfo{
+			SenderDID:   sc.GetExecutorDID(),
+			ReceiverDID: royaltyDID,
+			Comment:     tcb.TransInfo.Comment,
+			TID:         tcb.TransInfo.TID,
+			Tokens:      tks,
+		},
+		QuorumSignature:    tcb.QuorumSignature,
+		SmartContract:      sc.GetBlock(),
+		PledgeDetails:      tcb.PledgeDetails,
+		InitiatorSignature: tcb.InitiatorSignature,
+		Epoch:              tcb.Epoch,
+		ConsensusPolicy:    tcb.ConsensusPolicy,
+	}
+	rb := block.CreateNewBlock(ctcb, &rtcb)
+	if rb == nil {
+		return nil, fmt.Errorf("failed to create royalty token chain block")
+	}
+	return rb, nil
+}
+
+// sendNFTRoyalty sends the royalty block to the royalty recipient before the
+// pledge finality, the recipient returns the token state hashes of the royalty
+// tokens which are finalised with the NFT block
+func (c *Core) sendNFTRoyalty(cr *ConensusRequest, sc *contract.Contract, rb *block.Block) ([]string, error) {
+	_, royaltyDID := sc.GetNFTRoyalty()
+	rp, err := c.getPeer(cr.RoyaltyPeerID+"."+royaltyDID, "")
+	if err != nil {
+		c.log.Error("Royalty recipient not connected", "err", err)
+		return nil, err
+	}
+	defer rp.Close()
+	sr := SendTokenRequest{
+		Address:          cr.ExecuterPeerID + "." + sc.GetExecutorDID(),
+		TokenInfo:        sc.GetRoyaltyTokensInfo(),
+		TokenChainBlock:  rb.GetBlock(),
+		QuorumList:       cr.QuorumList,
+		TransactionEpoch: cr.TransactionEpoch,
+	}
+	var br model.BasicResponse
+	err = rp.SendJSONRequest("POST", APISendReceiverToken, nil, &sr, &br, true)
+	if err != nil {
+		c.log.Error("Unable to send royalty tokens to the recipient", "err", err)
+		return nil, err
+	}
+	if !br.Status {
+		c.log.Error("Unable to send royalty tokens to the recipient", "msg", br.Message)
+		return nil, fmt.Errorf("unable to send royalty tokens to the recipient, %s", br.Message)
+	}
+	hr, ok := br.Result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid royalty token state hashes from the recipient")
+	}
+	hashes := make([]string, 0, len(hr))
+	for _, h := range hr {
+		sh, ok := h.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid royalty token state hashes from the recipient")
+		}
+		hashes = append(hashes, sh)
+	}
+	return hashes, nil
+}
+
+// nftRoyaltyPaid tells the previous quorums of the royalty tokens about the
+// exhausted token states, so they unpledge, and unpins the royalty tokens
+func (c *Core) nftRoyaltyPaid(sc *contract.Contract) error {
+	executor := sc.GetExecutorDID()
+	rti := sc.GetRoyaltyTokensInfo()
+	for _, ti := range rti {
+		// the block paid with, the recipient on the same node may have added the royalty block
+		bb, err := c.w.GetTokenBlock(ti.Token, ti.TokenType, ti.BlockID)
+		if err != nil {
+			continue
+		}
+		b := block.InitBlock(bb, nil)
+		if b == nil {
+			continue
+		}
+		signers, err := b.GetSigner()
+		if err != nil || len(signers) == 0 || signers[0] == executor {
+			continue
+		}
+		sh, err := c.ipfs.Add(bytes.NewBuffer([]byte(ti.Token+ti.BlockID)), ipfsnode.Pin(false), ipfsnode.OnlyHash(true))
+		if err != nil {
+			return fmt.Errorf("unable to get previous token state hash for token: %v, err: %v", ti.Token, err)
+		}
+		for _, s := range signers {
+			qp, err := c.getPeer(s, "")
+			if err != nil {
+				c.log.Error("Failed to connect previous quorum", "did", s, "err", err)
+				continue
+			}
+			qp.SendJSONRequest("POST", APIUpdateTokenHashDetails, map[string]string{"tokenIDTokenStateHash": sh}, nil, nil, true)
+			qp.Close()
+		}
+	}
+	for _, ti := range rti {
+		c.w.UnPin(ti.Token, wallet.PrevSenderRole, executor)
+	}
+	return nil
+}
+
+// nftExecuted adds the royalty block and the NFT block to the token chains of
+// the executor once the pledge finality is done, the blocks added before the
+// interruption are skipped when the transaction is replayed from the journal
+func (c *Core) nftExecuted(nft string, sc *contract.Contract, nb *block.Block, rb *block.Block, royaltyLocal bool) error {
+	if rb != nil {
+		err := c.w.TokensTransferred(sc.GetExecutorDID(), sc.GetRoyaltyTokensInfo(), rb, royaltyLocal, false)
+		if err != nil {
+			c.log.Error("Failed to transfer royalty tokens", "err", err)
+			return err
+		}
+	}
+	if c.w.IsLatestTokenBlock(nb) {
+		return nil
+	}
+	err := c.w.AddTokenBlock(nft, nb)
+	if err != nil {
+		c.log.Error("NFT chain creation failed", "err", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+)
+
+// Kinds of the sort fields, cursor value is decoded to the kind
+const (
+	pageString int = iota
+	pageNumber
+	pageTime
+)
+
+type pageField struct {
+	column string
+	kind   int
+}
+
+// pager pages the list by the keyset of the sort field and the key column,
+// rows with the same sort value are ordered by the key
+type pager struct {
+	fields map[string]pageField
+	key    string
+	sort   string
+}
+
+// pageCursor is the position after the last row of the page
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	Key   string `json:"k"`
+}
+
+// pageLimit returns the rows of the page, 0 when neither the limit nor the
+// cursor is sent as the lists are not paged for the older clients
+func pageLimit(pr *model.PageRequest) int {
+	switch {
+	case pr == nil || (pr.Limit <= 0 && pr.Cursor == ""):
+		return 0
+	case pr.Limit <= 0:
+		return model.DefaultPageLimit
+	case pr.Limit > model.MaxPageLimit:
+		return model.MaxPageLimit
+	default:
+		return pr.Limit
+	}
+}
+
+func encodeCursor(pc *pageCursor) string {
+	cb, _ := json.Marshal(pc)
+	return base64.RawURLEncoding.EncodeToString(cb)
+}
+
+func decodeCursor(cursor string) (*pageCursor, error) {
+	cb, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	var pc pageCursor
+	err = json.Unmarshal(cb, &pc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &pc, nil
+}
+
+func (p *pager) field(sort string) (pageField, bool, error) {
+	if sort == "" {
+		sort = p.sort
+	}
+	desc := strings.HasPrefix(sort, "-")
+	f, ok := p.fields[strings.TrimPrefix(sort, "-")]
+	if !ok {
+		return f, false, fmt.Errorf("invalid sort field %s", strings.TrimPrefix(sort, "-"))
+	}
+	return f, desc, nil
+}
+
+func (p *pager) sortName(pr *model.PageRequest) string {
+	if pr == nil || pr.Sort == "" {
+		return p.sort
+	}
+	return pr.Sort
+}
+
+// query returns the query of the page, one more row than the limit is read
+// to find whether there is a next page
+func (p *pager) query(pr *model.PageRequest) (*wallet.PageQuery, error) {
+	sort := p.sortName(pr)
+	f, desc, err := p.field(sort)
+	if err != nil {
+		return nil, err
+	}
+	dir, op := "ASC", ">"
+	if desc {
+		dir, op = "DESC", "<"
+	}
+	q := &wallet.PageQuery{Limit: -1}
+	if limit := pageLimit(pr); limit > 0 {
+		q.Limit = limit + 1
+	}
+	if f.column == p.key {
+		q.Order = p.key + " " + dir
+	} else {
+		q.Order = f.column + " " + dir + ", " + p.key + " " + dir
+	}
+	if pr == nil || pr.Cursor == "" {
+		return q, nil
+	}
+	pc, err := decodeCursor(pr.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pc.Sort != sort {
+		return nil, fmt.Errorf("cursor does not match the sort %s", sort)
+	}
+	if f.column == p.key {
+		q.Where(p.key+" "+op+" ?", pc.Key)
+		return q, nil
+	}
+	var v interface{}
+	switch f.kind {
+	case pageNumber:
+		v, err = strconv.ParseFloat(pc.Value, 64)
+	case pageTime:
+		v, err = time.Parse(time.RFC3339Nano, pc.Value)
+	default:
+		v = pc.Value
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	q.Where("("+f.column+" "+op+" ? OR ("+f.column+" = ? AND "+p.key+" "+op+" ?))", v, v, pc.Key)
+	return q, nil
+}
+
+// next trims the rows read with the query to the limit and returns the count
+// of the rows in the page with the cursor of the next page, value returns
+// the value of the sort column and the key of the row
+func (p *pager) next(pr *model.PageRequest, count int, value func(i int, column string) (interface{}, string)) (int, string) {
+	limit := pageLimit(pr)
+	if limit == 0 || count <= limit {
+		return count, ""
+	}
+	sort := p.sortName(pr)
+	f, _, _ := p.field(sort)
+	v, key := value(limit-1, f.column)
+	pc := &pageCursor{Sort: sort, Key: key}
+	switch vt := v.(type) {
+	case float64:
+		pc.Value = strconv.FormatFloat(vt, 'g', -1, 64)
+	case int:
+		pc.Value = strconv.Itoa(vt)
+	case time.Time:
+		pc.Value = vt.Format(time.RFC3339Nano)
+	case string:
+		pc.Value = vt
+	}
+	return limit, encodeCursor(pc)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/rubixchain/rubixgoplatform/core/storage"
 	"github.com/rubixchain/rubixgoplatform/core/wallet"
@@ -40,6 +41,7 @@ type QuorumManager struct {
 	ql  []string
 	s   storage.Storage
 	log logger.Logger
+	hl  sync.Mutex
 }
 
 type QuorumData struct {
@@ -62,6 +64,11 @@ func NewQuorumManager(s storage.Storage, log logger.Logger) (*QuorumManager, err
 		qm.log.Error("Failed to init quorum storage", "err", err)
 		return nil, err
 	}
+	err = qm.s.Init(QuorumHealthStorage, &QuorumHealth{}, true)
+	if err != nil {
+		qm.log.Error("Failed to init quorum health storage", "err", err)
+		return nil, err
+	}
 	var qd []QuorumData
 	err = qm.s.Read(QuorumStorage, &qd, "type=?", QuorumTypeTwo)
 	if err == nil {
@@ -99,8 +106,10 @@ func NewQuorumManager(s storage.Storage, log logger.Logger) (*QuorumManager, err
 	return qm, nil
 }
 
-// GetQuorum will get the configured or available quorum
-func (qm *QuorumManager) GetQuorum(t int, lastChar string, selfPeer string) []string {
+// GetQuorum will get the configured or available quorum, at most quorumCount
+// quorums are returned (all when quorumCount is zero) and at least minQuorum
+// quorums should be available
+func (qm *QuorumManager) GetQuorum(t int, lastChar string, selfPeer string, quorumCount int, minQuorum int) []string {
 	//QuorumTypeOne is to select quorums from the public pool of quorums instead of a private subnet.
 	//Once a new node is created, it will create a DID. Using the command "registerdid", the peerID and DID will be
 	//published in the network, and all the nodes listening to the subscription will have the DID added on the DIDPeerTable
@@ -116,7 +125,7 @@ func (qm *QuorumManager) GetQuorum(t int, lastChar string, selfPeer string) []st
 			qm.log.Error("Quorums not present")
 			return nil
 		}
-		if len(quorumList) < 5 {
+		if len(quorumList) < minQuorum {
 			qm.log.Error("Not enough quorums present")
 			return nil
 		}
@@ -126,7 +135,7 @@ func (qm *QuorumManager) GetQuorum(t int, lastChar string, selfPeer string) []st
 			addr := string(q.PeerID + "." + q.DID)
 			quorumAddrList = append(quorumAddrList, addr)
 			quorumAddrCount = quorumAddrCount + 1
-			if quorumAddrCount == 7 {
+			if quorumAddrCount == quorumCount {
 				break
 			}
 		}
@@ -139,7 +148,7 @@ func (qm *QuorumManager) GetQuorum(t int, lastChar string, selfPeer string) []st
 			addr := string(peerID + "." + q)
 			quorumAddrList = append(quorumAddrList, addr)
 			quorumAddrCount = quorumAddrCount + 1
-			if quorumAddrCount == 7 {
+			if quorumAddrCount == quorumCount {
 				break
 			}
 		}
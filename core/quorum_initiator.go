@@ -12,6 +12,7 @@ import (
 	ipfsnode "github.com/ipfs/go-ipfs-api"
 	"github.com/rubixchain/rubixgoplatform/block"
 	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/config"
 	"github.com/rubixchain/rubixgoplatform/core/ipfsport"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	wallet "github.com/rubixchain/rubixgoplatform/core/wallet"
@@ -19,6 +20,8 @@ import (
 	"github.com/rubixchain/rubixgoplatform/util"
 )
 
+// Default consensus policy, used when the node does not configure
+// the consensus policy for the transaction mode
 const (
 	QuorumRequired       int = 7
 	MinQuorumRequired    int = 5
@@ -35,6 +38,7 @@ const (
 	PinningServiceMode
 	NFTExecuteMode
 	FTTransferMode
+	SmartContractUpgradeMode
 )
 const (
 	AlphaQuorumType int = iota
@@ -57,6 +61,7 @@ type ConensusRequest struct {
 	TransactionEpoch   int          `json:"transaction_epoch"`
 	PinningNodePeerID  string       `json:"pinning_node_peer_id"`
 	NFT                string       `json:"nft"`
+	RoyaltyPeerID      string       `json:"royalty_peer_id"`
 	FTinfo             model.FTInfo `json:"ft_info"`
 }
 
@@ -81,6 +86,9 @@ type ConsensusStatus struct {
 	PledgeLock sync.Mutex
 	P          map[string]*ipfsport.Peer
 	Result     ConsensusResult
+	Policy     config.ConsensusPolicy
+	// RoyaltyBlock pays the royalty of the NFT execution
+	RoyaltyBlock *block.Block
 }
 
 type PledgeDetails struct {
@@ -270,7 +278,8 @@ func (c *Core) SetupQuorum(didStr string, pwd string, pvtKeyPwd string) error {
 }
 
 func (c *Core) GetAllQuorum() []string {
-	return c.qm.GetQuorum(QuorumTypeTwo, "", c.peerID)
+	cp := c.getConsensusPolicy(RBTTransferMode)
+	return c.qm.GetQuorum(QuorumTypeTwo, "", c.peerID, cp.QuorumCount, cp.MinQuorum)
 }
 
 func (c *Core) AddQuorum(ql []QuorumData) error {
@@ -314,6 +323,15 @@ func (c *Core) sendQuorumCredit(cr *ConensusRequest) {
 }
 
 func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc did.DIDCrypto) (*model.TransactionDetails, map[string]map[string]float64, *PledgeDetails, error) {
+	st := time.Now()
+	td, pl, pds, err := c.runConsensus(cr, sc, dc)
+	c.finishJournal(cr.ReqID, err)
+	c.metrics.observeConsensus(cr.Mode, err == nil, time.Since(st))
+	return td, pl, pds, err
+}
+
+func (c *Core) runConsensus(cr *ConensusRequest, sc *contract.Contract, dc did.DIDCrypto) (*model.TransactionDetails, map[string]map[string]float64, *PledgeDetails, error) {
+	cp := c.getConsensusPolicy(cr.Mode)
 	cs := ConsensusStatus{
 		Credit: CreditScore{
 			Credit: make([]CreditSignature, 0),
@@ -325,6 +343,7 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			FailedCount:           0,
 			PledgingDIDSignStatus: false,
 		},
+		Policy: cp,
 	}
 	reqPledgeTokens := float64(0)
 
@@ -349,7 +368,7 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		if reqPledgeTokens == 0 {
 			reqPledgeTokens = 1
 		}
-	case SmartContractExecuteMode, NFTExecuteMode:
+	case SmartContractExecuteMode, NFTExecuteMode, SmartContractUpgradeMode:
 		reqPledgeTokens = sc.GetTotalRBTs()
 	case FTTransferMode:
 		ti := sc.GetTransTokenInfo()
@@ -358,7 +377,7 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		}
 	}
 	minValue := MinDecimalValue(MaxDecimalPlaces)
-	minTotalPledgeAmount := minValue * float64(MinQuorumRequired)
+	minTotalPledgeAmount := minValue * float64(cp.MinQuorum)
 	if reqPledgeTokens < minTotalPledgeAmount {
 		reqPledgeTokens = minTotalPledgeAmount
 	}
@@ -375,29 +394,40 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 	tid := util.HexToStr(util.CalculateHash(sc.GetBlock(), "SHA3-256"))
 	lastCharTID := string(tid[len(tid)-1])
 	cr.TransactionID = tid
+	c.journalContractSigned(cr, sc)
 
-	ql := c.qm.GetQuorum(cr.Type, lastCharTID, c.peerID) //passing lastCharTID as a parameter. Made changes in GetQuorum function to take 2 arguments
-	if ql == nil || len(ql) < MinQuorumRequired {
+	ql := c.qm.GetQuorum(cr.Type, lastCharTID, c.peerID, 0, cp.MinQuorum) //passing lastCharTID as a parameter. Made changes in GetQuorum function to take 2 arguments
+	if ql == nil || len(ql) < cp.MinQuorum {
 		c.log.Error("Failed to get required quorums")
 		return nil, nil, nil, fmt.Errorf("failed to get required quorums")
 	}
+	ql, err := c.selectQuorum(ql, &cp)
+	if err != nil {
+		c.log.Error("Failed to select quorums", "err", err)
+		return nil, nil, nil, err
+	}
+	if len(ql) < cp.MinQuorum {
+		c.log.Error("Failed to get required quorums, quorums are not reachable")
+		return nil, nil, nil, fmt.Errorf("failed to get required quorums")
+	}
 
 	var finalQl []string
 	var errFQL error
 	if cr.Type == 2 {
-		finalQl, errFQL = c.GetFinalQuorumList(ql)
+		finalQl, errFQL = c.GetFinalQuorumList(ql, cp.QuorumCount)
 		if errFQL != nil {
 			c.log.Error("unable to get consensus from quorum(s). err: ", errFQL)
 			return nil, nil, nil, errFQL
 		}
 		cr.QuorumList = finalQl
-		if len(finalQl) < MinQuorumRequired {
+		if len(finalQl) < cp.MinQuorum {
 			c.log.Error("quorum(s) are unavailable for this trnx")
 			return nil, nil, nil, fmt.Errorf("quorum(s) are unavailable for this trnx. retry trnx after some time")
 		}
 	} else {
 		cr.QuorumList = ql
 	}
+	c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventQuorumsSelected, Status: true, TransactionID: tid, Quorums: cr.QuorumList})
 
 	c.qlock.Lock()
 	c.quorumRequest[cr.ReqID] = &cs
@@ -409,8 +439,8 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		delete(c.pd, cr.ReqID)
 		c.qlock.Unlock()
 	}()
-	c.quorumCount = QuorumRequired - len(cr.QuorumList)
-	c.noBalanceQuorumCount = QuorumRequired - len(cr.QuorumList)
+	c.quorumCount = cp.QuorumCount - len(cr.QuorumList)
+	c.noBalanceQuorumCount = cp.QuorumCount - len(cr.QuorumList)
 	for _, a := range cr.QuorumList {
 		//This part of code is trying to connect to the quorums in quorum list, where various functions are called to pledge the tokens
 		//and checking of transaction by the quorum i.e. consensus for the transaction. Once the quorum is connected, it pledges and
@@ -420,7 +450,6 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		go c.connectQuorum(cr, a, AlphaQuorumType, sc)
 	}
 	loop := true
-	var err error
 	err = nil
 	for {
 		time.Sleep(time.Second)
@@ -430,7 +459,7 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			loop = false
 			err = fmt.Errorf("invalid request")
 		} else {
-			if cs.Result.SuccessCount >= MinConsensusRequired {
+			if cs.Result.SuccessCount >= cp.MinConsensus {
 				loop = false
 			} else if cs.Result.RunningCount == 0 {
 				loop = false
@@ -451,16 +480,19 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		return nil, nil, nil, err
 	}
 
-	if c.noBalanceQuorumCount > (QuorumRequired - MinConsensusRequired) {
+	if c.noBalanceQuorumCount > (cp.QuorumCount - cp.MinConsensus) {
 		c.log.Error("Consensus failed due to insufficient balance in Quorum(s), Retry transaction after sometime")
 		return nil, nil, nil, fmt.Errorf("Consensus failed due to insufficient balance in Quorum(s)")
 	}
 
+	c.journalQuorumsPledged(cr)
+
 	nb, err := c.pledgeQuorumToken(cr, sc, tid, dc)
 	if err != nil {
 		c.log.Error("Failed to pledge token", "err", err)
 		return nil, nil, nil, err
 	}
+	c.journalBlockAdded(cr, nb)
 
 	ti := sc.GetTransTokenInfo()
 	c.qlock.Lock()
@@ -608,17 +640,22 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			statehash, ok := newTokenHash.(string)
 			if !ok {
 				c.log.Error("Type assertion to string failed at index", i)
-				return nil, nil, nil, fmt.Errorf("Type assertion to string failed at index", i)
+				return nil, nil, nil, fmt.Errorf("Type assertion to string failed at index %d", i)
 			}
 			newtokenhashes = append(newtokenhashes, statehash)
 		}
 
+		c.journalReceiverUpdated(cr, newtokenhashes)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventReceiverAck, Status: true, TransactionID: cr.TransactionID})
+
 		//trigger pledge finality to the quorum and also adding the new tokenstate hash details for transferred tokens to quorum
 		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, newtokenhashes, tid)
 		if pledgeFinalityError != nil {
 			c.log.Error("Pledge finlaity not achieved", "err", pledgeFinalityError)
 			return nil, nil, nil, pledgeFinalityError
 		}
+		c.journalFinality(cr)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventPledgeFinality, Status: true, TransactionID: cr.TransactionID})
 
 		//Checking prev block details (i.e. the latest block before transferring) by sender. Sender will connect with old quorums, and update about the exhausted token state hashes to quorums for them to unpledge their tokens.
 		for _, tokeninfo := range ti {
@@ -826,6 +863,8 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			}
 			newTokenHashes = append(newTokenHashes, stateHash)
 		}
+		c.journalReceiverUpdated(cr, newTokenHashes)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventReceiverAck, Status: true, TransactionID: cr.TransactionID})
 
 		//trigger pledge finality to the quorum and also adding the new tokenstate hash details for transferred tokens to quorum
 		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, newTokenHashes, tid)
@@ -833,6 +872,8 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			c.log.Error("Pledge finlaity not achieved", "err", err)
 			return nil, nil, nil, pledgeFinalityError
 		}
+		c.journalFinality(cr)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventPledgeFinality, Status: true, TransactionID: cr.TransactionID})
 		//Checking prev block details (i.e. the latest block before transferring) by sender. Sender will connect with old quorums, and update about the exhausted token state hashes to quorums for them to unpledge their tokens.
 		for _, tokeninfo := range ti {
 			b := c.w.GetLatestTokenBlock(tokeninfo.Token, tokeninfo.TokenType)
@@ -1033,17 +1074,22 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			statehash, ok := newTokenHash.(string)
 			if !ok {
 				c.log.Error("Type assertion to string failed at index", i)
-				return nil, nil, nil, fmt.Errorf("Type assertion to string failed at index", i)
+				return nil, nil, nil, fmt.Errorf("Type assertion to string failed at index %d", i)
 			}
 			newtokenhashes = append(newtokenhashes, statehash)
 		}
 
+		c.journalReceiverUpdated(cr, newtokenhashes)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventReceiverAck, Status: true, TransactionID: cr.TransactionID})
+
 		//trigger pledge finality to the quorum and also adding the new tokenstate hash details for transferred tokens to quorum
 		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, newtokenhashes, tid)
 		if pledgeFinalityError != nil {
 			c.log.Error("Pledge finlaity not achieved", "err", err)
 			return nil, nil, nil, pledgeFinalityError
 		}
+		c.journalFinality(cr)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventPledgeFinality, Status: true, TransactionID: cr.TransactionID})
 
 		//Checking prev block details (i.e. the latest block before transferring) by sender. Sender will connect with old quorums, and update about the exhausted token state hashes to quorums for them to unpledge their tokens.
 		for _, tokeninfo := range ti {
@@ -1184,12 +1230,17 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 
 		}
 
+		c.journalReceiverUpdated(cr, updatedTokenHashes)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventReceiverAck, Status: true, TransactionID: cr.TransactionID})
+
 		//trigger pledge finality to the quorum and also adding the new tokenstate hash details for transferred tokens to quorum
 		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, updatedTokenHashes, tid)
 		if pledgeFinalityError != nil {
 			c.log.Error("Pledge finlaity not achieved", "err", err)
 			return nil, nil, nil, pledgeFinalityError
 		}
+		c.journalFinality(cr)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventPledgeFinality, Status: true, TransactionID: cr.TransactionID})
 
 		//Checking prev block details (i.e. the latest block before transferring) by sender. Sender will connect with old quorums, and update about the exhausted token state hashes to quorums for them to unpledge their tokens.
 		for _, tokeninfo := range ti {
@@ -1379,7 +1430,12 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		}
 
 		return &txnDetails, pl, pds, nil
-	case SmartContractExecuteMode:
+	case SmartContractExecuteMode, SmartContractUpgradeMode:
+		// the upgrade is initiated by the deployer
+		initiatorDID, eventType, txnMode := sc.GetExecutorDID(), ExecuteType, wallet.ExecuteMode
+		if cr.Mode == SmartContractUpgradeMode {
+			initiatorDID, eventType, txnMode = sc.GetDeployerDID(), UpgradeType, wallet.UpgradeMode
+		}
 		//Get the latest block details before being executed to get the old signers
 		b := c.w.GetLatestTokenBlock(cr.SmartContractToken, nb.GetTokenType(cr.SmartContractToken))
 
@@ -1395,10 +1451,12 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			return nil, nil, nil, err
 		}
 		//update smart contracttoken status to deployed in DB
-		err = c.w.UpdateSmartContractStatus(cr.SmartContractToken, wallet.TokenIsExecuted)
-		if err != nil {
-			c.log.Error("Failed to update smart contract Token execute detail in storage", err)
-			return nil, nil, nil, err
+		if cr.Mode == SmartContractExecuteMode {
+			err = c.w.UpdateSmartContractStatus(cr.SmartContractToken, wallet.TokenIsExecuted)
+			if err != nil {
+				c.log.Error("Failed to update smart contract Token execute detail in storage", err)
+				return nil, nil, nil, err
+			}
 		}
 
 		newBlockId, err := nb.GetBlockID(cr.SmartContractToken)
@@ -1423,8 +1481,8 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		//Todo pubsub - publish smart contract token details
 		newEvent := model.NewContractEvent{
 			SmartContractToken:     cr.SmartContractToken,
-			Did:                    sc.GetExecutorDID(),
-			Type:                   ExecuteType,
+			Did:                    initiatorDID,
+			Type:                   eventType,
 			SmartContractBlockHash: newBlockId,
 		}
 
@@ -1477,8 +1535,8 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			TransactionID:   tid,
 			TransactionType: nb.GetTransType(),
 			BlockID:         newBlockId,
-			Mode:            wallet.ExecuteMode,
-			DeployerDID:     sc.GetExecutorDID(),
+			Mode:            txnMode,
+			DeployerDID:     initiatorDID,
 			Comment:         sc.GetComment(),
 			DateTime:        time.Now(),
 			Status:          true,
@@ -1557,11 +1615,6 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 			return nil, nil, nil, fmt.Errorf("unable to fetch previous quorum's DIDs for token: %v, err: %v", cr.NFT, err)
 		}
 
-		err = c.w.AddTokenBlock(cr.NFT, nb)
-		if err != nil {
-			c.log.Error("NFT chain creation failed", "err", err)
-			return nil, nil, nil, err
-		}
 		newBlockId, err := nb.GetBlockID(cr.NFT)
 		if err != nil {
 			c.log.Error("failed to get new block id ", "err", err)
@@ -1574,12 +1627,41 @@ func (c *Core) initiateConsensus(cr *ConensusRequest, sc *contract.Contract, dc
 		newtokenIDTokenStateHash, err := c.ipfs.Add(tokenIDTokenStateBuffer, ipfsnode.Pin(false), ipfsnode.OnlyHash(true))
 		c.log.Info(fmt.Sprintf("New NFT state hash after being executed : %s", newtokenIDTokenStateHash))
 
+		// the royalty recipient gets the royalty block before the finality, both
+		// the blocks are journaled so the replay adds them if the node restarts
+		var royaltyHashes []string
+		if cs.RoyaltyBlock != nil {
+			c.journalRoyaltyBlock(cr, cs.RoyaltyBlock)
+			royaltyHashes, err = c.sendNFTRoyalty(cr, sc, cs.RoyaltyBlock)
+			if err != nil {
+				c.log.Error("Failed to pay NFT royalty", "err", err)
+				return nil, nil, nil, err
+			}
+		}
+		nftHashes := append([]string{newtokenIDTokenStateHash}, royaltyHashes...)
+		c.journalReceiverUpdated(cr, nftHashes)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventReceiverAck, Status: true, TransactionID: cr.TransactionID})
+
 		//trigger pledge finality to the quorum and adding the details in token hash table
-		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, []string{newtokenIDTokenStateHash}, tid)
+		pledgeFinalityError := c.quorumPledgeFinality(cr, nb, nftHashes, tid)
 		if pledgeFinalityError != nil {
 			c.log.Error("Pledge finlaity not achieved", "err", err)
 			return nil, nil, nil, pledgeFinalityError
 		}
+		c.journalFinality(cr)
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventPledgeFinality, Status: true, TransactionID: cr.TransactionID})
+
+		err = c.nftExecuted(cr.NFT, sc, nb, cs.RoyaltyBlock, cr.RoyaltyPeerID == c.peerID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if cs.RoyaltyBlock != nil {
+			err = c.nftRoyaltyPaid(sc)
+			if err != nil {
+				c.log.Error("Failed to update royalty token quorums", "err", err)
+				return nil, nil, nil, err
+			}
+		}
 
 		newEvent := model.NFTEvent{
 			NFT:          cr.NFT,
@@ -1834,7 +1916,7 @@ func (c *Core) finishConsensus(id string, qt int, p *ipfsport.Peer, status bool,
 				Hash:          hash,
 				SignType:      signType,
 			}
-			if cs.Result.SuccessCount < MinConsensusRequired {
+			if cs.Result.SuccessCount < cs.Policy.MinConsensus {
 				if _, ok := pd.PledgedTokens[did]; ok {
 					cs.P[did] = p
 					cs.Credit.Credit = append(cs.Credit.Credit, csig)
@@ -1856,6 +1938,23 @@ func (c *Core) finishConsensus(id string, qt int, p *ipfsport.Peer, status bool,
 
 func (c *Core) connectQuorum(cr *ConensusRequest, addr string, qt int, sc *contract.Contract) {
 	c.startConsensus(cr.ReqID, qt)
+	// record the quorum health, so that failing quorums are not selected again,
+	// only the failures caused by the quorum reply count against the quorum
+	st := time.Now()
+	success := false
+	quorumFault := false
+	defer func() {
+		_, did, ok := util.ParseAddress(addr)
+		if ok {
+			if success || quorumFault {
+				c.qm.RecordQuorumResult(did, success, time.Since(st))
+			}
+		} else {
+			did = addr
+		}
+		c.metrics.quorumResults.Inc(did, metricResult(success))
+		c.AddTxnEvent(cr.ReqID, model.TxnEvent{Type: model.TxnEventQuorumResult, Status: success, TransactionID: cr.TransactionID, Quorum: addr})
+	}()
 	var p *ipfsport.Peer
 	var err error
 	p, err = c.getPeer(addr, sc.GetSenderDID())
@@ -1868,6 +1967,7 @@ func (c *Core) connectQuorum(cr *ConensusRequest, addr string, qt int, sc *contr
 	if err != nil {
 		if strings.Contains(err.Error(), "don't have enough balance to pledge") {
 			c.log.Error("Quorum failed to pledge token")
+			quorumFault = true
 			c.finishConsensus(cr.ReqID, qt, p, false, "", nil, nil)
 			return
 		}
@@ -1981,9 +2081,11 @@ func (c *Core) connectQuorum(cr *ConensusRequest, addr string, qt int, sc *contr
 
 	if !cresp.Status {
 		c.log.Error("Failed to get consensus", "msg", cresp.Message)
+		quorumFault = true
 		c.finishConsensus(cr.ReqID, qt, p, false, "", nil, nil)
 		return
 	}
+	success = true
 	c.finishConsensus(cr.ReqID, qt, p, true, cresp.Hash, cresp.ShareSig, cresp.PrivSig)
 }
 
@@ -2040,14 +2142,15 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 	tks := make([]block.TransTokens, 0)
 	ctcb := make(map[string]*block.Block)
 
-	if sc.GetDeployerDID() != "" {
+	// the upgrade is signed by the deployer but chains on the latest block
+	if sc.GetDeployerDID() != "" && cr.Mode != SmartContractUpgradeMode {
 		tt := block.TransTokens{
 			Token:     ti[0].Token,
 			TokenType: ti[0].TokenType,
 		}
 		tks = append(tks, tt)
 		ctcb[ti[0].Token] = nil
-	} else if sc.GetExecutorDID() != "" {
+	} else if sc.GetExecutorDID() != "" || cr.Mode == SmartContractUpgradeMode {
 		tt := block.TransTokens{
 			Token:     ti[0].Token,
 			TokenType: ti[0].TokenType,
@@ -2125,6 +2228,7 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 			SmartContract:      sc.GetBlock(),
 			GenesisBlock:       smartContractGensisBlock,
 			PledgeDetails:      ptds,
+			SCStateHash:        wallet.SCStateHash(""),
 			InitiatorSignature: deployerSign,
 			Epoch:              cr.TransactionEpoch,
 		}
@@ -2154,10 +2258,43 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 			SmartContract:      sc.GetBlock(),
 			PledgeDetails:      ptds,
 			SmartContractData:  sc.GetSmartContractData(),
+			SCStateHash:        wallet.SCStateHash(sc.GetSmartContractData()),
+			SCEvents:           sc.GetSmartContractEvents(),
 			InitiatorSignature: executorSign,
 			Epoch:              cr.TransactionEpoch,
 		}
 
+	} else if cr.Mode == SmartContractUpgradeMode {
+		bti.DeployerDID = sc.GetDeployerDID()
+
+		signData, deployerNLSSShare, deployerPrivSign, err := sc.GetHashSig(bti.DeployerDID)
+		if err != nil {
+			c.log.Error("failed to fetch deployer sign", "err", err)
+			return nil, fmt.Errorf("failed to fetch deployer sign")
+		}
+		deployerSign := &block.InitiatorSignature{
+			NLSSShare:   deployerNLSSShare,
+			PrivateSign: deployerPrivSign,
+			DID:         bti.DeployerDID,
+			Hash:        signData,
+			SignType:    dc.GetSignType(),
+		}
+
+		tcb = block.TokenChainBlock{
+			TransactionType:    block.TokenUpgradedType,
+			TokenOwner:         sc.GetDeployerDID(),
+			TransInfo:          bti,
+			QuorumSignature:    credit,
+			SmartContract:      sc.GetBlock(),
+			PledgeDetails:      ptds,
+			SmartContractData:  sc.GetSmartContractData(),
+			SCStateHash:        wallet.SCStateHash(sc.GetSmartContractData()),
+			SCEvents:           sc.GetSmartContractEvents(),
+			SCCode:             sc.GetSmartContractCode(),
+			InitiatorSignature: deployerSign,
+			Epoch:              cr.TransactionEpoch,
+		}
+
 	} else if cr.Mode == NFTExecuteMode {
 		bti.ExecutorDID = sc.GetExecutorDID()
 
@@ -2208,11 +2345,12 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 		}
 
 		nftValue := sc.GetTotalRBTs()
+		royalty, royaltyDID := sc.GetNFTRoyalty()
 
 		nftGenesisBlock := &block.GenesisBlock{
 			Type: block.TokenGeneratedType,
 			Info: []block.GenesisTokenInfo{
-				{Token: cr.NFT, NFTValue: nftValue, NFTData: sc.GetNFTData()},
+				{Token: cr.NFT, NFTValue: nftValue, NFTData: sc.GetNFTData(), NFTRoyalty: royalty, NFTRoyaltyDID: royaltyDID},
 			},
 		}
 		tcb = block.TokenChainBlock{
@@ -2274,6 +2412,8 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 	if cr.Mode == DTCommitMode {
 		tcb.TransactionType = block.TokenCommittedType
 	}
+	// record the policy in force, so that the block is validated against it
+	tcb.ConsensusPolicy = newBlockConsensusPolicy(cr.Mode, &cs.Policy)
 	nb := block.CreateNewBlock(ctcb, &tcb)
 	if nb == nil {
 		c.log.Error("Failed to create new token chain block - qrm init")
@@ -2284,30 +2424,21 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 		c.log.Error("Failed to get new block")
 		return nil, fmt.Errorf("failed to get new block")
 	}
-	for k := range pd.PledgedTokens {
-		p, ok := cs.P[k]
-		if !ok {
-			c.log.Error("Invalid pledge request, failed to get peer connection")
-			return nil, fmt.Errorf("invalid pledge request, failed to get peer connection")
-		}
-		sr := SignatureRequest{
-			TokenChainBlock: blk,
-		}
-		var srep SignatureReply
-		err := p.SendJSONRequest("POST", APISignatureRequest, nil, &sr, &srep, true)
+	err := c.quorumSignBlock(cs, pd, blk, nb)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Mode == NFTExecuteMode && len(sc.GetRoyaltyTokensInfo()) > 0 {
+		rb, err := c.nftRoyaltyBlock(sc, &tcb)
 		if err != nil {
-			c.log.Error("Failed to get signature from the quorum", "err", err)
-			return nil, fmt.Errorf("failed to get signature from the quorum")
-		}
-		if !srep.Status {
-			c.log.Error("Failed to get signature from the quorum", "msg", srep.Message)
-			return nil, fmt.Errorf("failed to get signature from the quorum, " + srep.Message)
+			c.log.Error("Failed to create royalty block", "err", err)
+			return nil, err
 		}
-		err = nb.ReplaceSignature(k, srep.Signature)
+		err = c.quorumSignBlock(cs, pd, rb.GetBlock(), rb)
 		if err != nil {
-			c.log.Error("Failed to update signature to block", "err", err)
-			return nil, fmt.Errorf("failed to update signature to block")
+			return nil, err
 		}
+		cs.RoyaltyBlock = rb
 	}
 	/* for k, v := range pd.PledgedTokens {
 		p, ok := cs.P[k]
@@ -2338,6 +2469,36 @@ func (c *Core) pledgeQuorumToken(cr *ConensusRequest, sc *contract.Contract, tid
 	return nb, nil
 }
 
+// quorumSignBlock gets the signatures of the pledged quorums on the block
+func (c *Core) quorumSignBlock(cs *ConsensusStatus, pd *PledgeDetails, blk []byte, nb *block.Block) error {
+	for k := range pd.PledgedTokens {
+		p, ok := cs.P[k]
+		if !ok {
+			c.log.Error("Invalid pledge request, failed to get peer connection")
+			return fmt.Errorf("invalid pledge request, failed to get peer connection")
+		}
+		sr := SignatureRequest{
+			TokenChainBlock: blk,
+		}
+		var srep SignatureReply
+		err := p.SendJSONRequest("POST", APISignatureRequest, nil, &sr, &srep, true)
+		if err != nil {
+			c.log.Error("Failed to get signature from the quorum", "err", err)
+			return fmt.Errorf("failed to get signature from the quorum")
+		}
+		if !srep.Status {
+			c.log.Error("Failed to get signature from the quorum", "msg", srep.Message)
+			return fmt.Errorf("failed to get signature from the quorum, " + srep.Message)
+		}
+		err = nb.ReplaceSignature(k, srep.Signature)
+		if err != nil {
+			c.log.Error("Failed to update signature to block", "err", err)
+			return fmt.Errorf("failed to update signature to block")
+		}
+	}
+	return nil
+}
+
 func (c *Core) initPledgeQuorumToken(cr *ConensusRequest, p *ipfsport.Peer, qt int) error {
 	c.qlock.Lock()
 	cs, ok := c.quorumRequest[cr.ReqID]
@@ -2356,10 +2517,10 @@ func (c *Core) initPledgeQuorumToken(cr *ConensusRequest, p *ipfsport.Peer, qt i
 		err := fmt.Errorf("invalid pledge request")
 		return err
 	}
-	pledgeTokensPerQuorum := pd.TransferAmount / float64(MinQuorumRequired)
+	pledgeTokensPerQuorum := pd.TransferAmount / float64(cs.Policy.MinQuorum)
 
 	// Request pledge token
-	if (c.quorumCount - c.noBalanceQuorumCount) < MinConsensusRequired {
+	if (c.quorumCount - c.noBalanceQuorumCount) < cs.Policy.MinConsensus {
 		pr := PledgeRequest{
 			TokensRequired: CeilfloatPrecision(pledgeTokensPerQuorum, MaxDecimalPlaces), // Request the determined number of tokens per quorum,
 		}
@@ -2413,13 +2574,13 @@ func (c *Core) initPledgeQuorumToken(cr *ConensusRequest, p *ipfsport.Peer, qt i
 		}
 
 		// check if total connected quorums, with balance, has reached the minimum consensus threshold
-		if (c.quorumCount - c.noBalanceQuorumCount) < MinConsensusRequired {
-			if c.quorumCount < QuorumRequired {
+		if (c.quorumCount - c.noBalanceQuorumCount) < cs.Policy.MinConsensus {
+			if c.quorumCount < cs.Policy.QuorumCount {
 				if count == 300 {
 					err := fmt.Errorf("Unable to pledge after wait")
 					return err
 				}
-			} else if c.quorumCount == QuorumRequired {
+			} else if c.quorumCount == cs.Policy.QuorumCount {
 				err := fmt.Errorf("Unable to pledge")
 				return err
 			}
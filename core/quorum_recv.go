@@ -362,7 +362,7 @@ func (c *Core) quorumSmartContractConsensus(req *ensweb.Request, did string, qdc
 
 	var verifyDID string
 
-	if consensusRequest.Mode == SmartContractDeployMode {
+	if consensusRequest.Mode == SmartContractDeployMode || consensusRequest.Mode == SmartContractUpgradeMode {
 		c.log.Debug("Fetching Deployer DID")
 		verifyDID = consensusContract.GetDeployerDID()
 		c.log.Debug("deployer did ", verifyDID)
@@ -442,6 +442,9 @@ func (c *Core) quorumSmartContractConsensus(req *ensweb.Request, did string, qdc
 	} else {
 		//sync the smartcontract tokenchain
 		address := consensusRequest.ExecuterPeerID + "." + consensusContract.GetExecutorDID()
+		if consensusRequest.Mode == SmartContractUpgradeMode {
+			address = consensusRequest.DeployerPeerID + "." + consensusContract.GetDeployerDID()
+		}
 		peerConn, err := c.getPeer(address, did)
 		if err != nil {
 			c.log.Error("Failed to get executor peer to sync smart contract token chain", "err", err)
@@ -464,6 +467,17 @@ func (c *Core) quorumSmartContractConsensus(req *ensweb.Request, did string, qdc
 			go c.checkTokenState(t, did, i, tokenStateCheckResult, &wg, consensusRequest.QuorumList, ti.TokenType)
 		}
 		wg.Wait()
+		//4. re-execute the contract and verify the state
+		if consensusRequest.Mode == SmartContractUpgradeMode {
+			err = c.verifySmartContractUpgrade(consensusRequest, consensusContract)
+		} else {
+			err = c.verifySmartContractExecution(consensusRequest, consensusContract)
+		}
+		if err != nil {
+			c.log.Error("Failed to verify smart contract execution", "err", err)
+			consensusReply.Message = "Failed to verify smart contract execution, " + err.Error()
+			return c.l.RenderJSON(req, &consensusReply, http.StatusOK)
+		}
 	}
 	for i := range tokenStateCheckResult {
 		if tokenStateCheckResult[i].Error != nil {
@@ -620,6 +634,31 @@ func (c *Core) quorumNFTConsensus(req *ensweb.Request, did string, qdc didcrypto
 			go c.checkTokenState(t, did, i, tokenStateCheckResult, &wg, consensusRequest.QuorumList, ti.TokenType)
 		}
 		wg.Wait()
+
+		//4. check the royalty paid to the royalty recipient, the royalty tokens
+		// are pinned along with the nft to prevent the double spend
+		royaltyTokens, err := c.validateNFTRoyalty(consensusRequest, consensusContract, peerConn)
+		if err != nil {
+			c.log.Error("NFT royalty check failed", "err", err)
+			consensusReply.Message = "NFT royalty check failed, " + err.Error()
+			return c.l.RenderJSON(req, &consensusReply, http.StatusOK)
+		}
+		results := make([]MultiPinCheckRes, len(royaltyTokens))
+		royaltyStateCheckResult := make([]TokenStateCheckResult, len(royaltyTokens))
+		for i, ti := range royaltyTokens {
+			wg.Add(2)
+			go c.pinCheck(ti.Token, i, consensusRequest.ExecuterPeerID, consensusRequest.RoyaltyPeerID, results, &wg)
+			go c.checkTokenState(ti.Token, did, i, royaltyStateCheckResult, &wg, consensusRequest.QuorumList, ti.TokenType)
+		}
+		wg.Wait()
+		for i := range results {
+			if results[i].Error != nil || results[i].Status {
+				c.log.Error("Royalty token has multiple owners", "token", results[i].Token, "err", results[i].Error)
+				consensusReply.Message = "Royalty token has multiple owners"
+				return c.l.RenderJSON(req, &consensusReply, http.StatusOK)
+			}
+		}
+		tokenStateCheckResult = append(tokenStateCheckResult, royaltyStateCheckResult...)
 	}
 	for i := range tokenStateCheckResult {
 		if tokenStateCheckResult[i].Error != nil {
@@ -810,6 +849,9 @@ func (c *Core) quorumConensus(req *ensweb.Request) *ensweb.Result {
 	case SmartContractExecuteMode:
 		c.log.Debug("Smart contract Consensus for execution started")
 		return c.quorumSmartContractConsensus(req, did, qdc, &cr)
+	case SmartContractUpgradeMode:
+		c.log.Debug("Smart contract Consensus for upgrade started")
+		return c.quorumSmartContractConsensus(req, did, qdc, &cr)
 	case NFTDeployMode:
 		c.log.Info("NFT deploy consensus started")
 		return c.quorumNFTConsensus(req, did, qdc, &cr)
@@ -949,16 +991,24 @@ func (c *Core) updateReceiverToken(
 		}
 		defer senderPeer.Close()
 
+		tis := make([]TokenSyncInfo, 0, len(tokenInfo))
 		for _, ti := range tokenInfo {
-			t := ti.Token
-			pblkID, err := b.GetPrevBlockID(t)
+			pblkID, err := b.GetPrevBlockID(ti.Token)
 			if err != nil {
-				return nil, fmt.Errorf("failed to sync token chain block, missing previous block id for token %v, error: %v", t, err)
+				return nil, fmt.Errorf("failed to sync token chain block, missing previous block id for token %v, error: %v", ti.Token, err)
 			}
+			tis = append(tis, TokenSyncInfo{Token: ti.Token, TokenType: ti.TokenType, BlockID: pblkID})
+		}
+		err = c.syncTokenChains(senderPeer, tis)
+		if err != nil {
+			return nil, err
+		}
 
-			err = c.syncTokenChainFrom(senderPeer, pblkID, t, ti.TokenType)
+		for _, ti := range tokenInfo {
+			t := ti.Token
+			pblkID, err := b.GetPrevBlockID(t)
 			if err != nil {
-				return nil, fmt.Errorf("failed to sync tokenchain Token: %v, issueType: %v", t, TokenChainNotSynced)
+				return nil, fmt.Errorf("failed to sync token chain block, missing previous block id for token %v, error: %v", t, err)
 			}
 
 			if c.TokenType(PartString) == ti.TokenType {
@@ -972,12 +1022,12 @@ func (c *Core) updateReceiverToken(
 				}
 				err = c.syncParentToken(senderPeer, pt)
 				if err != nil {
-					return nil, fmt.Errorf("failed to sync parent token %v childtoken %v err : ", pt, t, err)
+					return nil, fmt.Errorf("failed to sync parent token %v childtoken %v err : %v", pt, t, err)
 				}
 			}
 			ptcbArray, err := c.w.GetTokenBlock(t, ti.TokenType, pblkID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to fetch previous block for token: % err : %v", t, err)
+				return nil, fmt.Errorf("failed to fetch previous block for token: %v err : %v", t, err)
 			}
 			ptcb := block.InitBlock(ptcbArray, nil)
 			if c.checkIsPledged(ptcb) {
@@ -1042,18 +1092,27 @@ func (c *Core) updateReceiverToken(
 		return nil, fmt.Errorf("Failed to update token status, failed to get block ID, err: %v", err)
 	}
 
+	senderDID, contractReceiverDID, amount := sc.GetSenderDID(), sc.GetReceiverDID(), sc.GetTotalRBTs()
+	// the royalty of the NFT is paid by the executor of the NFT contract
+	if rti := sc.GetRoyaltyTokensInfo(); len(rti) > 0 {
+		senderDID, contractReceiverDID, amount = sc.GetExecutorDID(), receiverDID, 0
+		for _, ti := range rti {
+			amount = floatPrecision(amount+ti.TokenValue, MaxDecimalPlaces)
+		}
+	}
+
 	// Store the transaction info only when we are dealing with RBT transfer between
 	// two DIDs that are situated on different nodes, as this avoid Unique Constraint
 	// issue while adding to Transaction History table from the Sender's end
-	if sc.GetSenderDID() != sc.GetReceiverDID() && senderPeerId != receiverPeerId {
+	if senderDID != contractReceiverDID && senderPeerId != receiverPeerId {
 		td := &model.TransactionDetails{
 			TransactionID:   b.GetTid(),
 			TransactionType: b.GetTransType(),
 			BlockID:         bid,
 			Mode:            wallet.RecvMode,
-			Amount:          sc.GetTotalRBTs(),
-			SenderDID:       sc.GetSenderDID(),
-			ReceiverDID:     sc.GetReceiverDID(),
+			Amount:          amount,
+			SenderDID:       senderDID,
+			ReceiverDID:     contractReceiverDID,
 			Comment:         sc.GetComment(),
 			DateTime:        time.Now(),
 			Status:          true,
@@ -1102,6 +1161,11 @@ func (c *Core) updateReceiverTokenHandle(req *ensweb.Request) *ensweb.Result {
 		crep.Message = err.Error()
 		return c.l.RenderJSON(req, &crep, http.StatusOK)
 	}
+	c.emitWebhookEvent(did, model.WebhookTokensReceived, map[string]interface{}{
+		"sender":         sr.Address,
+		"transaction_id": blockTxnID(sr.TokenChainBlock),
+		"tokens":         sr.TokenInfo,
+	})
 
 	crep.Status = true
 	crep.Message = "Token received successfully"
@@ -1128,6 +1192,18 @@ func (c *Core) updateFTToken(senderAddress string, receiverAddress string, token
 		return nil, fmt.Errorf("failed to get peer : %v", err.Error())
 	}
 	defer senderPeer.Close()
+	tis := make([]TokenSyncInfo, 0, len(tokenInfo))
+	for _, ti := range tokenInfo {
+		pblkID, err := b.GetPrevBlockID(ti.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sync token chain block, missing previous block id for token %v, error: %v", ti.Token, err)
+		}
+		tis = append(tis, TokenSyncInfo{Token: ti.Token, TokenType: ti.TokenType, BlockID: pblkID})
+	}
+	err = c.syncTokenChains(senderPeer, tis)
+	if err != nil {
+		return nil, err
+	}
 	for _, ti := range tokenInfo {
 		t := ti.Token
 		pblkID, err := b.GetPrevBlockID(t)
@@ -1135,11 +1211,6 @@ func (c *Core) updateFTToken(senderAddress string, receiverAddress string, token
 			return nil, fmt.Errorf("failed to sync token chain block, missing previous block id for token %v, error: %v", t, err)
 		}
 
-		err = c.syncTokenChainFrom(senderPeer, pblkID, t, ti.TokenType)
-		if err != nil {
-			return nil, fmt.Errorf("failed to sync tokenchain Token: %v, issueType: %v", t, TokenChainNotSynced)
-		}
-
 		if c.TokenType(PartString) == ti.TokenType {
 			gb := c.w.GetGenesisTokenBlock(t, ti.TokenType)
 			if gb == nil {
@@ -1280,6 +1351,13 @@ func (c *Core) updateReceiverFTHandle(req *ensweb.Request) *ensweb.Result {
 		crep.Message = err.Error()
 		return c.l.RenderJSON(req, &crep, http.StatusOK)
 	}
+	c.emitWebhookEvent(did, model.WebhookFTReceived, map[string]interface{}{
+		"sender":         sr.Address,
+		"transaction_id": blockTxnID(sr.TokenChainBlock),
+		"ft_name":        sr.FTInfo.FTName,
+		"ft_count":       sr.FTInfo.FTCount,
+		"creator_did":    sr.FTInfo.CreatorDID,
+	})
 
 	crep.Status = true
 	crep.Message = "Token received successfully"
@@ -1314,6 +1392,15 @@ func (c *Core) signatureRequest(req *ensweb.Request) *ensweb.Result {
 		srep.Message = "Failed to do signature, invalid token chanin block"
 		return c.l.RenderJSON(req, &srep, http.StatusOK)
 	}
+	// blocks from the older nodes do not have the tokens merkle root
+	if b.GetTokensMerkleRoot() != "" {
+		err = b.VerifyTokensMerkleRoot()
+		if err != nil {
+			c.log.Error("Failed to do signature, invalid tokens merkle root", "err", err)
+			srep.Message = "Failed to do signature, " + err.Error()
+			return c.l.RenderJSON(req, &srep, http.StatusOK)
+		}
+	}
 	sig, err := b.GetSignature(dc)
 	if err != nil {
 		c.log.Error("Failed to do signature", "err", err)
@@ -1460,6 +1547,11 @@ func (c *Core) updatePledgeToken(req *ensweb.Request) *ensweb.Result {
 		}
 	}
 
+	c.emitWebhookEvent(did, model.WebhookPledged, map[string]interface{}{
+		"transaction_id": ur.TransactionID,
+		"tokens":         ur.PledgedTokens,
+	})
+
 	crep.Status = true
 	crep.Message = "Token pledge status updated"
 	return c.l.RenderJSON(req, &crep, http.StatusOK)
@@ -0,0 +1,326 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/util"
+)
+
+const (
+	QuorumHealthStorage string = "quorumhealth"
+)
+
+const (
+	FirstQuorumSelector          string = "first"
+	CreditWeightedQuorumSelector string = "credit_weighted"
+	LatencyQuorumSelector        string = "latency"
+)
+
+const (
+	DefaultMaxQuorumFailures   int = 3
+	DefaultQuorumExclusionTime int = 600
+)
+
+// QuorumHealth is the persisted health & success history of a quorum
+type QuorumHealth struct {
+	DID                 string    `gorm:"column:did;primaryKey" json:"did"`
+	SuccessCount        int       `gorm:"column:success_count" json:"success_count"`
+	FailureCount        int       `gorm:"column:failure_count" json:"failure_count"`
+	ConsecutiveFailures int       `gorm:"column:consecutive_failures" json:"consecutive_failures"`
+	Latency             int64     `gorm:"column:latency" json:"latency"`
+	LastSuccess         time.Time `gorm:"column:last_success" json:"last_success"`
+	LastFailure         time.Time `gorm:"column:last_failure" json:"last_failure"`
+}
+
+// SuccessRate returns the smoothed success rate of the quorum,
+// quorums without any history get the rate of 0.5
+func (qh *QuorumHealth) SuccessRate() float64 {
+	return float64(qh.SuccessCount+1) / float64(qh.SuccessCount+qh.FailureCount+2)
+}
+
+// QuorumCandidate is the quorum considered by the quorum selector
+type QuorumCandidate struct {
+	Address string
+	PeerID  string
+	DID     string
+	Health  QuorumHealth
+	Credit  int
+	Latency time.Duration
+}
+
+// QuorumSelector selects count quorums from the candidates in the order of preference
+type QuorumSelector interface {
+	SelectQuorum(candidates []QuorumCandidate, count int) []QuorumCandidate
+}
+
+// firstSelector selects the quorums in the configured order
+type firstSelector struct {
+}
+
+func (s *firstSelector) SelectQuorum(candidates []QuorumCandidate, count int) []QuorumCandidate {
+	if len(candidates) > count {
+		return candidates[:count]
+	}
+	return candidates
+}
+
+// creditWeightedSelector selects the quorums randomly, weighted by
+// the credit score and the success rate of the quorum
+type creditWeightedSelector struct {
+	r *rand.Rand
+}
+
+func (s *creditWeightedSelector) SelectQuorum(candidates []QuorumCandidate, count int) []QuorumCandidate {
+	rc := make([]QuorumCandidate, len(candidates))
+	copy(rc, candidates)
+	sl := make([]QuorumCandidate, 0)
+	for len(sl) < count && len(rc) > 0 {
+		total := float64(0)
+		w := make([]float64, len(rc))
+		for i := range rc {
+			w[i] = float64(rc[i].Credit+1) * rc[i].Health.SuccessRate()
+			total = total + w[i]
+		}
+		rv := s.r.Float64() * total
+		idx := len(rc) - 1
+		for i := range w {
+			rv = rv - w[i]
+			if rv < 0 {
+				idx = i
+				break
+			}
+		}
+		sl = append(sl, rc[idx])
+		rc = append(rc[:idx], rc[idx+1:]...)
+	}
+	return sl
+}
+
+// latencySelector selects the quorums with the lowest latency
+type latencySelector struct {
+}
+
+func (s *latencySelector) SelectQuorum(candidates []QuorumCandidate, count int) []QuorumCandidate {
+	sl := make([]QuorumCandidate, len(candidates))
+	copy(sl, candidates)
+	sort.SliceStable(sl, func(i, j int) bool {
+		return sl[i].Latency < sl[j].Latency
+	})
+	if len(sl) > count {
+		return sl[:count]
+	}
+	return sl
+}
+
+// NewQuorumSelector returns the quorum selector for the strategy name
+func NewQuorumSelector(name string) (QuorumSelector, error) {
+	switch name {
+	case "", FirstQuorumSelector:
+		return &firstSelector{}, nil
+	case CreditWeightedQuorumSelector:
+		return &creditWeightedSelector{r: rand.New(rand.NewSource(time.Now().UnixNano()))}, nil
+	case LatencyQuorumSelector:
+		return &latencySelector{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported quorum selector %s", name)
+	}
+}
+
+// GetQuorumHealth returns the persisted health of the quorum
+func (qm *QuorumManager) GetQuorumHealth(did string) QuorumHealth {
+	var qh QuorumHealth
+	err := qm.s.Read(QuorumHealthStorage, &qh, "did=?", did)
+	if err != nil {
+		return QuorumHealth{DID: did}
+	}
+	return qh
+}
+
+// GetAllQuorumHealth returns the persisted health of all the quorums
+func (qm *QuorumManager) GetAllQuorumHealth() ([]QuorumHealth, error) {
+	var qhs []QuorumHealth
+	err := qm.s.Read(QuorumHealthStorage, &qhs, "did!=?", "")
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []QuorumHealth{}, nil
+		}
+		return nil, err
+	}
+	return qhs, nil
+}
+
+// RecordQuorumResult updates the quorum health with the outcome of the request
+func (qm *QuorumManager) RecordQuorumResult(did string, success bool, latency time.Duration) {
+	qm.hl.Lock()
+	defer qm.hl.Unlock()
+	var qh QuorumHealth
+	exist := true
+	err := qm.s.Read(QuorumHealthStorage, &qh, "did=?", did)
+	if err != nil {
+		exist = false
+		qh = QuorumHealth{DID: did}
+	}
+	if success {
+		qh.SuccessCount++
+		qh.ConsecutiveFailures = 0
+		qh.LastSuccess = time.Now()
+		qh.Latency = latency.Milliseconds()
+	} else {
+		qh.FailureCount++
+		qh.ConsecutiveFailures++
+		qh.LastFailure = time.Now()
+	}
+	if exist {
+		err = qm.s.Update(QuorumHealthStorage, &qh, "did=?", did)
+	} else {
+		err = qm.s.Write(QuorumHealthStorage, &qh)
+	}
+	if err != nil {
+		qm.log.Error("Failed to update quorum health", "did", did, "err", err)
+	}
+}
+
+// isQuorumExcluded checks whether the quorum failed recently
+func isQuorumExcluded(qh *QuorumHealth, cc *config.ConsensusConfig) bool {
+	mf := cc.MaxQuorumFailures
+	if mf == 0 {
+		mf = DefaultMaxQuorumFailures
+	}
+	et := cc.QuorumExclusionTime
+	if et == 0 {
+		et = DefaultQuorumExclusionTime
+	}
+	if qh.ConsecutiveFailures < mf {
+		return false
+	}
+	return time.Since(qh.LastFailure) < time.Duration(et)*time.Second
+}
+
+// getQuorumCredit fetches the credit score of the quorum
+func (c *Core) getQuorumCredit(qc *QuorumCandidate) (int, error) {
+	p, err := c.getPeer(qc.Address, "")
+	if err != nil {
+		return 0, err
+	}
+	defer p.Close()
+	q := make(map[string]string)
+	q["did"] = qc.DID
+	var cs model.CreditStatus
+	err = p.SendJSONRequest("GET", APICreditStatus, q, nil, &cs, false, time.Minute)
+	if err != nil {
+		return 0, err
+	}
+	return cs.Score, nil
+}
+
+// probeQuorum measures the latency of the quorum using the quorum status check
+func (c *Core) probeQuorum(qc *QuorumCandidate) (time.Duration, error) {
+	st := time.Now()
+	_, ok, err := c.CheckQuorumStatus(qc.PeerID, qc.DID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("quorum is not setup")
+	}
+	return time.Since(st), nil
+}
+
+// selectQuorum selects the quorums for the consensus using the configured quorum selector,
+// quorums which failed recently are excluded as long as enough quorums are available
+func (c *Core) selectQuorum(ql []string, cp *config.ConsensusPolicy) ([]string, error) {
+	cc := &c.cfg.CfgData.ConsensusConfig
+	qs, err := NewQuorumSelector(cc.QuorumSelector)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]QuorumCandidate, 0)
+	excluded := make([]QuorumCandidate, 0)
+	for _, addr := range ql {
+		peerID, did, ok := util.ParseAddress(addr)
+		if !ok {
+			c.log.Error("Invalid quorum address", "addr", addr)
+			continue
+		}
+		qc := QuorumCandidate{
+			Address: addr,
+			PeerID:  peerID,
+			DID:     did,
+			Health:  c.qm.GetQuorumHealth(did),
+		}
+		if isQuorumExcluded(&qc.Health, cc) {
+			excluded = append(excluded, qc)
+		} else {
+			candidates = append(candidates, qc)
+		}
+	}
+	if len(candidates) < cp.MinQuorum {
+		// not enough healthy quorums, fall back to the quorums which failed least recently
+		sort.SliceStable(excluded, func(i, j int) bool {
+			return excluded[i].Health.LastFailure.Before(excluded[j].Health.LastFailure)
+		})
+		for i := range excluded {
+			if len(candidates) >= cp.MinQuorum {
+				break
+			}
+			c.log.Debug("Including recently failed quorum", "did", excluded[i].DID)
+			candidates = append(candidates, excluded[i])
+		}
+	}
+	switch cc.QuorumSelector {
+	case CreditWeightedQuorumSelector, LatencyQuorumSelector:
+		var wg sync.WaitGroup
+		var l sync.Mutex
+		reachable := make([]QuorumCandidate, 0)
+		for i := range candidates {
+			wg.Add(1)
+			go func(qc QuorumCandidate) {
+				defer wg.Done()
+				lt, err := c.probeQuorum(&qc)
+				if err != nil {
+					c.log.Error("Quorum is not reachable", "did", qc.DID, "err", err)
+					c.qm.RecordQuorumResult(qc.DID, false, 0)
+					return
+				}
+				qc.Latency = lt
+				if cc.QuorumSelector == CreditWeightedQuorumSelector {
+					qc.Credit, err = c.getQuorumCredit(&qc)
+					if err != nil {
+						c.log.Error("Failed to get quorum credit", "did", qc.DID, "err", err)
+					}
+				}
+				l.Lock()
+				reachable = append(reachable, qc)
+				l.Unlock()
+			}(candidates[i])
+		}
+		wg.Wait()
+		// keep the configured order for the selector input
+		sort.SliceStable(reachable, func(i, j int) bool {
+			return indexOfAddress(ql, reachable[i].Address) < indexOfAddress(ql, reachable[j].Address)
+		})
+		candidates = reachable
+	}
+	sl := qs.SelectQuorum(candidates, cp.QuorumCount)
+	fql := make([]string, 0)
+	for i := range sl {
+		fql = append(fql, sl[i].Address)
+	}
+	return fql, nil
+}
+
+func indexOfAddress(ql []string, addr string) int {
+	for i := range ql {
+		if ql[i] == addr {
+			return i
+		}
+	}
+	return len(ql)
+}
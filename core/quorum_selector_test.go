@@ -0,0 +1,204 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
+)
+
+// fakeHealthStorage keeps the quorum health table in memory
+type fakeHealthStorage struct {
+	storage.Storage
+	rows   map[string]QuorumHealth
+	writes int
+}
+
+func newFakeHealthStorage(rows ...QuorumHealth) *fakeHealthStorage {
+	fs := &fakeHealthStorage{rows: make(map[string]QuorumHealth)}
+	for _, qh := range rows {
+		fs.rows[qh.DID] = qh
+	}
+	return fs
+}
+
+func (fs *fakeHealthStorage) Write(storageName string, value interface{}) error {
+	qh := value.(*QuorumHealth)
+	fs.rows[qh.DID] = *qh
+	fs.writes++
+	return nil
+}
+
+func (fs *fakeHealthStorage) Update(storageName string, value interface{}, queryString string, queryValue ...interface{}) error {
+	return fs.Write(storageName, value)
+}
+
+func (fs *fakeHealthStorage) Read(storageName string, value interface{}, queryString string, queryValue ...interface{}) error {
+	switch v := value.(type) {
+	case *QuorumHealth:
+		qh, ok := fs.rows[queryValue[0].(string)]
+		if !ok {
+			return fmt.Errorf("no records found")
+		}
+		*v = qh
+	case *[]QuorumHealth:
+		if len(fs.rows) == 0 {
+			return fmt.Errorf("no records found")
+		}
+		for _, qh := range fs.rows {
+			*v = append(*v, qh)
+		}
+	}
+	return nil
+}
+
+func newTestLogger() logger.Logger {
+	return logger.New(&logger.LoggerOptions{
+		Name:   "test",
+		Level:  logger.Error,
+		Color:  []logger.ColorOption{logger.ColorOff},
+		Output: []io.Writer{io.Discard},
+	})
+}
+
+func newTestSelectorCore(cc config.ConsensusConfig, rows ...QuorumHealth) *Core {
+	log := newTestLogger()
+	return &Core{
+		cfg: &config.Config{CfgData: config.ConfigData{ConsensusConfig: cc}},
+		qm:  &QuorumManager{s: newFakeHealthStorage(rows...), log: log},
+		log: log,
+	}
+}
+
+func candidateDIDs(cl []QuorumCandidate) []string {
+	dl := make([]string, 0)
+	for _, qc := range cl {
+		dl = append(dl, qc.DID)
+	}
+	return dl
+}
+
+func TestFirstSelector(t *testing.T) {
+	qs, err := NewQuorumSelector("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := []QuorumCandidate{{DID: "a"}, {DID: "b"}, {DID: "c"}}
+	sl := qs.SelectQuorum(cl, 2)
+	if fmt.Sprint(candidateDIDs(sl)) != "[a b]" {
+		t.Fatalf("quorums are not selected in the order, %v", candidateDIDs(sl))
+	}
+	if len(qs.SelectQuorum(cl, 5)) != 3 {
+		t.Fatal("more quorums are selected than the candidates")
+	}
+	_, err = NewQuorumSelector("random")
+	if err == nil {
+		t.Fatal("unsupported quorum selector is accepted")
+	}
+}
+
+func TestCreditWeightedSelector(t *testing.T) {
+	qs := &creditWeightedSelector{r: rand.New(rand.NewSource(1))}
+	cl := []QuorumCandidate{
+		{DID: "a", Credit: 0, Health: QuorumHealth{FailureCount: 10}},
+		{DID: "b", Credit: 100, Health: QuorumHealth{SuccessCount: 10}},
+		{DID: "c", Credit: 0},
+	}
+	first := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		sl := qs.SelectQuorum(cl, 2)
+		if len(sl) != 2 || sl[0].DID == sl[1].DID {
+			t.Fatalf("invalid quorum selection, %v", candidateDIDs(sl))
+		}
+		first[sl[0].DID]++
+	}
+	if first["b"] < 900 || first["c"] <= first["a"] {
+		t.Fatalf("selection is not weighted by the credit & success rate, %v", first)
+	}
+	if fmt.Sprint(candidateDIDs(cl)) != "[a b c]" {
+		t.Fatal("candidates are modified by the selector")
+	}
+}
+
+func TestLatencySelector(t *testing.T) {
+	qs, err := NewQuorumSelector(LatencyQuorumSelector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cl := []QuorumCandidate{
+		{DID: "a", Latency: 30 * time.Millisecond},
+		{DID: "b", Latency: 10 * time.Millisecond},
+		{DID: "c", Latency: 20 * time.Millisecond},
+		{DID: "d", Latency: 10 * time.Millisecond},
+	}
+	sl := qs.SelectQuorum(cl, 3)
+	if fmt.Sprint(candidateDIDs(sl)) != "[b d c]" {
+		t.Fatalf("quorums are not selected by the latency, %v", candidateDIDs(sl))
+	}
+}
+
+func TestQuorumHealth(t *testing.T) {
+	c := newTestSelectorCore(config.ConsensusConfig{})
+	c.qm.RecordQuorumResult("a", true, 15*time.Millisecond)
+	c.qm.RecordQuorumResult("a", false, 0)
+	c.qm.RecordQuorumResult("a", false, 0)
+	qh := c.qm.GetQuorumHealth("a")
+	if qh.SuccessCount != 1 || qh.FailureCount != 2 || qh.ConsecutiveFailures != 2 || qh.Latency != 15 {
+		t.Fatalf("quorum health mismatch, %+v", qh)
+	}
+	c.qm.RecordQuorumResult("a", true, 5*time.Millisecond)
+	qh = c.qm.GetQuorumHealth("a")
+	if qh.ConsecutiveFailures != 0 || qh.Latency != 5 {
+		t.Fatalf("success does not reset the failures, %+v", qh)
+	}
+	if qh = c.qm.GetQuorumHealth("b"); qh.DID != "b" || qh.SuccessRate() != 0.5 {
+		t.Fatalf("health of the unknown quorum mismatch, %+v", qh)
+	}
+	qhs, err := c.qm.GetAllQuorumHealth()
+	if err != nil || len(qhs) != 1 {
+		t.Fatalf("failed to get all quorum health, %v", err)
+	}
+}
+
+func TestSelectQuorumExclusion(t *testing.T) {
+	now := time.Now()
+	cc := config.ConsensusConfig{MaxQuorumFailures: 2, QuorumExclusionTime: 60}
+	c := newTestSelectorCore(cc,
+		QuorumHealth{DID: "a", ConsecutiveFailures: 2, LastFailure: now},
+		QuorumHealth{DID: "b", ConsecutiveFailures: 3, LastFailure: now.Add(-time.Second)},
+		QuorumHealth{DID: "c", ConsecutiveFailures: 1, LastFailure: now},
+		QuorumHealth{DID: "d", ConsecutiveFailures: 5, LastFailure: now.Add(-2 * time.Minute)},
+	)
+	ql := []string{"p1.a", "p2.b", "p3.c", "p4.d", "p5.e"}
+	cases := []struct {
+		minQuorum int
+		count     int
+		selected  string
+	}{
+		// a & b failed recently, c is below the failure limit & d is past the exclusion time
+		{3, 3, "[p3.c p4.d p5.e]"},
+		// not enough healthy quorums, b failed before a so it is included first
+		{4, 4, "[p3.c p4.d p5.e p2.b]"},
+		{5, 5, "[p3.c p4.d p5.e p2.b p1.a]"},
+	}
+	for _, tc := range cases {
+		cp := &config.ConsensusPolicy{QuorumCount: tc.count, MinQuorum: tc.minQuorum, MinConsensus: tc.minQuorum}
+		sl, err := c.selectQuorum(ql, cp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fmt.Sprint(sl) != tc.selected {
+			t.Fatalf("min quorum %d selection mismatch, expected %s, got %v", tc.minQuorum, tc.selected, sl)
+		}
+	}
+	c.cfg.CfgData.ConsensusConfig.QuorumSelector = "random"
+	_, err := c.selectQuorum(ql, &config.ConsensusPolicy{QuorumCount: 3, MinQuorum: 3, MinConsensus: 3})
+	if err == nil {
+		t.Fatal("unsupported quorum selector is accepted")
+	}
+}
@@ -29,7 +29,7 @@ func (c *Core) validateSigner(b *block.Block, selfDID string, p *ipfsport.Peer)
 	signers, err := b.GetSigner()
 	if err != nil {
 		c.log.Error("failed to get signers", "err", err)
-		return false, fmt.Errorf("failed to get signers", "err", err)
+		return false, fmt.Errorf("failed to get signers, err: %v", err)
 	}
 	c.log.Debug("Signers", signers)
 	for _, signer := range signers {
@@ -39,7 +39,7 @@ func (c *Core) validateSigner(b *block.Block, selfDID string, p *ipfsport.Peer)
 			dc, err = c.SetupForienDID(signer, selfDID)
 			if err != nil {
 				c.log.Error("failed to setup foreign DID", "err", err)
-				return false, fmt.Errorf("failed to setup foreign DID : ", signer, "err", err)
+				return false, fmt.Errorf("failed to setup foreign DID : %v, err: %v", signer, err)
 			}
 		default:
 			signerDIDType, err := c.w.GetPeerDIDType(signer)
@@ -56,13 +56,13 @@ func (c *Core) validateSigner(b *block.Block, selfDID string, p *ipfsport.Peer)
 			dc, err = c.SetupForienDIDQuorum(signer, selfDID)
 			if err != nil {
 				c.log.Error("failed to setup foreign DID quorum", "err", err)
-				return false, fmt.Errorf("failed to setup foreign DID quorum : ", signer, "err", err)
+				return false, fmt.Errorf("failed to setup foreign DID quorum : %v, err: %v", signer, err)
 			}
 		}
 		err := b.VerifySignature(dc)
 		if err != nil {
 			c.log.Error("Failed to verify signature", "err", err)
-			return false, fmt.Errorf("Failed to verify signature", "err", err)
+			return false, fmt.Errorf("Failed to verify signature, err: %v", err)
 		}
 	}
 	return true, nil
@@ -170,12 +170,16 @@ func (c *Core) validateTokenOwnership(cr *ConensusRequest, sc *contract.Contract
 		return false, err
 	}
 	defer p.Close()
+	tis := make([]TokenSyncInfo, 0, len(ti))
+	for i := range ti {
+		tis = append(tis, TokenSyncInfo{Token: ti[i].Token, TokenType: ti[i].TokenType, BlockID: ti[i].BlockID})
+	}
+	err = c.syncTokenChains(p, tis)
+	if err != nil {
+		c.log.Error("Failed to sync token chain block", "err", err)
+		return false, err
+	}
 	for i := range ti {
-		err := c.syncTokenChainFrom(p, ti[i].BlockID, ti[i].Token, ti[i].TokenType)
-		if err != nil {
-			c.log.Error("Failed to sync token chain block", "err", err)
-			return false, fmt.Errorf("failed to sync tokenchain Token: %v, issueType: %v", ti[i].Token, TokenChainNotSynced)
-		}
 		fb := c.w.GetGenesisTokenBlock(ti[i].Token, ti[i].TokenType)
 		if fb == nil {
 			c.log.Error("Failed to get first token chain block")
@@ -215,13 +219,13 @@ func (c *Core) validateTokenOwnership(cr *ConensusRequest, sc *contract.Contract
 			}
 			if tid != ti[i].Token {
 				c.log.Error("Invalid token", "token", ti[i].Token, "exp_token", tid, "tl", tl, "tn", tn)
-				return false, fmt.Errorf("Invalid token", "token", ti[i].Token, "exp_token", tid, "tl", tl, "tn", tn)
+				return false, fmt.Errorf("Invalid token %v, exp_token %v, tl %v, tn %v", ti[i].Token, tid, tl, tn)
 			}
 		}
 		b := c.w.GetLatestTokenBlock(ti[i].Token, ti[i].TokenType)
 		if b == nil {
 			c.log.Error("Invalid token chain block")
-			return false, fmt.Errorf("Invalid token chain block for ", ti[i].Token)
+			return false, fmt.Errorf("Invalid token chain block for %v", ti[i].Token)
 		}
 		c.log.Info("Validating token ownership", "token", ti[i].Token, "owner", b.GetOwner(), "sender", sc.GetSenderDID())
 		pinningNodeDID := b.GetPinningNodeDID()
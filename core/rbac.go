@@ -0,0 +1,197 @@
+package core
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+	"github.com/rubixchain/rubixgoplatform/wrapper/uuid"
+)
+
+const (
+	APIKeyStorage string = "apikeys"
+)
+
+// Permissions required by the API calls
+const (
+	PermRead     string = "read"
+	PermDIDRead  string = "did_read"
+	PermDIDWrite string = "did_write"
+	PermSign     string = "sign"
+	PermOperate  string = "operate"
+	PermAdmin    string = "admin"
+)
+
+var rolePermissions = map[string][]string{
+	model.RoleAdmin:    {PermRead, PermDIDRead, PermDIDWrite, PermSign, PermOperate, PermAdmin},
+	model.RoleOperator: {PermRead, PermDIDRead, PermDIDWrite, PermSign, PermOperate},
+	model.RoleDIDOwner: {PermRead, PermDIDRead, PermDIDWrite, PermSign},
+	model.RoleReadOnly: {PermRead, PermDIDRead},
+}
+
+// Caller is the authenticated caller of the API
+type Caller struct {
+	Role string
+	// DIDs limits the caller to the DIDs, empty for all the DIDs
+	DIDs []string
+}
+
+func (cl *Caller) HasPermission(perm string) bool {
+	for _, p := range rolePermissions[cl.Role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// Scoped reports whether the caller is limited to its DIDs, admin is never limited
+func (cl *Caller) Scoped() bool {
+	return cl.Role != model.RoleAdmin && len(cl.DIDs) > 0
+}
+
+func (cl *Caller) HasDID(did string) bool {
+	if !cl.Scoped() {
+		return true
+	}
+	for _, d := range cl.DIDs {
+		if d == did {
+			return true
+		}
+	}
+	return false
+}
+
+// didFilter adds the DID condition of the query, the DID asked should be one
+// of the caller DIDs and scoped callers are limited to their DIDs
+func (cl *Caller) didFilter(did string, conds []string, args []interface{}) ([]string, []interface{}, error) {
+	if did != "" {
+		if !cl.HasDID(did) {
+			return nil, nil, fmt.Errorf("access denied for the DID %s", did)
+		}
+		return append(conds, "did=?"), append(args, did), nil
+	}
+	if cl.Scoped() {
+		return append(conds, "did IN ?"), append(args, cl.DIDs), nil
+	}
+	return conds, args, nil
+}
+
+func (c *Core) initAPIKeys() error {
+	err := c.s.Init(APIKeyStorage, &model.APIKey{}, true)
+	if err != nil {
+		c.log.Error("Failed to initialise storage api keys", "err", err)
+		return err
+	}
+	return nil
+}
+
+func apiKeyHash(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+// AddAPIKey adds the API key with the role, only the hash of the key is
+// stored so the key is returned only here
+func (c *Core) AddAPIKey(req *model.APIKeyRequest) (*model.APIKey, string, error) {
+	if _, ok := rolePermissions[req.Role]; !ok {
+		return nil, "", fmt.Errorf("invalid role %s", req.Role)
+	}
+	if req.Role == model.RoleDIDOwner && len(req.DIDs) == 0 {
+		return nil, "", fmt.Errorf("DIDs are required for the role %s", req.Role)
+	}
+	for _, did := range req.DIDs {
+		if !c.w.IsDIDExist(did) {
+			return nil, "", fmt.Errorf("DID %s does not exist", did)
+		}
+	}
+	kb := make([]byte, 32)
+	_, err := rand.Read(kb)
+	if err != nil {
+		return nil, "", err
+	}
+	key := hex.EncodeToString(kb)
+	ak := &model.APIKey{
+		ID:        uuid.New().String(),
+		Name:      req.Name,
+		KeyHash:   apiKeyHash(key),
+		Role:      req.Role,
+		DIDs:      strings.Join(req.DIDs, ","),
+		CreatedAt: time.Now(),
+	}
+	err = c.s.Write(APIKeyStorage, ak)
+	if err != nil {
+		c.log.Error("Failed to add the api key", "err", err)
+		return nil, "", fmt.Errorf("failed to add the api key")
+	}
+	return ak, key, nil
+}
+
+func (c *Core) RemoveAPIKey(id string) error {
+	var ak model.APIKey
+	err := c.s.Read(APIKeyStorage, &ak, "id=?", id)
+	if err != nil {
+		return fmt.Errorf("api key not found")
+	}
+	err = c.s.Delete(APIKeyStorage, &model.APIKey{}, "id=?", id)
+	if err != nil {
+		c.log.Error("Failed to remove the api key", "err", err)
+		return fmt.Errorf("failed to remove the api key")
+	}
+	return nil
+}
+
+func (c *Core) GetAPIKeys() ([]model.APIKey, error) {
+	var aks []model.APIKey
+	err := c.s.Read(APIKeyStorage, &aks, "id!=?", "")
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []model.APIKey{}, nil
+		}
+		c.log.Error("Failed to read the api keys", "err", err)
+		return nil, err
+	}
+	sort.Slice(aks, func(i, j int) bool {
+		return aks[i].CreatedAt.Before(aks[j].CreatedAt)
+	})
+	return aks, nil
+}
+
+// GetAPIKeyCaller returns the caller of the API key
+func (c *Core) GetAPIKeyCaller(key string) (*Caller, bool) {
+	if key == "" {
+		return nil, false
+	}
+	var ak model.APIKey
+	err := c.s.Read(APIKeyStorage, &ak, "key_hash=?", apiKeyHash(key))
+	if err != nil {
+		return nil, false
+	}
+	cl := &Caller{Role: ak.Role}
+	if ak.DIDs != "" {
+		cl.DIDs = strings.Split(ak.DIDs, ",")
+	}
+	return cl, true
+}
+
+// GetTokenCaller returns the caller of the DID access token, the root
+// token has the admin role and the others own the DID of the token
+func (c *Core) GetTokenCaller(token string) (*Caller, *setup.BearerToken, bool) {
+	if token == "" {
+		return nil, nil, false
+	}
+	bt, ok := c.ValidateDIDToken(token, setup.AccessTokenType, "")
+	if !ok {
+		return nil, nil, false
+	}
+	if bt.Root {
+		return &Caller{Role: model.RoleAdmin}, bt, true
+	}
+	return &Caller{Role: model.RoleDIDOwner, DIDs: []string{bt.DID}}, bt, true
+}
@@ -22,7 +22,13 @@ type SendRecoverRequest struct {
 }
 
 func (c *Core) InitiateRecoverRBT(reqID string, req *model.RBTRecoverRequest) {
+	err := c.startJob(reqID, JobRecoverRBT, req.Sender)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.initiateRecoverRBT(reqID, req)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
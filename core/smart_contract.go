@@ -22,6 +22,7 @@ const (
 const (
 	DeployType  int = 1
 	ExecuteType int = 2
+	UpgradeType int = 3
 )
 
 type NewState struct {
@@ -58,10 +59,15 @@ type SmartContractTokenResponse struct {
 }
 
 func (c *Core) GenerateSmartContractToken(requestID string, smartContractTokenRequest *GenerateSmartContractRequest) {
-
 	defer os.RemoveAll(smartContractTokenRequest.SCPath)
+	err := c.startJob(requestID, JobGenerateSmartContract, smartContractTokenRequest.DID)
+	if err != nil {
+		c.rejectJob(requestID, err)
+		return
+	}
 
 	smartContractTokenResponse := c.generateSmartContractToken(requestID, smartContractTokenRequest)
+	c.finishJob(requestID, smartContractTokenResponse)
 	dc := c.GetWebReq(requestID)
 	if dc == nil {
 		c.log.Error("failed to get web request", "requestID", requestID)
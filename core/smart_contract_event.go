@@ -0,0 +1,128 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+)
+
+const scEventsBuffer = 64
+
+// scEventSubs are the live subscribers of the smart contract events
+type scEventSubs struct {
+	lock sync.Mutex
+	subs map[chan model.SmartContractEventLog]*model.SmartContractEventFilter
+}
+
+func newSCEventSubs() *scEventSubs {
+	return &scEventSubs{
+		subs: make(map[chan model.SmartContractEventLog]*model.SmartContractEventFilter),
+	}
+}
+
+var scEventPager = &pager{
+	fields: map[string]pageField{
+		"block": {column: "block_number", kind: pageNumber},
+	},
+	key:  "event_id",
+	sort: "block",
+}
+
+func scEventLog(ev *wallet.SmartContractEvent) model.SmartContractEventLog {
+	return model.SmartContractEventLog{
+		ID:                 ev.EventID,
+		SmartContractToken: ev.Token,
+		BlockNumber:        ev.BlockNumber,
+		BlockID:            ev.BlockID,
+		TransactionID:      ev.TransactionID,
+		ExecutorDID:        ev.ExecutorDID,
+		Index:              ev.EventIndex,
+		Name:               ev.Name,
+		Topics:             ev.Topics(),
+		Data:               ev.Data,
+	}
+}
+
+// GetSmartContractEvents returns the page of the smart contract events
+// matching the filter, the events are ordered by the block
+func (c *Core) GetSmartContractEvents(f *model.SmartContractEventFilter, pr *model.PageRequest) (*model.SmartContractEventsReply, error) {
+	q, err := scEventPager.query(pr)
+	if err != nil {
+		return nil, err
+	}
+	if f.SmartContractToken != "" {
+		_, err = c.w.SyncSmartContractIndex(f.SmartContractToken, c.TokenType(SmartContractString))
+		if err != nil {
+			return nil, err
+		}
+		q.Where("token=?", f.SmartContractToken)
+	}
+	if f.Name != "" {
+		q.Where("name=?", f.Name)
+	}
+	if f.Topic != "" {
+		q.Where("(topic0=? OR topic1=? OR topic2=? OR topic3=?)", f.Topic, f.Topic, f.Topic, f.Topic)
+	}
+	if f.FromBlock > 0 {
+		q.Where("block_number>=?", f.FromBlock)
+	}
+	if f.ToBlock > 0 {
+		q.Where("block_number<=?", f.ToBlock)
+	}
+	evs, err := c.w.GetSmartContractEventsPage(q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart contract events")
+	}
+	n, next := scEventPager.next(pr, len(evs), func(i int, column string) (interface{}, string) {
+		return float64(evs[i].BlockNumber), evs[i].EventID
+	})
+	er := &model.SmartContractEventsReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got smart contract events",
+		},
+		Events:     make([]model.SmartContractEventLog, 0, n),
+		NextCursor: next,
+	}
+	for i := range evs[:n] {
+		er.Events = append(er.Events, scEventLog(&evs[i]))
+	}
+	return er, nil
+}
+
+// publishSCEvents sends the events of the new smart contract block to the
+// subscribers, the slow subscriber misses the events
+func (c *Core) publishSCEvents(evs []wallet.SmartContractEvent) {
+	c.sce.lock.Lock()
+	defer c.sce.lock.Unlock()
+	for i := range evs {
+		ev := scEventLog(&evs[i])
+		for ch, f := range c.sce.subs {
+			if !f.Match(&ev) {
+				continue
+			}
+			select {
+			case ch <- ev:
+			default:
+				c.log.Debug("Smart contract event subscriber is slow, event dropped", "id", ev.ID)
+			}
+		}
+	}
+}
+
+// SubscribeSmartContractEvents returns the channel of the new events matching
+// the filter, the cancel function should be called once done
+func (c *Core) SubscribeSmartContractEvents(f *model.SmartContractEventFilter) (chan model.SmartContractEventLog, func()) {
+	c.sce.lock.Lock()
+	defer c.sce.lock.Unlock()
+	ch := make(chan model.SmartContractEventLog, scEventsBuffer)
+	c.sce.subs[ch] = f
+	cancel := func() {
+		c.sce.lock.Lock()
+		defer c.sce.lock.Unlock()
+		delete(c.sce.subs, ch)
+	}
+	return ch, cancel
+}
@@ -0,0 +1,404 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/wasm"
+)
+
+// Smart contracts built for the engine import the host functions from the
+// "rubix" module and export the memory and the execute function. The execute
+// function returns 0 on success and the new state is set with set_state.
+//
+//	caller(ptr, cap) i32       executor DID
+//	contract(ptr, cap) i32     smart contract token
+//	state(ptr, cap) i32        state of the latest block
+//	input(ptr, cap) i32        execution input
+//	prev_block(ptr, cap) i32   latest block ID
+//	block_number() i64         number of the block being created
+//	block_epoch() i64          epoch of the transaction
+//	set_state(ptr, len)
+//	log(ptr, len)
+//	revert(ptr, len)
+//	emit(ptr, len)             JSON event {"name", "topics", "data"}
+//
+// The buffer functions copy at most cap bytes and return the full length so
+// that the contract can retry with the larger buffer.
+//
+// The code set by the upgrade may export the migrate function, it is run once
+// by the upgrade with the migration input as the input and the same host
+// functions.
+const (
+	SmartContractHostModule   string = "rubix"
+	SmartContractEntry        string = "execute"
+	SmartContractMigrateEntry string = "migrate"
+)
+
+const (
+	smartContractFuel     uint64 = 50000000
+	smartContractMaxPages uint32 = 256
+	hostCallFuel          uint64 = 100
+	maxSmartContractCode  int64  = 16 << 20
+	maxSmartContractState int    = 1 << 20
+)
+
+// errSmartContractDecode is set on the binaries fetched from IPFS that the
+// engine can not decode
+var errSmartContractDecode = errors.New("smart contract binary is not an engine module")
+
+// SmartContractContext is the input of the contract execution, the quorums
+// build the same context from the synced token chain
+type SmartContractContext struct {
+	Caller      string
+	Contract    string
+	State       string
+	Input       string
+	PrevBlockID string
+	BlockNumber uint64
+	Epoch       int64
+}
+
+// SmartContractResult is the result of the contract execution
+type SmartContractResult struct {
+	State    string
+	Logs     []string
+	Events   []block.SCEvent
+	FuelUsed uint64
+}
+
+// readSmartContractToken reads the code hashes of the smart contract token
+// from IPFS
+func (c *Core) readSmartContractToken(token string) (*SmartContractToken, error) {
+	tr, err := c.ipfs.Cat(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart contract token, %v", err)
+	}
+	tb, err := io.ReadAll(tr)
+	tr.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read smart contract token, %v", err)
+	}
+	var sct SmartContractToken
+	err = json.Unmarshal(tb, &sct)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse smart contract token, %v", err)
+	}
+	return &sct, nil
+}
+
+// smartContractCode returns the latest code of the contract, the code of the
+// smart contract token is used till the contract is upgraded
+func (c *Core) smartContractCode(token string) (*block.SCCode, error) {
+	vs, err := c.w.GetSmartContractVersions(token, c.TokenType(SmartContractString))
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) > 0 {
+		v := vs[len(vs)-1]
+		return &block.SCCode{
+			Version:        v.Version,
+			CodeToken:      v.CodeToken,
+			BinaryCodeHash: v.BinaryCodeHash,
+			RawCodeHash:    v.RawCodeHash,
+			SchemaCodeHash: v.SchemaCodeHash,
+		}, nil
+	}
+	sct, err := c.readSmartContractToken(token)
+	if err != nil {
+		return nil, err
+	}
+	code := &block.SCCode{
+		Version:        1,
+		CodeToken:      token,
+		BinaryCodeHash: sct.BinaryCodeHash,
+		RawCodeHash:    sct.RawCodeHash,
+		SchemaCodeHash: sct.SchemaCodeHash,
+	}
+	return code, nil
+}
+
+// getSmartContractModule returns the compiled latest code of the smart contract
+func (c *Core) getSmartContractModule(token string) (*wasm.Module, error) {
+	code, err := c.smartContractCode(token)
+	if err != nil {
+		return nil, err
+	}
+	return c.loadSmartContractModule(code.BinaryCodeHash)
+}
+
+// loadSmartContractModule fetches the binary from IPFS and compiles it, the
+// modules are cached by the binary hash. The binaries that are fetched but
+// not decoded return errSmartContractDecode.
+func (c *Core) loadSmartContractModule(binaryHash string) (*wasm.Module, error) {
+	if m, ok := c.scModules.Load(binaryHash); ok {
+		return m.(*wasm.Module), nil
+	}
+	br, err := c.ipfs.Cat(binaryHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart contract binary, %v", err)
+	}
+	code, err := io.ReadAll(io.LimitReader(br, maxSmartContractCode+1))
+	br.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read smart contract binary, %v", err)
+	}
+	m, err := decodeSmartContractModule(code)
+	if err != nil {
+		return nil, err
+	}
+	c.scModules.Store(binaryHash, m)
+	return m, nil
+}
+
+// decodeSmartContractModule compiles the fetched binary
+func decodeSmartContractModule(code []byte) (*wasm.Module, error) {
+	if int64(len(code)) > maxSmartContractCode {
+		return nil, fmt.Errorf("%w, binary is too large", errSmartContractDecode)
+	}
+	m, err := wasm.Decode(code)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", errSmartContractDecode, err)
+	}
+	return m, nil
+}
+
+// hasSmartContractEntry checks whether the module exports the entry function
+// built for the engine
+func hasSmartContractEntry(m *wasm.Module, entry string) bool {
+	ft, ok := m.ExportedFunc(entry)
+	if !ok || len(ft.Params) != 0 || len(ft.Results) != 1 || ft.Results[0] != wasm.I32 {
+		return false
+	}
+	for _, imp := range m.Imports() {
+		if imp[0] != SmartContractHostModule {
+			return false
+		}
+	}
+	return true
+}
+
+// isEngineContract checks whether the contract is built for the engine, the
+// other contracts are executed by the clients and only the data is recorded
+func isEngineContract(m *wasm.Module) bool {
+	return hasSmartContractEntry(m, SmartContractEntry)
+}
+
+// smartContractContext builds the execution context on top of the latest
+// block of the smart contract token chain
+func (c *Core) smartContractContext(token string, caller string, input string, epoch int) (*SmartContractContext, error) {
+	b := c.w.GetLatestTokenBlock(token, c.TokenType(SmartContractString))
+	if b == nil {
+		return nil, fmt.Errorf("smart contract token chain is not synced")
+	}
+	bn, err := b.GetBlockNumber(token)
+	if err != nil {
+		return nil, err
+	}
+	bid, err := b.GetBlockID(token)
+	if err != nil {
+		return nil, err
+	}
+	ctx := &SmartContractContext{
+		Caller:      caller,
+		Contract:    token,
+		State:       b.GetSmartContractData(),
+		Input:       input,
+		PrevBlockID: bid,
+		BlockNumber: bn + 1,
+		Epoch:       int64(epoch),
+	}
+	return ctx, nil
+}
+
+// runSmartContract calls the entry function of the contract, the execution
+// only depends on the code and the context so the quorums get the same state
+// as the executor
+func runSmartContract(m *wasm.Module, entry string, ctx *SmartContractContext) (*SmartContractResult, error) {
+	res := &SmartContractResult{State: ctx.State}
+	i32 := []wasm.ValueType{wasm.I32}
+	i64 := []wasm.ValueType{wasm.I64}
+	buf := []wasm.ValueType{wasm.I32, wasm.I32}
+	readArgs := func(inst *wasm.Instance, args []uint64) ([]byte, error) {
+		err := inst.UseFuel(hostCallFuel + args[1]/64)
+		if err != nil {
+			return nil, err
+		}
+		return inst.Read(uint32(args[0]), uint32(args[1]))
+	}
+	out := func(data string) *wasm.HostFunc {
+		return &wasm.HostFunc{
+			Type: wasm.FuncType{Params: buf, Results: i32},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				err := inst.UseFuel(hostCallFuel + uint64(len(data))/64)
+				if err != nil {
+					return nil, err
+				}
+				n := uint32(len(data))
+				if n > uint32(args[1]) {
+					n = uint32(args[1])
+				}
+				err = inst.Write(uint32(args[0]), []byte(data[:n]))
+				if err != nil {
+					return nil, err
+				}
+				return []uint64{uint64(len(data))}, nil
+			},
+		}
+	}
+	value := func(v uint64) *wasm.HostFunc {
+		return &wasm.HostFunc{
+			Type: wasm.FuncType{Results: i64},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				return []uint64{v}, inst.UseFuel(hostCallFuel)
+			},
+		}
+	}
+	host := map[string]*wasm.HostFunc{
+		"caller":       out(ctx.Caller),
+		"contract":     out(ctx.Contract),
+		"state":        out(ctx.State),
+		"input":        out(ctx.Input),
+		"prev_block":   out(ctx.PrevBlockID),
+		"block_number": value(ctx.BlockNumber),
+		"block_epoch":  value(uint64(ctx.Epoch)),
+		"set_state": {
+			Type: wasm.FuncType{Params: buf},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				if args[1] > uint64(maxSmartContractState) {
+					return nil, fmt.Errorf("smart contract state is too large")
+				}
+				b, err := readArgs(inst, args)
+				if err != nil {
+					return nil, err
+				}
+				res.State = string(b)
+				return nil, nil
+			},
+		},
+		"log": {
+			Type: wasm.FuncType{Params: buf},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				b, err := readArgs(inst, args)
+				if err != nil {
+					return nil, err
+				}
+				res.Logs = append(res.Logs, string(b))
+				return nil, nil
+			},
+		},
+		"emit": {
+			Type: wasm.FuncType{Params: buf},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				if len(res.Events) == block.MaxSCEvents {
+					return nil, fmt.Errorf("smart contract emitted more than %d events", block.MaxSCEvents)
+				}
+				if args[1] > uint64(block.MaxSCEventDataSize)*2 {
+					return nil, fmt.Errorf("smart contract event is too large")
+				}
+				b, err := readArgs(inst, args)
+				if err != nil {
+					return nil, err
+				}
+				var ev block.SCEvent
+				err = json.Unmarshal(b, &ev)
+				if err != nil {
+					return nil, fmt.Errorf("invalid smart contract event, %v", err)
+				}
+				err = ev.Validate()
+				if err != nil {
+					return nil, err
+				}
+				res.Events = append(res.Events, ev)
+				return nil, nil
+			},
+		},
+		"revert": {
+			Type: wasm.FuncType{Params: buf},
+			Func: func(inst *wasm.Instance, args []uint64) ([]uint64, error) {
+				b, err := readArgs(inst, args)
+				if err != nil {
+					return nil, err
+				}
+				return nil, fmt.Errorf("smart contract reverted, %s", string(b))
+			},
+		},
+	}
+	inst, err := m.Instantiate(wasm.Imports{SmartContractHostModule: host}, wasm.Config{Fuel: smartContractFuel, MaxPages: smartContractMaxPages})
+	if err != nil {
+		return nil, err
+	}
+	r, err := inst.Call(entry)
+	res.FuelUsed = inst.FuelUsed()
+	if err != nil {
+		return nil, err
+	}
+	if uint32(r[0]) != 0 {
+		return nil, fmt.Errorf("smart contract execution failed with code %d", int32(r[0]))
+	}
+	return res, nil
+}
+
+// executeSmartContract runs the contract on top of the latest block
+func (c *Core) executeSmartContract(token string, caller string, input string, epoch int) (*SmartContractResult, error) {
+	m, err := c.getSmartContractModule(token)
+	if err != nil {
+		return nil, err
+	}
+	if !isEngineContract(m) {
+		return nil, fmt.Errorf("smart contract does not support the execution engine")
+	}
+	ctx, err := c.smartContractContext(token, caller, input, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return runSmartContract(m, SmartContractEntry, ctx)
+}
+
+// verifySmartContractExecution re-executes the engine contract and checks the
+// state recorded by the executor, the contracts not built for the engine are
+// accepted as before. The legacy binaries may not decode with the engine, such
+// contracts are treated as not built for the engine unless the executor sent
+// the input of the engine execution. The binary must be fetched, otherwise the
+// quorum can not tell the legacy contract from the engine contract.
+func (c *Core) verifySmartContractExecution(cr *ConensusRequest, sc *contract.Contract) error {
+	input := sc.GetSmartContractInput()
+	m, err := c.getSmartContractModule(cr.SmartContractToken)
+	if err != nil {
+		if input != "" || !errors.Is(err, errSmartContractDecode) {
+			return err
+		}
+		c.log.Debug("Smart contract is not an engine contract", "token", cr.SmartContractToken, "err", err)
+	}
+	if err != nil || !isEngineContract(m) {
+		// the events are set by the executor, only the format is checked
+		_, err = block.DecodeSCEvents(sc.GetSmartContractEvents())
+		return err
+	}
+	if input == "" {
+		return fmt.Errorf("smart contract input is missing")
+	}
+	ctx, err := c.smartContractContext(cr.SmartContractToken, sc.GetExecutorDID(), input, cr.TransactionEpoch)
+	if err != nil {
+		return err
+	}
+	res, err := runSmartContract(m, SmartContractEntry, ctx)
+	if err != nil {
+		return err
+	}
+	if res.State != sc.GetSmartContractData() {
+		return fmt.Errorf("smart contract state mismatch")
+	}
+	evs, err := block.EncodeSCEvents(res.Events)
+	if err != nil {
+		return err
+	}
+	if evs != sc.GetSmartContractEvents() {
+		return fmt.Errorf("smart contract events mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ipfsnode "github.com/ipfs/go-ipfs-api"
+	"github.com/rubixchain/rubixgoplatform/contract"
+)
+
+func TestDecodeSmartContractModule(t *testing.T) {
+	m, err := decodeSmartContractModule([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if isEngineContract(m) {
+		t.Fatal("empty module is not an engine contract")
+	}
+	_, err = decodeSmartContractModule([]byte("legacy contract"))
+	if !errors.Is(err, errSmartContractDecode) {
+		t.Fatalf("expected the decode error, got %v", err)
+	}
+}
+
+func TestVerifySmartContractFetchError(t *testing.T) {
+	c := newTestJournalCore(t)
+	// no IPFS daemon listens on the port, the binary can not be fetched
+	c.ipfs = ipfsnode.NewShell("127.0.0.1:1")
+	tk := "Qm" + strings.Repeat("d", 44)
+	sc := contract.CreateNewContract(&contract.ContractType{
+		Type:      contract.SmartContractDeployType,
+		TransInfo: &contract.TransInfo{SmartContractToken: tk, ExecutorDID: "executor"},
+	})
+	if sc == nil {
+		t.Fatal("failed to create the contract")
+	}
+	err := c.verifySmartContractExecution(&ConensusRequest{SmartContractToken: tk}, sc)
+	if err == nil || errors.Is(err, errSmartContractDecode) {
+		t.Fatalf("expected the fetch error to fail the consensus, got %v", err)
+	}
+}
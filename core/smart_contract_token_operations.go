@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rubixchain/rubixgoplatform/block"
 	"github.com/rubixchain/rubixgoplatform/contract"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/core/wallet"
@@ -14,7 +15,13 @@ import (
 )
 
 func (c *Core) DeploySmartContractToken(reqID string, deployReq *model.DeploySmartContractRequest) {
+	err := c.startJob(reqID, JobDeploySmartContract, deployReq.DeployerAddress)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.deploySmartContractToken(reqID, deployReq)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -61,7 +68,9 @@ func (c *Core) deploySmartContractToken(reqID string, deployReq *model.DeploySma
 	}
 
 	rbtTokensToCommit := make([]string, 0)
-	defer c.w.ReleaseTokens(rbtTokensToCommitDetails)
+	crID := uuid.New().String()
+	defer c.releaseConsensusTokens(crID, rbtTokensToCommitDetails)
+	c.journalTokensLocked(crID, SmartContractDeployMode, did, rbtTokensToCommitDetails)
 
 	for i := range rbtTokensToCommitDetails {
 		c.w.Pin(rbtTokensToCommitDetails[i].TokenID, wallet.OwnerRole, did, "NA", "NA", "NA", float64(0)) //TODO: Ensure whether trnxId should be added ?
@@ -141,7 +150,7 @@ func (c *Core) deploySmartContractToken(reqID string, deployReq *model.DeploySma
 		return resp
 	}
 	conensusRequest := &ConensusRequest{
-		ReqID:              uuid.New().String(),
+		ReqID:              crID,
 		Type:               deployReq.QuorumType,
 		DeployerPeerID:     c.peerID,
 		ContractBlock:      consensusContract.GetBlock(),
@@ -150,6 +159,7 @@ func (c *Core) deploySmartContractToken(reqID string, deployReq *model.DeploySma
 		TransactionEpoch:   txEpoch,
 	}
 
+	c.linkTxnEvents(reqID, conensusRequest.ReqID)
 	txnDetails, _, pds, err := c.initiateConsensus(conensusRequest, consensusContract, didCryptoLib)
 
 	if err != nil {
@@ -196,7 +206,15 @@ func (c *Core) deploySmartContractToken(reqID string, deployReq *model.DeploySma
 }
 
 func (c *Core) ExecuteSmartContractToken(reqID string, executeReq *model.ExecuteSmartContractRequest) {
+	err := c.startJob(reqID, JobExecuteSmartContract, executeReq.ExecutorAddress)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.executeSmartContractToken(reqID, executeReq)
+	c.finishJob(reqID, br)
+	_, did, _ := util.ParseAddress(executeReq.ExecutorAddress)
+	c.emitTransferEvent(did, reqID, "smart_contract", "", br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -253,6 +271,34 @@ func (c *Core) executeSmartContractToken(reqID string, executeReq *model.Execute
 		return resp
 	}
 
+	// the engine contracts are executed here and the new state is recorded
+	smartContractData := executeReq.SmartContractData
+	scEvents := executeReq.SmartContractEvents
+	if executeReq.SmartContractInput != "" {
+		if len(scEvents) > 0 {
+			resp.Message = "Smart contract events are emitted by the contract, events can not be set with the input"
+			return resp
+		}
+		scRes, err := c.executeSmartContract(executeReq.SmartContractToken, did, executeReq.SmartContractInput, txEpoch)
+		if err != nil {
+			c.log.Error("Failed to execute smart contract", "err", err)
+			resp.Message = "Failed to execute smart contract, " + err.Error()
+			return resp
+		}
+		for _, l := range scRes.Logs {
+			c.log.Debug("Smart contract log", "token", executeReq.SmartContractToken, "msg", l)
+		}
+		c.log.Debug("Smart contract executed", "fuel", scRes.FuelUsed)
+		smartContractData = scRes.State
+		scEvents = scRes.Events
+	}
+	smartContractEvents, err := block.EncodeSCEvents(scEvents)
+	if err != nil {
+		c.log.Error("Invalid smart contract events", "err", err)
+		resp.Message = "Invalid smart contract events, " + err.Error()
+		return resp
+	}
+
 	smartContractInfoArray := make([]contract.TokenInfo, 0)
 	smartContractInfo := contract.TokenInfo{
 		Token:      executeReq.SmartContractToken,
@@ -268,11 +314,13 @@ func (c *Core) executeSmartContractToken(reqID string, executeReq *model.Execute
 		PledgeMode: contract.PeriodicPledgeMode,
 		TotalRBTs:  smartContractValue,
 		TransInfo: &contract.TransInfo{
-			ExecutorDID:        did,
-			Comment:            executeReq.Comment,
-			SmartContractToken: executeReq.SmartContractToken,
-			TransTokens:        smartContractInfoArray,
-			SmartContractData:  executeReq.SmartContractData,
+			ExecutorDID:         did,
+			Comment:             executeReq.Comment,
+			SmartContractToken:  executeReq.SmartContractToken,
+			TransTokens:         smartContractInfoArray,
+			SmartContractData:   smartContractData,
+			SmartContractInput:  executeReq.SmartContractInput,
+			SmartContractEvents: smartContractEvents,
 		},
 		ReqID: reqID,
 	}
@@ -306,6 +354,7 @@ func (c *Core) executeSmartContractToken(reqID string, executeReq *model.Execute
 		TransactionEpoch:   txEpoch,
 	}
 
+	c.linkTxnEvents(reqID, consensusRequest.ReqID)
 	txnDetails, _, pds, err := c.initiateConsensus(consensusRequest, consensusContract, didCryptoLib)
 
 	if err != nil {
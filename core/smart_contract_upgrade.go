@@ -0,0 +1,280 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/util"
+	"github.com/rubixchain/rubixgoplatform/wrapper/uuid"
+)
+
+func (c *Core) UpgradeSmartContractToken(reqID string, upgradeReq *model.UpgradeSmartContractRequest) {
+	err := c.startJob(reqID, JobUpgradeSmartContract, upgradeReq.DeployerAddress)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	br := c.upgradeSmartContractToken(reqID, upgradeReq)
+	c.finishJob(reqID, br)
+	dc := c.GetWebReq(reqID)
+	if dc == nil {
+		c.log.Error("Failed to get did channels")
+		return
+	}
+	dc.OutChan <- br
+}
+
+// smartContractMigration returns the next version of the contract with the
+// code of the code token and runs the migrate function of the code on top of
+// the latest block, the state is carried over if the code does not have the
+// migrate function
+func (c *Core) smartContractMigration(token string, codeToken string, deployer string, input string, epoch int) (*block.SCCode, *SmartContractResult, error) {
+	cur, err := c.smartContractCode(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cur.CodeToken == codeToken {
+		return nil, nil, fmt.Errorf("smart contract is already at the code of %s", codeToken)
+	}
+	sct, err := c.readSmartContractToken(codeToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	code := &block.SCCode{
+		Version:        cur.Version + 1,
+		CodeToken:      codeToken,
+		BinaryCodeHash: sct.BinaryCodeHash,
+		RawCodeHash:    sct.RawCodeHash,
+		SchemaCodeHash: sct.SchemaCodeHash,
+	}
+	m, err := c.loadSmartContractModule(code.BinaryCodeHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, err := c.smartContractContext(token, deployer, input, epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasSmartContractEntry(m, SmartContractMigrateEntry) {
+		if input != "" {
+			return nil, nil, fmt.Errorf("smart contract code does not have the migrate function")
+		}
+		return code, &SmartContractResult{State: ctx.State}, nil
+	}
+	res, err := runSmartContract(m, SmartContractMigrateEntry, ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("smart contract migration failed, %v", err)
+	}
+	return code, res, nil
+}
+
+func (c *Core) upgradeSmartContractToken(reqID string, upgradeReq *model.UpgradeSmartContractRequest) *model.BasicResponse {
+	st := time.Now()
+	txEpoch := int(st.Unix())
+
+	resp := &model.BasicResponse{
+		Status: false,
+	}
+	_, did, ok := util.ParseAddress(upgradeReq.DeployerAddress)
+	if !ok {
+		resp.Message = "Invalid Deployer DID"
+		return resp
+	}
+	didCryptoLib, err := c.SetupDID(reqID, did)
+	if err != nil {
+		resp.Message = "Failed to setup Deployer DID, " + err.Error()
+		return resp
+	}
+	tokenType := c.TokenType(SmartContractString)
+	gensysBlock := c.w.GetGenesisTokenBlock(upgradeReq.SmartContractToken, tokenType)
+	if gensysBlock == nil {
+		resp.Message = "Gensys block is empty - Smart contract Token chain not synced"
+		return resp
+	}
+	if gensysBlock.GetDeployerDID() != did {
+		resp.Message = "Only the deployer can upgrade the smart contract"
+		return resp
+	}
+	smartContractValue, err := gensysBlock.GetSmartContractValue(upgradeReq.SmartContractToken)
+	if err != nil {
+		c.log.Error("Failed to retrieve smart contract Token Value", "err", err)
+		resp.Message = err.Error()
+		return resp
+	}
+
+	code, res, err := c.smartContractMigration(upgradeReq.SmartContractToken, upgradeReq.CodeToken, did, upgradeReq.MigrationInput, txEpoch)
+	if err != nil {
+		c.log.Error("Failed to upgrade smart contract", "err", err)
+		resp.Message = "Failed to upgrade smart contract, " + err.Error()
+		return resp
+	}
+	for _, l := range res.Logs {
+		c.log.Debug("Smart contract log", "token", upgradeReq.SmartContractToken, "msg", l)
+	}
+	smartContractCode, err := block.EncodeSCCode(code)
+	if err != nil {
+		resp.Message = err.Error()
+		return resp
+	}
+	smartContractEvents, err := block.EncodeSCEvents(res.Events)
+	if err != nil {
+		resp.Message = "Invalid smart contract events, " + err.Error()
+		return resp
+	}
+
+	smartContractInfo := contract.TokenInfo{
+		Token:      upgradeReq.SmartContractToken,
+		TokenType:  tokenType,
+		TokenValue: smartContractValue,
+		OwnerDID:   did,
+	}
+	consensusContractDetails := &contract.ContractType{
+		Type:       contract.SmartContractDeployType,
+		PledgeMode: contract.PeriodicPledgeMode,
+		TotalRBTs:  smartContractValue,
+		TransInfo: &contract.TransInfo{
+			DeployerDID:         did,
+			Comment:             upgradeReq.Comment,
+			SmartContractToken:  upgradeReq.SmartContractToken,
+			TransTokens:         []contract.TokenInfo{smartContractInfo},
+			SmartContractData:   res.State,
+			SmartContractInput:  upgradeReq.MigrationInput,
+			SmartContractEvents: smartContractEvents,
+			SmartContractCode:   smartContractCode,
+		},
+		ReqID: reqID,
+	}
+	consensusContract := contract.CreateNewContract(consensusContractDetails)
+	if consensusContract == nil {
+		c.log.Error("Failed to create Consensus contract")
+		resp.Message = "Failed to create Consensus contract"
+		return resp
+	}
+	err = consensusContract.UpdateSignature(didCryptoLib)
+	if err != nil {
+		c.log.Error(err.Error())
+		resp.Message = err.Error()
+		return resp
+	}
+	consensusRequest := &ConensusRequest{
+		ReqID:              uuid.New().String(),
+		Type:               upgradeReq.QuorumType,
+		DeployerPeerID:     c.peerID,
+		ContractBlock:      consensusContract.GetBlock(),
+		SmartContractToken: upgradeReq.SmartContractToken,
+		Mode:               SmartContractUpgradeMode,
+		TransactionEpoch:   txEpoch,
+	}
+
+	c.linkTxnEvents(reqID, consensusRequest.ReqID)
+	txnDetails, _, _, err := c.initiateConsensus(consensusRequest, consensusContract, didCryptoLib)
+	if err != nil {
+		c.log.Error("Consensus failed", "err", err)
+		resp.Message = "Consensus failed" + err.Error()
+		return resp
+	}
+	dif := time.Since(st)
+	txnDetails.TotalTime = float64(dif.Milliseconds())
+	c.w.AddTransactionHistory(txnDetails)
+
+	c.log.Info("Smart Contract Token Upgraded successfully", "version", code.Version, "duration", dif)
+	resp.Status = true
+	resp.Message = fmt.Sprintf("Smart Contract Token Upgraded to version %d successfully in %v", code.Version, dif)
+	return resp
+}
+
+// verifySmartContractUpgrade checks that the deployer upgrades the contract and
+// runs the migration again to check the state recorded by the deployer
+func (c *Core) verifySmartContractUpgrade(cr *ConensusRequest, sc *contract.Contract) error {
+	gb := c.w.GetGenesisTokenBlock(cr.SmartContractToken, c.TokenType(SmartContractString))
+	if gb == nil {
+		return fmt.Errorf("smart contract token chain is not synced")
+	}
+	if gb.GetDeployerDID() != sc.GetDeployerDID() {
+		return fmt.Errorf("only the deployer can upgrade the smart contract")
+	}
+	code, err := block.DecodeSCCode(sc.GetSmartContractCode())
+	if err != nil {
+		return err
+	}
+	if code == nil {
+		return fmt.Errorf("smart contract code is missing")
+	}
+	ecode, res, err := c.smartContractMigration(cr.SmartContractToken, code.CodeToken, sc.GetDeployerDID(), sc.GetSmartContractInput(), cr.TransactionEpoch)
+	if err != nil {
+		return err
+	}
+	if *ecode != *code {
+		return fmt.Errorf("smart contract code mismatch")
+	}
+	if res.State != sc.GetSmartContractData() {
+		return fmt.Errorf("smart contract state mismatch")
+	}
+	evs, err := block.EncodeSCEvents(res.Events)
+	if err != nil {
+		return err
+	}
+	if evs != sc.GetSmartContractEvents() {
+		return fmt.Errorf("smart contract events mismatch")
+	}
+	return nil
+}
+
+// GetSmartContractVersions returns the code versions of the contract, the
+// first version is the code of the smart contract token
+func (c *Core) GetSmartContractVersions(token string) (*model.SmartContractVersionsReply, error) {
+	tt := c.TokenType(SmartContractString)
+	gb := c.w.GetGenesisTokenBlock(token, tt)
+	if gb == nil {
+		return nil, fmt.Errorf("smart contract token chain is not synced")
+	}
+	bn, err := gb.GetBlockNumber(token)
+	if err != nil {
+		return nil, err
+	}
+	bid, err := gb.GetBlockID(token)
+	if err != nil {
+		return nil, err
+	}
+	sct, err := c.readSmartContractToken(token)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := c.w.GetSmartContractVersions(token, tt)
+	if err != nil {
+		return nil, err
+	}
+	vr := &model.SmartContractVersionsReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got smart contract versions",
+		},
+		Token: token,
+		Versions: []model.SmartContractVersion{{
+			Version:        1,
+			BlockNumber:    bn,
+			BlockID:        bid,
+			TransactionID:  gb.GetTid(),
+			CodeToken:      token,
+			BinaryCodeHash: sct.BinaryCodeHash,
+			RawCodeHash:    sct.RawCodeHash,
+			SchemaCodeHash: sct.SchemaCodeHash,
+		}},
+	}
+	for _, v := range vs {
+		vr.Versions = append(vr.Versions, model.SmartContractVersion{
+			Version:        v.Version,
+			BlockNumber:    v.BlockNumber,
+			BlockID:        v.BlockID,
+			TransactionID:  v.TransactionID,
+			CodeToken:      v.CodeToken,
+			BinaryCodeHash: v.BinaryCodeHash,
+			RawCodeHash:    v.RawCodeHash,
+			SchemaCodeHash: v.SchemaCodeHash,
+		})
+	}
+	return vr, nil
+}
@@ -119,7 +119,15 @@ func (c *Core) ValidateSmartContractTokenChain(userDID string, tokenInfo wallet.
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
 				}
-			case block.TokenExecutedType:
+			case block.TokenExecutedType, block.TokenUpgradedType:
+				// only the deployer can upgrade the smart contract
+				if txnType == block.TokenUpgradedType {
+					gb := c.w.GetGenesisTokenBlock(tokenInfo.SmartContractHash, tokenType)
+					if gb == nil || gb.GetDeployerDID() != b.GetDeployerDID() {
+						response.Message = "smart contract is not upgraded by the deployer"
+						return response, fmt.Errorf("smart contract is not upgraded by the deployer")
+					}
+				}
 				//calculate previous block Id
 				prevBlock := block.InitBlock(blocks[i-1], nil)
 				prevBlockId, err = prevBlock.GetBlockID(tokenInfo.SmartContractHash)
@@ -179,7 +187,7 @@ func (c *Core) ValidateSmartContractTokenChain(userDID string, tokenInfo wallet.
 	return response, nil
 }
 
-// validate block of type: TokenTransferredType = "02" / TokenDeployedType = "09" / TokenExecutedType = "10"
+// validate block of type: TokenTransferredType = "02" / TokenDeployedType = "09" / TokenExecutedType = "10" / TokenUpgradedType = "14"
 func (c *Core) ValidateSmartContractBlock(b *block.Block, tokenId string, calculatedPrevBlockId string, userDID string) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{}
 
@@ -209,6 +217,9 @@ func (c *Core) ValidateSmartContractBlock(b *block.Block, tokenId string, calcul
 		response.Message = "smart contract deployed block validated successfully"
 		c.log.Debug("successfully validated smart contract deployed block")
 		// return response, nil
+	} else if b.GetTransType() == block.TokenUpgradedType {
+		response.Message = "smart contract upgraded block validated successfully"
+		c.log.Debug("successfully validated smart contract upgraded block")
 	} else { //smart contract executed mode
 		response.Message = "smart contract executed block validated successfully"
 		c.log.Debug("successfully validated smart contract executed block")
@@ -225,7 +236,7 @@ func (c *Core) ValidateTxnInitiator(b *block.Block) (*model.BasicResponse, error
 
 	var initiator string
 	txnType := b.GetTransType()
-	if txnType == block.TokenDeployedType {
+	if txnType == block.TokenDeployedType || txnType == block.TokenUpgradedType {
 		initiator = b.GetDeployerDID()
 	} else if txnType == block.TokenExecutedType {
 		initiator = b.GetExecutorDID()
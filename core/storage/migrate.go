@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	DefaultMigrationBatchSize int = 500
+)
+
+// Table is the storage name and the model of the table
+type Table struct {
+	Name  string
+	Value interface{}
+}
+
+// tableOrder returns the primary key columns of the model to page the table
+// in a stable order, all the columns are used if the model has no primary key
+func tableOrder(typ reflect.Type) string {
+	pk := make([]string, 0)
+	all := make([]string, 0)
+	for i := 0; i < typ.NumField(); i++ {
+		col := ""
+		isPK := false
+		for _, s := range strings.Split(typ.Field(i).Tag.Get("gorm"), ";") {
+			if strings.HasPrefix(s, "column:") {
+				col = strings.TrimPrefix(s, "column:")
+			}
+			if s == "primaryKey" {
+				isPK = true
+			}
+		}
+		if col == "" {
+			continue
+		}
+		all = append(all, col)
+		if isPK {
+			pk = append(pk, col)
+		}
+	}
+	if len(pk) == 0 {
+		pk = all
+	}
+	return strings.Join(pk, ",")
+}
+
+// MigrateTable copies all the records of the table from the source storage
+// to the destination storage, the destination table should be empty
+func MigrateTable(src Storage, dst Storage, t Table, batchSize int) (int, error) {
+	n, err := MigrateTables(src, dst, []Table{t}, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	return n[0], nil
+}
+
+// MigrateTables copies all the records of the tables from the source storage
+// to the destination storage in one transaction, so a failed migration leaves
+// the destination tables empty and can be run again
+func MigrateTables(src Storage, dst Storage, tables []Table, batchSize int) ([]int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultMigrationBatchSize
+	}
+	for _, t := range tables {
+		err := dst.Init(t.Name, t.Value, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize table %s, %v", t.Name, err)
+		}
+		if dst.GetDataCount(t.Name, "1=1") > 0 {
+			return nil, fmt.Errorf("table %s already has records in the destination storage", t.Name)
+		}
+	}
+	counts := make([]int, len(tables))
+	err := dst.WithTx(func(tx Storage) error {
+		for i, t := range tables {
+			n, err := copyTable(src, tx, t, batchSize)
+			if err != nil {
+				return err
+			}
+			counts[i] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func copyTable(src Storage, dst Storage, t Table, batchSize int) (int, error) {
+	typ := reflect.TypeOf(t.Value)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	order := tableOrder(typ)
+	count := 0
+	for {
+		rs := reflect.New(reflect.SliceOf(typ))
+		err := src.ReadPageWithOffset(t.Name, rs.Interface(), order, count, batchSize, "1=1")
+		if err != nil {
+			return count, fmt.Errorf("failed to read table %s, %v", t.Name, err)
+		}
+		n := rs.Elem().Len()
+		if n == 0 {
+			break
+		}
+		err = dst.WriteBatch(t.Name, rs.Interface(), batchSize)
+		if err != nil {
+			return count, fmt.Errorf("failed to write table %s, %v", t.Name, err)
+		}
+		count = count + n
+		if n < batchSize {
+			break
+		}
+	}
+	return count, nil
+}
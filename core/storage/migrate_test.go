@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/wrapper/config"
+)
+
+func TestMigrateTable(t *testing.T) {
+	src, err := NewStorageDB(&config.Config{DBAddress: "migrate_src.db", DBType: "Sqlite3"})
+	if err != nil {
+		t.Fatal("Failed to init DB", err.Error())
+	}
+	defer os.Remove("migrate_src.db")
+	dst, err := NewStorageDB(&config.Config{DBAddress: "migrate_dst.db", DBType: "Sqlite3", MaxOpenConns: 4})
+	if err != nil {
+		t.Fatal("Failed to init DB", err.Error())
+	}
+	defer os.Remove("migrate_dst.db")
+	if err := src.Init("user", &model{}, true); err != nil {
+		t.Fatal("Failed to initialize storage", err.Error())
+	}
+	for i := 0; i < 25; i++ {
+		if err := src.Write("user", &model{Name: fmt.Sprintf("TestUser%d", i), Age: i, Address: "Hyderabad"}); err != nil {
+			t.Fatal("Failed to write storage", err.Error())
+		}
+	}
+	n, err := MigrateTable(src, dst, Table{Name: "user", Value: &model{}}, 10)
+	if err != nil {
+		t.Fatal("Failed to migrate table", err.Error())
+	}
+	if n != 25 || dst.GetDataCount("user", "1=1") != 25 {
+		t.Fatal("Migrated record count miss match", n)
+	}
+	var m model
+	if err := dst.Read("user", &m, "Name=?", "TestUser24"); err != nil || m.Age != 24 {
+		t.Fatal("Failed to read migrated record")
+	}
+	if _, err := MigrateTable(src, dst, Table{Name: "user", Value: &model{}}, 10); err == nil {
+		t.Fatal("Migration into non empty table should fail")
+	}
+	src.Close()
+	dst.Close()
+}
+
+func TestTableOrder(t *testing.T) {
+	type nopk struct {
+		DID    string `gorm:"column:did"`
+		Credit string `gorm:"column:credit;size:4000"`
+	}
+	type cpk struct {
+		Token   string `gorm:"column:token;primaryKey"`
+		Version int    `gorm:"column:version;primaryKey"`
+		Code    string `gorm:"column:code"`
+	}
+	if o := tableOrder(reflect.TypeOf(model{})); o != "Name" {
+		t.Fatal("Invalid table order", o)
+	}
+	if o := tableOrder(reflect.TypeOf(nopk{})); o != "did,credit" {
+		t.Fatal("Invalid table order", o)
+	}
+	if o := tableOrder(reflect.TypeOf(cpk{})); o != "token,version" {
+		t.Fatal("Invalid table order", o)
+	}
+}
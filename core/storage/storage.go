@@ -12,8 +12,15 @@ type Storage interface {
 	Read(storageName string, vaule interface{}, querryString string, querryVaule ...interface{}) error
 	WriteBatch(storageName string, vaule interface{}, batchSize int) error
 	ReadWithOffset(storageName string, offset int, limit int, vaule interface{}, querryString string, querryVaule ...interface{}) error
+	// ReadPage reads upto limit rows sorted by the order, no rows is not an error
+	ReadPage(storageName string, vaule interface{}, order string, limit int, querryString string, querryVaule ...interface{}) error
+	// ReadPageWithOffset reads upto limit rows sorted by the order from the offset, no rows is not an error
+	ReadPageWithOffset(storageName string, vaule interface{}, order string, offset int, limit int, querryString string, querryVaule ...interface{}) error
 	GetDataCount(stroageName string, querryString string, querryVaule ...interface{}) int64
 	Drop(storageName string, value interface{}) error
+	// WithTx runs the function in a transaction, the changes made using tx are
+	// committed if the function returns nil, otherwise they are rolled back
+	WithTx(fn func(tx Storage) error) error
 	Close() error
 }
 
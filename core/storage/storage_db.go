@@ -8,6 +8,7 @@ import (
 
 type StorageDB struct {
 	ad *adapter.Adapter
+	tx bool
 }
 
 func NewStorageDB(cfg *config.Config) (*StorageDB, error) {
@@ -56,12 +57,33 @@ func (s *StorageDB) ReadWithOffset(stroageName string, offset int, limit int, va
 	return s.ad.FindWithOffset(uuid.Nil, stroageName, querryString, value, offset, limit, querryVaule...)
 }
 
+// ReadPage will read the page from the storage
+func (s *StorageDB) ReadPage(stroageName string, value interface{}, order string, limit int, querryString string, querryVaule ...interface{}) error {
+	return s.ad.FindPage(uuid.Nil, stroageName, querryString, value, order, limit, querryVaule...)
+}
+
+// ReadPageWithOffset will read the page from the offset
+func (s *StorageDB) ReadPageWithOffset(stroageName string, value interface{}, order string, offset int, limit int, querryString string, querryVaule ...interface{}) error {
+	return s.ad.FindPageWithOffset(uuid.Nil, stroageName, querryString, value, order, offset, limit, querryVaule...)
+}
+
 func (s *StorageDB) GetDataCount(stroageName string, querryString string, querryVaule ...interface{}) int64 {
 	return s.ad.GetCount(uuid.Nil, stroageName, querryString, querryVaule...)
 }
 
+// WithTx will run the function in a DB transaction
+func (s *StorageDB) WithTx(fn func(tx Storage) error) error {
+	return s.ad.Transaction(func(ad *adapter.Adapter) error {
+		return fn(&StorageDB{ad: ad, tx: true})
+	})
+}
+
 // Close will close the stroage BD
 func (s *StorageDB) Close() error {
+	// transaction shares the DB connection with the storage
+	if s.tx {
+		return nil
+	}
 	db, err := s.ad.GetDB().DB()
 	if err != nil {
 		return err
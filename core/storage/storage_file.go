@@ -56,10 +56,27 @@ func (s *StorageFile) ReadWithOffset(stroageName string, offset int, limit int,
 	return s.ad.FindWithOffset(uuid.Nil, stroageName, querryString, value, offset, limit, querryVaule...)
 }
 
+// ReadPage will read the page from the storage
+func (s *StorageFile) ReadPage(stroageName string, value interface{}, order string, limit int, querryString string, querryVaule ...interface{}) error {
+	return s.ad.FindPage(uuid.Nil, stroageName, querryString, value, order, limit, querryVaule...)
+}
+
+// ReadPageWithOffset will read the page from the offset
+func (s *StorageFile) ReadPageWithOffset(stroageName string, value interface{}, order string, offset int, limit int, querryString string, querryVaule ...interface{}) error {
+	return s.ad.FindPageWithOffset(uuid.Nil, stroageName, querryString, value, order, offset, limit, querryVaule...)
+}
+
 func (s *StorageFile) GetDataCount(stroageName string, querryString string, querryVaule ...interface{}) int64 {
 	return s.ad.GetCount(uuid.Nil, stroageName, querryString, querryVaule...)
 }
 
+// WithTx will run the function in a DB transaction
+func (s *StorageFile) WithTx(fn func(tx Storage) error) error {
+	return s.ad.Transaction(func(ad *adapter.Adapter) error {
+		return fn(&StorageDB{ad: ad, tx: true})
+	})
+}
+
 // Close will close the stroage BD
 func (s *StorageFile) Close() error {
 	db, err := s.ad.GetDB().DB()
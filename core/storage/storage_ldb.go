@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 )
 
 type StorageLDB struct {
@@ -149,3 +150,158 @@ func (s *StorageLDB) Read(storageName string, value interface{}, querryString st
 func (s *StorageLDB) Close() error {
 	return nil
 }
+
+// WithTx will run the function in a transaction, the changes are buffered
+// and written as a single batch when the function succeeds. Each storage is
+// a separate level DB, so the transaction can change only one storage and
+// fails without writing anything if the function changes more storages.
+// Only the key based Read, Write, Update and Delete are supported in the
+// transaction, the paged reads, counts and batch writes return an error.
+func (s *StorageLDB) WithTx(fn func(tx Storage) error) error {
+	t := &ldbTx{
+		s:  s,
+		b:  make(map[string]*leveldb.Batch),
+		pv: make(map[string]map[string][]byte),
+	}
+	err := fn(t)
+	if err != nil {
+		return err
+	}
+	if len(t.b) > 1 {
+		return fmt.Errorf("level DB transaction can not change more than one storage")
+	}
+	for storageName, b := range t.b {
+		err = s.writeBatch(storageName, b)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StorageLDB) writeBatch(storageName string, b *leveldb.Batch) error {
+	s.getStorage(storageName)
+	defer s.releaseStorage(storageName)
+	db, err := leveldb.OpenFile(s.dirPath+storageName+".db", nil)
+	if err != nil {
+		return err
+	}
+	err = db.Write(b, &opt.WriteOptions{Sync: true})
+	db.Close()
+	return err
+}
+
+// ldbTx is the transaction of the level DB storage
+type ldbTx struct {
+	s  *StorageLDB
+	b  map[string]*leveldb.Batch
+	pv map[string]map[string][]byte
+}
+
+func (t *ldbTx) put(storageName string, k string, vb []byte) {
+	b, ok := t.b[storageName]
+	if !ok {
+		b = new(leveldb.Batch)
+		t.b[storageName] = b
+		t.pv[storageName] = make(map[string][]byte)
+	}
+	if vb == nil {
+		b.Delete([]byte(k))
+	} else {
+		b.Put([]byte(k), vb)
+	}
+	t.pv[storageName][k] = vb
+}
+
+func (t *ldbTx) Init(storageName string, value interface{}, force bool) error {
+	return t.s.Init(storageName, value)
+}
+
+func (t *ldbTx) Write(storageName string, value interface{}) error {
+	v, ok := value.(*StorageType)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	vb, err := json.Marshal(v.Value)
+	if err != nil {
+		return err
+	}
+	t.put(storageName, v.Key, vb)
+	return nil
+}
+
+func (t *ldbTx) Update(storageName string, value interface{}, querryString string, querryVaule ...interface{}) error {
+	v, ok := value.(*StorageType)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	k, ok := querryVaule[0].(string)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	v.Key = k
+	return t.Write(storageName, value)
+}
+
+func (t *ldbTx) Delete(storageName string, value interface{}, querryString string, querryVaule ...interface{}) error {
+	k, ok := querryVaule[0].(string)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	t.put(storageName, k, nil)
+	return nil
+}
+
+func (t *ldbTx) Read(storageName string, value interface{}, querryString string, querryVaule ...interface{}) error {
+	v, ok := value.(*StorageType)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	k, ok := querryVaule[0].(string)
+	if !ok {
+		return fmt.Errorf("invalid data type")
+	}
+	vb, ok := t.pv[storageName][k]
+	if !ok {
+		return t.s.Read(storageName, value, querryString, querryVaule...)
+	}
+	if vb == nil {
+		return leveldb.ErrNotFound
+	}
+	v.Key = k
+	return json.Unmarshal(vb, &v.Value)
+}
+
+func (t *ldbTx) WriteBatch(storageName string, value interface{}, batchSize int) error {
+	return fmt.Errorf("batch write is not supported")
+}
+
+func (t *ldbTx) ReadWithOffset(storageName string, offset int, limit int, value interface{}, querryString string, querryVaule ...interface{}) error {
+	return fmt.Errorf("read with offset is not supported")
+}
+
+func (t *ldbTx) ReadPage(storageName string, value interface{}, order string, limit int, querryString string, querryVaule ...interface{}) error {
+	return fmt.Errorf("read page is not supported")
+}
+
+func (t *ldbTx) ReadPageWithOffset(storageName string, value interface{}, order string, offset int, limit int, querryString string, querryVaule ...interface{}) error {
+	return fmt.Errorf("read page is not supported")
+}
+
+// GetDataCount is not supported in the transaction, the level DB storage
+// does not keep the count of the records
+func (t *ldbTx) GetDataCount(storageName string, querryString string, querryVaule ...interface{}) int64 {
+	return 0
+}
+
+func (t *ldbTx) Drop(storageName string, value interface{}) error {
+	return fmt.Errorf("drop is not supported")
+}
+
+func (t *ldbTx) WithTx(fn func(tx Storage) error) error {
+	return fn(t)
+}
+
+func (t *ldbTx) Close() error {
+	return nil
+}
@@ -212,3 +212,71 @@ func TestNodeDB(t *testing.T) {
 	iter.Release()
 	db.Close()
 }
+
+func TestWithTx(t *testing.T) {
+	s, err := NewStorageDB(&config.Config{DBAddress: "tx_test.db", DBType: "Sqlite3"})
+	if err != nil {
+		t.Fatal("Failed to init DB", err.Error())
+	}
+	defer os.Remove("tx_test.db")
+	defer s.Close()
+	if err := s.Init("user", &model{}, true); err != nil {
+		t.Fatal("Failed to initialize storage", err.Error())
+	}
+	err = s.WithTx(func(tx Storage) error {
+		if err := tx.Write("user", &model{Name: "TestUser1", Age: 20}); err != nil {
+			return err
+		}
+		return fmt.Errorf("rollback")
+	})
+	if err == nil {
+		t.Fatal("Transaction should fail")
+	}
+	var m model
+	if err := s.Read("user", &m, "Name=?", "TestUser1"); err == nil {
+		t.Fatal("Transaction is not rolled back")
+	}
+	err = s.WithTx(func(tx Storage) error {
+		if err := tx.Write("user", &model{Name: "TestUser1", Age: 20}); err != nil {
+			return err
+		}
+		return tx.Update("user", &model{Name: "TestUser1", Age: 21}, "Name=?", "TestUser1")
+	})
+	if err != nil {
+		t.Fatal("Failed to commit transaction", err.Error())
+	}
+	if err := s.Read("user", &m, "Name=?", "TestUser1"); err != nil || m.Age != 21 {
+		t.Fatal("Transaction is not committed")
+	}
+}
+
+func TestLDBWithTx(t *testing.T) {
+	s, err := NewStorageLDB("ldbtx_")
+	if err != nil {
+		t.Fatal("Failed to init storage", err.Error())
+	}
+	defer os.RemoveAll("ldbtx_one.db")
+	defer os.RemoveAll("ldbtx_two.db")
+	err = s.WithTx(func(tx Storage) error {
+		if err := tx.Write("one", &StorageType{Key: "k", Value: "v"}); err != nil {
+			return err
+		}
+		return tx.Write("two", &StorageType{Key: "k", Value: "v"})
+	})
+	if err == nil {
+		t.Fatal("Transaction changing more than one storage should fail")
+	}
+	var st StorageType
+	if err := s.Read("one", &st, "key=?", "k"); err == nil {
+		t.Fatal("Failed transaction should not write")
+	}
+	err = s.WithTx(func(tx Storage) error {
+		return tx.Write("one", &StorageType{Key: "k", Value: "v"})
+	})
+	if err != nil {
+		t.Fatal("Failed to commit transaction", err.Error())
+	}
+	if err := s.Read("one", &st, "key=?", "k"); err != nil || st.Value != "v" {
+		t.Fatal("Transaction is not committed")
+	}
+}
@@ -0,0 +1,723 @@
+package testnet
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	ipfsnode "github.com/ipfs/go-ipfs-api"
+	"github.com/multiformats/go-multihash"
+)
+
+// IPFS is the in-process IPFS network shared by the test nodes, it serves the
+// part of the IPFS HTTP API used by the core. The content is stored in memory
+// and the p2p streams are plain TCP proxies to the target node listener.
+type IPFS struct {
+	lock      sync.Mutex
+	objects   map[string]*ipfsObject
+	providers map[string]map[string]bool
+	listeners map[string]string
+	subs      map[string][]*ipfsSub
+	nodes     map[string]*IPFSNode
+	seqno     uint64
+}
+
+// IPFSNode is the IPFS API endpoint of one test node
+type IPFSNode struct {
+	ipfs     *IPFS
+	peerID   string
+	srv      *httptest.Server
+	lock     sync.Mutex
+	forwards map[string]net.Listener
+}
+
+type ipfsObject struct {
+	data  []byte
+	dir   bool
+	links map[string]string
+}
+
+type ipfsSub struct {
+	ch   chan []byte
+	done chan struct{}
+}
+
+type ipfsEntry struct {
+	name  string
+	dir   bool
+	data  []byte
+	child []*ipfsEntry
+}
+
+type ipfsAddOutput struct {
+	Name string
+	Hash string
+	Size string
+}
+
+type ipfsError struct {
+	Message string
+	Code    int
+	Type    string
+}
+
+// NewIPFS creates the empty IPFS network
+func NewIPFS() *IPFS {
+	return &IPFS{
+		objects:   make(map[string]*ipfsObject),
+		providers: make(map[string]map[string]bool),
+		listeners: make(map[string]string),
+		subs:      make(map[string][]*ipfsSub),
+		nodes:     make(map[string]*IPFSNode),
+	}
+}
+
+// peerIDFromSeed returns the libp2p peer id of the ed25519 key derived from the seed
+func peerIDFromSeed(seed int) string {
+	s := make([]byte, ed25519.SeedSize)
+	copy(s, fmt.Sprintf("rubix-testnet-peer-%d", seed))
+	pub := ed25519.NewKeyFromSeed(s).Public().(ed25519.PublicKey)
+	// protobuf encoded public key, key type ed25519
+	pb := append([]byte{0x08, 0x01, 0x12, 0x20}, pub...)
+	mh, _ := multihash.Sum(pb, multihash.IDENTITY, -1)
+	return mh.B58String()
+}
+
+// NewNode starts the IPFS API endpoint of the node, the peer id is derived
+// from the seed so the network is reproducible
+func (f *IPFS) NewNode(seed int) *IPFSNode {
+	n := &IPFSNode{
+		ipfs:     f,
+		peerID:   peerIDFromSeed(seed),
+		forwards: make(map[string]net.Listener),
+	}
+	n.srv = httptest.NewServer(http.HandlerFunc(n.serve))
+	f.lock.Lock()
+	f.nodes[n.peerID] = n
+	f.lock.Unlock()
+	return n
+}
+
+// PeerID returns the peer id of the node
+func (n *IPFSNode) PeerID() string {
+	return n.peerID
+}
+
+// Shell returns the IPFS shell connected to the node
+func (n *IPFSNode) Shell() *ipfsnode.Shell {
+	return ipfsnode.NewShell(strings.TrimPrefix(n.srv.URL, "http://"))
+}
+
+// Close closes the p2p forwards of the node, the API endpoint is left running
+// as the pubsub readers of the core never stop
+func (n *IPFSNode) Close() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for addr, l := range n.forwards {
+		l.Close()
+		delete(n.forwards, addr)
+	}
+	n.ipfs.lock.Lock()
+	for k := range n.ipfs.listeners {
+		if strings.HasPrefix(k, n.peerID+"/") {
+			delete(n.ipfs.listeners, k)
+		}
+	}
+	n.ipfs.lock.Unlock()
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(&ipfsError{Message: err.Error(), Code: 0, Type: "error"})
+}
+
+func (n *IPFSNode) serve(w http.ResponseWriter, r *http.Request) {
+	cmd := strings.TrimPrefix(r.URL.Path, "/api/v0/")
+	q := r.URL.Query()
+	args := q["arg"]
+	var err error
+	switch cmd {
+	case "id":
+		writeJSON(w, &ipfsnode.IdOutput{ID: n.peerID, Addresses: []string{}})
+	case "add":
+		err = n.add(w, r)
+	case "cat":
+		err = n.cat(w, args)
+	case "get":
+		err = n.get(w, args)
+	case "pin/add":
+		err = n.pin(w, args, true)
+	case "pin/rm":
+		err = n.pin(w, args, false)
+	case "swarm/connect":
+		err = n.swarmConnect(w, args)
+	case "bootstrap/add", "bootstrap/rm/all":
+		writeJSON(w, map[string][]string{"Peers": args})
+	case "config":
+		writeJSON(w, map[string]interface{}{})
+	case "dht/findprovs":
+		err = n.findProvs(w, args)
+	case "p2p/listen":
+		err = n.p2pListen(w, args)
+	case "p2p/forward":
+		err = n.p2pForward(w, args)
+	case "p2p/close":
+		err = n.p2pClose(w, q.Get("listen-address"))
+	case "pubsub/sub":
+		err = n.subscribe(w, r, args)
+	case "pubsub/pub":
+		err = n.publish(w, r, args)
+	default:
+		err = fmt.Errorf("command %s is not supported", cmd)
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func hashCode(name string) (uint64, error) {
+	switch name {
+	case "", "sha2-256":
+		return multihash.SHA2_256, nil
+	case "sha3-256":
+		return multihash.SHA3_256, nil
+	case "sha3-512", "sha3":
+		return multihash.SHA3_512, nil
+	}
+	return 0, fmt.Errorf("unsupported hash %s", name)
+}
+
+// objectCid returns the cid of the object, the directory content is the sorted
+// list of the links so the same tree always gets the same cid
+func objectCid(o *ipfsObject, version int, hash string) (string, error) {
+	code, err := hashCode(hash)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if o.dir {
+		b.WriteString("dir\n")
+		names := make([]string, 0, len(o.links))
+		for name := range o.links {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b.WriteString(name + " " + o.links[name] + "\n")
+		}
+	} else {
+		b.WriteString("file\n")
+		b.Write(o.data)
+	}
+	mh, err := multihash.Sum(b.Bytes(), code, -1)
+	if err != nil {
+		return "", err
+	}
+	if version == 0 && code == multihash.SHA2_256 {
+		return cid.NewCidV0(mh).String(), nil
+	}
+	codec := uint64(cid.DagProtobuf)
+	if !o.dir {
+		codec = cid.Raw
+	}
+	return cid.NewCidV1(codec, mh).String(), nil
+}
+
+func boolOption(q url.Values, name string, def bool) bool {
+	v := q.Get(name)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+// readEntries reads the multipart body into the file tree
+func readEntries(r *http.Request) ([]*ipfsEntry, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]*ipfsEntry, 0)
+	dirs := make(map[string]*ipfsEntry)
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the filename is read from the header as the part file name drops the path
+		_, params, err := mime.ParseMediaType(p.Header.Get("Content-Disposition"))
+		if err != nil {
+			return nil, err
+		}
+		name, err := url.QueryUnescape(params["filename"])
+		if err != nil {
+			return nil, err
+		}
+		e := &ipfsEntry{name: name}
+		switch p.Header.Get("Content-Type") {
+		case "application/x-directory":
+			e.dir = true
+			dirs[name] = e
+		case "application/symlink":
+			continue
+		default:
+			e.data, err = ioutil.ReadAll(p)
+			if err != nil {
+				return nil, err
+			}
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			roots = append(roots, e)
+			continue
+		}
+		parent, ok := dirs[name[:i]]
+		if !ok {
+			return nil, fmt.Errorf("missing parent directory of %s", name)
+		}
+		e.name = name[i+1:]
+		parent.child = append(parent.child, e)
+	}
+	return roots, nil
+}
+
+// addEntry stores the entry and returns its cid, the outputs are added children first
+func (f *IPFS) addEntry(e *ipfsEntry, path string, version int, hash string, store bool, out *[]ipfsAddOutput) (string, error) {
+	o := &ipfsObject{data: e.data, dir: e.dir}
+	if e.dir {
+		o.links = make(map[string]string)
+		for _, c := range e.child {
+			id, err := f.addEntry(c, path+"/"+c.name, version, hash, store, out)
+			if err != nil {
+				return "", err
+			}
+			o.links[c.name] = id
+		}
+	}
+	id, err := objectCid(o, version, hash)
+	if err != nil {
+		return "", err
+	}
+	if store {
+		f.objects[id] = o
+	}
+	if path == "" {
+		path = id
+	}
+	*out = append(*out, ipfsAddOutput{Name: path, Hash: id, Size: strconv.Itoa(len(e.data))})
+	return id, nil
+}
+
+func (n *IPFSNode) add(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	version := 0
+	if v := q.Get("cid-version"); v != "" {
+		version, _ = strconv.Atoi(v)
+	}
+	onlyHash := boolOption(q, "only-hash", false)
+	pin := boolOption(q, "pin", true)
+	roots, err := readEntries(r)
+	if err != nil {
+		return err
+	}
+	out := make([]ipfsAddOutput, 0)
+	f := n.ipfs
+	f.lock.Lock()
+	for _, e := range roots {
+		var id string
+		id, err = f.addEntry(e, e.name, version, q.Get("hash"), !onlyHash, &out)
+		if err != nil {
+			break
+		}
+		if !onlyHash && pin {
+			f.provide(id, n.peerID, true)
+		}
+	}
+	f.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	for i := range out {
+		enc.Encode(&out[i])
+	}
+	return nil
+}
+
+// provide updates the providers of the object, caller should hold the lock
+func (f *IPFS) provide(id string, peerID string, add bool) {
+	pm, ok := f.providers[id]
+	if !ok {
+		pm = make(map[string]bool)
+		f.providers[id] = pm
+	}
+	if add {
+		pm[peerID] = true
+	} else {
+		delete(pm, peerID)
+	}
+}
+
+// resolve returns the object of the ipfs path
+func (f *IPFS) resolve(path string) (string, *ipfsObject, error) {
+	path = strings.TrimPrefix(path, "/ipfs/")
+	elems := strings.Split(strings.Trim(path, "/"), "/")
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	id := elems[0]
+	o, ok := f.objects[id]
+	if !ok {
+		return "", nil, fmt.Errorf("%s not found", id)
+	}
+	for _, e := range elems[1:] {
+		if !o.dir {
+			return "", nil, fmt.Errorf("%s is not a directory", id)
+		}
+		id, ok = o.links[e]
+		if !ok {
+			return "", nil, fmt.Errorf("no link named %s", e)
+		}
+		o = f.objects[id]
+	}
+	return id, o, nil
+}
+
+func (n *IPFSNode) cat(w http.ResponseWriter, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("argument path is required")
+	}
+	_, o, err := n.ipfs.resolve(args[0])
+	if err != nil {
+		return err
+	}
+	if o.dir {
+		return fmt.Errorf("this dag node is a directory")
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write(o.data)
+	return nil
+}
+
+func (f *IPFS) writeTar(tw *tar.Writer, name string, o *ipfsObject) error {
+	if !o.dir {
+		err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(o.data))})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(o.data)
+		return err
+	}
+	err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeDir, Mode: 0755})
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(o.links))
+	for ln := range o.links {
+		names = append(names, ln)
+	}
+	sort.Strings(names)
+	for _, ln := range names {
+		f.lock.Lock()
+		co := f.objects[o.links[ln]]
+		f.lock.Unlock()
+		if co == nil {
+			return fmt.Errorf("%s not found", o.links[ln])
+		}
+		err = f.writeTar(tw, name+"/"+ln, co)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *IPFSNode) get(w http.ResponseWriter, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("argument path is required")
+	}
+	id, o, err := n.ipfs.resolve(args[0])
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	tw := tar.NewWriter(&b)
+	err = n.ipfs.writeTar(tw, id, o)
+	if err != nil {
+		return err
+	}
+	tw.Close()
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Write(b.Bytes())
+	return nil
+}
+
+func (n *IPFSNode) pin(w http.ResponseWriter, args []string, add bool) error {
+	f := n.ipfs
+	for _, a := range args {
+		id, _, err := f.resolve(a)
+		if err != nil {
+			return err
+		}
+		f.lock.Lock()
+		if !add && !f.providers[id][n.peerID] {
+			f.lock.Unlock()
+			return fmt.Errorf("not pinned or pinned indirectly")
+		}
+		f.provide(id, n.peerID, add)
+		f.lock.Unlock()
+	}
+	writeJSON(w, map[string][]string{"Pins": args})
+	return nil
+}
+
+func (n *IPFSNode) swarmConnect(w http.ResponseWriter, args []string) error {
+	for _, a := range args {
+		elems := strings.Split(a, "/")
+		id := elems[len(elems)-1]
+		n.ipfs.lock.Lock()
+		_, ok := n.ipfs.nodes[id]
+		n.ipfs.lock.Unlock()
+		if !ok {
+			return fmt.Errorf("failure: dial to %s failed", id)
+		}
+	}
+	writeJSON(w, map[string][]string{"Strings": args})
+	return nil
+}
+
+func (n *IPFSNode) findProvs(w http.ResponseWriter, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("argument key is required")
+	}
+	f := n.ipfs
+	f.lock.Lock()
+	peers := make([]string, 0)
+	for p := range f.providers[args[0]] {
+		peers = append(peers, p)
+	}
+	f.lock.Unlock()
+	sort.Strings(peers)
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	for _, p := range peers {
+		enc.Encode(map[string]interface{}{
+			"Type":      4,
+			"Responses": []map[string]interface{}{{"ID": p, "Addrs": []string{}}},
+		})
+	}
+	return nil
+}
+
+// tcpAddr converts the /ip4/<ip>/tcp/<port> multiaddr to host:port
+func tcpAddr(ma string) (string, error) {
+	elems := strings.Split(strings.Trim(ma, "/"), "/")
+	if len(elems) != 4 || elems[0] != "ip4" || elems[2] != "tcp" {
+		return "", fmt.Errorf("unsupported address %s", ma)
+	}
+	return elems[1] + ":" + elems[3], nil
+}
+
+func (n *IPFSNode) p2pListen(w http.ResponseWriter, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("protocol and target address are required")
+	}
+	addr, err := tcpAddr(args[1])
+	if err != nil {
+		return err
+	}
+	f := n.ipfs
+	f.lock.Lock()
+	f.listeners[n.peerID+"/"+args[0]] = addr
+	f.lock.Unlock()
+	return nil
+}
+
+func (n *IPFSNode) p2pForward(w http.ResponseWriter, args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("protocol, listen address and target are required")
+	}
+	laddr, err := tcpAddr(args[1])
+	if err != nil {
+		return err
+	}
+	target := strings.TrimPrefix(args[2], "/p2p/")
+	f := n.ipfs
+	f.lock.Lock()
+	taddr, ok := f.listeners[target+"/"+args[0]]
+	f.lock.Unlock()
+	if !ok {
+		return fmt.Errorf("protocol not supported by the peer")
+	}
+	l, err := net.Listen("tcp", laddr)
+	if err != nil {
+		return err
+	}
+	n.lock.Lock()
+	n.forwards[args[1]] = l
+	n.lock.Unlock()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go proxy(c, taddr)
+		}
+	}()
+	return nil
+}
+
+func proxy(c net.Conn, addr string) {
+	defer c.Close()
+	t, err := net.Dial("tcp", addr)
+	if err != nil {
+		return
+	}
+	defer t.Close()
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(t, c)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(c, t)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+func (n *IPFSNode) p2pClose(w http.ResponseWriter, addr string) error {
+	n.lock.Lock()
+	l, ok := n.forwards[addr]
+	delete(n.forwards, addr)
+	n.lock.Unlock()
+	if !ok {
+		return fmt.Errorf("no matching p2p stream")
+	}
+	l.Close()
+	return nil
+}
+
+// multibase encoding used by the pubsub API
+func mbEncode(b []byte) string {
+	return "u" + base64.RawURLEncoding.EncodeToString(b)
+}
+
+func mbDecode(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "u") {
+		return nil, fmt.Errorf("unsupported multibase encoding")
+	}
+	return base64.RawURLEncoding.DecodeString(s[1:])
+}
+
+func (n *IPFSNode) subscribe(w http.ResponseWriter, r *http.Request, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("argument topic is required")
+	}
+	tb, err := mbDecode(args[0])
+	if err != nil {
+		return err
+	}
+	topic := string(tb)
+	s := &ipfsSub{ch: make(chan []byte, 64), done: make(chan struct{})}
+	f := n.ipfs
+	f.lock.Lock()
+	f.subs[topic] = append(f.subs[topic], s)
+	f.lock.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	fl, _ := w.(http.Flusher)
+	if fl != nil {
+		fl.Flush()
+	}
+	for {
+		select {
+		case m := <-s.ch:
+			w.Write(m)
+			if fl != nil {
+				fl.Flush()
+			}
+		case <-r.Context().Done():
+			close(s.done)
+			f.lock.Lock()
+			ss := f.subs[topic]
+			for i := range ss {
+				if ss[i] == s {
+					f.subs[topic] = append(ss[:i], ss[i+1:]...)
+					break
+				}
+			}
+			f.lock.Unlock()
+			return nil
+		}
+	}
+}
+
+func (n *IPFSNode) publish(w http.ResponseWriter, r *http.Request, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("argument topic is required")
+	}
+	tb, err := mbDecode(args[0])
+	if err != nil {
+		return err
+	}
+	entries, err := readEntries(r)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	for _, e := range entries {
+		data = append(data, e.data...)
+	}
+	f := n.ipfs
+	f.lock.Lock()
+	f.seqno++
+	seq := make([]byte, 8)
+	binary.BigEndian.PutUint64(seq, f.seqno)
+	m, _ := json.Marshal(map[string]interface{}{
+		"from":     n.peerID,
+		"data":     mbEncode(data),
+		"seqno":    mbEncode(seq),
+		"topicIDs": []string{mbEncode(tb)},
+	})
+	m = append(m, '\n')
+	subs := append([]*ipfsSub{}, f.subs[string(tb)]...)
+	f.lock.Unlock()
+	for _, s := range subs {
+		select {
+		case s.ch <- m:
+		case <-s.done:
+		}
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+// Package testnet runs a network of core nodes in one process against the
+// in-memory IPFS, it is used to test the multi node flows without the IPFS
+// daemon or the public network.
+package testnet
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/did"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultPortBase is the first receiver port tried for the nodes
+	DefaultPortBase uint16 = 21000
+	portStep        uint16 = 100
+	reqTimeout             = 5 * time.Minute
+)
+
+var explorerURLs = []string{"https://testnet-core-api.rubixexplorer.com"}
+
+// Config of the test network
+type Config struct {
+	// Nodes is the number of nodes to start
+	Nodes int
+	// Dir is the base directory, every node gets its own sub directory
+	Dir string
+	// PortBase is the receiver port of the first node, zero uses DefaultPortBase
+	PortBase uint16
+	// Consensus is the consensus configuration of all the nodes
+	Consensus config.ConsensusConfig
+	// Log is the logger of the nodes, errors only on the stderr if not set
+	Log logger.Logger
+}
+
+// Network is the set of nodes sharing the in-memory IPFS
+type Network struct {
+	ipfs  *IPFS
+	log   logger.Logger
+	Nodes []*Node
+}
+
+// Node is one core node of the test network
+type Node struct {
+	*core.Core
+	Index int
+	Dir   string
+	ipfs  *IPFSNode
+	lock  sync.Mutex
+	dids  int
+}
+
+// portFree checks whether the port can be listened on
+func portFree(port uint16) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	l.Close()
+	return true
+}
+
+// New starts the test network, the nodes are started one after the other
+// with the peer ids derived from the node index
+func New(cfg *Config) (*Network, error) {
+	if cfg.Nodes <= 0 {
+		return nil, fmt.Errorf("number of nodes should be greater than zero")
+	}
+	n := &Network{
+		ipfs:  NewIPFS(),
+		log:   cfg.Log,
+		Nodes: make([]*Node, 0, cfg.Nodes),
+	}
+	if n.log == nil {
+		n.log = logger.New(&logger.LoggerOptions{
+			Name:   "testnet",
+			Level:  logger.Error,
+			Color:  []logger.ColorOption{logger.ColorOff},
+			Output: []io.Writer{os.Stderr},
+		})
+	}
+	port := cfg.PortBase
+	if port == 0 {
+		port = DefaultPortBase
+	}
+	for i := 0; i < cfg.Nodes; i++ {
+		for !portFree(port+10) || !portFree(port+11) {
+			port += portStep
+		}
+		nd, err := n.startNode(i, cfg, port)
+		if err != nil {
+			n.Close()
+			return nil, err
+		}
+		n.Nodes = append(n.Nodes, nd)
+		port += portStep
+	}
+	return n, nil
+}
+
+func (n *Network) startNode(index int, cfg *Config, port uint16) (*Node, error) {
+	dir, err := filepath.Abs(filepath.Join(cfg.Dir, fmt.Sprintf("node%d", index)))
+	if err != nil {
+		return nil, err
+	}
+	dir = filepath.ToSlash(dir) + "/"
+	err = os.MkdirAll(dir+core.RubixRootDir, os.ModeDir|os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	sc := config.StorageConfig{
+		StorageType: storage.StorageDBType,
+		DBAddress:   dir + core.RubixRootDir + core.DefaultTestNetDB,
+		DBType:      "Sqlite3",
+	}
+	ccfg := &config.Config{
+		NodeAddress: "localhost",
+		DirPath:     dir,
+		CfgData: config.ConfigData{
+			Ports: config.Ports{
+				ReceiverPort: port,
+			},
+			StorageConfig:     sc,
+			TestStorageConfig: sc,
+			ConsensusConfig:   cfg.Consensus,
+		},
+	}
+	log := n.log.Named(fmt.Sprintf("node%d", index))
+	c, err := core.NewCore(ccfg, dir+"api_config.json", "", log, true, "", false, false)
+	if err != nil {
+		return nil, err
+	}
+	err = c.RemoveExplorer(explorerURLs)
+	if err != nil {
+		return nil, err
+	}
+	ipfs := n.ipfs.NewNode(index)
+	err = c.AttachIPFS(ipfs.Shell())
+	if err != nil {
+		return nil, err
+	}
+	err = c.SetupCore()
+	if err != nil {
+		return nil, err
+	}
+	ok, msg := c.Start()
+	if !ok {
+		return nil, fmt.Errorf("failed to start the node, %s", msg)
+	}
+	return &Node{Core: c, Index: index, Dir: dir, ipfs: ipfs}, nil
+}
+
+// Close stops all the nodes
+func (n *Network) Close() {
+	for _, nd := range n.Nodes {
+		nd.StopCore()
+		nd.ipfs.Close()
+	}
+}
+
+// PeerID returns the peer id of the node
+func (nd *Node) PeerID() string {
+	return nd.ipfs.PeerID()
+}
+
+// Run runs the async core operation with a new request id, password requests
+// of the lite DIDs are answered with pwd and the final response is returned
+func (nd *Node) Run(pwd string, op func(reqID string)) *model.BasicResponse {
+	req := &ensweb.Request{ID: uuid.New().String()}
+	err := nd.AddWebReq(req)
+	if err != nil {
+		return &model.BasicResponse{Status: false, Message: err.Error()}
+	}
+	defer nd.RemoveWebReq(req.ID)
+	dc := nd.GetWebReq(req.ID)
+	go op(req.ID)
+	for {
+		select {
+		case ch := <-dc.OutChan:
+			switch r := ch.(type) {
+			case *did.SignResponse:
+				dc.InChan <- did.SignRespData{ID: r.Result.ID, Mode: r.Result.Mode, Password: pwd}
+			case *model.BasicResponse:
+				return r
+			case model.BasicResponse:
+				return &r
+			default:
+				return &model.BasicResponse{Status: false, Message: "invalid response"}
+			}
+		case <-time.After(reqTimeout):
+			return &model.BasicResponse{Status: false, Message: "request timed out"}
+		}
+	}
+}
+
+// CreateDID creates the lite DID with the password, the mnemonic is derived
+// from the node index and the DID count so the DIDs are reproducible
+func (nd *Node) CreateDID(pwd string) (string, error) {
+	nd.lock.Lock()
+	seed := fmt.Sprintf("rubix-testnet-did-%d-%d", nd.Index, nd.dids)
+	nd.dids++
+	nd.lock.Unlock()
+	entropy := sha256.Sum256([]byte(seed))
+	mnemonic, err := bip39.NewMnemonic(entropy[:])
+	if err != nil {
+		return "", err
+	}
+	mf := nd.Dir + uuid.New().String() + ".txt"
+	err = os.WriteFile(mf, []byte(mnemonic), 0644)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(mf)
+	return nd.Core.CreateDID(&did.DIDCreate{
+		Type:         did.LiteDIDMode,
+		PrivPWD:      pwd,
+		MnemonicFile: mf,
+	})
+}
+
+// GenerateTestTokens generates num test tokens for the DID
+func (nd *Node) GenerateTestTokens(didStr string, pwd string, num int) error {
+	br := nd.Run(pwd, func(reqID string) {
+		nd.Core.GenerateTestTokens(reqID, num, didStr)
+	})
+	if !br.Status {
+		return fmt.Errorf("%s", br.Message)
+	}
+	return nil
+}
+
+// Address returns the peerID.DID address of the DID
+func (nd *Node) Address(didStr string) string {
+	return nd.PeerID() + "." + didStr
+}
+
+// AddPeer records the DID of the other node, the DID is resolved to the peer
+// without the pubsub peer map
+func (n *Network) AddPeer(didStr string, owner *Node) error {
+	dt := did.LiteDIDMode
+	for _, nd := range n.Nodes {
+		if nd == owner {
+			continue
+		}
+		err := nd.AddPeerDetails(wallet.DIDPeerMap{DID: didStr, PeerID: owner.PeerID(), DIDType: &dt})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupQuorums creates a quorum DID with tokens to pledge on each of the
+// nodes and adds them as quorums of every node of the network
+func (n *Network) SetupQuorums(nodes []*Node, pwd string, tokens int) ([]string, error) {
+	dids := make([]string, 0, len(nodes))
+	for _, nd := range nodes {
+		d, err := nd.CreateDID(pwd)
+		if err != nil {
+			return nil, err
+		}
+		err = nd.GenerateTestTokens(d, pwd, tokens)
+		if err != nil {
+			return nil, err
+		}
+		err = nd.SetupQuorum(d, pwd, pwd)
+		if err != nil {
+			return nil, err
+		}
+		err = n.AddPeer(d, nd)
+		if err != nil {
+			return nil, err
+		}
+		dids = append(dids, d)
+	}
+	ql := make([]core.QuorumData, 0, len(dids))
+	for _, d := range dids {
+		ql = append(ql, core.QuorumData{Type: core.QuorumTypeTwo, Address: d})
+	}
+	for _, nd := range n.Nodes {
+		err := nd.AddQuorum(ql)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dids, nil
+}
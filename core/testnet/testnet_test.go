@@ -0,0 +1,1197 @@
+package testnet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/grpcserver"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"github.com/rubixchain/rubixgoplatform/util"
+	srvcfg "github.com/rubixchain/rubixgoplatform/wrapper/config"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testPwd = "mypassword"
+
+// adminCaller is the node owner calling the core
+var adminCaller = &core.Caller{Role: model.RoleAdmin}
+
+func testConsensus() config.ConsensusConfig {
+	return config.ConsensusConfig{
+		DefaultPolicy: config.ConsensusPolicy{
+			QuorumCount:  3,
+			MinQuorum:    3,
+			MinConsensus: 3,
+		},
+	}
+}
+
+// newTestNetwork starts the network with 3 quorum nodes followed by the user nodes
+func newTestNetwork(t *testing.T, users int) (*Network, []*Node) {
+	n, err := New(&Config{Nodes: 3 + users, Dir: t.TempDir(), Consensus: testConsensus()})
+	if err != nil {
+		t.Fatalf("failed to start the network, %v", err)
+	}
+	t.Cleanup(n.Close)
+	_, err = n.SetupQuorums(n.Nodes[:3], testPwd, 5)
+	if err != nil {
+		t.Fatalf("failed to setup quorums, %v", err)
+	}
+	return n, n.Nodes[3:]
+}
+
+func createDID(t *testing.T, n *Network, nd *Node, tokens int) string {
+	d, err := nd.CreateDID(testPwd)
+	if err != nil {
+		t.Fatalf("failed to create did, %v", err)
+	}
+	err = n.AddPeer(d, nd)
+	if err != nil {
+		t.Fatalf("failed to add peer, %v", err)
+	}
+	if tokens > 0 {
+		err = nd.GenerateTestTokens(d, testPwd, tokens)
+		if err != nil {
+			t.Fatalf("failed to generate tokens, %v", err)
+		}
+	}
+	return d
+}
+
+func checkBalance(t *testing.T, nd *Node, d string, rbt float64) {
+	info, err := nd.GetAccountInfo(d)
+	if err != nil {
+		t.Fatalf("failed to get account info, %v", err)
+	}
+	if info.RBTAmount != rbt {
+		t.Fatalf("balance mismatch, expected %v, got %v", rbt, info.RBTAmount)
+	}
+}
+
+func TestDIDCreation(t *testing.T) {
+	n, err := New(&Config{Nodes: 1, Dir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to start the network, %v", err)
+	}
+	defer n.Close()
+	nd := n.Nodes[0]
+	d := createDID(t, n, nd, 2)
+	if len(d) != 59 {
+		t.Fatalf("invalid did %s", d)
+	}
+	checkBalance(t, nd, d, 2)
+	// DIDs are derived from the node index, the same network gives the same DIDs
+	n2, err := New(&Config{Nodes: 1, Dir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to start the network, %v", err)
+	}
+	defer n2.Close()
+	d2, err := n2.Nodes[0].CreateDID(testPwd)
+	if err != nil {
+		t.Fatalf("failed to create did, %v", err)
+	}
+	if d != d2 || n.Nodes[0].PeerID() != n2.Nodes[0].PeerID() {
+		t.Fatalf("network is not reproducible")
+	}
+}
+
+func TestRBTTransfer(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 3)
+	rd := createDID(t, n, receiver, 0)
+	reqID := transferRBT(t, sender, sd, rd, 2)
+	checkBalance(t, sender, sd, 1)
+	checkBalance(t, receiver, rd, 2)
+	// lifecycle events of the consensus are recorded under the request id
+	count := make(map[string]int)
+	for _, ev := range sender.GetTxnEvents(reqID, 0) {
+		count[ev.Type]++
+	}
+	if count[model.TxnEventQuorumsSelected] != 1 || count[model.TxnEventQuorumResult] != 3 ||
+		count[model.TxnEventPledgeFinality] != 1 || count[model.TxnEventReceiverAck] != 1 {
+		t.Fatalf("lifecycle events mismatch, %v", count)
+	}
+	var mb strings.Builder
+	err := sender.Metrics().Write(&mb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []string{
+		`rubix_consensus_duration_seconds_count{mode="rbt_transfer",result="success"} 1`,
+		`rubix_did_tokens{did="` + sd + `",state="free"} 1`,
+	} {
+		if !strings.Contains(mb.String(), m+"\n") {
+			t.Fatalf("metric %s is not found", m)
+		}
+	}
+	if strings.Count(mb.String(), `,result="success"} 1`+"\n") != 4 {
+		t.Fatalf("quorum results mismatch\n%s", mb.String())
+	}
+}
+
+// checkFinalityEvents checks the receiver & the pledge finality events of the consensus
+func checkFinalityEvents(t *testing.T, nd *Node, reqID string) {
+	count := make(map[string]int)
+	for _, ev := range nd.GetTxnEvents(reqID, 0) {
+		count[ev.Type]++
+	}
+	if count[model.TxnEventPledgeFinality] != 1 || count[model.TxnEventReceiverAck] != 1 {
+		t.Fatalf("finality events mismatch, %v", count)
+	}
+}
+
+// forgeBlock returns a copy of the block with the keys replaced
+func forgeBlock(t *testing.T, b *block.Block, keys map[string]interface{}) *block.Block {
+	bm := make(map[string]interface{})
+	for k, v := range b.GetBlockMap() {
+		bm[k] = v
+	}
+	for k, v := range keys {
+		bm[k] = v
+	}
+	fb := block.InitBlock(nil, bm)
+	if fb == nil {
+		t.Fatal("failed to create the forged block")
+	}
+	return fb
+}
+
+func TestQuorumValidation(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 1)
+	rd := createDID(t, n, receiver, 0)
+	transferRBT(t, sender, sd, rd, 1)
+	tr, err := receiver.GetAllTokens(rd, model.RBTType, nil, nil)
+	if err != nil || len(tr.TokenDetails) != 1 {
+		t.Fatalf("failed to get the received token, %v", err)
+	}
+	dr := receiver.DumpTokenChain(&model.TCDumpRequest{Token: tr.TokenDetails[0].Token})
+	if !dr.Status || len(dr.Blocks) == 0 {
+		t.Fatalf("failed to dump token chain, %s", dr.Message)
+	}
+	b := block.InitBlock(dr.Blocks[len(dr.Blocks)-1], nil)
+	if b == nil {
+		t.Fatal("invalid token chain block")
+	}
+	if br, err := receiver.ValidateQuorums(b, rd); err != nil || !br.Status {
+		t.Fatalf("failed to validate the quorums, %v", err)
+	}
+	// a quorum signing twice can not make up for a missing signature
+	qs := b.GetBlockMap()[block.TCQuorumSignatureKey].([]interface{})
+	repeated := []interface{}{qs[0], qs[1], qs[0]}
+	fb := forgeBlock(t, b, map[string]interface{}{block.TCQuorumSignatureKey: repeated})
+	if br, err := receiver.ValidateQuorums(fb, rd); err == nil || br.Status {
+		t.Fatal("block with repeated quorum signature is validated")
+	}
+	// the recorded policy can not be weaker than the node policy for the mode
+	policies := []map[string]interface{}{
+		{block.CPQuorumCountKey: 3, block.CPMinQuorumKey: 3, block.CPMinConsensusKey: 2, block.CPAlphaCountKey: 3, block.CPModeKey: "rbt_transfer"},
+		{block.CPQuorumCountKey: 3, block.CPMinQuorumKey: 3, block.CPMinConsensusKey: 3, block.CPAlphaCountKey: 3, block.CPModeKey: "nft_deploy"},
+		{block.CPQuorumCountKey: 3, block.CPMinQuorumKey: 3, block.CPMinConsensusKey: 3, block.CPAlphaCountKey: 2, block.CPModeKey: "rbt_transfer"},
+	}
+	for _, cp := range policies {
+		fb := forgeBlock(t, b, map[string]interface{}{block.TCConsensusPolicyKey: cp})
+		if br, err := receiver.ValidateQuorums(fb, rd); err == nil || br.Status {
+			t.Fatalf("block with consensus policy %v is validated", cp)
+		}
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 2)
+	rd := createDID(t, n, receiver, 0)
+	var l sync.Mutex
+	received := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sig := core.WebhookSignature("whsecret", r.Header.Get(core.WebhookTimestampHeader), body)
+		if sig != r.Header.Get(core.WebhookSignatureHeader) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var ev model.WebhookEvent
+		if json.Unmarshal(body, &ev) != nil || ev.Event != r.Header.Get(core.WebhookEventHeader) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		l.Lock()
+		received[ev.DID+"/"+ev.Event]++
+		l.Unlock()
+	}))
+	t.Cleanup(srv.Close)
+	_, err := sender.AddWebhook(adminCaller, &model.WebhookRequest{DID: sd, URL: srv.URL, Secret: "whsecret", Events: []string{model.WebhookTransferCompleted}})
+	if err != nil {
+		t.Fatalf("failed to add webhook, %v", err)
+	}
+	_, err = receiver.AddWebhook(adminCaller, &model.WebhookRequest{DID: rd, URL: srv.URL, Secret: "whsecret"})
+	if err != nil {
+		t.Fatalf("failed to add webhook, %v", err)
+	}
+	if _, err = receiver.AddWebhook(adminCaller, &model.WebhookRequest{DID: rd, URL: srv.URL, Events: []string{"unknown"}}); err == nil {
+		t.Fatal("webhook added with an invalid event")
+	}
+	transferRBT(t, sender, sd, rd, 1)
+	want := map[string]int{
+		sd + "/" + model.WebhookTransferCompleted: 1,
+		rd + "/" + model.WebhookTokensReceived:    1,
+	}
+	// waitEvents waits till the expected events are received
+	waitEvents := func() {
+		deadline := time.Now().Add(30 * time.Second)
+		for {
+			l.Lock()
+			done := true
+			for k, v := range want {
+				if received[k] < v {
+					done = false
+				}
+			}
+			l.Unlock()
+			if done {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("webhook events not delivered, %v", received)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	waitEvents()
+	ds, err := receiver.GetWebhookDeliveries(adminCaller, "", model.WebhookDeliveryDelivered, 0)
+	if err != nil || len(ds) != 1 {
+		t.Fatalf("webhook delivery mismatch, %v, %v", len(ds), err)
+	}
+	// callers scoped to other DIDs can not see or replay the deliveries
+	other := &core.Caller{Role: model.RoleDIDOwner, DIDs: []string{sd}}
+	if ods, err := receiver.GetWebhookDeliveries(other, "", "", 0); err != nil || len(ods) != 0 {
+		t.Fatalf("deliveries of the other DID are listed, %v, %v", len(ods), err)
+	}
+	if receiver.ReplayWebhookDelivery(other, ds[0].ID) == nil {
+		t.Fatal("delivery of the other DID is replayed")
+	}
+	if _, err := receiver.AddWebhook(other, &model.WebhookRequest{DID: rd, URL: srv.URL}); err == nil {
+		t.Fatal("webhook added for the other DID")
+	}
+	// deliveries can be replayed
+	err = receiver.ReplayWebhookDelivery(adminCaller, ds[0].ID)
+	if err != nil {
+		t.Fatalf("failed to replay webhook delivery, %v", err)
+	}
+	want[rd+"/"+model.WebhookTokensReceived] = 2
+	waitEvents()
+	l.Lock()
+	defer l.Unlock()
+	for k, v := range received {
+		if want[k] != v {
+			t.Fatalf("unexpected webhook events, %v", received)
+		}
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 0)
+	if _, _, err := nd.AddAPIKey(&model.APIKeyRequest{Role: model.RoleDIDOwner}); err == nil {
+		t.Fatal("did owner key added without DIDs")
+	}
+	if _, _, err := nd.AddAPIKey(&model.APIKeyRequest{Role: "root"}); err == nil {
+		t.Fatal("key added with an invalid role")
+	}
+	ak, key, err := nd.AddAPIKey(&model.APIKeyRequest{Name: "wallet", Role: model.RoleDIDOwner, DIDs: []string{d}})
+	if err != nil {
+		t.Fatalf("failed to add api key, %v", err)
+	}
+	cl, ok := nd.GetAPIKeyCaller(key)
+	if !ok {
+		t.Fatal("api key not found")
+	}
+	if !cl.HasPermission(core.PermDIDWrite) || cl.HasPermission(core.PermOperate) || cl.HasPermission(core.PermAdmin) {
+		t.Fatalf("permissions mismatch for the role %s", cl.Role)
+	}
+	if !cl.HasDID(d) || cl.HasDID("bafybmiother") {
+		t.Fatal("DID scope mismatch")
+	}
+	if _, ok := nd.GetAPIKeyCaller(ak.KeyHash); ok {
+		t.Fatal("api key hash accepted as the key")
+	}
+	err = nd.RemoveAPIKey(ak.ID)
+	if err != nil {
+		t.Fatalf("failed to remove api key, %v", err)
+	}
+	if _, ok := nd.GetAPIKeyCaller(key); ok {
+		t.Fatal("removed api key accepted")
+	}
+}
+
+func transferRBT(t *testing.T, nd *Node, sender string, receiver string, amount float64) string {
+	req := &model.RBTTransferRequest{
+		Sender:     sender,
+		Receiver:   receiver,
+		TokenCount: amount,
+		Type:       2,
+	}
+	var id string
+	br := nd.Run(testPwd, func(reqID string) {
+		id = reqID
+		nd.InitiateRBTTransfer(reqID, req)
+	})
+	if !br.Status {
+		t.Fatalf("rbt transfer failed, %s", br.Message)
+	}
+	return id
+}
+
+func TestPledgeUnpledge(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	a, b := users[0], users[1]
+	ad := createDID(t, n, a, 2)
+	bd := createDID(t, n, b, 0)
+	transferRBT(t, a, ad, bd, 1)
+	// moving the token on changes the state of the pledged token
+	transferRBT(t, b, bd, ad, 1)
+	checkBalance(t, a, ad, 2)
+	unpledged := false
+	for _, q := range n.Nodes[:3] {
+		msg, err := q.InititateUnpledgeProcess()
+		if err != nil {
+			t.Fatalf("failed to unpledge, %v", err)
+		}
+		if strings.Contains(msg, "successful") {
+			unpledged = true
+		}
+	}
+	if !unpledged {
+		t.Fatalf("pledged tokens are not unpledged")
+	}
+}
+
+// exportToken exports the token of the DID and returns the signed bundle
+func exportToken(t *testing.T, nd *Node, d string, tk string) []byte {
+	bp := filepath.Join(t.TempDir(), "bundle.json")
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.ExportToken(reqID, &model.ExportTokenRequest{DID: d, Token: tk, BundlePath: bp})
+	})
+	if !br.Status {
+		t.Fatalf("failed to export token, %s", br.Message)
+	}
+	bb, err := os.ReadFile(bp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bb
+}
+
+func TestTokenBundle(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 1)
+	rd := createDID(t, n, receiver, 0)
+	transferRBT(t, sender, sd, rd, 1)
+	tr, err := receiver.GetAllTokens(rd, model.RBTType, nil, nil)
+	if err != nil || len(tr.TokenDetails) != 1 {
+		t.Fatalf("failed to get the received token, %v", err)
+	}
+	tk := tr.TokenDetails[0].Token
+	bb := exportToken(t, receiver, rd, tk)
+
+	// the importer is not connected to the network, the dids come from the bundle
+	n2, err := New(&Config{Nodes: 1, Dir: t.TempDir(), Consensus: testConsensus()})
+	if err != nil {
+		t.Fatalf("failed to start the network, %v", err)
+	}
+	t.Cleanup(n2.Close)
+	importer := n2.Nodes[0]
+	id := createDID(t, n2, importer, 0)
+
+	var sb core.SignedTokenBundle
+	err = json.Unmarshal(bb, &sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tb core.TokenBundle
+	err = json.Unmarshal(sb.Bundle, &tb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamper := func(f func(sb *core.SignedTokenBundle)) []byte {
+		tsb := sb
+		f(&tsb)
+		fb, err := json.Marshal(&tsb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fb
+	}
+	// the last block is dropped & the bundle is hashed again
+	dropped := tb
+	dropped.Chains = append([]core.TokenBundleChain{}, tb.Chains...)
+	dropped.Chains[0].Blocks = tb.Chains[0].Blocks[:len(tb.Chains[0].Blocks)-1]
+	db, err := json.Marshal(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name   string
+		bundle []byte
+		msg    string
+	}{
+		{"hash", tamper(func(sb *core.SignedTokenBundle) { sb.Hash = strings.Repeat("0", 64) }), "bundle hash does not match"},
+		{"signature", tamper(func(sb *core.SignedTokenBundle) {
+			sb.Bundle = db
+			sb.Hash = util.HexToStr(util.CalculateHash(db, "SHA3-256"))
+		}), "Failed to verify the bundle signature"},
+	}
+	for _, tc := range cases {
+		br := importer.ImportToken(&model.ImportTokenRequest{DID: id, Bundle: tc.bundle})
+		if br.Status || !strings.Contains(br.Message, tc.msg) {
+			t.Fatalf("tampered %s bundle is imported, %s", tc.name, br.Message)
+		}
+		if dr := importer.DumpTokenChain(&model.TCDumpRequest{Token: tk}); len(dr.Blocks) != 0 {
+			t.Fatalf("token chain of the tampered %s bundle is written", tc.name)
+		}
+	}
+
+	br := importer.ImportToken(&model.ImportTokenRequest{DID: id, Bundle: bb})
+	if !br.Status {
+		t.Fatalf("failed to import token, %s", br.Message)
+	}
+	dr := importer.DumpTokenChain(&model.TCDumpRequest{Token: tk})
+	if !dr.Status || len(dr.Blocks) != len(tb.Chains[0].Blocks) {
+		t.Fatalf("imported token chain mismatch, %s", dr.Message)
+	}
+	// did types of the bundle are not kept after the import
+	dc, err := importer.SetupForienDID(rd, "")
+	if err == nil && dc != nil {
+		t.Fatal("did type of the bundle is kept after the import")
+	}
+	// importing again adds nothing
+	br = importer.ImportToken(&model.ImportTokenRequest{DID: id, Bundle: bb})
+	if !br.Status || !strings.Contains(br.Message, "added 0 token chain blocks") {
+		t.Fatalf("token is imported again, %s", br.Message)
+	}
+}
+
+func TestFTTransfer(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	a, b := users[0], users[1]
+	ad := createDID(t, n, a, 2)
+	bd := createDID(t, n, b, 0)
+	var jobID string
+	br := a.Run(testPwd, func(reqID string) {
+		jobID = reqID
+		a.CreateFTs(reqID, ad, 10, "testft", 1)
+	})
+	if !br.Status {
+		t.Fatalf("failed to create fts, %s", br.Message)
+	}
+	job, err := a.GetJob(adminCaller, jobID)
+	if err != nil || job.Type != core.JobCreateFT || job.DID != ad || job.Status != model.JobSucceeded {
+		t.Fatalf("job is not recorded, %v %v", job, err)
+	}
+	// job of the other DID is not found for the scoped caller
+	if _, err := a.GetJob(&core.Caller{Role: model.RoleDIDOwner, DIDs: []string{bd}}, jobID); err == nil {
+		t.Fatal("job of the other DID is returned")
+	}
+	req := &model.TransferFTReq{
+		Sender:     ad,
+		Receiver:   bd,
+		FTName:     "testft",
+		FTCount:    4,
+		QuorumType: 2,
+		CreatorDID: ad,
+	}
+	var ftReqID string
+	br = a.Run(testPwd, func(reqID string) {
+		ftReqID = reqID
+		if _, used := a.ClaimIdempotencyKey(reqID, ad, "ft-key", core.JobFTTransfer, "hash"); used {
+			t.Errorf("new idempotency key is reported as used")
+		}
+		a.InitiateFTTransfer(reqID, req)
+	})
+	if !br.Status {
+		t.Fatalf("ft transfer failed, %s", br.Message)
+	}
+	checkFinalityEvents(t, a, ftReqID)
+	// retry with the same key gets the earlier result without a new transfer
+	rbr, used := a.ClaimIdempotencyKey("retry", ad, "ft-key", core.JobFTTransfer, "hash")
+	if !used || !rbr.Status || rbr.Message != br.Message {
+		t.Fatalf("retry did not return the earlier result, %v", rbr)
+	}
+	rbr, used = a.ClaimIdempotencyKey("retry", ad, "ft-key", core.JobFTTransfer, "other")
+	if !used || rbr.Status {
+		t.Fatalf("key is reused for a different request, %v", rbr)
+	}
+	jobs, err := a.GetJobs(adminCaller, ad, core.JobFTTransfer, model.JobSucceeded, 0)
+	if err != nil || len(jobs) != 1 {
+		t.Fatalf("ft transfer job is not recorded, %v %v", jobs, err)
+	}
+	// request id of the finished job can not be reused
+	if a.AddWebReq(&ensweb.Request{ID: jobs[0].ID}) == nil {
+		t.Fatalf("used request id is accepted")
+	}
+	fi, err := b.GetFTInfo(bd)
+	if err != nil {
+		t.Fatalf("failed to get ft info, %v", err)
+	}
+	if len(fi) != 1 || fi[0].FTCount != 4 {
+		t.Fatalf("ft balance mismatch, %v", fi)
+	}
+}
+
+// createNFT creates the NFT of the DID with the test artifact
+func createNFT(t *testing.T, nd *Node, d string) string {
+	folder, err := nd.CreateNFTTempFolder()
+	if err != nil {
+		t.Fatalf("failed to create nft folder, %v", err)
+	}
+	err = os.WriteFile(filepath.Join(folder, "artifact.txt"), []byte("test nft artifact"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(folder, "metadata.json"), []byte(`{"name":"test nft"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.CreateNFTRequest(reqID, core.NFTReq{DID: d, NFTPath: folder})
+	})
+	if !br.Status {
+		t.Fatalf("failed to create nft, %s", br.Message)
+	}
+	nft, _ := br.Result.(string)
+	return nft
+}
+
+func TestNFTTransfer(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	a, b := users[0], users[1]
+	ad := createDID(t, n, a, 2)
+	bd := createDID(t, n, b, 2)
+	nft := createNFT(t, a, ad)
+	br := a.Run(testPwd, func(reqID string) {
+		a.DeployNFT(reqID, model.DeployNFTRequest{NFT: nft, DID: ad, QuorumType: 2, NFTValue: 1})
+	})
+	if !br.Status {
+		t.Fatalf("failed to deploy nft, %s", br.Message)
+	}
+	var execReqID string
+	br = a.Run(testPwd, func(reqID string) {
+		execReqID = reqID
+		a.ExecuteNFT(reqID, &model.ExecuteNFTRequest{NFT: nft, Owner: ad, Receiver: bd, QuorumType: 2, NFTValue: 1})
+	})
+	if !br.Status {
+		t.Fatalf("failed to execute nft, %s", br.Message)
+	}
+	checkFinalityEvents(t, a, execReqID)
+	// the owner node records the receiver as the new owner of the nft
+	nl := a.GetAllNFT(nil, nil)
+	if len(nl.NFTs) != 1 || nl.NFTs[0].NFTId != nft || nl.NFTs[0].Owner != bd {
+		t.Fatalf("nft is not transferred, %v", nl)
+	}
+}
+
+func TestNFTRoyalty(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	a, b := users[0], users[1]
+	ad := createDID(t, n, a, 3)
+	buyer := createDID(t, n, a, 0)
+	rd := createDID(t, n, b, 0)
+	nft := createNFT(t, a, ad)
+	br := a.Run(testPwd, func(reqID string) {
+		a.DeployNFT(reqID, model.DeployNFTRequest{NFT: nft, DID: ad, QuorumType: 2, NFTValue: 1, RoyaltyPercent: 10, RoyaltyRecipient: rd})
+	})
+	if !br.Status {
+		t.Fatalf("failed to deploy nft, %s", br.Message)
+	}
+	execute := func(owner string, receiver string, value float64) *model.BasicResponse {
+		return a.Run(testPwd, func(reqID string) {
+			a.ExecuteNFT(reqID, &model.ExecuteNFTRequest{NFT: nft, Owner: owner, Receiver: receiver, QuorumType: 2, NFTValue: value})
+		})
+	}
+	// the sale pays 10% of the sale value to the royalty recipient
+	br = execute(ad, buyer, 5)
+	if !br.Status {
+		t.Fatalf("failed to execute nft, %s", br.Message)
+	}
+	checkBalance(t, a, ad, 2.5)
+	checkBalance(t, b, rd, 0.5)
+	nl := a.GetAllNFT(nil, nil)
+	if len(nl.NFTs) != 1 || nl.NFTs[0].Owner != buyer {
+		t.Fatalf("nft is not transferred, %v", nl)
+	}
+	// the royalty is owed on the last recorded value, the nft is not sold
+	// without the royalty even if the seller sends no value
+	br = execute(buyer, ad, 0)
+	if br.Status || !strings.Contains(br.Message, "royalty") {
+		t.Fatalf("nft is sold without the royalty, %s", br.Message)
+	}
+	nl = a.GetAllNFT(nil, nil)
+	if len(nl.NFTs) != 1 || nl.NFTs[0].Owner != buyer {
+		t.Fatalf("nft is transferred without the royalty, %v", nl)
+	}
+}
+
+// appendContract is the engine contract appending the input to the state,
+// the input starting with "!" reverts
+var appendContract = appendModule("execute")
+
+// migrateContract is the upgrade of the contract, the migrate function
+// appends the migration input to the state
+var migrateContract = appendModule("execute", "migrate")
+
+func appendModule(exports ...string) []byte {
+	es := []byte{byte(len(exports) + 1), 0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00}
+	for _, e := range exports {
+		es = append(append(append(es, byte(len(e))), e...), 0x00, 0x04)
+	}
+	return wasmModule(
+		// types (i32, i32) -> i32, (i32, i32) -> (), () -> i32
+		wasmSection(1, 0x03, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x00, 0x60, 0x00, 0x01, 0x7f),
+		// imports state, input, set_state and revert
+		wasmSection(2, append(append(append(append([]byte{0x04},
+			wasmImport("state", 0)...), wasmImport("input", 0)...), wasmImport("set_state", 1)...), wasmImport("revert", 1)...)...),
+		wasmSection(3, 0x01, 0x02),
+		wasmSection(5, 0x01, 0x00, 0x01),
+		wasmSection(7, es...),
+		wasmSection(10, 0x01, 0x32, 0x01, 0x02, 0x7f,
+			// n0 = state(0, 1024), n1 = input(n0, 1024)
+			0x41, 0x00, 0x41, 0x80, 0x08, 0x10, 0x00, 0x21, 0x00,
+			0x20, 0x00, 0x41, 0x80, 0x08, 0x10, 0x01, 0x21, 0x01,
+			// revert if the input starts with "!"
+			0x20, 0x00, 0x2d, 0x00, 0x00, 0x41, 0x21, 0x46,
+			0x04, 0x40, 0x20, 0x00, 0x20, 0x01, 0x10, 0x03, 0x0b,
+			// set_state(0, n0 + n1), return 0
+			0x41, 0x00, 0x20, 0x00, 0x20, 0x01, 0x6a, 0x10, 0x02,
+			0x41, 0x00, 0x0b),
+	)
+}
+
+func wasmModule(sections ...[]byte) []byte {
+	b := []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+	for _, s := range sections {
+		b = append(b, s...)
+	}
+	return b
+}
+
+func wasmSection(id byte, content ...byte) []byte {
+	return append([]byte{id, byte(len(content))}, content...)
+}
+
+func wasmImport(name string, typeIdx byte) []byte {
+	b := append([]byte{0x05}, "rubix"...)
+	b = append(b, byte(len(name)))
+	b = append(b, name...)
+	return append(b, 0x00, typeIdx)
+}
+
+// generateContract generates the smart contract token for the code
+func generateContract(t *testing.T, nd *Node, d string, code []byte) string {
+	folder, err := nd.CreateSCTempFolder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{"contract.wasm": code, "contract.wat": []byte("contract"), "schema.json": []byte("{}")}
+	for name, data := range files {
+		err = os.WriteFile(filepath.Join(folder, name), data, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.GenerateSmartContractToken(reqID, &core.GenerateSmartContractRequest{
+			BinaryCode: filepath.Join(folder, "contract.wasm"),
+			RawCode:    filepath.Join(folder, "contract.wat"),
+			SchemaCode: filepath.Join(folder, "schema.json"),
+			DID:        d,
+			SCPath:     folder,
+		})
+	})
+	if !br.Status {
+		t.Fatalf("failed to generate smart contract, %s", br.Message)
+	}
+	sct, _ := br.Result.(string)
+	return sct
+}
+
+// deployContract generates and deploys the smart contract with the code
+func deployContract(t *testing.T, nd *Node, d string, code []byte) string {
+	sct := generateContract(t, nd, d, code)
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.DeploySmartContractToken(reqID, &model.DeploySmartContractRequest{SmartContractToken: sct, DeployerAddress: d, RBTAmount: 1, QuorumType: 2})
+	})
+	if !br.Status {
+		t.Fatalf("failed to deploy smart contract, %s", br.Message)
+	}
+	return sct
+}
+
+func TestSmartContractExecution(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	sct := deployContract(t, nd, d, appendContract)
+	var br *model.BasicResponse
+	execute := func(input string) *model.BasicResponse {
+		return nd.Run(testPwd, func(reqID string) {
+			nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractInput: input})
+		})
+	}
+	for _, in := range []string{"a", "b"} {
+		br = execute(in)
+		if !br.Status {
+			t.Fatalf("failed to execute smart contract, %s", br.Message)
+		}
+	}
+	// the reverted execution does not add the block
+	br = execute("!c")
+	if br.Status || !strings.Contains(br.Message, "reverted, !c") {
+		t.Fatalf("reverted execution is accepted, %s", br.Message)
+	}
+	sd := nd.GetSmartContractTokenChainData(&model.SmartContractTokenChainDataReq{Token: sct, Latest: true})
+	if !sd.Status || len(sd.SCTDataReply) != 1 || sd.SCTDataReply[0].SmartContractData != "ab" || sd.SCTDataReply[0].BlockNo != 2 {
+		t.Fatalf("smart contract state mismatch, %+v", sd)
+	}
+}
+
+func TestLegacySmartContract(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	// the binary does not decode with the engine, the quorums accept the state
+	// set by the executor as before
+	sct := deployContract(t, nd, d, []byte("legacy contract binary"))
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: "legacy"})
+	})
+	if !br.Status {
+		t.Fatalf("failed to execute legacy smart contract, %s", br.Message)
+	}
+	br = nd.Run(testPwd, func(reqID string) {
+		nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractInput: "a"})
+	})
+	if br.Status {
+		t.Fatal("engine execution of the legacy smart contract is accepted")
+	}
+}
+
+func TestSmartContractState(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	// the contract is not built for the engine, the executor sets the state
+	sct := deployContract(t, nd, d, wasmModule())
+	for _, data := range []string{`{"owner": "alice", "count": 1}`, `{"items": ["a"], "count": 2}`} {
+		br := nd.Run(testPwd, func(reqID string) {
+			nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: data})
+		})
+		if !br.Status {
+			t.Fatalf("failed to execute smart contract, %s", br.Message)
+		}
+	}
+	cases := []struct {
+		key    string
+		bn     uint64
+		latest bool
+		value  string
+	}{
+		{"count", 0, true, "2"},
+		{"count", 1, false, "1"},
+		{"owner", 1, false, `"alice"`},
+		{"owner", 2, false, ""},
+		{"items", 0, true, `["a"]`},
+		{"count", 0, false, ""},
+	}
+	for _, c := range cases {
+		sr := nd.QuerySmartContractState(sct, c.key, c.bn, c.latest)
+		if !sr.Status || sr.Found != (c.value != "") || sr.Value != c.value {
+			t.Fatalf("state of %s at block %d mismatch, %+v", c.key, c.bn, sr)
+		}
+	}
+	sr := nd.QuerySmartContractState(sct, "count", 3, false)
+	if sr.Status {
+		t.Fatalf("state of the missing block is returned, %+v", sr)
+	}
+	dr := nd.DumpSmartContractTokenChain(&model.TCDumpRequest{Token: sct})
+	if !dr.Status || len(dr.Blocks) != 3 {
+		t.Fatalf("failed to dump smart contract token chain, %s", dr.Message)
+	}
+	b := block.InitBlock(dr.Blocks[2], nil)
+	if b == nil || b.GetSCStateHash() != wallet.SCStateHash(`{"count":2,"items":["a"]}`) {
+		t.Fatal("state hash is not recorded in the block")
+	}
+	// the deploy block has the hash, the later blocks must have it
+	b = block.InitBlock(dr.Blocks[0], nil)
+	if b == nil || b.GetSCStateHash() != wallet.SCStateHash("") {
+		t.Fatal("state hash is not recorded in the deploy block")
+	}
+}
+
+func TestSmartContractUpgrade(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	sct := deployContract(t, nd, d, wasmModule())
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: "ab"})
+	})
+	if !br.Status {
+		t.Fatalf("failed to execute smart contract, %s", br.Message)
+	}
+	ct := generateContract(t, nd, d, migrateContract)
+	upgrade := func(did string) *model.BasicResponse {
+		return nd.Run(testPwd, func(reqID string) {
+			nd.UpgradeSmartContractToken(reqID, &model.UpgradeSmartContractRequest{SmartContractToken: sct, CodeToken: ct, DeployerAddress: did, QuorumType: 2, MigrationInput: "m"})
+		})
+	}
+	// only the deployer can upgrade the contract
+	od := createDID(t, n, nd, 1)
+	br = upgrade(od)
+	if br.Status || !strings.Contains(br.Message, "Only the deployer") {
+		t.Fatalf("upgrade by the other did is accepted, %s", br.Message)
+	}
+	br = upgrade(d)
+	if !br.Status {
+		t.Fatalf("failed to upgrade smart contract, %s", br.Message)
+	}
+	// the upgraded contract is executed with the new code
+	br = nd.Run(testPwd, func(reqID string) {
+		nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractInput: "c"})
+	})
+	if !br.Status {
+		t.Fatalf("failed to execute upgraded smart contract, %s", br.Message)
+	}
+	sd := nd.GetSmartContractTokenChainData(&model.SmartContractTokenChainDataReq{Token: sct})
+	if !sd.Status || len(sd.SCTDataReply) != 4 || sd.SCTDataReply[2].SmartContractData != "abm" || sd.SCTDataReply[3].SmartContractData != "abmc" {
+		t.Fatalf("smart contract state mismatch, %+v", sd)
+	}
+	vr, err := nd.GetSmartContractVersions(sct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vr.Versions) != 2 || vr.Versions[0].CodeToken != sct || vr.Versions[1].Version != 2 || vr.Versions[1].CodeToken != ct || vr.Versions[1].BlockNumber != 2 {
+		t.Fatalf("smart contract versions mismatch, %+v", vr)
+	}
+	br = upgrade(d)
+	if br.Status {
+		t.Fatal("upgrade to the same code is accepted")
+	}
+}
+
+func TestSmartContractEvents(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	sct := deployContract(t, nd, d, wasmModule())
+	ch, cancel := nd.SubscribeSmartContractEvents(&model.SmartContractEventFilter{SmartContractToken: sct, Name: "Transfer"})
+	defer cancel()
+	execs := [][]block.SCEvent{
+		{{Name: "Transfer", Topics: []string{"alice", "bob"}, Data: "10"}, {Name: "Approval", Topics: []string{"alice"}}},
+		{{Name: "Transfer", Topics: []string{"bob", "carol"}, Data: "5"}},
+	}
+	for i, evs := range execs {
+		br := nd.Run(testPwd, func(reqID string) {
+			nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: "{}", SmartContractEvents: evs})
+		})
+		if !br.Status {
+			t.Fatalf("failed to execute smart contract, %s", br.Message)
+		}
+		select {
+		case ev := <-ch:
+			if ev.BlockNumber != uint64(i+1) || ev.Name != "Transfer" || ev.Data != evs[0].Data {
+				t.Fatalf("unexpected event from the subscription, %+v", ev)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("event is not published to the subscriber")
+		}
+	}
+	cases := []struct {
+		f     model.SmartContractEventFilter
+		count int
+	}{
+		{model.SmartContractEventFilter{SmartContractToken: sct}, 3},
+		{model.SmartContractEventFilter{SmartContractToken: sct, Name: "Transfer"}, 2},
+		{model.SmartContractEventFilter{SmartContractToken: sct, Topic: "bob"}, 2},
+		{model.SmartContractEventFilter{SmartContractToken: sct, Topic: "alice", Name: "Approval"}, 1},
+		{model.SmartContractEventFilter{SmartContractToken: sct, FromBlock: 2}, 1},
+		{model.SmartContractEventFilter{SmartContractToken: sct, ToBlock: 1}, 2},
+		{model.SmartContractEventFilter{Topic: "carol"}, 1},
+	}
+	for _, c := range cases {
+		er, err := nd.GetSmartContractEvents(&c.f, nil)
+		if err != nil {
+			t.Fatalf("failed to get smart contract events, %v", err)
+		}
+		if len(er.Events) != c.count {
+			t.Fatalf("expected %d events for %+v, got %d", c.count, c.f, len(er.Events))
+		}
+	}
+	er, err := nd.GetSmartContractEvents(&model.SmartContractEventFilter{SmartContractToken: sct}, &model.PageRequest{Limit: 2})
+	if err != nil || len(er.Events) != 2 || er.NextCursor == "" {
+		t.Fatalf("failed to get the first page of events, %v", err)
+	}
+	if er.Events[0].Name != "Transfer" || er.Events[1].Name != "Approval" || er.Events[0].Topics[1] != "bob" {
+		t.Fatalf("events are not in the emitted order, %+v", er.Events)
+	}
+	er, err = nd.GetSmartContractEvents(&model.SmartContractEventFilter{SmartContractToken: sct}, &model.PageRequest{Limit: 2, Cursor: er.NextCursor})
+	if err != nil || len(er.Events) != 1 || er.Events[0].BlockNumber != 2 {
+		t.Fatalf("failed to get the next page of events, %v", err)
+	}
+	br := nd.Run(testPwd, func(reqID string) {
+		nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: "{}", SmartContractEvents: []block.SCEvent{{Name: "Bad", Topics: []string{"1", "2", "3", "4", "5"}}}})
+	})
+	if br.Status {
+		t.Fatal("event with too many topics is accepted")
+	}
+}
+
+func TestGRPCSmartContractEvents(t *testing.T) {
+	n, users := newTestNetwork(t, 1)
+	nd := users[0]
+	d := createDID(t, n, nd, 2)
+	sct := deployContract(t, nd, d, wasmModule())
+	execute := func(evs ...block.SCEvent) {
+		br := nd.Run(testPwd, func(reqID string) {
+			nd.ExecuteSmartContractToken(reqID, &model.ExecuteSmartContractRequest{SmartContractToken: sct, ExecutorAddress: d, QuorumType: 2, SmartContractData: "{}", SmartContractEvents: evs})
+		})
+		if !br.Status {
+			t.Fatalf("failed to execute smart contract, %s", br.Message)
+		}
+	}
+	execute(block.SCEvent{Name: "Transfer", Topics: []string{"alice", "bob"}, Data: "10"}, block.SCEvent{Name: "Approval", Topics: []string{"alice"}})
+	gc := newGRPCClient(t, n, nd)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := gc.GetSmartContractEvents(ctx, &protos.SmartContractEventsReq{FromBlock: 2, ToBlock: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("invalid block range is accepted, %v", err)
+	}
+	stream, err := gc.WatchSmartContractEvents(ctx, &protos.SmartContractEventsReq{SmartContractToken: sct, FromBlock: 2, ToBlock: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("invalid block range is accepted for the stream, %v", err)
+	}
+	stream, err = gc.WatchSmartContractEvents(ctx, &protos.SmartContractEventsReq{SmartContractToken: sct, Name: "Transfer", FromBlock: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// recv waits for the next event of the stream
+	recv := func() *protos.SmartContractEvent {
+		evc := make(chan *protos.SmartContractEvent, 1)
+		go func() {
+			ev, err := stream.Recv()
+			if err != nil {
+				t.Errorf("failed to receive the event, %v", err)
+			}
+			evc <- ev
+		}()
+		select {
+		case ev := <-evc:
+			if ev == nil {
+				t.FailNow()
+			}
+			return ev
+		case <-time.After(10 * time.Second):
+			t.Fatal("event is not streamed")
+		}
+		return nil
+	}
+	// event of the executed block is sent from the index
+	ev := recv()
+	if ev.BlockNumber != 1 || ev.Name != "Transfer" || ev.Data != "10" || len(ev.Topics) != 2 || ev.SmartContractToken != sct {
+		t.Fatalf("unexpected event from the index, %+v", ev)
+	}
+	execute(block.SCEvent{Name: "Approval"}, block.SCEvent{Name: "Transfer", Topics: []string{"bob", "carol"}, Data: "5"})
+	ev = recv()
+	if ev.BlockNumber != 2 || ev.Index != 1 || ev.Data != "5" {
+		t.Fatalf("unexpected live event, %+v", ev)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 5)
+	rd := createDID(t, n, receiver, 0)
+	pr := &model.PageRequest{Limit: 2, Sort: "-token"}
+	seen := make(map[string]bool)
+	last := ""
+	for pages := 1; ; pages++ {
+		tr, err := sender.GetAllTokens(sd, model.RBTType, nil, pr)
+		if err != nil {
+			t.Fatalf("failed to get tokens, %v", err)
+		}
+		for _, td := range tr.TokenDetails {
+			if seen[td.Token] || (last != "" && td.Token >= last) {
+				t.Fatalf("tokens are not in the order, %s after %s", td.Token, last)
+			}
+			seen[td.Token] = true
+			last = td.Token
+		}
+		if tr.NextCursor == "" {
+			if pages != 3 {
+				t.Fatalf("expected 3 pages, got %d", pages)
+			}
+			break
+		}
+		pr.Cursor = tr.NextCursor
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected 5 tokens, got %d", len(seen))
+	}
+	_, err := sender.GetAllTokens(sd, model.RBTType, nil, &model.PageRequest{Cursor: pr.Cursor})
+	if err == nil {
+		t.Fatalf("cursor of the other sort is accepted")
+	}
+	// list is not paged without the limit and the cursor
+	tr, err := sender.GetAllTokens(sd, model.RBTType, nil, nil)
+	if err != nil || len(tr.TokenDetails) != 5 || tr.NextCursor != "" {
+		t.Fatalf("unpaged list mismatch, %v", err)
+	}
+	ids := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		transferRBT(t, sender, sd, rd, 1)
+	}
+	pr = &model.PageRequest{Limit: 2}
+	for {
+		td, next, err := sender.GetTxnDetailsByDID(sd, &model.TxnFilter{Role: "sender", MinAmount: 1}, pr)
+		if err != nil {
+			t.Fatalf("failed to get transactions, %v", err)
+		}
+		for _, d := range td {
+			ids = append(ids, d.TransactionID)
+		}
+		if next == "" {
+			break
+		}
+		pr.Cursor = next
+	}
+	if len(ids) != 3 || ids[0] == ids[1] || ids[1] == ids[2] {
+		t.Fatalf("transactions mismatch, %v", ids)
+	}
+	td, _, err := sender.GetTxnDetailsByDID(sd, &model.TxnFilter{Role: "receiver"}, nil)
+	if err != nil || len(td) != 0 {
+		t.Fatalf("sender is listed as the receiver, %v", err)
+	}
+	// part of the token is sent, rest of the token is kept as the part token
+	transferRBT(t, sender, sd, rd, 0.5)
+	tr, err = sender.GetAllTokens(sd, model.RBTType, &model.TokenFilter{TokenType: model.PartTokenType}, nil)
+	if err != nil || len(tr.TokenDetails) == 0 {
+		t.Fatalf("part tokens are not listed, %v", err)
+	}
+	for _, d := range tr.TokenDetails {
+		if d.Value >= 1 {
+			t.Fatalf("whole token %s is listed as the part token", d.Token)
+		}
+	}
+	tr, err = sender.GetAllTokens(sd, model.RBTType, &model.TokenFilter{TokenType: model.WholeTokenType, Status: []int{wallet.TokenIsFree}}, nil)
+	if err != nil || len(tr.TokenDetails) != 1 || tr.TokenDetails[0].Value != 1 {
+		t.Fatalf("whole tokens mismatch, %v", err)
+	}
+	if _, err = sender.GetAllTokens(sd, model.RBTType, &model.TokenFilter{TokenType: "half"}, nil); err == nil {
+		t.Fatal("invalid token type is accepted")
+	}
+	if nl := sender.GetNFTsByDid(sd, &model.TokenFilter{TokenType: model.WholeTokenType}, nil); nl.Status {
+		t.Fatal("token type filter is accepted for the NFTs")
+	}
+}
+
+// newGRPCClient runs the gRPC service of the node & returns the client of it
+func newGRPCClient(t *testing.T, n *Network, nd *Node) protos.RubixServiceClient {
+	gs, err := grpcserver.NewServerGRPC(nd.Core, &srvcfg.Config{}, n.log, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	protos.RegisterRubixServiceServer(srv, gs.Native)
+	go srv.Serve(l)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return protos.NewRubixServiceClient(conn)
+}
+
+func TestGRPCWatchTransaction(t *testing.T) {
+	n, users := newTestNetwork(t, 2)
+	sender, receiver := users[0], users[1]
+	sd := createDID(t, n, sender, 1)
+	rd := createDID(t, n, receiver, 0)
+	reqID := transferRBT(t, sender, sd, rd, 1)
+	// final status is added by the API server
+	sender.AddTxnEvent(reqID, model.TxnEvent{Type: model.TxnEventFinalStatus, Status: true})
+	gc := newGRPCClient(t, n, sender)
+	// watchCtx returns the context with the API key of the DID
+	watchCtx := func(d string) context.Context {
+		_, key, err := sender.AddAPIKey(&model.APIKeyRequest{Role: model.RoleDIDOwner, DIDs: []string{d}})
+		if err != nil {
+			t.Fatalf("failed to add api key, %v", err)
+		}
+		return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", key)
+	}
+	// job of the other DID can not be watched
+	od := createDID(t, n, sender, 0)
+	ostream, err := gc.WatchTransaction(watchCtx(od), &protos.TxnEventReq{ReqID: reqID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ostream.Recv(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("job of the other DID is watched, %v", err)
+	}
+	stream, err := gc.WatchTransaction(watchCtx(sd), &protos.TxnEventReq{ReqID: reqID, FromSeq: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	evs := make([]*protos.TxnEvent, 0)
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to receive the event, %v", err)
+		}
+		evs = append(evs, ev)
+	}
+	all := sender.GetTxnEvents(reqID, 0)
+	if len(evs) != len(all)-1 || evs[0].Seq != 2 {
+		t.Fatalf("events mismatch, got %d events from %d", len(evs), len(all))
+	}
+	last := evs[len(evs)-1]
+	if last.Type != model.TxnEventFinalStatus || !last.Status || last.ReqID != reqID {
+		t.Fatalf("final status mismatch, %v", last)
+	}
+}
@@ -24,10 +24,14 @@ type TokenPublish struct {
 	Token string `json:"token"`
 }
 
+// TCBSyncRequest requests the token chain blocks, blocks are paged by
+// block number if the PageSize is set
 type TCBSyncRequest struct {
-	Token     string `json:"token"`
-	TokenType int    `json:"token_type"`
-	BlockID   string `json:"block_id"`
+	Token       string `json:"token"`
+	TokenType   int    `json:"token_type"`
+	BlockID     string `json:"block_id"`
+	BlockNumber uint64 `json:"block_number,omitempty"`
+	PageSize    int    `json:"page_size,omitempty"`
 }
 
 type TCBSyncReply struct {
@@ -35,6 +39,10 @@ type TCBSyncReply struct {
 	Message     string   `json:"message"`
 	NextBlockID string   `json:"next_block_id"`
 	TCBlock     [][]byte `json:"tc_block"`
+	Paged       bool     `json:"paged,omitempty"`
+	More        bool     `json:"more,omitempty"`
+	// Checkpoint is set when the blocks before the checkpoint are pruned
+	Checkpoint *wallet.TokenChainCheckpoint `json:"checkpoint,omitempty"`
 }
 
 // TokenVerificationRequest struct
@@ -51,53 +59,127 @@ func (c *Core) SetupToken() {
 	c.l.AddRoute(APISyncTokenChain, "POST", c.syncTokenChain)
 }
 
-func (c *Core) GetAllTokens(did string, tt string) (*model.TokenResponse, error) {
+var tokenPager = &pager{
+	fields: map[string]pageField{
+		"token":  {column: "token_id", kind: pageString},
+		"value":  {column: "token_value", kind: pageNumber},
+		"status": {column: "token_status", kind: pageNumber},
+	},
+	key:  "token_id",
+	sort: "token",
+}
+
+var dataTokenPager = &pager{
+	fields: map[string]pageField{
+		"token":  {column: "token_id", kind: pageString},
+		"status": {column: "token_status", kind: pageNumber},
+	},
+	key:  "token_id",
+	sort: "token",
+}
+
+// filterTokens adds the token filter to the query, value column is empty
+// if the tokens do not have a value & parent column is empty if the tokens
+// can not be split into the part tokens
+func filterTokens(q *wallet.PageQuery, f *model.TokenFilter, valueColumn string, parentColumn string) error {
+	if f == nil {
+		return nil
+	}
+	if len(f.Status) > 0 {
+		q.Where("token_status IN ?", f.Status)
+	}
+	if (f.MinValue > 0 || f.MaxValue > 0) && valueColumn == "" {
+		return fmt.Errorf("value filter is not supported for the token type")
+	}
+	if f.MinValue > 0 {
+		q.Where(valueColumn+" >= ?", f.MinValue)
+	}
+	if f.MaxValue > 0 {
+		q.Where(valueColumn+" <= ?", f.MaxValue)
+	}
+	if f.TokenType == "" {
+		return nil
+	}
+	if parentColumn == "" {
+		return fmt.Errorf("token type filter is not supported for the token type")
+	}
+	switch f.TokenType {
+	case model.WholeTokenType:
+		q.Where(parentColumn+" = ?", "")
+	case model.PartTokenType:
+		q.Where(parentColumn+" <> ?", "")
+	default:
+		return fmt.Errorf("invalid token type %s, expected %s or %s", f.TokenType, model.WholeTokenType, model.PartTokenType)
+	}
+	return nil
+}
+
+// GetAllTokens returns the page of the tokens of the DID matching the filter
+func (c *Core) GetAllTokens(did string, tt string, f *model.TokenFilter, pr *model.PageRequest) (*model.TokenResponse, error) {
 	tr := &model.TokenResponse{
 		BasicResponse: model.BasicResponse{
 			Status:  true,
 			Message: "Got all tokens",
 		},
+		TokenDetails: make([]model.TokenDetail, 0),
 	}
 	switch tt {
 	case model.RBTType:
-		tkns, err := c.w.GetAllTokens(did)
+		q, err := tokenPager.query(pr)
+		if err == nil {
+			q.Where("did=?", did)
+			err = filterTokens(q, f, "token_value", "parent_token_id")
+		}
 		if err != nil {
-			return tr, nil
+			return nil, err
 		}
-		tr.TokenDetails = make([]model.TokenDetail, 0)
-		for _, t := range tkns {
-			td := model.TokenDetail{
+		tkns, err := c.w.GetTokensPage(q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tokens")
+		}
+		n, next := tokenPager.next(pr, len(tkns), func(i int, column string) (interface{}, string) {
+			switch column {
+			case "token_value":
+				return tkns[i].TokenValue, tkns[i].TokenID
+			case "token_status":
+				return tkns[i].TokenStatus, tkns[i].TokenID
+			}
+			return tkns[i].TokenID, tkns[i].TokenID
+		})
+		for _, t := range tkns[:n] {
+			tr.TokenDetails = append(tr.TokenDetails, model.TokenDetail{
 				Token:  t.TokenID,
 				Status: t.TokenStatus,
-			}
-			tr.TokenDetails = append(tr.TokenDetails, td)
+				Value:  t.TokenValue,
+			})
 		}
+		tr.NextCursor = next
 	case model.DTType:
-		tkns, err := c.w.GetAllDataTokens(did)
+		q, err := dataTokenPager.query(pr)
+		if err == nil {
+			q.Where("did=?", did)
+			err = filterTokens(q, f, "", "")
+		}
+		if err != nil {
+			return nil, err
+		}
+		tkns, err := c.w.GetDataTokensPage(q)
 		if err != nil {
-			return tr, nil
+			return nil, fmt.Errorf("failed to get tokens")
 		}
-		tr.TokenDetails = make([]model.TokenDetail, 0)
-		for _, t := range tkns {
-			td := model.TokenDetail{
+		n, next := dataTokenPager.next(pr, len(tkns), func(i int, column string) (interface{}, string) {
+			if column == "token_status" {
+				return tkns[i].TokenStatus, tkns[i].TokenID
+			}
+			return tkns[i].TokenID, tkns[i].TokenID
+		})
+		for _, t := range tkns[:n] {
+			tr.TokenDetails = append(tr.TokenDetails, model.TokenDetail{
 				Token:  t.TokenID,
 				Status: t.TokenStatus,
-			}
-			tr.TokenDetails = append(tr.TokenDetails, td)
-		}
-	// case model.NFTType:
-	// 	tkns, err := c.w.GetAllNFT()
-	// 	if err != nil {
-	// 		return tr, nil
-	// 	}
-	// 	tr.TokenDetails = make([]model.TokenDetail, 0)
-	// 	for _, t := range tkns {
-	// 		td := model.TokenDetail{
-	// 			Token:  t.TokenID,
-	// 			Status: t.TokenStatus,
-	// 		}
-	// 		tr.TokenDetails = append(tr.TokenDetails, td)
-	// 	}
+			})
+		}
+		tr.NextCursor = next
 	default:
 		tr.BasicResponse.Status = false
 		tr.BasicResponse.Message = "Invalid token type"
@@ -134,7 +216,12 @@ func (c *Core) GetAccountInfo(did string) (model.DIDAccountInfo, error) {
 }
 
 func (c *Core) GenerateTestTokens(reqID string, num int, did string) {
-	err := c.generateTestTokens(reqID, num, did)
+	err := c.startJob(reqID, JobGenerateTestTokens, did)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	err = c.generateTestTokens(reqID, num, did)
 	br := model.BasicResponse{
 		Status:  true,
 		Message: "Test tokens generated successfully",
@@ -143,6 +230,7 @@ func (c *Core) GenerateTestTokens(reqID string, num int, did string) {
 		br.Status = false
 		br.Message = err.Error()
 	}
+	c.finishJob(reqID, &br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -268,37 +356,64 @@ func (c *Core) syncTokenChain(req *ensweb.Request) *ensweb.Result {
 	if err != nil {
 		return c.l.RenderJSON(req, &TCBSyncReply{Status: false, Message: "Failed to parse request"}, http.StatusOK)
 	}
-	blks, nextID, err := c.w.GetAllTokenBlocks(tr.Token, tr.TokenType, tr.BlockID)
-	if err != nil {
-		return c.l.RenderJSON(req, &TCBSyncReply{Status: false, Message: err.Error()}, http.StatusOK)
+	var reply *TCBSyncReply
+	if tr.PageSize > 0 {
+		// paged request, return the blocks starting from the block number
+		if tr.PageSize > wallet.TCBlockCountLimit {
+			tr.PageSize = wallet.TCBlockCountLimit
+		}
+		blks, more, err := c.w.GetTokenBlocksFrom(tr.Token, tr.TokenType, tr.BlockNumber, tr.PageSize)
+		if err != nil {
+			return c.l.RenderJSON(req, &TCBSyncReply{Status: false, Message: err.Error()}, http.StatusOK)
+		}
+		reply = &TCBSyncReply{Status: true, Message: "Got blocks", TCBlock: blks, Paged: true, More: more}
+	} else {
+		blks, nextID, err := c.w.GetAllTokenBlocks(tr.Token, tr.TokenType, tr.BlockID)
+		if err != nil {
+			return c.l.RenderJSON(req, &TCBSyncReply{Status: false, Message: err.Error()}, http.StatusOK)
+		}
+		reply = &TCBSyncReply{Status: true, Message: "Got all blocks", TCBlock: blks, NextBlockID: nextID}
+	}
+	cp, err := c.w.GetTokenChainCheckpoint(tr.Token)
+	if err == nil && cp.PrunedCount > 0 {
+		reply.Checkpoint = cp
 	}
-	return c.l.RenderJSON(req, &TCBSyncReply{Status: true, Message: "Got all blocks", TCBlock: blks, NextBlockID: nextID}, http.StatusOK)
+	return c.l.RenderJSON(req, reply, http.StatusOK)
 }
 
+// syncTokenChainFrom syncs the token chain from the peer page by page, every page
+// is verified before it is written so the latest local block is always the last
+// verified block and an interrupted sync resumes from there
 func (c *Core) syncTokenChainFrom(p *ipfsport.Peer, pblkID string, token string, tokenType int) error {
-	// p, err := c.getPeer(address)
-	// if err != nil {
-	// 	c.log.Error("Failed to get peer", "err", err)
-	// 	return err
-	// }
-	// defer p.Close()
+	unlock := c.lockTokenSync(token)
+	defer unlock()
 	var err error
 	blk := c.w.GetLatestTokenBlock(token, tokenType)
-	blkID := ""
+	ts := &tokenSyncState{}
 	if blk != nil {
-		blkID, err = blk.GetBlockID(token)
+		ts.blockID, err = blk.GetBlockID(token)
 		if err != nil {
 			c.log.Error("Failed to get block id", "err", err)
 			return err
 		}
-		if blkID == pblkID {
+		if ts.blockID == pblkID {
 			return nil
 		}
+		ts.blockNumber, err = blk.GetBlockNumber(token)
+		if err != nil {
+			c.log.Error("Failed to get block number", "err", err)
+			return err
+		}
+		ts.started = true
 	}
 	tr := TCBSyncRequest{
 		Token:     token,
 		TokenType: tokenType,
-		BlockID:   blkID,
+		BlockID:   ts.blockID,
+		PageSize:  c.tokenSyncPageSize(),
+	}
+	if ts.started {
+		tr.BlockNumber = ts.blockNumber + 1
 	}
 	for {
 		var trep TCBSyncReply
@@ -311,22 +426,32 @@ func (c *Core) syncTokenChainFrom(p *ipfsport.Peer, pblkID string, token string,
 			c.log.Error("Failed to sync token chain block", "msg", trep.Message)
 			return fmt.Errorf(trep.Message)
 		}
-		for _, bb := range trep.TCBlock {
-			blk := block.InitBlock(bb, nil)
-			if blk == nil {
-				c.log.Error("Failed to add token chain block, invalid block, sync failed", "err", err)
-				return fmt.Errorf("failed to add token chain block, invalid block, sync failed")
-			}
-			err = c.w.AddTokenBlock(token, blk)
+		blks, nts, err := c.verifySyncPage(token, tokenType, ts, trep.TCBlock, trep.Checkpoint)
+		if err != nil {
+			c.log.Error("Failed to verify token chain blocks, sync failed", "token", token, "err", err)
+			return err
+		}
+		for _, b := range blks {
+			err = c.w.AddTokenBlock(token, b)
 			if err != nil {
 				c.log.Error("Failed to add token chain block, syncing failed", "err", err)
 				return err
 			}
 		}
-		if trep.NextBlockID == "" {
-			break
+		ts = nts
+		if trep.Paged {
+			if !trep.More {
+				break
+			}
+			tr.BlockID = ts.blockID
+			tr.BlockNumber = ts.blockNumber + 1
+		} else {
+			// peer does not support paging
+			if trep.NextBlockID == "" {
+				break
+			}
+			tr.BlockID = trep.NextBlockID
 		}
-		tr.BlockID = trep.NextBlockID
 	}
 	return nil
 }
@@ -544,6 +669,11 @@ func (c *Core) GetpinnedTokens(did string) ([]wallet.Token, error) {
 }
 
 func (c *Core) GenerateFaucetTestTokens(reqID string, tokenCount int, did string) {
+	err := c.startJob(reqID, JobGenerateFaucetTestTokens, did)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	tokenDetails, err := c.generateTestTokensFaucet(reqID, tokenCount, did)
 
 	br := model.BasicResponse{
@@ -566,6 +696,7 @@ func (c *Core) GenerateFaucetTestTokens(reqID string, tokenCount int, did string
 		c.log.Error("Error marshaling JSON:", "err", err)
 		br.Status = false
 		br.Message = br.Message + ",  " + err.Error()
+		c.finishJob(reqID, &br)
 		return
 	}
 	resp, err := http.Post("http://103.209.145.177:3999/api/update-token-value", "application/json", bytes.NewBuffer(jsonData))
@@ -573,6 +704,7 @@ func (c *Core) GenerateFaucetTestTokens(reqID string, tokenCount int, did string
 		c.log.Error("Failed to update latest token number in Faucet", "err", err)
 		br.Status = false
 		br.Message = br.Message + ",  " + err.Error()
+		c.finishJob(reqID, &br)
 		return
 	}
 	defer resp.Body.Close()
@@ -581,8 +713,9 @@ func (c *Core) GenerateFaucetTestTokens(reqID string, tokenCount int, did string
 		br.Message = br.Message + ",  " + "Successfully updated token details."
 	} else {
 		br.Status = false
-		br.Message = br.Message + ",  " + "Failed to update token details. Status code:" + string(resp.StatusCode)
+		br.Message = br.Message + ",  " + "Failed to update token details. Status code:" + strconv.Itoa(resp.StatusCode)
 	}
+	c.finishJob(reqID, &br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -0,0 +1,756 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	didm "github.com/rubixchain/rubixgoplatform/did"
+	"github.com/rubixchain/rubixgoplatform/token"
+	"github.com/rubixchain/rubixgoplatform/util"
+)
+
+const (
+	TokenBundleVersion int    = 1
+	TokenBundleDir     string = "TokenBundles/"
+)
+
+// only the public files of the did are carried in the bundle
+var tokenBundleDIDFiles = []string{
+	didm.DIDImgFileName,
+	didm.PubShareFileName,
+	didm.PubKeyFileName,
+	didm.QuorumPubKeyFileName,
+	didm.MasterDIDFileName,
+}
+
+// TokenBundle is the portable archive of the token chain along with the parent
+// token chains and the public files of the dids referenced in the blocks
+type TokenBundle struct {
+	Version     int                `json:"version"`
+	Token       string             `json:"token"`
+	TokenType   int                `json:"token_type"`
+	Chains      []TokenBundleChain `json:"chains"`
+	DIDs        []TokenBundleDID   `json:"dids"`
+	ExporterDID string             `json:"exporter_did"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type TokenBundleChain struct {
+	Token     string          `json:"token"`
+	TokenType int             `json:"token_type"`
+	TokenRow  json.RawMessage `json:"token_row,omitempty"`
+	Blocks    [][]byte        `json:"blocks"`
+}
+
+type TokenBundleDID struct {
+	DID   string            `json:"did"`
+	Type  int               `json:"type"`
+	Files map[string][]byte `json:"files"`
+}
+
+// SignedTokenBundle is the bundle signed by the exporter did, this is what gets
+// written into the archive file
+type SignedTokenBundle struct {
+	Bundle       []byte `json:"bundle"`
+	Hash         string `json:"hash"`
+	DID          string `json:"did"`
+	SignType     int    `json:"sign_type"`
+	Signature    string `json:"signature"`
+	PvtSignature string `json:"pvt_signature"`
+}
+
+// tokenBundleReader serves the token chains of the bundle to the token chain
+// validation, so the chains are validated before they get into the wallet
+type tokenBundleReader struct {
+	chains map[string]*TokenBundleChain
+}
+
+func newTokenBundleReader(tb *TokenBundle) *tokenBundleReader {
+	r := &tokenBundleReader{
+		chains: make(map[string]*TokenBundleChain),
+	}
+	for i := range tb.Chains {
+		r.chains[tb.Chains[i].Token] = &tb.Chains[i]
+	}
+	return r
+}
+
+func (r *tokenBundleReader) GetAllTokenBlocks(token string, tokenType int, blockID string) ([][]byte, string, error) {
+	ch, ok := r.chains[token]
+	if !ok {
+		return nil, "", fmt.Errorf("token chain is not part of the bundle")
+	}
+	return ch.Blocks, "", nil
+}
+
+func (r *tokenBundleReader) GetLatestTokenBlock(token string, tokenType int) *block.Block {
+	ch, ok := r.chains[token]
+	if !ok || len(ch.Blocks) == 0 {
+		return nil
+	}
+	return block.InitBlock(ch.Blocks[len(ch.Blocks)-1], nil)
+}
+
+func (r *tokenBundleReader) GetGenesisTokenBlock(token string, tokenType int) *block.Block {
+	ch, ok := r.chains[token]
+	if !ok || len(ch.Blocks) == 0 {
+		return nil
+	}
+	return block.InitBlock(ch.Blocks[0], nil)
+}
+
+// GetTokenChainCheckpoint never returns a checkpoint, the bundle carries the full
+// token chain and it is validated till the genesis block
+func (r *tokenBundleReader) GetTokenChainCheckpoint(token string) (*wallet.TokenChainCheckpoint, error) {
+	return nil, fmt.Errorf("checkpoint not found")
+}
+
+func (r *tokenBundleReader) ReadToken(token string) (*wallet.Token, error) {
+	ch, ok := r.chains[token]
+	if !ok || ch.TokenRow == nil {
+		return nil, fmt.Errorf("token is not part of the bundle")
+	}
+	var t wallet.Token
+	err := json.Unmarshal(ch.TokenRow, &t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func isRBTTokenType(tt int) bool {
+	switch tt {
+	case token.RBTTokenType, token.PartTokenType, token.TestTokenType, token.TestPartTokenType:
+		return true
+	}
+	return false
+}
+
+func (c *Core) ExportToken(reqID string, req *model.ExportTokenRequest) {
+	err := c.startJob(reqID, JobExportToken, req.DID)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
+	br := c.exportToken(reqID, req)
+	c.finishJob(reqID, br)
+	dc := c.GetWebReq(reqID)
+	if dc == nil {
+		c.log.Error("Failed to get did channels")
+		return
+	}
+	dc.OutChan <- br
+}
+
+func (c *Core) exportToken(reqID string, req *model.ExportTokenRequest) *model.BasicResponse {
+	br := &model.BasicResponse{
+		Status: false,
+	}
+	if !c.w.IsDIDExist(req.DID) {
+		br.Message = "Invalid did, did does not exist"
+		return br
+	}
+	tt, err := c.w.GetTokenChainType(req.Token)
+	if err != nil {
+		br.Message = "Failed to get token chain, " + err.Error()
+		return br
+	}
+	tb := &TokenBundle{
+		Version:     TokenBundleVersion,
+		Token:       req.Token,
+		TokenType:   tt,
+		Chains:      make([]TokenBundleChain, 0),
+		DIDs:        make([]TokenBundleDID, 0),
+		ExporterDID: req.DID,
+		CreatedAt:   time.Now(),
+	}
+	dids := make(map[string]bool)
+	err = c.addBundleChain(tb, req.Token, tt, dids)
+	if err != nil {
+		c.log.Error("Failed to export token chain", "token", req.Token, "err", err)
+		br.Message = "Failed to export token chain, " + err.Error()
+		return br
+	}
+	if tb.Chains[0].TokenRow == nil {
+		br.Message = "Failed to export token, token does not exist"
+		return br
+	}
+	for d := range dids {
+		err = c.addBundleDID(tb, d)
+		if err != nil {
+			c.log.Error("Failed to add did to the bundle", "did", d, "err", err)
+			br.Message = "Failed to add did to the bundle, " + err.Error()
+			return br
+		}
+	}
+	bb, err := json.Marshal(tb)
+	if err != nil {
+		br.Message = "Failed to marshal the bundle, " + err.Error()
+		return br
+	}
+	dc, err := c.SetupDID(reqID, req.DID)
+	if err != nil {
+		br.Message = "Failed to setup did, " + err.Error()
+		return br
+	}
+	sb := &SignedTokenBundle{
+		Bundle:   bb,
+		Hash:     util.HexToStr(util.CalculateHash(bb, "SHA3-256")),
+		DID:      req.DID,
+		SignType: dc.GetSignType(),
+	}
+	share, pvtSign, err := dc.Sign(sb.Hash)
+	if err != nil {
+		c.log.Error("Failed to sign the bundle", "err", err)
+		br.Message = "Failed to sign the bundle, " + err.Error()
+		return br
+	}
+	sb.Signature = util.HexToStr(share)
+	sb.PvtSignature = util.HexToStr(pvtSign)
+	fb, err := json.Marshal(sb)
+	if err != nil {
+		br.Message = "Failed to marshal the bundle, " + err.Error()
+		return br
+	}
+	fileName := req.BundlePath
+	if fileName == "" {
+		fileName = c.cfg.DirPath + TokenBundleDir + req.Token + ".json"
+	}
+	err = os.MkdirAll(filepath.Dir(fileName), os.ModeDir|os.ModePerm)
+	if err != nil {
+		br.Message = "Failed to create bundle directory, " + err.Error()
+		return br
+	}
+	err = ioutil.WriteFile(fileName, fb, 0644)
+	if err != nil {
+		br.Message = "Failed to write the bundle, " + err.Error()
+		return br
+	}
+	br.Status = true
+	br.Message = fmt.Sprintf("Token exported with %d token chains to %s", len(tb.Chains), fileName)
+	return br
+}
+
+// addBundleChain adds the token chain and the parent token chains into the bundle
+func (c *Core) addBundleChain(tb *TokenBundle, tkn string, tt int, dids map[string]bool) error {
+	for _, ch := range tb.Chains {
+		if ch.Token == tkn {
+			return nil
+		}
+	}
+	ch := TokenBundleChain{
+		Token:     tkn,
+		TokenType: tt,
+		Blocks:    make([][]byte, 0),
+	}
+	var bn uint64
+	for {
+		blks, more, err := c.w.GetTokenBlocksFrom(tkn, tt, bn, wallet.TCBlockCountLimit)
+		if err != nil {
+			return err
+		}
+		ch.Blocks = append(ch.Blocks, blks...)
+		if !more || len(blks) == 0 {
+			break
+		}
+		lb := block.InitBlock(blks[len(blks)-1], nil)
+		if lb == nil {
+			return fmt.Errorf("invalid token chain block")
+		}
+		lbn, err := lb.GetBlockNumber(tkn)
+		if err != nil {
+			return err
+		}
+		bn = lbn + 1
+	}
+	if len(ch.Blocks) == 0 {
+		return fmt.Errorf("token chain of %s does not exist", tkn)
+	}
+	row, err := c.w.ReadTokenRow(tkn, tt)
+	if err == nil {
+		ch.TokenRow = row
+	}
+	cp, err := c.w.GetTokenChainCheckpoint(tkn)
+	if err == nil && cp.PrunedCount > 0 {
+		return fmt.Errorf("token chain of %s is pruned, restore the token chain before export", tkn)
+	}
+	for _, bb := range ch.Blocks {
+		b := block.InitBlock(bb, nil)
+		if b == nil {
+			return fmt.Errorf("invalid token chain block")
+		}
+		addBlockDIDs(b, dids)
+	}
+	tb.Chains = append(tb.Chains, ch)
+	if !isRBTTokenType(tt) {
+		return nil
+	}
+	gb := block.InitBlock(ch.Blocks[0], nil)
+	pt, _, err := gb.GetParentDetials(tkn)
+	if err != nil || pt == "" {
+		return nil
+	}
+	ptt, err := c.w.GetTokenChainType(pt)
+	if err != nil {
+		return fmt.Errorf("parent token chain of %s does not exist", tkn)
+	}
+	return c.addBundleChain(tb, pt, ptt, dids)
+}
+
+func addBlockDIDs(b *block.Block, dids map[string]bool) {
+	add := func(d string) {
+		if d != "" {
+			dids[d] = true
+		}
+	}
+	add(b.GetOwner())
+	add(b.GetSenderDID())
+	add(b.GetReceiverDID())
+	add(b.GetDeployerDID())
+	add(b.GetExecutorDID())
+	signers, _ := b.GetSigner()
+	for _, s := range signers {
+		add(s)
+	}
+	qsl, _ := b.GetQuorumSignatureList()
+	for _, q := range qsl {
+		add(q.DID)
+	}
+	is := b.GetInitiatorSignature()
+	if is != nil {
+		add(is.DID)
+	}
+}
+
+// addBundleDID adds the public files of the did, master did is added along with it
+func (c *Core) addBundleDID(tb *TokenBundle, d string) error {
+	for _, bd := range tb.DIDs {
+		if bd.DID == d {
+			return nil
+		}
+	}
+	err := c.FetchDID(d)
+	if err != nil {
+		return err
+	}
+	bd := TokenBundleDID{
+		DID:   d,
+		Type:  -1,
+		Files: make(map[string][]byte),
+	}
+	dt, err := c.w.GetDID(d)
+	if err == nil {
+		bd.Type = dt.Type
+	} else {
+		pdt, err := c.w.GetPeerDIDType(d)
+		if err == nil {
+			bd.Type = pdt
+		}
+	}
+	for _, fn := range tokenBundleDIDFiles {
+		fb, err := ioutil.ReadFile(c.didDir + d + "/" + fn)
+		if err != nil {
+			continue
+		}
+		bd.Files[fn] = fb
+	}
+	tb.DIDs = append(tb.DIDs, bd)
+	mb, ok := bd.Files[didm.MasterDIDFileName]
+	if ok {
+		return c.addBundleDID(tb, string(mb))
+	}
+	return nil
+}
+
+// ImportToken verifies the signed bundle and validates all the token chains of the
+// bundle, the wallet is updated only after the validation is successful
+func (c *Core) ImportToken(req *model.ImportTokenRequest) *model.BasicResponse {
+	br := &model.BasicResponse{
+		Status: false,
+	}
+	if !c.w.IsDIDExist(req.DID) {
+		br.Message = "Invalid did, please pass did of the tokenchain validator"
+		return br
+	}
+	var sb SignedTokenBundle
+	err := json.Unmarshal(req.Bundle, &sb)
+	if err != nil {
+		br.Message = "Invalid bundle, " + err.Error()
+		return br
+	}
+	if util.HexToStr(util.CalculateHash(sb.Bundle, "SHA3-256")) != sb.Hash {
+		br.Message = "Invalid bundle, bundle hash does not match"
+		return br
+	}
+	var tb TokenBundle
+	err = json.Unmarshal(sb.Bundle, &tb)
+	if err != nil {
+		br.Message = "Invalid bundle, " + err.Error()
+		return br
+	}
+	if tb.Version != TokenBundleVersion {
+		br.Message = fmt.Sprintf("Unsupported bundle version %d", tb.Version)
+		return br
+	}
+	if tb.ExporterDID != sb.DID || len(tb.Chains) == 0 || tb.Chains[0].Token != tb.Token {
+		br.Message = "Invalid bundle"
+		return br
+	}
+	created, dth, err := c.stageBundleDIDs(&tb)
+	if err != nil {
+		c.removeBundleDIDs(created)
+		br.Message = "Failed to stage the bundle dids, " + err.Error()
+		return br
+	}
+	err = c.verifyTokenBundle(&sb)
+	if err != nil {
+		c.removeBundleDIDs(created)
+		c.log.Error("Failed to verify the bundle signature", "err", err)
+		br.Message = "Failed to verify the bundle signature, " + err.Error()
+		return br
+	}
+	for i := range tb.Chains {
+		ch := &tb.Chains[i]
+		if i > 0 && ch.TokenRow == nil {
+			continue
+		}
+		ch.TokenRow, err = c.bundleTokenRow(ch)
+		if err != nil {
+			c.removeBundleDIDs(created)
+			br.Message = "Invalid bundle, " + err.Error()
+			return br
+		}
+	}
+	tbr := newTokenBundleReader(&tb)
+	for i := range tb.Chains {
+		ch := &tb.Chains[i]
+		err = c.checkBundleChain(ch)
+		if err == nil {
+			err = c.validateBundleChain(tbr, dth, ch, req.DID)
+		}
+		if err != nil {
+			c.removeBundleDIDs(created)
+			c.log.Error("Token chain validation failed, token not imported", "token", ch.Token, "err", err)
+			br.Message = "Token chain validation failed for " + ch.Token + ", " + err.Error()
+			return br
+		}
+	}
+	bws := make([]*bundleWrite, 0, len(tb.Chains))
+	count := 0
+	for i := range tb.Chains {
+		bw, err := c.writeBundleChain(&tb.Chains[i])
+		if err != nil {
+			c.rollbackBundleWrites(bws)
+			c.removeBundleDIDs(created)
+			c.log.Error("Failed to import token chain", "token", tb.Chains[i].Token, "err", err)
+			br.Message = "Failed to import token chain, " + err.Error()
+			return br
+		}
+		bws = append(bws, bw)
+		count = count + bw.blocks
+	}
+	br.Status = true
+	br.Message = fmt.Sprintf("Token imported, added %d token chain blocks", count)
+	return br
+}
+
+// stageBundleDIDs writes the dids which are not known to the node into the did
+// directory, it is needed to verify the signatures without the network. The files
+// which do not hash to the did are discarded. The did types of the bundle are
+// returned as the hints for the validation of the bundle only.
+func (c *Core) stageBundleDIDs(tb *TokenBundle) ([]string, didTypeHints, error) {
+	created := make([]string, 0)
+	dth := make(didTypeHints)
+	for _, bd := range tb.DIDs {
+		if bd.DID == "" || filepath.Base(bd.DID) != bd.DID {
+			return created, dth, fmt.Errorf("invalid did %s", bd.DID)
+		}
+		if bd.Type >= 0 {
+			dth[bd.DID] = bd.Type
+		}
+		_, err := os.Stat(c.didDir + bd.DID)
+		if err == nil {
+			continue
+		}
+		err = os.MkdirAll(c.didDir+bd.DID, os.ModeDir|os.ModePerm)
+		if err != nil {
+			return created, dth, err
+		}
+		created = append(created, bd.DID)
+		for _, fn := range tokenBundleDIDFiles {
+			fb, ok := bd.Files[fn]
+			if !ok {
+				continue
+			}
+			err = ioutil.WriteFile(c.didDir+bd.DID+"/"+fn, fb, 0644)
+			if err != nil {
+				return created, dth, err
+			}
+		}
+		// the files are trusted only if they hash to the did
+		err = c.d.CheckDIDHash(bd.DID, c.didDir+bd.DID+"/")
+		if err != nil {
+			c.log.Error("Discarding the bundle did files", "did", bd.DID, "err", err)
+			os.RemoveAll(c.didDir + bd.DID)
+			created = created[:len(created)-1]
+		}
+	}
+	return created, dth, nil
+}
+
+func (c *Core) removeBundleDIDs(dids []string) {
+	for _, d := range dids {
+		os.RemoveAll(c.didDir + d)
+	}
+}
+
+func (c *Core) verifyTokenBundle(sb *SignedTokenBundle) error {
+	didType := didm.BasicDIDMode
+	if sb.SignType == didm.BIPVersion {
+		didType = didm.LiteDIDMode
+	}
+	dc, err := c.InitialiseDID(sb.DID, didType)
+	if err != nil {
+		return err
+	}
+	var ok bool
+	if sb.SignType == didm.BIPVersion {
+		ok, err = dc.PvtVerify([]byte(sb.Hash), util.StrToHex(sb.PvtSignature))
+	} else {
+		ok, err = dc.NlssVerify(sb.Hash, util.StrToHex(sb.Signature), util.StrToHex(sb.PvtSignature))
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// checkBundleChain makes sure the bundle does not roll back the local token chain
+func (c *Core) checkBundleChain(ch *TokenBundleChain) error {
+	lb := c.w.GetLatestTokenBlock(ch.Token, ch.TokenType)
+	if lb == nil {
+		return nil
+	}
+	lbid, err := lb.GetBlockID(ch.Token)
+	if err != nil {
+		return err
+	}
+	for _, bb := range ch.Blocks {
+		b := block.InitBlock(bb, nil)
+		if b == nil {
+			return fmt.Errorf("invalid token chain block")
+		}
+		bid, err := b.GetBlockID(ch.Token)
+		if err == nil && bid == lbid {
+			return nil
+		}
+	}
+	return fmt.Errorf("local token chain is ahead of the bundle or diverged")
+}
+
+func (c *Core) validateBundleChain(tbr *tokenBundleReader, dth didTypeHints, ch *TokenBundleChain, userDID string) error {
+	tokenInfo := &wallet.Token{
+		TokenID: ch.Token,
+	}
+	if isRBTTokenType(ch.TokenType) {
+		t, err := tbr.ReadToken(ch.Token)
+		if err == nil {
+			tokenInfo = t
+		}
+	}
+	for _, bb := range ch.Blocks {
+		b := block.InitBlock(bb, nil)
+		if b == nil {
+			return fmt.Errorf("invalid token chain block")
+		}
+		if b.GetTokenType(ch.Token) != ch.TokenType {
+			return fmt.Errorf("token type does not match the block")
+		}
+	}
+	vr, err := c.validateTokenChain(tbr, dth, userDID, tokenInfo, ch.TokenType, 0, false)
+	if err != nil {
+		return err
+	}
+	if !vr.Status {
+		return fmt.Errorf("%s", vr.Message)
+	}
+	return nil
+}
+
+// bundleWrite is what the import wrote for the token chain, row is the token
+// row replaced by the import
+type bundleWrite struct {
+	token      string
+	tokenType  int
+	blocks     int
+	row        []byte
+	rowWritten bool
+}
+
+// writeBundleChain adds the blocks which are not present in the local token chain,
+// the writes are rolled back if it fails
+func (c *Core) writeBundleChain(ch *TokenBundleChain) (*bundleWrite, error) {
+	var lbn uint64
+	local := false
+	lb := c.w.GetLatestTokenBlock(ch.Token, ch.TokenType)
+	if lb != nil {
+		n, err := lb.GetBlockNumber(ch.Token)
+		if err != nil {
+			return nil, err
+		}
+		lbn = n
+		local = true
+	}
+	bw := &bundleWrite{
+		token:     ch.Token,
+		tokenType: ch.TokenType,
+	}
+	for _, bb := range ch.Blocks {
+		b := block.InitBlock(bb, nil)
+		bn, err := b.GetBlockNumber(ch.Token)
+		if err != nil {
+			c.rollbackBundleWrites([]*bundleWrite{bw})
+			return nil, err
+		}
+		if local && bn <= lbn {
+			continue
+		}
+		err = c.w.AddTokenBlock(ch.Token, b)
+		if err != nil {
+			c.rollbackBundleWrites([]*bundleWrite{bw})
+			return nil, err
+		}
+		bw.blocks++
+	}
+	if ch.TokenRow != nil {
+		row, err := c.w.ReadTokenRow(ch.Token, ch.TokenType)
+		if err == nil {
+			bw.row = row
+		}
+		err = c.w.WriteTokenRow(ch.Token, ch.TokenType, ch.TokenRow)
+		if err != nil {
+			c.rollbackBundleWrites([]*bundleWrite{bw})
+			return nil, err
+		}
+		bw.rowWritten = true
+	}
+	return bw, nil
+}
+
+// rollbackBundleWrites removes the imported blocks and puts back the replaced token rows
+func (c *Core) rollbackBundleWrites(bws []*bundleWrite) {
+	for i := len(bws) - 1; i >= 0; i-- {
+		bw := bws[i]
+		for n := 0; n < bw.blocks; n++ {
+			err := c.w.RemoveTokenChainBlocklatest(bw.token, bw.tokenType)
+			if err != nil {
+				c.log.Error("Failed to remove the imported block", "token", bw.token, "err", err)
+				break
+			}
+		}
+		if !bw.rowWritten {
+			continue
+		}
+		var err error
+		if bw.row != nil {
+			err = c.w.WriteTokenRow(bw.token, bw.tokenType, bw.row)
+		} else {
+			err = c.w.RemoveTokenRow(bw.token, bw.tokenType)
+		}
+		if err != nil {
+			c.log.Error("Failed to restore the token row", "token", bw.token, "err", err)
+		}
+	}
+}
+
+// bundleTokenRow builds the token row from the token chain of the bundle, the owner,
+// the value and the transaction are taken from the blocks. Only the fields which are
+// not recorded in the token chain are taken from the exporter row.
+func (c *Core) bundleTokenRow(ch *TokenBundleChain) ([]byte, error) {
+	if len(ch.Blocks) == 0 {
+		return nil, fmt.Errorf("token chain of %s is empty", ch.Token)
+	}
+	gb := block.InitBlock(ch.Blocks[0], nil)
+	lb := block.InitBlock(ch.Blocks[len(ch.Blocks)-1], nil)
+	if gb == nil || lb == nil {
+		return nil, fmt.Errorf("invalid token chain block")
+	}
+	owner := lb.GetOwner()
+	status := wallet.TokenIsTransferred
+	if lb.GetTransType() == block.TokenBurntType {
+		status = wallet.TokenIsBurnt
+	} else if c.w.IsDIDExist(owner) {
+		status = wallet.TokenIsFree
+	}
+	var row interface{}
+	switch ch.TokenType {
+	case token.RBTTokenType, token.PartTokenType, token.TestTokenType, token.TestPartTokenType:
+		pt, _, err := gb.GetParentDetials(ch.Token)
+		if err != nil {
+			return nil, err
+		}
+		value := 1.0
+		if ch.TokenType == token.PartTokenType || ch.TokenType == token.TestPartTokenType {
+			value = gb.GetTokenValue()
+		}
+		row = &wallet.Token{
+			TokenID:       ch.Token,
+			ParentTokenID: pt,
+			TokenValue:    value,
+			DID:           owner,
+			TokenStatus:   status,
+			TransactionID: lb.GetTid(),
+		}
+	case token.NFTTokenType, token.TestNFTTokenType:
+		row = &wallet.NFT{
+			TokenID:     ch.Token,
+			DID:         owner,
+			TokenStatus: status,
+			TokenValue:  lb.GetTokenValue(),
+		}
+	case token.FTTokenType:
+		var ft wallet.FTToken
+		if ch.TokenRow != nil {
+			json.Unmarshal(ch.TokenRow, &ft)
+		}
+		row = &wallet.FTToken{
+			TokenID:       ch.Token,
+			FTName:        ft.FTName,
+			DID:           owner,
+			CreatorDID:    gb.GetOwner(),
+			TokenStatus:   status,
+			TokenValue:    gb.GetTokenValue(),
+			TransactionID: lb.GetTid(),
+		}
+	case token.SmartContractTokenType:
+		var sc wallet.SmartContract
+		if ch.TokenRow != nil {
+			json.Unmarshal(ch.TokenRow, &sc)
+		}
+		sc.SmartContractHash = ch.Token
+		sc.Deployer = gb.GetDeployerDID()
+		row = &sc
+	case token.DataTokenType, token.TestDataTokenType:
+		var dt model.DataToken
+		if ch.TokenRow != nil {
+			json.Unmarshal(ch.TokenRow, &dt)
+		}
+		dt.TokenID = ch.Token
+		dt.DID = owner
+		dt.TokenStatus = status
+		row = &dt
+	default:
+		return nil, fmt.Errorf("invalid token type")
+	}
+	return json.Marshal(row)
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/token"
+)
+
+func TestWriteBundleChainRollback(t *testing.T) {
+	c := newTestJournalCore(t)
+	tk := "Qm" + strings.Repeat("d", 44)
+	blks := newSyncChain(t, tk, "owner", 3)
+	// block 1 is repeated, the write fails after two blocks
+	ch := &TokenBundleChain{Token: tk, TokenType: token.RBTTokenType, Blocks: syncPage(blks[0], blks[1], blks[1])}
+	_, err := c.writeBundleChain(ch)
+	if err == nil {
+		t.Fatal("invalid token chain is written")
+	}
+	if c.w.GetLatestTokenBlock(tk, token.RBTTokenType) != nil {
+		t.Fatal("token chain blocks are not rolled back")
+	}
+
+	err = c.w.CreateToken(&wallet.Token{TokenID: tk, DID: "old", TokenValue: 1, TokenStatus: wallet.TokenIsFree})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := json.Marshal(&wallet.Token{TokenID: tk, DID: "new", TokenValue: 1, TokenStatus: wallet.TokenIsFree})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch = &TokenBundleChain{Token: tk, TokenType: token.RBTTokenType, Blocks: syncPage(blks...), TokenRow: row}
+	bw, err := c.writeBundleChain(ch)
+	if err != nil || bw.blocks != 3 {
+		t.Fatalf("failed to write the token chain, %v", err)
+	}
+	wt, err := c.w.ReadToken(tk)
+	if err != nil || wt.DID != "new" {
+		t.Fatalf("token row is not written, %v", err)
+	}
+	c.rollbackBundleWrites([]*bundleWrite{bw})
+	if c.w.GetLatestTokenBlock(tk, token.RBTTokenType) != nil {
+		t.Fatal("token chain blocks are not rolled back")
+	}
+	wt, err = c.w.ReadToken(tk)
+	if err != nil || wt.DID != "old" {
+		t.Fatalf("token row is not restored, %v", err)
+	}
+	// token row added by the import is removed
+	ntk := "Qm" + strings.Repeat("e", 44)
+	row, err = json.Marshal(&wallet.Token{TokenID: ntk, DID: "new", TokenValue: 1, TokenStatus: wallet.TokenIsFree})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch = &TokenBundleChain{Token: ntk, TokenType: token.RBTTokenType, Blocks: syncPage(newSyncChain(t, ntk, "owner", 1)...), TokenRow: row}
+	bw, err = c.writeBundleChain(ch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.rollbackBundleWrites([]*bundleWrite{bw})
+	_, err = c.w.ReadToken(ntk)
+	if err == nil {
+		t.Fatal("token row added by the import is not removed")
+	}
+}
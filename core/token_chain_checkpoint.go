@@ -0,0 +1,145 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+)
+
+// CheckpointTokenChain validates the token chain locally and records a checkpoint
+// keepBlocks behind the latest block, older blocks are pruned if requested
+func (c *Core) CheckpointTokenChain(req *model.TokenChainCheckpointRequest) *model.BasicResponse {
+	br := &model.BasicResponse{
+		Status: false,
+	}
+	if !c.w.IsDIDExist(req.DID) {
+		br.Message = "Invalid did, please pass did of the token owner"
+		return br
+	}
+	tokenInfo, err := c.w.ReadToken(req.Token)
+	if err != nil {
+		br.Message = "Failed to get token, token does not exist"
+		return br
+	}
+	typeString := RBTString
+	if tokenInfo.TokenValue < 1.0 {
+		typeString = PartString
+	}
+	tokenType := c.TokenType(typeString)
+	vr, err := c.ValidateTokenChain(req.DID, tokenInfo, tokenType, 0)
+	if err != nil || !vr.Status {
+		c.log.Error("Token chain validation failed, checkpoint not created", "token", req.Token, "msg", vr.Message)
+		br.Message = "Token chain validation failed, " + vr.Message
+		return br
+	}
+	lb := c.w.GetLatestTokenBlock(req.Token, tokenType)
+	if lb == nil {
+		br.Message = "Failed to get latest token chain block"
+		return br
+	}
+	lbn, err := lb.GetBlockNumber(req.Token)
+	if err != nil {
+		br.Message = "Failed to get latest block number"
+		return br
+	}
+	if req.KeepBlocks < 0 || uint64(req.KeepBlocks) >= lbn {
+		br.Message = fmt.Sprintf("Not enough blocks to checkpoint, token chain has %d blocks", lbn+1)
+		return br
+	}
+	b, err := c.w.GetTokenBlockByNumber(req.Token, tokenType, lbn-uint64(req.KeepBlocks))
+	if err != nil {
+		c.log.Error("Failed to get checkpoint block", "err", err)
+		br.Message = "Failed to get checkpoint block, " + err.Error()
+		return br
+	}
+	cp, err := c.w.CreateTokenChainCheckpoint(req.Token, tokenType, b)
+	if err != nil {
+		br.Message = "Failed to create checkpoint, " + err.Error()
+		return br
+	}
+	br.Message = fmt.Sprintf("Checkpoint created at block %d", cp.BlockNumber)
+	if req.Prune {
+		count, err := c.w.PruneTokenChain(req.Token, req.Archive)
+		if err != nil {
+			c.log.Error("Failed to prune token chain", "token", req.Token, "err", err)
+			br.Message = br.Message + ", failed to prune token chain, " + err.Error()
+			return br
+		}
+		br.Message = br.Message + fmt.Sprintf(", pruned %d blocks", count)
+		cp, _ = c.w.GetTokenChainCheckpoint(req.Token)
+	}
+	br.Status = true
+	br.Result = cp
+	return br
+}
+
+// RestoreTokenChain brings back the archived blocks of the token
+func (c *Core) RestoreTokenChain(token string) *model.BasicResponse {
+	br := &model.BasicResponse{
+		Status: false,
+	}
+	count, err := c.w.RestoreTokenChain(token)
+	if err != nil {
+		c.log.Error("Failed to restore token chain", "token", token, "err", err)
+		br.Message = "Failed to restore token chain, " + err.Error()
+		return br
+	}
+	br.Status = true
+	br.Message = fmt.Sprintf("Restored %d blocks", count)
+	return br
+}
+
+// validateCheckpointBlock verifies the checkpoint block, it is used as trusted anchor
+// for the token chain validation, so the signatures of the block are verified along
+// with the block hash
+func (c *Core) validateCheckpointBlock(b *block.Block, token string, cp *wallet.TokenChainCheckpoint, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
+	bid, err := b.GetBlockID(token)
+	if err != nil || bid != cp.BlockID {
+		c.log.Error("checkpoint block id does not match")
+		return &model.BasicResponse{Status: false, Message: "checkpoint block id does not match"}, fmt.Errorf("checkpoint block id does not match")
+	}
+	response, err := c.ValidateBlockHash(b, token, "")
+	if err != nil || !response.Status {
+		if err == nil {
+			err = fmt.Errorf("checkpoint block hash validation failed")
+		}
+		return response, err
+	}
+	switch b.GetTransType() {
+	case block.TokenGeneratedType, block.TokenBurntType:
+		response, err = c.validateTokenOwner(b, userDID, dth)
+	default:
+		response, err = c.validateQuorums(b, userDID, dth)
+	}
+	if err != nil || !response.Status {
+		c.log.Error("checkpoint block signature validation failed", "err", err)
+		response.Message = "checkpoint block signature validation failed"
+		if err == nil {
+			err = fmt.Errorf("checkpoint block signature validation failed")
+		}
+		return response, err
+	}
+	return response, nil
+}
+
+// addSyncCheckpoint records the checkpoint of the peer when the synced chain starts from it,
+// the checkpoint block is verified before it is trusted
+func (c *Core) addSyncCheckpoint(token string, tokenType int, b *block.Block, cp *wallet.TokenChainCheckpoint) error {
+	_, err := c.w.GetTokenChainCheckpoint(token)
+	if err == nil {
+		return nil
+	}
+	_, err = c.validateCheckpointBlock(b, token, cp, "", nil)
+	if err != nil {
+		c.log.Error("Failed to validate peer checkpoint", "token", token, "err", err)
+		return fmt.Errorf("invalid checkpoint block, %v", err)
+	}
+	_, err = c.w.CreateTokenChainCheckpoint(token, tokenType, b)
+	if err != nil {
+		c.log.Error("Failed to add checkpoint", "token", token, "err", err)
+		return err
+	}
+	return nil
+}
@@ -75,8 +75,25 @@ func (c *Core) TokenChainValidation(userDID string, tokenId string, blockCount i
 	return response, nil
 }
 
+// tokenChainReader is where the token chain validation reads the blocks from,
+// it is the wallet unless the chain is validated before it gets stored
+type tokenChainReader interface {
+	GetAllTokenBlocks(token string, tokenType int, blockID string) ([][]byte, string, error)
+	GetLatestTokenBlock(token string, tokenType int) *block.Block
+	GetGenesisTokenBlock(token string, tokenType int) *block.Block
+	GetTokenChainCheckpoint(token string) (*wallet.TokenChainCheckpoint, error)
+	ReadToken(token string) (*wallet.Token, error)
+}
+
 // Validates tokenchain for the given token upto the specified block height
 func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, tokenType int, blockCount int) (*model.BasicResponse, error) {
+	return c.validateTokenChain(c.w, nil, userDID, tokenInfo, tokenType, blockCount, true)
+}
+
+// validateTokenChain validates the token chain read from tcr, pin checks need the
+// network and are skipped for offline validation. The did types of the dids not
+// known to the node are taken from dth.
+func (c *Core) validateTokenChain(tcr tokenChainReader, dth didTypeHints, userDID string, tokenInfo *wallet.Token, tokenType int, blockCount int, pinCheck bool) (*model.BasicResponse, error) {
 	c.log.Info("--------validating tokenchain", tokenInfo.TokenID, "---------")
 	response := &model.BasicResponse{
 		Status: false,
@@ -94,7 +111,7 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 	for {
 		//GetAllTokenBlocks returns next 100 blocks and nextBlockID of the 100th block,
 		//starting from the given block Id, in the direction: genesis to latest block
-		blocks, nextBlockID, err = c.w.GetAllTokenBlocks(tokenInfo.TokenID, tokenType, blockId)
+		blocks, nextBlockID, err = tcr.GetAllTokenBlocks(tokenInfo.TokenID, tokenType, blockId)
 		if err != nil {
 			response.Message = "Failed to get token chain block"
 			return response, err
@@ -106,6 +123,12 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 		}
 	}
 
+	// blocks before the checkpoint may be pruned, validation stops at the checkpoint
+	cp, err := tcr.GetTokenChainCheckpoint(tokenInfo.TokenID)
+	if err != nil {
+		cp = nil
+	}
+
 	c.log.Info("token chain length", len(blocks))
 	for i := len(blocks) - 1; i >= 0; i-- {
 		b := block.InitBlock(blocks[i], nil)
@@ -122,6 +145,16 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 
 			c.log.Info("validating at block height:", blockHeight)
 
+			if cp != nil && blockHeight == cp.BlockNumber {
+				response, err = c.validateCheckpointBlock(b, tokenInfo.TokenID, cp, userDID, dth)
+				if err != nil {
+					c.log.Error("msg", response.Message, "err", err)
+					return response, err
+				}
+				c.log.Info("reached token chain checkpoint", "block", cp.BlockNumber)
+				break
+			}
+
 			//fetch transaction type to validate the block accordingly
 			txnType := b.GetTransType()
 			switch txnType {
@@ -134,21 +167,21 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 					continue
 				}
 				//validate rbt transfer block
-				response, err = c.ValidateRBTTransferBlock(b, tokenInfo.TokenID, prevBlockId, userDID)
+				response, err = c.validateRBTTransferBlock(b, tokenInfo.TokenID, prevBlockId, userDID, dth)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
 				}
 			case block.TokenGeneratedType:
 				//validate genesis block
-				response, err = c.ValidateGenesisBlock(b, *tokenInfo, tokenType, userDID)
+				response, err = c.validateGenesisBlock(tcr, dth, b, *tokenInfo, tokenType, userDID)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
 				}
 			case block.TokenBurntType:
 				//validate RBT burnt block
-				response, err = c.ValidateRBTBurntBlock(b, *tokenInfo, prevBlockId, userDID)
+				response, err = c.validateRBTBurntBlock(b, *tokenInfo, prevBlockId, userDID, dth)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
@@ -162,7 +195,7 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 					continue
 				}
 				//validate Pledged block
-				response, err = c.ValidatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID)
+				response, err = c.validatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID, dth)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
@@ -176,7 +209,7 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 					continue
 				}
 				//validate Pledged block
-				response, err = c.ValidatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID)
+				response, err = c.validatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID, dth)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
@@ -190,7 +223,7 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 					continue
 				}
 				//validate Pledged block
-				response, err = c.ValidatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID)
+				response, err = c.validatePledgedUnpledgedBlock(b, tokenInfo.TokenID, prevBlockId, userDID, dth)
 				if err != nil {
 					c.log.Error("msg", response.Message, "err", err)
 					return response, err
@@ -210,6 +243,13 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 		}
 	}
 
+	if !pinCheck {
+		c.log.Info("token chain validated offline, skipping pin checks")
+		response.Message = "token chain validated successfully"
+		response.Status = true
+		return response, nil
+	}
+
 	//Get latest block in the token chain
 	latestBlock := c.w.GetLatestTokenBlock(tokenInfo.TokenID, tokenType)
 
@@ -244,6 +284,10 @@ func (c *Core) ValidateTokenChain(userDID string, tokenInfo *wallet.Token, token
 
 // validate block of type: TokenTransferredType = "02" / TokenDeployedType = "09" / TokenExecutedType = "10"
 func (c *Core) ValidateRBTTransferBlock(b *block.Block, tokenId string, calculatedPrevBlockId string, userDID string) (*model.BasicResponse, error) {
+	return c.validateRBTTransferBlock(b, tokenId, calculatedPrevBlockId, userDID, nil)
+}
+
+func (c *Core) validateRBTTransferBlock(b *block.Block, tokenId string, calculatedPrevBlockId string, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{}
 
 	//Validate block hash
@@ -260,7 +304,7 @@ func (c *Core) ValidateRBTTransferBlock(b *block.Block, tokenId string, calculat
 	}
 
 	//validate all quorums' signatures
-	response, err = c.ValidateQuorums(b, userDID)
+	response, err = c.validateQuorums(b, userDID, dth)
 	if err != nil {
 		c.log.Error("msg", response.Message, "err", err)
 		return response, err
@@ -274,6 +318,10 @@ func (c *Core) ValidateRBTTransferBlock(b *block.Block, tokenId string, calculat
 
 // validate block of type : TokenBurntType = "08"
 func (c *Core) ValidateRBTBurntBlock(b *block.Block, tokenInfo wallet.Token, calculatedPrevBlockId string, userDID string) (*model.BasicResponse, error) {
+	return c.validateRBTBurntBlock(b, tokenInfo, calculatedPrevBlockId, userDID, nil)
+}
+
+func (c *Core) validateRBTBurntBlock(b *block.Block, tokenInfo wallet.Token, calculatedPrevBlockId string, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{}
 
 	//Validate block hash
@@ -284,7 +332,7 @@ func (c *Core) ValidateRBTBurntBlock(b *block.Block, tokenInfo wallet.Token, cal
 	}
 
 	//validate burnt-token owner signature
-	response, err = c.ValidateTokenOwner(b, userDID)
+	response, err = c.validateTokenOwner(b, userDID, dth)
 	if err != nil {
 		response.Message = "invalid token owner in RBT burnt block"
 		c.log.Error("invalid token owner in RBT burnt block")
@@ -299,6 +347,10 @@ func (c *Core) ValidateRBTBurntBlock(b *block.Block, tokenInfo wallet.Token, cal
 
 // validate block of type : TokenPledgedType = "04" / TokenUnpledgedType = "06" / TokenContractCommited = "11"
 func (c *Core) ValidatePledgedUnpledgedBlock(b *block.Block, tokenId string, calculatedPrevBlockId string, userDID string) (*model.BasicResponse, error) {
+	return c.validatePledgedUnpledgedBlock(b, tokenId, calculatedPrevBlockId, userDID, nil)
+}
+
+func (c *Core) validatePledgedUnpledgedBlock(b *block.Block, tokenId string, calculatedPrevBlockId string, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{}
 
 	//Validate block hash
@@ -309,7 +361,7 @@ func (c *Core) ValidatePledgedUnpledgedBlock(b *block.Block, tokenId string, cal
 	}
 
 	//validate burnt-token owner signature
-	response, err = c.ValidateTokenOwner(b, userDID)
+	response, err = c.validateTokenOwner(b, userDID, dth)
 	if err != nil {
 		response.Message = "invalid token owner in RBT burnt block"
 		c.log.Error("invalid token owner in RBT burnt block")
@@ -324,6 +376,10 @@ func (c *Core) ValidatePledgedUnpledgedBlock(b *block.Block, tokenId string, cal
 
 // genesis block validation : validate block of type: TokenGeneratedType = "05"
 func (c *Core) ValidateGenesisBlock(b *block.Block, tokenInfo wallet.Token, tokenType int, userDID string) (*model.BasicResponse, error) {
+	return c.validateGenesisBlock(c.w, nil, b, tokenInfo, tokenType, userDID)
+}
+
+func (c *Core) validateGenesisBlock(tcr tokenChainReader, dth didTypeHints, b *block.Block, tokenInfo wallet.Token, tokenType int, userDID string) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{}
 
 	//Validate block hash of genesis block
@@ -334,7 +390,7 @@ func (c *Core) ValidateGenesisBlock(b *block.Block, tokenInfo wallet.Token, toke
 	}
 
 	//initial token owner signature verification
-	response, err = c.ValidateTokenOwner(b, userDID)
+	response, err = c.validateTokenOwner(b, userDID, dth)
 	if err != nil {
 		response.Message = "invalid token owner in genesis block"
 		c.log.Error("invalid token owner in genesis block")
@@ -343,7 +399,7 @@ func (c *Core) ValidateGenesisBlock(b *block.Block, tokenInfo wallet.Token, toke
 
 	//if part token, validate parent token chain
 	if tokenType == token.TestPartTokenType {
-		response, err = c.ValidateParentTokenLatestBlock(tokenInfo.ParentTokenID, userDID)
+		response, err = c.validateParentTokenLatestBlock(tcr, dth, tokenInfo.ParentTokenID, userDID)
 		if err != nil {
 			c.log.Error("msg", response.Message, "err", err)
 			return response, err
@@ -358,12 +414,16 @@ func (c *Core) ValidateGenesisBlock(b *block.Block, tokenInfo wallet.Token, toke
 
 // Validate Parent token latest block if token is part token
 func (c *Core) ValidateParentTokenLatestBlock(parentTokenId string, userDID string) (*model.BasicResponse, error) {
+	return c.validateParentTokenLatestBlock(c.w, nil, parentTokenId, userDID)
+}
+
+func (c *Core) validateParentTokenLatestBlock(tcr tokenChainReader, dth didTypeHints, parentTokenId string, userDID string) (*model.BasicResponse, error) {
 	c.log.Debug("validating parent token chain latest block", parentTokenId)
 	response := &model.BasicResponse{
 		Status: false,
 	}
 
-	parentTokenInfo, err := c.w.ReadToken(parentTokenId)
+	parentTokenInfo, err := tcr.ReadToken(parentTokenId)
 	if err != nil {
 		b, err := c.getFromIPFS(parentTokenId)
 		if err != nil {
@@ -410,8 +470,8 @@ func (c *Core) ValidateParentTokenLatestBlock(parentTokenId string, userDID stri
 	parentTokenType := c.TokenType(typeString)
 
 	//Get latest block in the token chain
-	parentTokenLatestBlock := c.w.GetLatestTokenBlock(parentTokenId, parentTokenType)
-	response, err = c.ValidateRBTBurntBlock(parentTokenLatestBlock, *parentTokenInfo, "", userDID)
+	parentTokenLatestBlock := tcr.GetLatestTokenBlock(parentTokenId, parentTokenType)
+	response, err = c.validateRBTBurntBlock(parentTokenLatestBlock, *parentTokenInfo, "", userDID, dth)
 	if err != nil {
 		c.log.Error("msg", response.Message, "err", err)
 		return response, err
@@ -420,7 +480,7 @@ func (c *Core) ValidateParentTokenLatestBlock(parentTokenId string, userDID stri
 	//if parent token is also a part token, then validate it's parent token latest block
 	if parentTokenType == c.TokenType(PartString) {
 		if parentTokenInfo.ParentTokenID == "" {
-			genesisBlock := c.w.GetGenesisTokenBlock(parentTokenId, parentTokenType)
+			genesisBlock := tcr.GetGenesisTokenBlock(parentTokenId, parentTokenType)
 			grandParentToken, _, err := genesisBlock.GetParentDetials(parentTokenId)
 			if err != nil {
 				c.log.Error("failed to get grand parent tokens to validate")
@@ -428,7 +488,7 @@ func (c *Core) ValidateParentTokenLatestBlock(parentTokenId string, userDID stri
 			c.log.Debug("grand parent token:", grandParentToken)
 			parentTokenInfo.ParentTokenID = grandParentToken
 		}
-		response, err = c.ValidateParentTokenLatestBlock(parentTokenInfo.ParentTokenID, userDID)
+		response, err = c.validateParentTokenLatestBlock(tcr, dth, parentTokenInfo.ParentTokenID, userDID)
 		if err != nil {
 			c.log.Error("msg", response.Message, "err", err)
 			return response, err
@@ -555,6 +615,10 @@ func (c *Core) ValidateSender(b *block.Block) (*model.BasicResponse, error) {
 
 // token owner signature verification
 func (c *Core) ValidateTokenOwner(b *block.Block, userDID string) (*model.BasicResponse, error) {
+	return c.validateTokenOwner(b, userDID, nil)
+}
+
+func (c *Core) validateTokenOwner(b *block.Block, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{
 		Status: false,
 	}
@@ -568,13 +632,13 @@ func (c *Core) ValidateTokenOwner(b *block.Block, userDID string) (*model.BasicR
 		var dc did.DIDCrypto
 		switch b.GetTransType() {
 		case block.TokenGeneratedType, block.TokenBurntType:
-			dc, err = c.SetupForienDID(signer, userDID)
+			dc, err = c.setupForienDID(signer, userDID, dth)
 			if err != nil {
 				c.log.Error("failed to setup foreign DID", signer, "err", err)
 				return response, err
 			}
 		default:
-			dc, err = c.SetupForienDIDQuorum(signer, userDID)
+			dc, err = c.setupForienDIDQuorum(signer, userDID, dth)
 			if err != nil {
 				c.log.Error("failed to setup foreign DID quorum", signer, "err", err)
 				return response, err
@@ -595,6 +659,10 @@ func (c *Core) ValidateTokenOwner(b *block.Block, userDID string) (*model.BasicR
 
 // quorums signature validation
 func (c *Core) ValidateQuorums(b *block.Block, userDID string) (*model.BasicResponse, error) {
+	return c.validateQuorums(b, userDID, nil)
+}
+
+func (c *Core) validateQuorums(b *block.Block, userDID string, dth didTypeHints) (*model.BasicResponse, error) {
 	response := &model.BasicResponse{
 		Status: false,
 	}
@@ -606,9 +674,32 @@ func (c *Core) ValidateQuorums(b *block.Block, userDID string) (*model.BasicResp
 		c.log.Error("failed to get quorum signature list")
 	}
 
+	//validate against the consensus policy which was in force when the block was created
+	cp, err := c.checkBlockConsensusPolicy(b)
+	if err != nil {
+		c.log.Error("invalid consensus policy in block", "err", err)
+		response.Message = "invalid consensus policy"
+		return response, err
+	}
+	if len(quorumSignList) > cp.QuorumCount {
+		response.Message = "invalid number of quorum signatures"
+		return response, fmt.Errorf("block has %d quorum signatures, consensus policy allows only %d quorums", len(quorumSignList), cp.QuorumCount)
+	}
+
+	//a quorum signs the block only once, repeated signatures can not add to the consensus
+	signers := make(map[string]bool)
+	for _, qrm := range quorumSignList {
+		if signers[qrm.DID] {
+			response.Message = "repeated quorum signature"
+			return response, fmt.Errorf("block has repeated quorum signature from %s", qrm.DID)
+		}
+		signers[qrm.DID] = true
+	}
+
 	response.Status = true
+	validSignCount := 0
 	for _, qrm := range quorumSignList {
-		qrmDIDCrypto, err := c.SetupForienDIDQuorum(qrm.DID, userDID)
+		qrmDIDCrypto, err := c.setupForienDIDQuorum(qrm.DID, userDID, dth)
 		if err != nil {
 			c.log.Error("failed to initialise quorum:", qrm.DID, "err", err)
 			continue
@@ -625,9 +716,18 @@ func (c *Core) ValidateQuorums(b *block.Block, userDID string) (*model.BasicResp
 				c.log.Error("failed signature verification for quorum:", qrm.DID)
 			}
 		}
+		if verificationStatus {
+			validSignCount++
+		}
 		response.Status = response.Status && verificationStatus
 	}
 
+	if validSignCount < cp.MinConsensus {
+		response.Status = false
+		response.Message = "not enough valid quorum signatures"
+		return response, fmt.Errorf("block has %d valid quorum signatures, consensus policy requires %d", validSignCount, cp.MinConsensus)
+	}
+
 	response.Message = "quorums validated successfully"
 	c.log.Debug("validated all quorums successfully")
 	return response, nil
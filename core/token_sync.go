@@ -0,0 +1,141 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/ipfsport"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+)
+
+const (
+	DefaultTokenSyncPageSize int = 50
+	DefaultTokenSyncWorkers  int = 8
+)
+
+// TokenSyncInfo is the token chain to be synced upto the BlockID
+type TokenSyncInfo struct {
+	Token     string
+	TokenType int
+	BlockID   string
+}
+
+// tokenSyncState is the last verified block of the token chain
+type tokenSyncState struct {
+	started     bool
+	blockID     string
+	blockNumber uint64
+}
+
+func (c *Core) tokenSyncPageSize() int {
+	ps := c.cfg.CfgData.TokenSyncConfig.PageSize
+	if ps <= 0 || ps > wallet.TCBlockCountLimit {
+		return DefaultTokenSyncPageSize
+	}
+	return ps
+}
+
+func (c *Core) tokenSyncWorkers() int {
+	w := c.cfg.CfgData.TokenSyncConfig.Workers
+	if w <= 0 {
+		return DefaultTokenSyncWorkers
+	}
+	return w
+}
+
+// lockTokenSync makes sure only one sync runs for the token at a time
+func (c *Core) lockTokenSync(token string) func() {
+	l, _ := c.tsLock.LoadOrStore(token, &sync.Mutex{})
+	m := l.(*sync.Mutex)
+	m.Lock()
+	return m.Unlock
+}
+
+// verifySyncPage verifies the hash linkage of the page against the last verified
+// block, the blocks already present are skipped. A gap in the chain is accepted
+// only at the checkpoint of the peer.
+func (c *Core) verifySyncPage(token string, tokenType int, ts *tokenSyncState, page [][]byte, cp *wallet.TokenChainCheckpoint) ([]*block.Block, *tokenSyncState, error) {
+	nts := *ts
+	blks := make([]*block.Block, 0, len(page))
+	for _, bb := range page {
+		b := block.InitBlock(bb, nil)
+		if b == nil {
+			return nil, nil, fmt.Errorf("failed to add token chain block, invalid block, sync failed")
+		}
+		bn, err := b.GetBlockNumber(token)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid token chain block, %v", err)
+		}
+		bid, err := b.GetBlockID(token)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid token chain block, %v", err)
+		}
+		switch {
+		case !nts.started:
+			if bn != 0 {
+				return nil, nil, fmt.Errorf("token chain does not start with genesis block")
+			}
+		case bn <= nts.blockNumber:
+			// already synced
+			continue
+		case bn == nts.blockNumber+1:
+			pbid, err := b.GetPrevBlockID(token)
+			if err != nil {
+				return nil, nil, err
+			}
+			if pbid != nts.blockID {
+				return nil, nil, fmt.Errorf("previous block id mismatch at block %d", bn)
+			}
+		default:
+			if cp == nil || cp.BlockNumber != bn || cp.BlockID != bid {
+				return nil, nil, fmt.Errorf("token chain block missing before block %d", bn)
+			}
+			err = c.addSyncCheckpoint(token, tokenType, b, cp)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		nts.started = true
+		nts.blockID = bid
+		nts.blockNumber = bn
+		blks = append(blks, b)
+	}
+	return blks, &nts, nil
+}
+
+// syncTokenChains syncs the token chains from the peer with bounded workers
+func (c *Core) syncTokenChains(p *ipfsport.Peer, tis []TokenSyncInfo) error {
+	return c.runTokenSync(tis, func(ti TokenSyncInfo) error {
+		return c.syncTokenChainFrom(p, ti.BlockID, ti.Token, ti.TokenType)
+	})
+}
+
+// runTokenSync runs the sync function for the tokens, at most tokenSyncWorkers at a time
+func (c *Core) runTokenSync(tis []TokenSyncInfo, sf func(ti TokenSyncInfo) error) error {
+	if len(tis) == 1 {
+		return sf(tis[0])
+	}
+	sem := make(chan struct{}, c.tokenSyncWorkers())
+	errs := make([]error, len(tis))
+	var wg sync.WaitGroup
+	for i := range tis {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = sf(tis[i])
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			c.log.Error("Failed to sync token chain", "token", tis[i].Token, "err", err)
+			return fmt.Errorf("failed to sync tokenchain Token: %v, issueType: %v", tis[i].Token, TokenChainNotSynced)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/wallet"
+	"github.com/rubixchain/rubixgoplatform/token"
+)
+
+// newSyncChain creates a token chain of n owner signed blocks without any quorum signature
+func newSyncChain(t *testing.T, tk string, owner string, n int) []*block.Block {
+	ctcb := make(map[string]*block.Block)
+	blks := make([]*block.Block, 0, n)
+	for i := 0; i < n; i++ {
+		ti := &block.TransInfo{Tokens: []block.TransTokens{{Token: tk, TokenType: token.RBTTokenType}}}
+		nb := block.CreateNewBlock(ctcb, &block.TokenChainBlock{TransactionType: block.TokenTransferredType, TokenOwner: owner, TransInfo: ti})
+		if nb == nil {
+			t.Fatal("failed to create the block")
+		}
+		bm := make(map[string]interface{})
+		for k, v := range nb.GetBlockMap() {
+			bm[k] = v
+		}
+		bm[block.TCSignatureKey] = map[string]interface{}{owner: "signature"}
+		b := block.InitBlock(nil, bm)
+		ctcb[tk] = b
+		blks = append(blks, b)
+	}
+	return blks
+}
+
+func syncPage(blks ...*block.Block) [][]byte {
+	page := make([][]byte, 0, len(blks))
+	for _, b := range blks {
+		page = append(page, b.GetBlock())
+	}
+	return page
+}
+
+func TestSyncForgedCheckpoint(t *testing.T) {
+	c := newTestJournalCore(t)
+	tk := "Qm" + strings.Repeat("c", 44)
+	blks := newSyncChain(t, tk, "owner", 5)
+	_, ts, err := c.verifySyncPage(tk, token.RBTTokenType, &tokenSyncState{}, syncPage(blks[0], blks[1]), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bid, err := blks[3].GetBlockID(tk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := syncPage(blks[3], blks[4])
+	_, _, err = c.verifySyncPage(tk, token.RBTTokenType, ts, page, nil)
+	if err == nil || !strings.Contains(err.Error(), "token chain block missing") {
+		t.Fatalf("gap is accepted without the checkpoint, %v", err)
+	}
+	// checkpoint of the peer must point to the block after the gap
+	cp := &wallet.TokenChainCheckpoint{TokenID: tk, BlockNumber: 4, BlockID: bid}
+	_, _, err = c.verifySyncPage(tk, token.RBTTokenType, ts, page, cp)
+	if err == nil || !strings.Contains(err.Error(), "token chain block missing") {
+		t.Fatalf("gap is accepted with the mismatched checkpoint, %v", err)
+	}
+	// checkpoint block is not signed by the quorums
+	cp.BlockNumber = 3
+	_, _, err = c.verifySyncPage(tk, token.RBTTokenType, ts, page, cp)
+	if err == nil || !strings.Contains(err.Error(), "invalid checkpoint block") {
+		t.Fatalf("forged checkpoint is accepted, %v", err)
+	}
+	_, err = c.w.GetTokenChainCheckpoint(tk)
+	if err == nil {
+		t.Fatal("forged checkpoint is stored")
+	}
+}
+
+func TestVerifySyncPage(t *testing.T) {
+	c := newTestJournalCore(t)
+	tk := "Qm" + strings.Repeat("a", 44)
+	blks := newSyncChain(t, tk, "owner", 5)
+	_, _, err := c.verifySyncPage(tk, token.RBTTokenType, &tokenSyncState{}, syncPage(blks[1], blks[2]), nil)
+	if err == nil {
+		t.Fatal("token chain is accepted without the genesis block")
+	}
+	vb, ts, err := c.verifySyncPage(tk, token.RBTTokenType, &tokenSyncState{}, syncPage(blks[0], blks[1], blks[2]), nil)
+	if err != nil || len(vb) != 3 {
+		t.Fatalf("failed to verify the page, %v", err)
+	}
+	bid, _ := blks[2].GetBlockID(tk)
+	if !ts.started || ts.blockNumber != 2 || ts.blockID != bid {
+		t.Fatalf("sync state is not moved to the last block, %+v", ts)
+	}
+	// next page overlaps the synced blocks
+	vb, nts, err := c.verifySyncPage(tk, token.RBTTokenType, ts, syncPage(blks[1], blks[2], blks[3], blks[4]), nil)
+	if err != nil || len(vb) != 2 || nts.blockNumber != 4 {
+		t.Fatalf("failed to verify the overlapping page, %v", err)
+	}
+	if ts.blockNumber != 2 {
+		t.Fatal("sync state of the previous page is modified")
+	}
+}
+
+func TestVerifySyncPageBrokenLink(t *testing.T) {
+	c := newTestJournalCore(t)
+	tk := "Qm" + strings.Repeat("b", 44)
+	blks := newSyncChain(t, tk, "owner", 3)
+	other := newSyncChain(t, tk, "other", 3)
+	// block 2 of the other chain does not link to block 1
+	_, _, err := c.verifySyncPage(tk, token.RBTTokenType, &tokenSyncState{}, syncPage(blks[0], blks[1], other[2]), nil)
+	if err == nil || !strings.Contains(err.Error(), "previous block id mismatch at block 2") {
+		t.Fatalf("broken link inside the page is accepted, %v", err)
+	}
+	_, ts, err := c.verifySyncPage(tk, token.RBTTokenType, &tokenSyncState{}, syncPage(blks[0], blks[1]), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = c.verifySyncPage(tk, token.RBTTokenType, ts, syncPage(other[2]), nil)
+	if err == nil {
+		t.Fatal("broken link across the pages is accepted")
+	}
+}
+
+func TestRunTokenSync(t *testing.T) {
+	c := newTestJournalCore(t)
+	c.cfg.CfgData.TokenSyncConfig = config.TokenSyncConfig{Workers: 3}
+	tis := make([]TokenSyncInfo, 20)
+	for i := range tis {
+		tis[i] = TokenSyncInfo{Token: fmt.Sprintf("t%d", i)}
+	}
+	var l sync.Mutex
+	running, max := 0, 0
+	synced := make(map[string]bool)
+	sf := func(ti TokenSyncInfo) error {
+		l.Lock()
+		running++
+		if running > max {
+			max = running
+		}
+		l.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		l.Lock()
+		running--
+		synced[ti.Token] = true
+		l.Unlock()
+		if ti.Token == "t7" {
+			return fmt.Errorf("sync failed")
+		}
+		return nil
+	}
+	err := c.runTokenSync(tis, sf)
+	if err == nil || !strings.Contains(err.Error(), "t7") {
+		t.Fatalf("sync error is not returned, %v", err)
+	}
+	if max > 3 {
+		t.Fatalf("%d token chains are synced at a time, workers limit is 3", max)
+	}
+	if len(synced) != len(tis) {
+		t.Fatalf("only %d token chains are synced", len(synced))
+	}
+	err = c.runTokenSync(tis[:1], sf)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,7 +15,14 @@ import (
 )
 
 func (c *Core) InitiateRBTTransfer(reqID string, req *model.RBTTransferRequest) {
+	err := c.startJob(reqID, JobRBTTransfer, req.Sender)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.initiateRBTTransfer(reqID, req)
+	c.finishJob(reqID, br)
+	c.emitTransferEvent(req.Sender, reqID, "rbt", req.Receiver, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -208,8 +215,15 @@ func (c *Core) initiateRBTTransfer(reqID string, req *model.RBTTransferRequest)
 		return resp
 	}
 
+	crID := uuid.New().String()
 	// release the locked tokens before exit
-	defer c.w.ReleaseTokens(tokensForTxn)
+	defer c.releaseConsensusTokens(crID, tokensForTxn)
+
+	if isSelfRBTTransfer {
+		c.journalTokensLocked(crID, SelfTransferMode, senderDID, tokensForTxn)
+	} else {
+		c.journalTokensLocked(crID, RBTTransferMode, senderDID, tokensForTxn)
+	}
 
 	for i := range tokensForTxn {
 		c.w.Pin(tokensForTxn[i].TokenID, wallet.OwnerRole, senderDID, "TID-Not Generated", req.Sender, req.Receiver, tokensForTxn[i].TokenValue)
@@ -341,7 +355,9 @@ func (c *Core) initiateRBTTransfer(reqID string, req *model.RBTTransferRequest)
 	}
 
 	cr := getConsensusRequest(req.Type, c.peerID, rpeerid, sc.GetBlock(), txEpoch, isSelfRBTTransfer)
+	cr.ReqID = crID
 
+	c.linkTxnEvents(reqID, cr.ReqID)
 	td, _, pds, err := c.initiateConsensus(cr, sc, dc)
 	if err != nil {
 		c.log.Error("Consensus failed ", "err", err)
@@ -392,7 +408,13 @@ func (c *Core) initiateRBTTransfer(reqID string, req *model.RBTTransferRequest)
 //Functions to initiate PinRBT
 
 func (c *Core) InitiatePinRBT(reqID string, req *model.RBTPinRequest) {
+	err := c.startJob(reqID, JobPinRBT, req.Sender)
+	if err != nil {
+		c.rejectJob(reqID, err)
+		return
+	}
 	br := c.initiatePinRBT(reqID, req)
+	c.finishJob(reqID, br)
 	dc := c.GetWebReq(reqID)
 	if dc == nil {
 		c.log.Error("Failed to get did channels")
@@ -502,8 +524,11 @@ func (c *Core) completePinning(st time.Time, reqID string, req *model.RBTPinRequ
 		sumOfTokensForTxn = sumOfTokensForTxn + tokenForTxn.TokenValue
 		sumOfTokensForTxn = floatPrecision(sumOfTokensForTxn, MaxDecimalPlaces)
 	}
+	crID := uuid.New().String()
 	// release the locked tokens before exit
-	defer c.w.ReleaseTokens(tokensForTxn)
+	defer c.releaseConsensusTokens(crID, tokensForTxn)
+
+	c.journalTokensLocked(crID, PinningServiceMode, did, tokensForTxn)
 
 	for i := range tokensForTxn {
 		c.w.Pin(tokensForTxn[i].TokenID, wallet.PinningRole, did, "TID-Not Generated", req.Sender, req.PinningNode, tokensForTxn[i].TokenValue)
@@ -571,13 +596,14 @@ func (c *Core) completePinning(st time.Time, reqID string, req *model.RBTPinRequ
 		return resp
 	}
 	cr := &ConensusRequest{
-		ReqID:             uuid.New().String(),
+		ReqID:             crID,
 		Type:              req.Type,
 		SenderPeerID:      c.peerID,
 		PinningNodePeerID: pinningNodepeerid,
 		ContractBlock:     sc.GetBlock(),
 		Mode:              PinningServiceMode,
 	}
+	c.linkTxnEvents(reqID, cr.ReqID)
 	td, _, pds, err := c.initiateConsensus(cr, sc, dc)
 	if err != nil {
 		c.log.Error("Consensus failed", "err", err)
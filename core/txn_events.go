@@ -0,0 +1,149 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+const (
+	// txnEventsExpiry is how long the events are kept after the request is finished
+	txnEventsExpiry = 10 * time.Minute
+	txnEventsBuffer = 64
+)
+
+// txnEvents keeps the lifecycle events of the requests, the consensus
+// request ids are linked to the request id of the web request
+type txnEvents struct {
+	lock  sync.Mutex
+	links map[string]string
+	logs  map[string]*txnEventLog
+}
+
+type txnEventLog struct {
+	events  []model.TxnEvent
+	subs    map[chan model.TxnEvent]bool
+	updated time.Time
+	done    bool
+}
+
+func newTxnEvents() *txnEvents {
+	return &txnEvents{
+		links: make(map[string]string),
+		logs:  make(map[string]*txnEventLog),
+	}
+}
+
+// getLog returns the event log of the request, caller should hold the lock
+func (te *txnEvents) getLog(reqID string) *txnEventLog {
+	el, ok := te.logs[reqID]
+	if !ok {
+		el = &txnEventLog{
+			events:  make([]model.TxnEvent, 0),
+			subs:    make(map[chan model.TxnEvent]bool),
+			updated: time.Now(),
+		}
+		te.logs[reqID] = el
+	}
+	return el
+}
+
+// prune removes the expired logs which are not followed, caller should hold the lock
+func (te *txnEvents) prune() {
+	for id, el := range te.logs {
+		if len(el.subs) == 0 && time.Since(el.updated) > txnEventsExpiry {
+			delete(te.logs, id)
+		}
+	}
+	for crID, reqID := range te.links {
+		if _, ok := te.logs[reqID]; !ok {
+			delete(te.links, crID)
+		}
+	}
+}
+
+// linkTxnEvents publishes the events of the consensus request under the request id
+func (c *Core) linkTxnEvents(reqID string, crID string) {
+	c.te.lock.Lock()
+	defer c.te.lock.Unlock()
+	c.te.prune()
+	c.te.links[crID] = reqID
+	c.te.getLog(reqID)
+}
+
+// AddTxnEvent adds the event to the request, id can be the request id or the
+// linked consensus request id. The final status finishes the request.
+func (c *Core) AddTxnEvent(id string, ev model.TxnEvent) {
+	c.te.lock.Lock()
+	defer c.te.lock.Unlock()
+	reqID, ok := c.te.links[id]
+	if !ok {
+		reqID = id
+	}
+	el := c.te.getLog(reqID)
+	if el.done {
+		return
+	}
+	ev.Seq = len(el.events) + 1
+	ev.ReqID = reqID
+	ev.Time = time.Now()
+	el.events = append(el.events, ev)
+	el.updated = ev.Time
+	for ch := range el.subs {
+		// slow subscriber misses the live event, it can resume from the last seq
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+	if ev.Type == model.TxnEventFinalStatus {
+		el.done = true
+		for crID, rid := range c.te.links {
+			if rid == reqID {
+				delete(c.te.links, crID)
+			}
+		}
+		c.te.prune()
+	}
+}
+
+// eventsFrom returns the events after the seq, caller should hold the lock
+func (el *txnEventLog) eventsFrom(seq int) []model.TxnEvent {
+	if seq < 0 {
+		seq = 0
+	}
+	evs := make([]model.TxnEvent, 0)
+	if seq < len(el.events) {
+		evs = append(evs, el.events[seq:]...)
+	}
+	return evs
+}
+
+// GetTxnEvents returns the events of the request after the seq
+func (c *Core) GetTxnEvents(reqID string, seq int) []model.TxnEvent {
+	c.te.lock.Lock()
+	defer c.te.lock.Unlock()
+	el, ok := c.te.logs[reqID]
+	if !ok {
+		return []model.TxnEvent{}
+	}
+	return el.eventsFrom(seq)
+}
+
+// SubscribeTxnEvents returns the events of the request after the seq and the
+// channel for the new events, the cancel function should be called once done
+func (c *Core) SubscribeTxnEvents(reqID string, seq int) ([]model.TxnEvent, chan model.TxnEvent, func()) {
+	c.te.lock.Lock()
+	defer c.te.lock.Unlock()
+	el := c.te.getLog(reqID)
+	ch := make(chan model.TxnEvent, txnEventsBuffer)
+	el.subs[ch] = true
+	cancel := func() {
+		c.te.lock.Lock()
+		defer c.te.lock.Unlock()
+		delete(el.subs, ch)
+		el.updated = time.Now()
+	}
+	return el.eventsFrom(seq), ch, cancel
+}
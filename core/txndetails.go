@@ -1,8 +1,8 @@
 package core
 
 import (
+	"fmt"
 	"strings"
-	"time"
 
 	"github.com/rubixchain/rubixgoplatform/core/model"
 )
@@ -16,68 +16,58 @@ func (c *Core) GetTxnDetailsByID(txnID string) (model.TransactionDetails, error)
 	return res, nil
 }
 
-func (c *Core) GetTxnDetailsByDID(did string, role string, startDateStr string, endDateStr string) ([]model.TransactionDetails, error) {
-	var startDate time.Time
-	var endDate time.Time
-	var err error
-
-	var result []model.TransactionDetails
-
-	if role == "" {
-		result, err = c.w.GetTransactionByDID(did)
-		if startDateStr == "" && endDateStr == "" {
-			if err != nil {
-				return nil, err
-			}
-			return result, nil
-		} else {
-			if startDateStr != "" {
-				startDate, err = time.Parse("2006-01-02", startDateStr)
-				if err != nil {
-					// Handle invalid date format
-					c.log.Error("Invalid StartDate format", err)
-					// Return an error response or handle it accordingly
-					return nil, err
-				}
-			}
-
-			if endDateStr != "" {
-				endDate, err = time.Parse("2006-01-02", endDateStr)
-				if err != nil {
-					// Handle invalid date format
-					c.log.Error("Invalid EndDate format", err)
-					// Return an error response or handle it accordingly
-					return nil, err
-				}
-			}
+var txnPager = &pager{
+	fields: map[string]pageField{
+		"date":   {column: "date_time", kind: pageTime},
+		"amount": {column: "amount", kind: pageNumber},
+	},
+	key:  "transaction_id",
+	sort: "-date",
+}
 
-			filteredTxnDetails, err := c.FilterTxnDetailsByDateRange(result, startDate, endDate)
-			if err != nil {
-				c.log.Error("failed to filter by date range", err)
-				return nil, err
-			}
-			return filteredTxnDetails, nil
-		}
+// GetTxnDetailsByDID returns the page of the transactions of the DID matching
+// the filter with the cursor of the next page
+func (c *Core) GetTxnDetailsByDID(did string, f *model.TxnFilter, pr *model.PageRequest) ([]model.TransactionDetails, string, error) {
+	q, err := txnPager.query(pr)
+	if err != nil {
+		return nil, "", err
 	}
-
-	lower := strings.ToLower(role)
-	if lower == "sender" {
-		txnAsSender, err := c.w.GetTransactionBySender(did)
-		if err != nil {
-			return nil, err
-		}
-		return txnAsSender, nil
+	if f == nil {
+		f = &model.TxnFilter{}
 	}
-
-	if lower == "receiver" {
-		txnAsReceiver, err := c.w.GetTransactionByReceiver(did)
-		if err != nil {
-			return nil, err
-		}
-		return txnAsReceiver, nil
+	switch strings.ToLower(f.Role) {
+	case "":
+		q.Where("(sender_did=? OR receiver_did=?)", did, did)
+	case "sender":
+		q.Where("sender_did=?", did)
+	case "receiver":
+		q.Where("receiver_did=?", did)
+	default:
+		return nil, "", fmt.Errorf("invalid role %s", f.Role)
 	}
-
-	return nil, nil
+	if !f.StartDate.IsZero() {
+		q.Where("date_time >= ?", f.StartDate)
+	}
+	if !f.EndDate.IsZero() {
+		q.Where("date_time < ?", f.EndDate)
+	}
+	if f.MinAmount > 0 {
+		q.Where("amount >= ?", f.MinAmount)
+	}
+	if f.MaxAmount > 0 {
+		q.Where("amount <= ?", f.MaxAmount)
+	}
+	td, err := c.w.GetTransactionsPage(q)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get transaction details")
+	}
+	n, next := txnPager.next(pr, len(td), func(i int, column string) (interface{}, string) {
+		if column == "amount" {
+			return td[i].Amount, td[i].TransactionID
+		}
+		return td[i].DateTime, td[i].TransactionID
+	})
+	return td[:n], next, nil
 }
 
 func (c *Core) GetTxnDetailsByComment(comment string) ([]model.TransactionDetails, error) {
@@ -88,23 +78,6 @@ func (c *Core) GetTxnDetailsByComment(comment string) ([]model.TransactionDetail
 	return res, nil
 }
 
-// FilterTxnDetailsByDateRange filters TransactionDetails by a date range.
-func (c *Core) FilterTxnDetailsByDateRange(transactions []model.TransactionDetails, startDate time.Time, endDate time.Time) ([]model.TransactionDetails, error) {
-	var filteredTransactions []model.TransactionDetails
-	for _, txn := range transactions {
-		txnDateTimeStr := txn.DateTime.Format("2006-01-02")
-		txnDateTimeParsed, err := time.Parse("2006-01-02", txnDateTimeStr)
-		if err != nil {
-			return nil, err
-		}
-		if (txnDateTimeParsed.Equal(startDate) || txnDateTimeParsed.After(startDate)) && txnDateTimeParsed.Before(endDate) {
-			filteredTransactions = append(filteredTransactions, txn)
-		}
-	}
-
-	return filteredTransactions, nil
-}
-
 func (c *Core) GetCountofTxn(did string) (model.TransactionCount, error) {
 	result := model.TransactionCount{
 		DID: did,
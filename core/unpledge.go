@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/core/wallet"
 
-	// "github.com/rubixchain/rubixgoplatform/core/model"
 	tkn "github.com/rubixchain/rubixgoplatform/token"
 )
 
@@ -99,29 +99,21 @@ func (c *Core) InititateUnpledgeProcess() (string, error) {
 				return "", err
 			}
 
-			creditStorageErr := c.w.StoreCredit(info.TransactionID, info.QuorumDID, pledgeInformation)
-			if creditStorageErr != nil {
-				errMsg := fmt.Errorf("failed while storing credits, err: %v", creditStorageErr.Error())
-				c.log.Error(errMsg.Error())
-				return "", errMsg
+			err = c.w.StoreCreditAndRemoveUnpledgeSequenceInfo(info.TransactionID, info.QuorumDID, pledgeInformation)
+			if err != nil {
+				c.log.Error(err.Error())
+				return "", err
 			}
 
-			removeUnpledgeSequenceInfoErr := c.w.RemoveUnpledgeSequenceInfo(info.TransactionID)
-			if removeUnpledgeSequenceInfoErr != nil {
-				errMsg := fmt.Errorf("failed to remove unpledgeSequenceInfo record for transaction: %v, error: %v", info.TransactionID, removeUnpledgeSequenceInfoErr)
-				c.log.Error(errMsg.Error())
-
-				// Remove the corresponding stored credit
-				creditRemovalErr := c.w.RemoveCredit(info.TransactionID)
-				if creditRemovalErr != nil {
-					errMsg := fmt.Errorf("failed to remove credit for transaction ID: %v", creditRemovalErr)
-					c.log.Error(errMsg.Error())
-					return "", errMsg
-				}
-
-				return "", errMsg
-			}
 			c.UpdatePledgeStatus(strings.Split(info.PledgeTokens, ","), info.QuorumDID)
+			c.emitWebhookEvent(info.QuorumDID, model.WebhookUnpledged, map[string]interface{}{
+				"transaction_id": info.TransactionID,
+				"tokens":         strings.Split(info.PledgeTokens, ","),
+			})
+			c.emitWebhookEvent(info.QuorumDID, model.WebhookCreditsStored, map[string]interface{}{
+				"transaction_id": info.TransactionID,
+				"credits":        pledgeInformation,
+			})
 			unpledgeAmountForTransaction, err := c.getTotalAmountFromTokenHashes(strings.Split(info.PledgeTokens, ","))
 			if err != nil {
 				return "", fmt.Errorf("failed while getting total pledge amount for transaction id: %v, err: %v", info.TransactionID, err)
@@ -143,7 +135,7 @@ func unpledgeToken(c *Core, pledgeToken string, pledgeTokenType int, quorumDID s
 	b := c.w.GetLatestTokenBlock(pledgeToken, pledgeTokenType)
 	if b == nil {
 		c.log.Error("Failed to unpledge invalid tokne chain block for token ", pledgeToken, " having token type as ", pledgeTokenType)
-		return "", "", fmt.Errorf("failed to unpledge invalid tokne chain block for token ", pledgeToken, " having token type as ", pledgeTokenType)
+		return "", "", fmt.Errorf("failed to unpledge invalid tokne chain block for token %v having token type as %v", pledgeToken, pledgeTokenType)
 	}
 
 	if b.GetTransType() != block.TokenPledgedType {
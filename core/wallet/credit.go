@@ -23,19 +23,25 @@ type PledgeInformation struct {
 	TransactionID   string `json:"transaction_id"`
 }
 
-func (w *Wallet) StoreCredit(transactionID string, quorumDID string, pledgeInfo []*PledgeInformation) error {
+func newCredit(transactionID string, quorumDID string, pledgeInfo []*PledgeInformation) (*Credit, error) {
 	pledgeInfoBytes, err := json.Marshal(pledgeInfo)
 	if err != nil {
-		return fmt.Errorf("failed while marshalling credits: %v", err.Error())
+		return nil, fmt.Errorf("failed while marshalling credits: %v", err.Error())
 	}
 	pledgeInfoEncoded := base64.StdEncoding.EncodeToString(pledgeInfoBytes)
 
-	credit := &Credit{
+	return &Credit{
 		DID:    quorumDID,
 		Credit: pledgeInfoEncoded,
 		Tx:     transactionID,
-	}
+	}, nil
+}
 
+func (w *Wallet) StoreCredit(transactionID string, quorumDID string, pledgeInfo []*PledgeInformation) error {
+	credit, err := newCredit(transactionID, quorumDID, pledgeInfo)
+	if err != nil {
+		return err
+	}
 	return w.s.Write(CreditStorage, credit)
 }
 
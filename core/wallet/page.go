@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+)
+
+// PageQuery is the page of the rows matching all the conditions
+type PageQuery struct {
+	Conds []string
+	Args  []interface{}
+	Order string
+	// Limit -1 reads all the rows
+	Limit int
+}
+
+// Where adds the condition to the query
+func (q *PageQuery) Where(cond string, args ...interface{}) {
+	q.Conds = append(q.Conds, cond)
+	q.Args = append(q.Args, args...)
+}
+
+func (w *Wallet) readPage(storageName string, value interface{}, q *PageQuery) error {
+	conds := q.Conds
+	if len(conds) == 0 {
+		conds = []string{"1=1"}
+	}
+	return w.s.ReadPage(storageName, value, q.Order, q.Limit, strings.Join(conds, " AND "), q.Args...)
+}
+
+// GetTokensPage returns the page of the RBT tokens
+func (w *Wallet) GetTokensPage(q *PageQuery) ([]Token, error) {
+	t := make([]Token, 0)
+	err := w.readPage(TokenStorage, &t, q)
+	if err != nil {
+		w.log.Error("Failed to get tokens", "err", err)
+		return nil, err
+	}
+	return t, nil
+}
+
+// GetDataTokensPage returns the page of the data tokens
+func (w *Wallet) GetDataTokensPage(q *PageQuery) ([]model.DataToken, error) {
+	dts := make([]model.DataToken, 0)
+	err := w.readPage(DataTokenStorage, &dts, q)
+	if err != nil {
+		w.log.Error("Failed to get data tokens", "err", err)
+		return nil, err
+	}
+	return dts, nil
+}
+
+// GetNFTsPage returns the page of the NFTs
+func (w *Wallet) GetNFTsPage(q *PageQuery) ([]NFT, error) {
+	tkns := make([]NFT, 0)
+	err := w.readPage(NFTTokenStorage, &tkns, q)
+	if err != nil {
+		w.log.Error("Failed to get NFTs", "err", err)
+		return nil, err
+	}
+	return tkns, nil
+}
+
+// GetDIDsPage returns the page of the DIDs
+func (w *Wallet) GetDIDsPage(q *PageQuery) ([]DIDType, error) {
+	dt := make([]DIDType, 0)
+	err := w.readPage(DIDStorage, &dt, q)
+	if err != nil {
+		w.log.Error("Failed to get DIDs", "err", err)
+		return nil, err
+	}
+	return dt, nil
+}
+
+// GetTransactionsPage returns the page of the transaction details
+func (w *Wallet) GetTransactionsPage(q *PageQuery) ([]model.TransactionDetails, error) {
+	td := make([]model.TransactionDetails, 0)
+	err := w.readPage(TransactionStorage, &td, q)
+	if err != nil {
+		w.log.Error("Failed to get transaction details", "err", err)
+		return nil, err
+	}
+	return td, nil
+}
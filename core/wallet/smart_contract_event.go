@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+)
+
+const SmartContractEventStorage string = "SmartContractEventTable"
+
+// SmartContractEvent is the event emitted in the smart contract block, the
+// event id orders the events of the contract by the block and the index
+type SmartContractEvent struct {
+	EventID       string `gorm:"column:event_id;primaryKey" json:"event_id"`
+	Token         string `gorm:"column:token" json:"token"`
+	BlockNumber   uint64 `gorm:"column:block_number" json:"block_number"`
+	BlockID       string `gorm:"column:block_id" json:"block_id"`
+	TransactionID string `gorm:"column:transaction_id" json:"transaction_id"`
+	ExecutorDID   string `gorm:"column:executor_did" json:"executor_did"`
+	EventIndex    int    `gorm:"column:event_index" json:"event_index"`
+	Name          string `gorm:"column:name" json:"name"`
+	Topic0        string `gorm:"column:topic0" json:"topic0"`
+	Topic1        string `gorm:"column:topic1" json:"topic1"`
+	Topic2        string `gorm:"column:topic2" json:"topic2"`
+	Topic3        string `gorm:"column:topic3" json:"topic3"`
+	Data          string `gorm:"column:data" json:"data"`
+}
+
+// Topics returns the topics of the event
+func (ev *SmartContractEvent) Topics() []string {
+	ts := make([]string, 0)
+	for _, t := range []string{ev.Topic0, ev.Topic1, ev.Topic2, ev.Topic3} {
+		if t != "" {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// SCEventsFromBlock returns the events of the smart contract block
+func SCEventsFromBlock(token string, b *block.Block) ([]SmartContractEvent, error) {
+	sevs, err := b.GetSCEvents()
+	if err != nil || len(sevs) == 0 {
+		return nil, err
+	}
+	bn, err := b.GetBlockNumber(token)
+	if err != nil {
+		return nil, err
+	}
+	bid, err := b.GetBlockID(token)
+	if err != nil {
+		return nil, err
+	}
+	evs := make([]SmartContractEvent, 0, len(sevs))
+	for i, sev := range sevs {
+		topics := make([]string, block.MaxSCEventTopics)
+		copy(topics, sev.Topics)
+		evs = append(evs, SmartContractEvent{
+			EventID:       fmt.Sprintf("%s-%016x-%04x", token, bn, i),
+			Token:         token,
+			BlockNumber:   bn,
+			BlockID:       bid,
+			TransactionID: b.GetTid(),
+			ExecutorDID:   b.GetExecutorDID(),
+			EventIndex:    i,
+			Name:          sev.Name,
+			Topic0:        topics[0],
+			Topic1:        topics[1],
+			Topic2:        topics[2],
+			Topic3:        topics[3],
+			Data:          sev.Data,
+		})
+	}
+	return evs, nil
+}
+
+func writeSCEvents(tx storage.Storage, token string, b *block.Block) error {
+	evs, err := SCEventsFromBlock(token, b)
+	if err != nil {
+		return err
+	}
+	for i := range evs {
+		err = tx.Write(SmartContractEventStorage, &evs[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetSCEventHandler sets the handler called with the events of the smart
+// contract blocks added to the chain
+func (w *Wallet) SetSCEventHandler(h func(evs []SmartContractEvent)) {
+	w.sceh = h
+}
+
+func (w *Wallet) publishSCEvents(token string, b *block.Block) {
+	if w.sceh == nil {
+		return
+	}
+	evs, err := SCEventsFromBlock(token, b)
+	if err != nil || len(evs) == 0 {
+		return
+	}
+	w.sceh(evs)
+}
+
+// GetSmartContractEventsPage returns the page of the smart contract events
+func (w *Wallet) GetSmartContractEventsPage(q *PageQuery) ([]SmartContractEvent, error) {
+	evs := make([]SmartContractEvent, 0)
+	err := w.readPage(SmartContractEventStorage, &evs, q)
+	if err != nil {
+		w.log.Error("Failed to get smart contract events", "err", err)
+		return nil, err
+	}
+	return evs, nil
+}
@@ -0,0 +1,358 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	tkn "github.com/rubixchain/rubixgoplatform/token"
+	ut "github.com/rubixchain/rubixgoplatform/util"
+)
+
+const (
+	SmartContractStateStorage     string = "SmartContractStateTable"
+	SmartContractStateHeadStorage string = "SmartContractStateHeadTable"
+)
+
+// SmartContractState is the value of the state key set in the block, the
+// value holds until the key is set or deleted in a later block
+type SmartContractState struct {
+	Token       string `gorm:"column:token;primaryKey" json:"token"`
+	StateKey    string `gorm:"column:state_key;primaryKey" json:"state_key"`
+	BlockNumber uint64 `gorm:"column:block_number;primaryKey" json:"block_number"`
+	StateValue  string `gorm:"column:state_value" json:"state_value"`
+	Deleted     bool   `gorm:"column:deleted" json:"deleted"`
+}
+
+// SmartContractStateHead is the range of the blocks indexed for the contract,
+// the blocks before the from block are pruned from the chain
+type SmartContractStateHead struct {
+	Token       string `gorm:"column:token;primaryKey" json:"token"`
+	FromBlock   uint64 `gorm:"column:from_block" json:"from_block"`
+	BlockNumber uint64 `gorm:"column:block_number" json:"block_number"`
+	BlockID     string `gorm:"column:block_id" json:"block_id"`
+	StateHash   string `gorm:"column:state_hash" json:"state_hash"`
+}
+
+func isSmartContractTokenType(tt int) bool {
+	return tt == tkn.SmartContractTokenType || tt == tkn.TestSmartContractTokenType
+}
+
+// parseSCState splits the state into the keys, only the JSON object state
+// has the keys and the values are kept as the compact JSON
+func parseSCState(data string) (map[string]string, bool) {
+	var m map[string]json.RawMessage
+	if json.Unmarshal([]byte(data), &m) != nil || m == nil {
+		return map[string]string{}, false
+	}
+	kv := make(map[string]string, len(m))
+	for k, v := range m {
+		var b bytes.Buffer
+		if json.Compact(&b, v) != nil {
+			return map[string]string{}, false
+		}
+		kv[k] = b.String()
+	}
+	return kv, true
+}
+
+// SCStateHash returns the hash of the smart contract state recorded in the
+// execute block, the object state is hashed in the sorted key order so the
+// key order and the spaces in the data do not change the hash
+func SCStateHash(data string) string {
+	kv, ok := parseSCState(data)
+	if ok {
+		return hashSCState(kv)
+	}
+	return ut.HexToStr(ut.CalculateHash([]byte(data), "SHA3-256"))
+}
+
+func hashSCState(kv map[string]string) string {
+	b, _ := json.Marshal(kv)
+	return ut.HexToStr(ut.CalculateHash(b, "SHA3-256"))
+}
+
+// verifySCBlock checks the state hash, the events and the code recorded in
+// the block. The blocks created before the state index do not have the hash,
+// once a block of the chain has the hash all the later blocks must have it.
+func verifySCBlock(b *block.Block, lb *block.Block) error {
+	h := b.GetSCStateHash()
+	if h == "" {
+		if lb != nil && lb.GetSCStateHash() != "" {
+			return fmt.Errorf("smart contract state hash is missing")
+		}
+	} else if h != SCStateHash(b.GetSmartContractData()) {
+		return fmt.Errorf("smart contract state hash mismatch")
+	}
+	_, err := b.GetSCEvents()
+	if err != nil {
+		return err
+	}
+	_, err = b.GetSCCode()
+	return err
+}
+
+// writeSCState writes the keys changed from the previous state
+func writeSCState(tx storage.Storage, token string, bn uint64, prev map[string]string, cur map[string]string) error {
+	for k, v := range cur {
+		if pv, ok := prev[k]; ok && pv == v {
+			continue
+		}
+		err := tx.Write(SmartContractStateStorage, &SmartContractState{Token: token, StateKey: k, BlockNumber: bn, StateValue: v})
+		if err != nil {
+			return err
+		}
+	}
+	for k := range prev {
+		if _, ok := cur[k]; ok {
+			continue
+		}
+		err := tx.Write(SmartContractStateStorage, &SmartContractState{Token: token, StateKey: k, BlockNumber: bn, Deleted: true})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSCState reads the state of the contract at the block from the index
+func readSCState(tx storage.Storage, token string, bn uint64) (map[string]string, error) {
+	var sts []SmartContractState
+	err := tx.Read(SmartContractStateStorage, &sts, "token=? AND block_number<=?", token, bn)
+	if err != nil && !strings.Contains(err.Error(), "no records found") {
+		return nil, err
+	}
+	sort.Slice(sts, func(i, j int) bool { return sts[i].BlockNumber < sts[j].BlockNumber })
+	kv := make(map[string]string)
+	for _, st := range sts {
+		if st.Deleted {
+			delete(kv, st.StateKey)
+		} else {
+			kv[st.StateKey] = st.StateValue
+		}
+	}
+	return kv, nil
+}
+
+// verifySCStateIndex hashes the state read from the index at the block and
+// checks it against the state hash recorded in the block, it returns the hash
+// of the indexed state. Only the object state has the keys in the index, the
+// other states must leave the index empty and are hashed as they are.
+func verifySCStateIndex(tx storage.Storage, token string, bn uint64, b *block.Block) (string, error) {
+	kv, err := readSCState(tx, token, bn)
+	if err != nil {
+		return "", err
+	}
+	data := b.GetSmartContractData()
+	var h string
+	if _, ok := parseSCState(data); ok {
+		h = hashSCState(kv)
+	} else if len(kv) != 0 {
+		return "", fmt.Errorf("smart contract state index mismatch at block %d", bn)
+	} else {
+		h = SCStateHash(data)
+	}
+	if b.GetSCStateHash() != "" && b.GetSCStateHash() != h {
+		return "", fmt.Errorf("smart contract state index does not match the state hash at block %d", bn)
+	}
+	return h, nil
+}
+
+func writeSCStateHead(tx storage.Storage, head *SmartContractStateHead) error {
+	err := tx.Delete(SmartContractStateHeadStorage, &SmartContractStateHead{}, "token=?", head.Token)
+	if err != nil {
+		return err
+	}
+	return tx.Write(SmartContractStateHeadStorage, head)
+}
+
+func (w *Wallet) getSCStateHead(token string) (*SmartContractStateHead, error) {
+	var head SmartContractStateHead
+	err := w.s.Read(SmartContractStateHeadStorage, &head, "token=?", token)
+	if err != nil {
+		return nil, err
+	}
+	return &head, nil
+}
+
+// indexSCState adds the state, the events and the code of the new block to
+// the index, the index is rebuilt if it is not at the previous block of the
+// chain
+func (w *Wallet) indexSCState(tt int, token string, b *block.Block, lb *block.Block) error {
+	w.scsl.Lock()
+	defer w.scsl.Unlock()
+	head, err := w.getSCStateHead(token)
+	if err != nil || lb == nil {
+		return w.rebuildSCState(tt, token)
+	}
+	lbid, err := lb.GetBlockID(token)
+	if err != nil {
+		return err
+	}
+	bn, err := b.GetBlockNumber(token)
+	if err != nil {
+		return err
+	}
+	if head.BlockID != lbid || head.BlockNumber+1 != bn {
+		return w.rebuildSCState(tt, token)
+	}
+	bid, err := b.GetBlockID(token)
+	if err != nil {
+		return err
+	}
+	prev, _ := parseSCState(lb.GetSmartContractData())
+	cur, _ := parseSCState(b.GetSmartContractData())
+	head.BlockNumber = bn
+	head.BlockID = bid
+	return w.s.WithTx(func(tx storage.Storage) error {
+		err := writeSCState(tx, token, bn, prev, cur)
+		if err == nil {
+			err = writeSCEvents(tx, token, b)
+		}
+		if err == nil {
+			err = writeSCVersion(tx, token, b)
+		}
+		if err == nil {
+			head.StateHash, err = verifySCStateIndex(tx, token, bn, b)
+		}
+		if err != nil {
+			return err
+		}
+		return writeSCStateHead(tx, head)
+	})
+}
+
+// rebuildSCState rebuilds the state and the event index of the contract from
+// the chain
+func (w *Wallet) rebuildSCState(tt int, token string) error {
+	blks, _, err := w.getAllBlocks(tt, token, "")
+	if err != nil {
+		return err
+	}
+	if len(blks) == 0 {
+		return fmt.Errorf("smart contract token chain is empty")
+	}
+	return w.s.WithTx(func(tx storage.Storage) error {
+		err := tx.Delete(SmartContractStateStorage, &SmartContractState{}, "token=?", token)
+		if err == nil {
+			err = tx.Delete(SmartContractEventStorage, &SmartContractEvent{}, "token=?", token)
+		}
+		if err != nil {
+			return err
+		}
+		var head *SmartContractStateHead
+		var lb *block.Block
+		prev := map[string]string{}
+		for _, blk := range blks {
+			b := block.InitBlock(blk, nil)
+			if b == nil {
+				return fmt.Errorf("invalid smart contract token chain block")
+			}
+			err = verifySCBlock(b, lb)
+			if err != nil {
+				return err
+			}
+			bn, err := b.GetBlockNumber(token)
+			if err != nil {
+				return err
+			}
+			bid, err := b.GetBlockID(token)
+			if err != nil {
+				return err
+			}
+			if head == nil {
+				head = &SmartContractStateHead{Token: token, FromBlock: bn}
+			} else if bn != head.BlockNumber+1 {
+				// the blocks are pruned, the state is complete from this block
+				head.FromBlock = bn
+			}
+			cur, _ := parseSCState(b.GetSmartContractData())
+			err = writeSCState(tx, token, bn, prev, cur)
+			if err == nil {
+				err = writeSCEvents(tx, token, b)
+			}
+			if err == nil {
+				err = writeSCVersion(tx, token, b)
+			}
+			if err != nil {
+				return err
+			}
+			prev = cur
+			lb = b
+			head.BlockNumber = bn
+			head.BlockID = bid
+		}
+		head.StateHash, err = verifySCStateIndex(tx, token, head.BlockNumber, lb)
+		if err != nil {
+			return err
+		}
+		return writeSCStateHead(tx, head)
+	})
+}
+
+// RebuildSmartContractState rebuilds the state index of the contract from the
+// token chain
+func (w *Wallet) RebuildSmartContractState(token string, tt int) error {
+	w.scsl.Lock()
+	defer w.scsl.Unlock()
+	return w.rebuildSCState(tt, token)
+}
+
+// SyncSmartContractIndex rebuilds the state and the event index of the
+// contract if it is behind the chain and returns the head of the index
+func (w *Wallet) SyncSmartContractIndex(token string, tt int) (*SmartContractStateHead, error) {
+	lb := w.getLatestBlock(tt, token)
+	if lb == nil {
+		return nil, fmt.Errorf("smart contract token chain is not synced")
+	}
+	lbid, err := lb.GetBlockID(token)
+	if err != nil {
+		return nil, err
+	}
+	w.scsl.Lock()
+	defer w.scsl.Unlock()
+	head, err := w.getSCStateHead(token)
+	if err != nil || head.BlockID != lbid {
+		err = w.rebuildSCState(tt, token)
+		if err == nil {
+			head, err = w.getSCStateHead(token)
+		}
+	}
+	if err != nil {
+		w.log.Error("Failed to get smart contract index", "token", token, "err", err)
+		return nil, fmt.Errorf("failed to get smart contract index, %v", err)
+	}
+	return head, nil
+}
+
+// GetSmartContractState returns the value of the state key at the block and
+// the block number, the latest block is used if latest is set. The value is
+// nil if the key is not set at the block.
+func (w *Wallet) GetSmartContractState(token string, tt int, key string, bn uint64, latest bool) (uint64, *SmartContractState, error) {
+	head, err := w.SyncSmartContractIndex(token, tt)
+	if err != nil {
+		return 0, nil, err
+	}
+	if latest {
+		bn = head.BlockNumber
+	}
+	if bn > head.BlockNumber {
+		return 0, nil, fmt.Errorf("block %d does not exist, latest block is %d", bn, head.BlockNumber)
+	}
+	if bn < head.FromBlock {
+		return 0, nil, fmt.Errorf("block %d is pruned, state is available from block %d", bn, head.FromBlock)
+	}
+	var sts []SmartContractState
+	err = w.s.ReadPage(SmartContractStateStorage, &sts, "block_number desc", 1, "token=? AND state_key=? AND block_number<=?", token, key, bn)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(sts) == 0 || sts[0].Deleted {
+		return bn, nil, nil
+	}
+	return bn, &sts[0], nil
+}
@@ -0,0 +1,39 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+)
+
+func scBlock(data string, hash string) *block.Block {
+	bm := map[string]interface{}{block.TCTransTypeKey: block.TokenExecutedType, block.TCSmartContractDataKey: data}
+	if hash != "" {
+		bm[block.TCSCStateHashKey] = hash
+	}
+	return block.InitBlock(nil, bm)
+}
+
+func TestVerifySCBlock(t *testing.T) {
+	legacy := scBlock(`{"a":1}`, "")
+	hashed := scBlock(`{"b": 2, "a": 1}`, SCStateHash(`{"a":1,"b":2}`))
+	cases := []struct {
+		b     *block.Block
+		lb    *block.Block
+		valid bool
+	}{
+		{legacy, nil, true},
+		{legacy, legacy, true},
+		{hashed, legacy, true},
+		{hashed, hashed, true},
+		// the hash can not be dropped once the chain has it
+		{legacy, hashed, false},
+		{scBlock(`{"a":2}`, SCStateHash(`{"a":1}`)), hashed, false},
+	}
+	for i, c := range cases {
+		err := verifySCBlock(c.b, c.lb)
+		if (err == nil) != c.valid {
+			t.Fatalf("case %d: unexpected result, %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+)
+
+const SmartContractVersionStorage string = "SmartContractVersionTable"
+
+// SmartContractVersion is the code of the contract set by the upgrade block,
+// the versions are kept when the blocks are pruned so that the latest code is
+// known from the index
+type SmartContractVersion struct {
+	Token          string `gorm:"column:token;primaryKey" json:"token"`
+	Version        int    `gorm:"column:version;primaryKey" json:"version"`
+	BlockNumber    uint64 `gorm:"column:block_number" json:"block_number"`
+	BlockID        string `gorm:"column:block_id" json:"block_id"`
+	TransactionID  string `gorm:"column:transaction_id" json:"transaction_id"`
+	CodeToken      string `gorm:"column:code_token" json:"code_token"`
+	BinaryCodeHash string `gorm:"column:binary_code_hash" json:"binary_code_hash"`
+	RawCodeHash    string `gorm:"column:raw_code_hash" json:"raw_code_hash"`
+	SchemaCodeHash string `gorm:"column:schema_code_hash" json:"schema_code_hash"`
+}
+
+func writeSCVersion(tx storage.Storage, token string, b *block.Block) error {
+	code, err := b.GetSCCode()
+	if err != nil || code == nil {
+		return err
+	}
+	bn, err := b.GetBlockNumber(token)
+	if err != nil {
+		return err
+	}
+	bid, err := b.GetBlockID(token)
+	if err != nil {
+		return err
+	}
+	err = tx.Delete(SmartContractVersionStorage, &SmartContractVersion{}, "token=? AND version=?", token, code.Version)
+	if err != nil {
+		return err
+	}
+	return tx.Write(SmartContractVersionStorage, &SmartContractVersion{
+		Token:          token,
+		Version:        code.Version,
+		BlockNumber:    bn,
+		BlockID:        bid,
+		TransactionID:  b.GetTid(),
+		CodeToken:      code.CodeToken,
+		BinaryCodeHash: code.BinaryCodeHash,
+		RawCodeHash:    code.RawCodeHash,
+		SchemaCodeHash: code.SchemaCodeHash,
+	})
+}
+
+// GetSmartContractVersions returns the code versions set by the upgrades of
+// the contract in the version order
+func (w *Wallet) GetSmartContractVersions(token string, tt int) ([]SmartContractVersion, error) {
+	_, err := w.SyncSmartContractIndex(token, tt)
+	if err != nil {
+		return nil, err
+	}
+	vs := make([]SmartContractVersion, 0)
+	err = w.s.ReadPage(SmartContractVersionStorage, &vs, "version", -1, "token=?", token)
+	if err != nil {
+		w.log.Error("Failed to get smart contract versions", "err", err)
+		return nil, err
+	}
+	return vs, nil
+}
@@ -9,6 +9,7 @@ import (
 	ipfsnode "github.com/ipfs/go-ipfs-api"
 	"github.com/rubixchain/rubixgoplatform/block"
 	"github.com/rubixchain/rubixgoplatform/contract"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
 	"github.com/rubixchain/rubixgoplatform/util"
 )
 
@@ -135,6 +136,19 @@ func (w *Wallet) GetFreeTokens(did string) ([]Token, error) {
 	return t, nil
 }
 
+// GetTokensByStatus returns the tokens of all the DIDs in any of the status
+func (w *Wallet) GetTokensByStatus(status ...int) ([]Token, error) {
+	var t []Token
+	err := w.s.Read(TokenStorage, &t, "token_status IN ?", status)
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []Token{}, nil
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
 func (w *Wallet) GetFTsAndCount(did string) ([]FT, error) {
 	fts, err := w.GetFreeFTsByDID(did)
 	if err != nil {
@@ -200,6 +214,54 @@ func (w *Wallet) GetFreeFTsByNameAndDID(ftName string, did string) ([]FTToken, e
 	return FT, nil
 }
 
+// LockFTTokens locks the free FTs for the transaction, none of the FTs
+// are locked if any of them is not free
+func (w *Wallet) LockFTTokens(ft []FTToken) error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	for i := range ft {
+		var t FTToken
+		err := w.s.Read(FTTokenStorage, &t, "token_id=? AND token_status=?", ft[i].TokenID, TokenIsFree)
+		if err == nil {
+			t.TokenStatus = TokenIsLocked
+			err = w.s.Update(FTTokenStorage, &t, "token_id=?", t.TokenID)
+		}
+		if err != nil {
+			w.log.Error("Failed to lock FT", "token", ft[i].TokenID, "err", err)
+			for j := 0; j < i; j++ {
+				ft[j].TokenStatus = TokenIsFree
+				w.s.Update(FTTokenStorage, &ft[j], "token_id=?", ft[j].TokenID)
+			}
+			return fmt.Errorf("failed to lock FT %s", ft[i].TokenID)
+		}
+		ft[i].TokenStatus = TokenIsLocked
+	}
+	return nil
+}
+
+// ReleaseFTTokens frees the FTs which are still locked
+func (w *Wallet) ReleaseFTTokens(tokens []string) error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	for _, tk := range tokens {
+		var t FTToken
+		err := w.s.Read(FTTokenStorage, &t, "token_id=?", tk)
+		if err != nil {
+			w.log.Error("Failed to read FT", "err", err)
+			return err
+		}
+		if t.TokenStatus == TokenIsLocked {
+			t.TokenStatus = TokenIsFree
+			err = w.s.Update(FTTokenStorage, &t, "token_id=?", tk)
+			if err != nil {
+				w.log.Error("Failed to update FT", "err", err)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (w *Wallet) GetFreeFTsByNameAndCreatorDID(ftName string, did string, creatorDID string) ([]FTToken, error) {
 	var FT []FTToken
 	err := w.s.Read(FTTokenStorage, &FT, "ft_name=? AND token_status =? AND owner_did=? AND creator_did=?", ftName, TokenIsFree, did, creatorDID)
@@ -473,9 +535,12 @@ func (w *Wallet) TokensTransferred(did string, ti []contract.TokenInfo, b *block
 	// ::TODO:: need to address part & other tokens
 	// Skip update if it is local DID
 	if !local {
-		err := w.CreateTokenBlock(b)
-		if err != nil {
-			return err
+		// the block is already added when the transaction is replayed
+		if !w.IsLatestTokenBlock(b) {
+			err := w.CreateTokenBlock(b)
+			if err != nil {
+				return err
+			}
 		}
 		var tokenStatus int
 		if pinningServiceMode {
@@ -483,19 +548,25 @@ func (w *Wallet) TokensTransferred(did string, ti []contract.TokenInfo, b *block
 		} else {
 			tokenStatus = TokenIsTransferred
 		}
-		for i := range ti {
-			var t Token
-			err := w.s.Read(TokenStorage, &t, "did=? AND token_id=?", did, ti[i].Token)
-			if err != nil {
-				return err
-			}
-			t.TokenStatus = tokenStatus
-			t.TransactionID = b.GetTid()
-
-			err = w.s.Update(TokenStorage, &t, "did=? AND token_id=?", did, ti[i].Token)
-			if err != nil {
-				return err
+		err := w.s.WithTx(func(tx storage.Storage) error {
+			for i := range ti {
+				var t Token
+				err := tx.Read(TokenStorage, &t, "did=? AND token_id=?", did, ti[i].Token)
+				if err != nil {
+					return err
+				}
+				t.TokenStatus = tokenStatus
+				t.TransactionID = b.GetTid()
+
+				err = tx.Update(TokenStorage, &t, "did=? AND token_id=?", did, ti[i].Token)
+				if err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 	// for i := range pt {
@@ -534,19 +605,25 @@ func (w *Wallet) FTTokensTransffered(did string, ti []contract.TokenInfo, b *blo
 			return err
 		}
 		tokenStatus := TokenIsTransferred
-		for i := range ti {
-			var t FTToken
-			err := w.s.Read(FTTokenStorage, &t, "token_id=?", ti[i].Token)
-			if err != nil {
-				return err
-			}
-			t.TokenStatus = tokenStatus
-			//TODO: Check the need of transaction ID in FT Tokens table
-			//t.TransactionID = b.GetTid()
-			err = w.s.Update(FTTokenStorage, &t, "token_id=?", ti[i].Token)
-			if err != nil {
-				return err
+		err = w.s.WithTx(func(tx storage.Storage) error {
+			for i := range ti {
+				var t FTToken
+				err := tx.Read(FTTokenStorage, &t, "token_id=?", ti[i].Token)
+				if err != nil {
+					return err
+				}
+				t.TokenStatus = tokenStatus
+				//TODO: Check the need of transaction ID in FT Tokens table
+				//t.TransactionID = b.GetTid()
+				err = tx.Update(FTTokenStorage, &t, "token_id=?", ti[i].Token)
+				if err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -577,7 +654,8 @@ func (w *Wallet) TokensReceived(did string, ti []contract.TokenInfo, b *block.Bl
 		tokenHashMap[t] = tokenIDTokenStateHash
 	}
 
-	// Handle each token
+	// Fetch the tokens which are not present in the wallet
+	nt := make(map[string]Token)
 	for _, tokenInfo := range ti {
 		// Check if token already exists
 		var t Token
@@ -605,40 +683,57 @@ func (w *Wallet) TokensReceived(did string, ti []contract.TokenInfo, b *block.Bl
 			}
 
 			// Create new token entry
-			t = Token{
+			nt[tokenInfo.Token] = Token{
 				TokenID:       tokenInfo.Token,
 				TokenValue:    tokenInfo.TokenValue,
 				ParentTokenID: parentTokenID,
 				DID:           tokenInfo.OwnerDID,
 			}
+		}
+	}
+
+	// Update token status
+	tokenStatus := TokenIsFree
+	role := OwnerRole
+	ownerdid := did
+	if pinningServiceMode {
+		tokenStatus = TokenIsPinnedAsService
+		role = PinningRole
+		ownerdid = b.GetOwner()
+	}
+	err = w.s.WithTx(func(tx storage.Storage) error {
+		for _, tokenInfo := range ti {
+			t, ok := nt[tokenInfo.Token]
+			if ok {
+				err := tx.Write(TokenStorage, &t)
+				if err != nil {
+					return err
+				}
+			} else {
+				err := tx.Read(TokenStorage, &t, "token_id=?", tokenInfo.Token)
+				if err != nil {
+					return err
+				}
+			}
+			t.DID = ownerdid
+			t.TokenStatus = tokenStatus
+			t.TransactionID = b.GetTid()
+			t.TokenStateHash = tokenHashMap[tokenInfo.Token]
 
-			err = w.s.Write(TokenStorage, &t)
+			err := tx.Update(TokenStorage, &t, "token_id=?", tokenInfo.Token)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
-		// Update token status and pin tokens
-		tokenStatus := TokenIsFree
-		role := OwnerRole
-		ownerdid := did
-		if pinningServiceMode {
-			tokenStatus = TokenIsPinnedAsService
-			role = PinningRole
-			ownerdid = b.GetOwner()
-		}
-
-		// Update token status
-		t.DID = ownerdid
-		t.TokenStatus = tokenStatus
-		t.TransactionID = b.GetTid()
-		t.TokenStateHash = tokenHashMap[tokenInfo.Token]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		err = w.s.Update(TokenStorage, &t, "token_id=?", tokenInfo.Token)
-		if err != nil {
-			return nil, err
-		}
-		senderAddress := senderPeerId + "." + b.GetSenderDID()
-		receiverAddress := receiverPeerId + "." + b.GetReceiverDID()
+	senderAddress := senderPeerId + "." + b.GetSenderDID()
+	receiverAddress := receiverPeerId + "." + b.GetReceiverDID()
+	for _, tokenInfo := range ti {
 		//Pinnig the whole tokens and pat tokens
 		ok, err := w.Pin(tokenInfo.Token, role, did, b.GetTid(), senderAddress, receiverAddress, tokenInfo.TokenValue)
 		if err != nil {
@@ -677,7 +772,8 @@ func (w *Wallet) FTTokensReceived(did string, ti []contract.TokenInfo, b *block.
 		tokenHashMap[t] = tokenIDTokenStateHash
 	}
 
-	// Handle each token
+	// Fetch the tokens which are not present in the wallet
+	nt := make(map[string]FTToken)
 	for _, tokenInfo := range ti {
 		var FTInfo FTToken
 		err := w.s.Read(FTTokenStorage, &FTInfo, "token_id=?", tokenInfo.Token)
@@ -703,35 +799,52 @@ func (w *Wallet) FTTokensReceived(did string, ti []contract.TokenInfo, b *block.
 			}
 			FTOwner := blk.GetOwner()
 			// Create new token entry
-			FTInfo = FTToken{
+			nt[tokenInfo.Token] = FTToken{
 				TokenID:    tokenInfo.Token,
 				TokenValue: tokenInfo.TokenValue,
 				CreatorDID: FTOwner,
 			}
+		}
+	}
+
+	// Update token status
+	tokenStatus := TokenIsFree
+	role := OwnerRole
+	ownerdid := did
+	err = w.s.WithTx(func(tx storage.Storage) error {
+		for _, tokenInfo := range ti {
+			FTInfo, ok := nt[tokenInfo.Token]
+			if ok {
+				err := tx.Write(FTTokenStorage, &FTInfo)
+				if err != nil {
+					return err
+				}
+			} else {
+				err := tx.Read(FTTokenStorage, &FTInfo, "token_id=?", tokenInfo.Token)
+				if err != nil {
+					return err
+				}
+			}
+			FTInfo.FTName = ftInfo.FTName
+			FTInfo.DID = ownerdid
+			FTInfo.TokenStatus = tokenStatus
+			FTInfo.TransactionID = b.GetTid()
+			FTInfo.TokenStateHash = tokenHashMap[tokenInfo.Token]
 
-			err = w.s.Write(FTTokenStorage, &FTInfo)
+			err := tx.Update(FTTokenStorage, &FTInfo, "token_id=?", tokenInfo.Token)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
-		// Update token status and pin tokens
-		tokenStatus := TokenIsFree
-		role := OwnerRole
-		ownerdid := did
-
-		// Update token status
-		FTInfo.FTName = ftInfo.FTName
-		FTInfo.DID = ownerdid
-		FTInfo.TokenStatus = tokenStatus
-		FTInfo.TransactionID = b.GetTid()
-		FTInfo.TokenStateHash = tokenHashMap[tokenInfo.Token]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		err = w.s.Update(FTTokenStorage, &FTInfo, "token_id=?", tokenInfo.Token)
-		if err != nil {
-			return nil, err
-		}
-		senderAddress := senderPeerId + "." + b.GetSenderDID()
-		receiverAddress := receiverPeerId + "." + b.GetReceiverDID()
+	senderAddress := senderPeerId + "." + b.GetSenderDID()
+	receiverAddress := receiverPeerId + "." + b.GetReceiverDID()
+	for _, tokenInfo := range ti {
 		//Pinnig the whole tokens and pat tokens
 		ok, err := w.Pin(tokenInfo.Token, role, did, b.GetTid(), senderAddress, receiverAddress, tokenInfo.TokenValue)
 		if err != nil {
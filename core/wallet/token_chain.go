@@ -371,7 +371,7 @@ func (w *Wallet) getLatestBlock(tt int, token string) *block.Block {
 }
 
 // addBlock will write block into storage
-func (w *Wallet) addBlock(token string, b *block.Block) error {
+func (w *Wallet) addBlock(token string, b *block.Block) (err error) {
 	opt := &opt.WriteOptions{
 		Sync: true,
 	}
@@ -411,6 +411,23 @@ func (w *Wallet) addBlock(token string, b *block.Block) error {
 			return fmt.Errorf("invalid block number, sequence missing")
 		}
 	}
+	if isSmartContractTokenType(tt) {
+		err = verifySCBlock(b, lb)
+		if err != nil {
+			w.log.Error("Invalid smart contract block", "token", token, "err", err)
+			return err
+		}
+		defer func() {
+			if err == nil {
+				ierr := w.indexSCState(tt, token, b, lb)
+				if ierr != nil {
+					// the index is rebuilt on the next block or the query
+					w.log.Error("Failed to index smart contract state", "token", token, "err", ierr)
+				}
+				w.publishSCEvents(token, b)
+			}
+		}()
+	}
 	if b.CheckMultiTokenBlock() {
 		bs, err := b.GetHash()
 		if err != nil {
@@ -573,6 +590,30 @@ func (w *Wallet) CreateTokenBlock(b *block.Block) error {
 	return w.addBlocks(b)
 }
 
+// IsLatestTokenBlock checks whether the block is the latest block of all its
+// tokens, the transaction replayed from the consensus journal may have added it
+func (w *Wallet) IsLatestTokenBlock(b *block.Block) bool {
+	tokens := b.GetTransTokens()
+	if len(tokens) == 0 {
+		return false
+	}
+	for _, t := range tokens {
+		lb := w.getLatestBlock(b.GetTokenType(t), t)
+		if lb == nil {
+			return false
+		}
+		lbid, err := lb.GetBlockID(t)
+		if err != nil {
+			return false
+		}
+		bid, err := b.GetBlockID(t)
+		if err != nil || bid != lbid {
+			return false
+		}
+	}
+	return true
+}
+
 func (w *Wallet) ClearTokenBlocks(tokenType int) error {
 	return w.clearBlocks(tokenType)
 }
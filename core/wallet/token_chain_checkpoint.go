@@ -0,0 +1,292 @@
+package wallet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	TokenChainCheckpointStorage string = "TokenChainCheckpoint"
+	TokenChainArchiveStorage    string = "tokenchainarchive"
+)
+
+// TokenChainCheckpoint records a locally verified block of the token chain,
+// blocks older than the checkpoint (except genesis) can be pruned
+type TokenChainCheckpoint struct {
+	TokenID     string    `gorm:"column:token_id;primaryKey" json:"token_id"`
+	TokenType   int       `gorm:"column:token_type" json:"token_type"`
+	BlockID     string    `gorm:"column:block_id" json:"block_id"`
+	BlockNumber uint64    `gorm:"column:block_number" json:"block_number"`
+	BlockHash   string    `gorm:"column:block_hash" json:"block_hash"`
+	PrunedCount int       `gorm:"column:pruned_count" json:"pruned_count"`
+	Archived    bool      `gorm:"column:archived" json:"archived"`
+	CreatedAt   time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// archiveDB holds the pruned token chain blocks
+type archiveDB struct {
+	*leveldb.DB
+	l sync.Mutex
+}
+
+func (w *Wallet) initArchiveDB(dir string, op *opt.Options) error {
+	adb, err := leveldb.OpenFile(dir+TokenChainArchiveStorage, op)
+	if err != nil {
+		w.log.Error("failed to configure token chain archive storage", "err", err)
+		return fmt.Errorf("failed to configure token chain archive storage")
+	}
+	w.archive = &archiveDB{DB: adb}
+	return nil
+}
+
+// GetTokenChainCheckpoint returns the checkpoint of the token
+func (w *Wallet) GetTokenChainCheckpoint(token string) (*TokenChainCheckpoint, error) {
+	var cp TokenChainCheckpoint
+	err := w.s.Read(TokenChainCheckpointStorage, &cp, "token_id=?", token)
+	if err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+// GetTokenBlockByNumber returns the token chain block at the given block number
+func (w *Wallet) GetTokenBlockByNumber(token string, tt int, bn uint64) (*block.Block, error) {
+	db := w.getChainDB(tt)
+	if db == nil {
+		return nil, fmt.Errorf("failed to get block, invalid token type")
+	}
+	err := w.updateNewKey(tt, token)
+	if err != nil {
+		return nil, err
+	}
+	prefix := []byte(tcsPrefix(tt, token) + fmt.Sprintf("%016x", bn) + "-")
+	iter := db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	if !iter.First() {
+		return nil, fmt.Errorf("token chain block %d does not exist", bn)
+	}
+	k := make([]byte, len(iter.Key()))
+	copy(k, iter.Key())
+	blk, err := w.getRawBlock(db, k)
+	if err != nil {
+		return nil, err
+	}
+	b := block.InitBlock(blk, nil)
+	if b == nil {
+		return nil, fmt.Errorf("invalid token chain block")
+	}
+	return b, nil
+}
+
+// GetTokenBlocksFrom returns up to limit token chain blocks starting from the given
+// block number, more is set if there are further blocks in the chain
+func (w *Wallet) GetTokenBlocksFrom(token string, tt int, bn uint64, limit int) ([][]byte, bool, error) {
+	db := w.getChainDB(tt)
+	if db == nil {
+		return nil, false, fmt.Errorf("failed to get blocks, invalid token type")
+	}
+	err := w.updateNewKey(tt, token)
+	if err != nil {
+		return nil, false, err
+	}
+	iter := db.NewIterator(util.BytesPrefix([]byte(tcsPrefix(tt, token))), nil)
+	defer iter.Release()
+	blks := make([][]byte, 0)
+	ok := iter.Seek([]byte(tcsPrefix(tt, token) + fmt.Sprintf("%016x", bn)))
+	for ok {
+		if len(blks) == limit {
+			return blks, true, nil
+		}
+		k := make([]byte, len(iter.Key()))
+		copy(k, iter.Key())
+		blk, err := w.getRawBlock(db, k)
+		if err != nil {
+			return nil, false, err
+		}
+		blks = append(blks, blk)
+		ok = iter.Next()
+	}
+	return blks, false, nil
+}
+
+// CreateTokenChainCheckpoint records the given block as the checkpoint of the token,
+// checkpoint can only move forward
+func (w *Wallet) CreateTokenChainCheckpoint(token string, tt int, b *block.Block) (*TokenChainCheckpoint, error) {
+	bn, err := b.GetBlockNumber(token)
+	if err != nil {
+		return nil, err
+	}
+	bid, err := b.GetBlockID(token)
+	if err != nil {
+		return nil, err
+	}
+	bh, err := b.GetHash()
+	if err != nil {
+		return nil, err
+	}
+	cp := &TokenChainCheckpoint{
+		TokenID:     token,
+		TokenType:   tt,
+		BlockID:     bid,
+		BlockNumber: bn,
+		BlockHash:   bh,
+		CreatedAt:   time.Now(),
+	}
+	ecp, err := w.GetTokenChainCheckpoint(token)
+	if err != nil {
+		err = w.s.Write(TokenChainCheckpointStorage, cp)
+	} else {
+		if bn < ecp.BlockNumber {
+			return nil, fmt.Errorf("checkpoint can not move backward, current checkpoint at %d", ecp.BlockNumber)
+		}
+		cp.PrunedCount = ecp.PrunedCount
+		cp.Archived = ecp.Archived
+		err = w.s.Update(TokenChainCheckpointStorage, cp, "token_id=?", token)
+	}
+	if err != nil {
+		w.log.Error("Failed to store token chain checkpoint", "err", err)
+		return nil, err
+	}
+	return cp, nil
+}
+
+// PruneTokenChain removes the blocks between genesis and the checkpoint,
+// removed blocks are moved into the archive storage if archive is set.
+// Blocks of the multi token transactions are stored once under the "rf-"
+// reference key & shared by all the tokens of the transaction, so only the
+// token's key is removed & the reference block is kept for the other tokens.
+func (w *Wallet) PruneTokenChain(token string, archive bool) (int, error) {
+	cp, err := w.GetTokenChainCheckpoint(token)
+	if err != nil {
+		return 0, fmt.Errorf("no checkpoint found for the token")
+	}
+	db := w.getChainDB(cp.TokenType)
+	if db == nil {
+		return 0, fmt.Errorf("failed to prune token chain, invalid token type")
+	}
+	// make sure the keys are in the new format before walking them
+	err = w.updateNewKey(cp.TokenType, token)
+	if err != nil {
+		return 0, err
+	}
+	ckey := []byte(tcsKey(cp.TokenType, token, cp.BlockID))
+	_, err = w.getRawBlock(db, ckey)
+	if err != nil {
+		return 0, fmt.Errorf("checkpoint block missing in the token chain")
+	}
+	b := new(leveldb.Batch)
+	ab := new(leveldb.Batch)
+	iter := db.NewIterator(util.BytesPrefix([]byte(tcsPrefix(cp.TokenType, token))), nil)
+	// skip genesis block
+	if !iter.First() {
+		iter.Release()
+		return 0, nil
+	}
+	count := 0
+	for iter.Next() {
+		if string(iter.Key()) >= string(ckey) {
+			break
+		}
+		k := make([]byte, len(iter.Key()))
+		copy(k, iter.Key())
+		if archive {
+			// reference blocks are shared by other tokens, archive the resolved block
+			blk, err := w.getRawBlock(db, k)
+			if err != nil {
+				iter.Release()
+				return 0, err
+			}
+			ab.Put(k, blk)
+		}
+		b.Delete(k)
+		count++
+	}
+	iter.Release()
+	if count == 0 {
+		return 0, nil
+	}
+	wo := &opt.WriteOptions{
+		Sync: true,
+	}
+	if archive {
+		w.archive.l.Lock()
+		err = w.archive.Write(ab, wo)
+		w.archive.l.Unlock()
+		if err != nil {
+			w.log.Error("Failed to archive token chain blocks", "err", err)
+			return 0, err
+		}
+	}
+	db.l.Lock()
+	err = db.Write(b, wo)
+	db.l.Unlock()
+	if err != nil {
+		w.log.Error("Failed to prune token chain blocks", "err", err)
+		return 0, err
+	}
+	cp.PrunedCount = cp.PrunedCount + count
+	cp.Archived = cp.Archived || archive
+	err = w.s.Update(TokenChainCheckpointStorage, cp, "token_id=?", token)
+	if err != nil {
+		w.log.Error("Failed to update token chain checkpoint", "err", err)
+		return count, err
+	}
+	return count, nil
+}
+
+// RestoreTokenChain moves the archived blocks of the token back into the token chain
+func (w *Wallet) RestoreTokenChain(token string) (int, error) {
+	cp, err := w.GetTokenChainCheckpoint(token)
+	if err != nil {
+		return 0, fmt.Errorf("no checkpoint found for the token")
+	}
+	if !cp.Archived {
+		return 0, fmt.Errorf("token chain is not archived")
+	}
+	db := w.getChainDB(cp.TokenType)
+	if db == nil {
+		return 0, fmt.Errorf("failed to restore token chain, invalid token type")
+	}
+	b := new(leveldb.Batch)
+	ab := new(leveldb.Batch)
+	iter := w.archive.NewIterator(util.BytesPrefix([]byte(tcsPrefix(cp.TokenType, token))), nil)
+	count := 0
+	for iter.Next() {
+		k := make([]byte, len(iter.Key()))
+		copy(k, iter.Key())
+		v := make([]byte, len(iter.Value()))
+		copy(v, iter.Value())
+		b.Put(k, v)
+		ab.Delete(k)
+		count++
+	}
+	iter.Release()
+	wo := &opt.WriteOptions{
+		Sync: true,
+	}
+	db.l.Lock()
+	err = db.Write(b, wo)
+	db.l.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	w.archive.l.Lock()
+	err = w.archive.Write(ab, wo)
+	w.archive.l.Unlock()
+	if err != nil {
+		return count, err
+	}
+	cp.PrunedCount = 0
+	cp.Archived = false
+	err = w.s.Update(TokenChainCheckpointStorage, cp, "token_id=?", token)
+	if err != nil {
+		return count, err
+	}
+	return count, nil
+}
@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/storage"
+	tkn "github.com/rubixchain/rubixgoplatform/token"
+	econfig "github.com/rubixchain/rubixgoplatform/wrapper/config"
+	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
+)
+
+func newTestWallet(t *testing.T) *Wallet {
+	dir := t.TempDir() + "/"
+	s, err := storage.NewStorageDB(&econfig.Config{DBAddress: dir + "test.db", DBType: "Sqlite3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	log := logger.New(&logger.LoggerOptions{
+		Name:   "test",
+		Level:  logger.Error,
+		Color:  []logger.ColorOption{logger.ColorOff},
+		Output: []io.Writer{io.Discard},
+	})
+	w, err := InitWallet(s, dir, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// testToken returns a token id of the ipfs hash length, keys of the shorter
+// token ids are treated as the old format keys
+func testToken(c string) string {
+	return "Qm" + strings.Repeat(c, 44)
+}
+
+// addTestBlock adds the next signed block of the given tokens to the token chain
+func addTestBlock(t *testing.T, w *Wallet, ctcb map[string]*block.Block, tokens ...string) *block.Block {
+	ti := &block.TransInfo{TID: fmt.Sprintf("tid-%d", len(ctcb))}
+	for _, tk := range tokens {
+		ti.Tokens = append(ti.Tokens, block.TransTokens{Token: tk, TokenType: tkn.RBTTokenType})
+	}
+	nb := block.CreateNewBlock(ctcb, &block.TokenChainBlock{TransactionType: block.TokenTransferredType, TokenOwner: "owner", TransInfo: ti})
+	if nb == nil {
+		t.Fatal("failed to create the block")
+	}
+	bm := make(map[string]interface{})
+	for k, v := range nb.GetBlockMap() {
+		bm[k] = v
+	}
+	bm[block.TCSignatureKey] = map[string]interface{}{"owner": "signature"}
+	b := block.InitBlock(nil, bm)
+	err := w.CreateTokenBlock(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tk := range tokens {
+		ctcb[tk] = b
+	}
+	return b
+}
+
+func TestPruneRestoreTokenChain(t *testing.T) {
+	w := newTestWallet(t)
+	a, b := testToken("a"), testToken("b")
+	ctcb := make(map[string]*block.Block)
+	blks := make([]*block.Block, 0)
+	blks = append(blks, addTestBlock(t, w, ctcb, a))
+	addTestBlock(t, w, ctcb, b)
+	// block 1 of a is shared with b
+	blks = append(blks, addTestBlock(t, w, ctcb, a, b))
+	for i := 0; i < 3; i++ {
+		blks = append(blks, addTestBlock(t, w, ctcb, a))
+	}
+	_, err := w.PruneTokenChain(a, true)
+	if err == nil {
+		t.Fatal("token chain is pruned without the checkpoint")
+	}
+	_, err = w.CreateTokenChainCheckpoint(a, tkn.RBTTokenType, blks[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.CreateTokenChainCheckpoint(a, tkn.RBTTokenType, blks[2])
+	if err == nil {
+		t.Fatal("checkpoint is moved backward")
+	}
+	count, err := w.PruneTokenChain(a, true)
+	if err != nil || count != 2 {
+		t.Fatalf("prune count mismatch, expected 2, got %d, %v", count, err)
+	}
+	for _, bn := range []uint64{1, 2} {
+		_, err = w.GetTokenBlockByNumber(a, tkn.RBTTokenType, bn)
+		if err == nil {
+			t.Fatalf("block %d is not pruned", bn)
+		}
+	}
+	for _, bn := range []uint64{0, 3, 4} {
+		blk, err := w.GetTokenBlockByNumber(a, tkn.RBTTokenType, bn)
+		if err != nil || !bytes.Equal(blk.GetBlock(), blks[bn].GetBlock()) {
+			t.Fatalf("block %d is missing after the prune, %v", bn, err)
+		}
+	}
+	// shared block is kept for the other token
+	blk, err := w.GetTokenBlockByNumber(b, tkn.RBTTokenType, 1)
+	if err != nil || !bytes.Equal(blk.GetBlock(), blks[1].GetBlock()) {
+		t.Fatalf("shared block is pruned, %v", err)
+	}
+	cp, err := w.GetTokenChainCheckpoint(a)
+	if err != nil || cp.PrunedCount != 2 || !cp.Archived {
+		t.Fatalf("checkpoint is not updated, %+v, %v", cp, err)
+	}
+
+	count, err = w.RestoreTokenChain(a)
+	if err != nil || count != 2 {
+		t.Fatalf("restore count mismatch, expected 2, got %d, %v", count, err)
+	}
+	blocks, _, err := w.GetTokenBlocksFrom(a, tkn.RBTTokenType, 0, 10)
+	if err != nil || len(blocks) != len(blks) {
+		t.Fatalf("token chain is not restored, %d blocks, %v", len(blocks), err)
+	}
+	for i := range blks {
+		if !bytes.Equal(blocks[i], blks[i].GetBlock()) {
+			t.Fatalf("block %d mismatch after the restore", i)
+		}
+	}
+	_, err = w.RestoreTokenChain(a)
+	if err == nil {
+		t.Fatal("token chain is restored without the archive")
+	}
+}
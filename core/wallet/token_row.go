@@ -0,0 +1,96 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	tkn "github.com/rubixchain/rubixgoplatform/token"
+)
+
+// tokenRowTable returns the table, the row model and the key column of the token type
+func tokenRowTable(tt int) (string, interface{}, string) {
+	switch tt {
+	case tkn.RBTTokenType, tkn.PartTokenType, tkn.TestTokenType, tkn.TestPartTokenType:
+		return TokenStorage, &Token{}, "token_id=?"
+	case tkn.NFTTokenType, tkn.TestNFTTokenType:
+		return NFTTokenStorage, &NFT{}, "token_id=?"
+	case tkn.FTTokenType:
+		return FTTokenStorage, &FTToken{}, "token_id=?"
+	case tkn.SmartContractTokenType:
+		return SmartContractStorage, &SmartContract{}, "smart_contract_hash=?"
+	case tkn.DataTokenType, tkn.TestDataTokenType:
+		return DataTokenStorage, &model.DataToken{}, "token_id=?"
+	}
+	return "", nil, ""
+}
+
+// ReadTokenRow returns the token table row of the token type as json
+func (w *Wallet) ReadTokenRow(token string, tt int) ([]byte, error) {
+	table, row, key := tokenRowTable(tt)
+	if row == nil {
+		return nil, fmt.Errorf("invalid token type")
+	}
+	w.l.Lock()
+	err := w.s.Read(table, row, key, token)
+	w.l.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(row)
+}
+
+// WriteTokenRow adds or replaces the token table row of the token type
+func (w *Wallet) WriteTokenRow(token string, tt int, rb []byte) error {
+	table, row, key := tokenRowTable(tt)
+	if row == nil {
+		return fmt.Errorf("invalid token type")
+	}
+	err := json.Unmarshal(rb, row)
+	if err != nil {
+		return fmt.Errorf("invalid token row, %v", err)
+	}
+	_, er, _ := tokenRowTable(tt)
+	w.l.Lock()
+	defer w.l.Unlock()
+	err = w.s.Read(table, er, key, token)
+	if err != nil {
+		err = w.s.Write(table, row)
+	} else {
+		err = w.s.Update(table, row, key, token)
+	}
+	if err != nil {
+		w.log.Error("Failed to write token row", "token", token, "err", err)
+		return err
+	}
+	return nil
+}
+
+// RemoveTokenRow removes the token table row of the token type
+func (w *Wallet) RemoveTokenRow(token string, tt int) error {
+	table, row, key := tokenRowTable(tt)
+	if row == nil {
+		return fmt.Errorf("invalid token type")
+	}
+	w.l.Lock()
+	defer w.l.Unlock()
+	err := w.s.Delete(table, row, key, token)
+	if err != nil {
+		w.log.Error("Failed to remove token row", "token", token, "err", err)
+		return err
+	}
+	return nil
+}
+
+// GetTokenChainType returns the token type of the token chain stored in the wallet
+func (w *Wallet) GetTokenChainType(token string) (int, error) {
+	tts := []int{tkn.RBTTokenType, tkn.PartTokenType, tkn.TestTokenType, tkn.TestPartTokenType,
+		tkn.NFTTokenType, tkn.TestNFTTokenType, tkn.FTTokenType, tkn.SmartContractTokenType,
+		tkn.DataTokenType, tkn.TestDataTokenType}
+	for _, tt := range tts {
+		if w.getLatestBlock(tt, token) != nil {
+			return tt, nil
+		}
+	}
+	return -1, fmt.Errorf("token chain does not exist")
+}
@@ -12,6 +12,7 @@ const (
 	DeployMode
 	ExecuteMode
 	PinningServiceMode
+	UpgradeMode
 )
 
 
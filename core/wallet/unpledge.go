@@ -3,6 +3,8 @@ package wallet
 import (
 	"fmt"
 	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/storage"
 )
 
 // Unpledging info associated with PoW Pledging
@@ -64,6 +66,26 @@ func (w *Wallet) RemoveUnpledgeSequenceInfo(transactionID string) error {
 	return nil
 }
 
+// StoreCreditAndRemoveUnpledgeSequenceInfo stores the credit of the unpledged
+// transaction and removes its unpledge sequence info in a single transaction
+func (w *Wallet) StoreCreditAndRemoveUnpledgeSequenceInfo(transactionID string, quorumDID string, pledgeInfo []*PledgeInformation) error {
+	credit, err := newCredit(transactionID, quorumDID, pledgeInfo)
+	if err != nil {
+		return err
+	}
+	return w.s.WithTx(func(tx storage.Storage) error {
+		err := tx.Write(CreditStorage, credit)
+		if err != nil {
+			return fmt.Errorf("failed while storing credits, err: %v", err)
+		}
+		err = tx.Delete(UnpledgeSequence, &UnpledgeSequenceInfo{}, "tx_id = ?", transactionID)
+		if err != nil {
+			return fmt.Errorf("failed to remove unpledgeSequenceInfo record for transaction: %v, error: %v", transactionID, err)
+		}
+		return nil
+	})
+}
+
 // Methods specific to migration from PoW based pledging
 func (w *Wallet) Migration_GetUnpledgeQueueInfo() ([]migration_UnpledgeQueueInfo, error) {
 	var unpledgeQueueInfo []migration_UnpledgeQueueInfo
@@ -47,6 +47,31 @@ type WalletConfig struct {
 	TokenChainDir string `json:"token_chain_dir"`
 }
 
+// WalletTables returns all the tables of the wallet stored in the storage DB
+func WalletTables() []storage.Table {
+	return []storage.Table{
+		{Name: DIDStorage, Value: &DIDType{}},
+		{Name: TokenStorage, Value: &Token{}},
+		{Name: DataTokenStorage, Value: &model.DataToken{}},
+		{Name: NFTTokenStorage, Value: &NFT{}},
+		{Name: CreditStorage, Value: &Credit{}},
+		{Name: DIDPeerStorage, Value: &DIDPeerMap{}},
+		{Name: TransactionStorage, Value: &model.TransactionDetails{}},
+		{Name: TokenProvider, Value: &TokenProviderMap{}},
+		{Name: SmartContractStorage, Value: &SmartContract{}},
+		{Name: UnpledgeSequence, Value: &UnpledgeSequenceInfo{}},
+		{Name: FTTokenStorage, Value: &FTToken{}},
+		{Name: FTStorage, Value: &FT{}},
+		{Name: CallBackUrlStorage, Value: &CallBackUrl{}},
+		{Name: TokenStateHash, Value: &TokenStateDetails{}},
+		{Name: TokenChainCheckpointStorage, Value: &TokenChainCheckpoint{}},
+		{Name: SmartContractStateStorage, Value: &SmartContractState{}},
+		{Name: SmartContractStateHeadStorage, Value: &SmartContractStateHead{}},
+		{Name: SmartContractEventStorage, Value: &SmartContractEvent{}},
+		{Name: SmartContractVersionStorage, Value: &SmartContractVersion{}},
+	}
+}
+
 type ChainDB struct {
 	leveldb.DB
 	l sync.Mutex
@@ -59,11 +84,14 @@ type Wallet struct {
 	dtl                            sync.Mutex
 	log                            logger.Logger
 	wl                             sync.Mutex
+	scsl                           sync.Mutex
 	tcs                            *ChainDB
 	dtcs                           *ChainDB
 	ntcs                           *ChainDB
 	smartContractTokenChainStorage *ChainDB
 	FTChainStorage                 *ChainDB
+	archive                        *archiveDB
+	sceh                           func(evs []SmartContractEvent)
 }
 
 func InitWallet(s storage.Storage, dir string, log logger.Logger) (*Wallet, error) {
@@ -99,63 +127,12 @@ func InitWallet(s storage.Storage, dir string, log logger.Logger) (*Wallet, erro
 		return nil, fmt.Errorf("failed to configure data chain block storage")
 	}
 	w.dtcs.DB = *dtdb
-	err = w.s.Init(DIDStorage, &DIDType{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize DID storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(TokenStorage, &Token{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize whole token storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(DataTokenStorage, &model.DataToken{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize data token storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(NFTTokenStorage, &NFT{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize data token storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(CreditStorage, &Credit{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize credit storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(DIDPeerStorage, &DIDPeerMap{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize DID Peer storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(TransactionStorage, &model.TransactionDetails{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize Transaction storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(TokenProvider, &TokenProviderMap{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize Token Provider Table", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(SmartContractStorage, &SmartContract{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize Smart Contract storage", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(UnpledgeSequence, &UnpledgeSequenceInfo{}, true)
-	if err != nil {
-		w.log.Error("failed to init UnpledgeSequence table", "err", err)
-		return nil, err
-	}
-	err = w.s.Init(FTTokenStorage, FTToken{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize FT Token storage", "err", err)
-	}
-	err = w.s.Init(FTStorage, &FT{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize FT storage", "err", err)
+	for _, t := range WalletTables() {
+		err = w.s.Init(t.Name, t.Value, true)
+		if err != nil {
+			w.log.Error("Failed to initialize storage", "table", t.Name, "err", err)
+			return nil, err
+		}
 	}
 
 	smartcontracTokenchainstorageDB, err := leveldb.OpenFile(dir+SmartContractTokenChainStorage, op)
@@ -171,15 +148,9 @@ func InitWallet(s storage.Storage, dir string, log logger.Logger) (*Wallet, erro
 		return nil, fmt.Errorf("failed to configure token chain block storage")
 	}
 	w.FTChainStorage.DB = *FTtokenStorageDB
-	err = w.s.Init(CallBackUrlStorage, &CallBackUrl{}, true)
-	if err != nil {
-		w.log.Error("Failed to initialize Smart Contract Callback Url storage", "err", err)
-		return nil, err
-	}
 
-	err = w.s.Init(TokenStateHash, &TokenStateDetails{}, true)
+	err = w.initArchiveDB(dir, op)
 	if err != nil {
-		w.log.Error("Failed to initialize TokenStateHash", "err", err)
 		return nil, err
 	}
 
@@ -0,0 +1,432 @@
+package core
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/block"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/uuid"
+)
+
+const (
+	WebhookStorage         string = "webhooks"
+	WebhookDeliveryStorage string = "webhookdeliveries"
+)
+
+// Headers of the webhook delivery, the signature is the hex HMAC-SHA256 of
+// the timestamp and the body joined with "." using the webhook secret
+const (
+	WebhookEventHeader     string = "X-Rubix-Event"
+	WebhookDeliveryHeader  string = "X-Rubix-Delivery"
+	WebhookTimestampHeader string = "X-Rubix-Timestamp"
+	WebhookSignatureHeader string = "X-Rubix-Signature"
+)
+
+const (
+	webhookPollInterval  = 5 * time.Second
+	webhookTimeout       = 10 * time.Second
+	webhookMaxAttempts   = 8
+	webhookMaxDeliveries = 100
+)
+
+// webhook retry backoff, doubled on every attempt till the max
+var (
+	webhookRetryBase = 10 * time.Second
+	webhookRetryMax  = time.Hour
+)
+
+func (c *Core) initWebhooks() error {
+	err := c.s.Init(WebhookStorage, &model.Webhook{}, true)
+	if err != nil {
+		c.log.Error("Failed to initialise storage webhooks", "err", err)
+		return err
+	}
+	err = c.s.Init(WebhookDeliveryStorage, &model.WebhookDelivery{}, true)
+	if err != nil {
+		c.log.Error("Failed to initialise storage webhook deliveries", "err", err)
+		return err
+	}
+	c.whSignal = make(chan struct{}, 1)
+	c.whStop = make(chan struct{})
+	return nil
+}
+
+// WebhookSignature returns the signature of the webhook delivery
+func WebhookSignature(secret string, timestamp string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp + "."))
+	h.Write(body)
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+func isWebhookEvent(event string) bool {
+	for _, e := range model.WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// AddWebhook registers the webhook of the DID, the secret is generated if it is not given
+func (c *Core) AddWebhook(cl *Caller, req *model.WebhookRequest) (*model.Webhook, error) {
+	if !cl.HasDID(req.DID) {
+		return nil, fmt.Errorf("access denied for the DID %s", req.DID)
+	}
+	if !c.w.IsDIDExist(req.DID) {
+		return nil, fmt.Errorf("DID does not exist")
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid webhook url")
+	}
+	for _, e := range req.Events {
+		if !isWebhookEvent(e) {
+			return nil, fmt.Errorf("invalid webhook event %s", e)
+		}
+	}
+	secret := req.Secret
+	if secret == "" {
+		sb := make([]byte, 32)
+		_, err = rand.Read(sb)
+		if err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(sb)
+	}
+	wh := &model.Webhook{
+		ID:        uuid.New().String(),
+		DID:       req.DID,
+		URL:       req.URL,
+		Secret:    secret,
+		Events:    strings.Join(req.Events, ","),
+		CreatedAt: time.Now(),
+	}
+	err = c.s.Write(WebhookStorage, wh)
+	if err != nil {
+		c.log.Error("Failed to add the webhook", "err", err)
+		return nil, fmt.Errorf("failed to add the webhook")
+	}
+	return wh, nil
+}
+
+// RemoveWebhook removes the webhook, its pending deliveries end up in the dead letters
+func (c *Core) RemoveWebhook(cl *Caller, id string) error {
+	var wh model.Webhook
+	err := c.s.Read(WebhookStorage, &wh, "id=?", id)
+	if err != nil || !cl.HasDID(wh.DID) {
+		return fmt.Errorf("webhook not found")
+	}
+	err = c.s.Delete(WebhookStorage, &model.Webhook{}, "id=?", id)
+	if err != nil {
+		c.log.Error("Failed to remove the webhook", "err", err)
+		return fmt.Errorf("failed to remove the webhook")
+	}
+	var ds []model.WebhookDelivery
+	err = c.s.Read(WebhookDeliveryStorage, &ds, "webhook_id=? AND status=?", id, model.WebhookDeliveryPending)
+	if err != nil {
+		return nil
+	}
+	for i := range ds {
+		ds[i].Status = model.WebhookDeliveryDead
+		ds[i].LastError = "webhook is removed"
+		ds[i].UpdatedAt = time.Now()
+		err = c.s.Update(WebhookDeliveryStorage, &ds[i], "id=?", ds[i].ID)
+		if err != nil {
+			c.log.Error("Failed to update the webhook delivery", "id", ds[i].ID, "err", err)
+		}
+	}
+	return nil
+}
+
+// GetWebhooks returns the webhooks of the DID or all the webhooks of the
+// caller, secrets are not returned
+func (c *Core) GetWebhooks(cl *Caller, did string) ([]model.Webhook, error) {
+	conds, args, err := cl.didFilter(did, make([]string, 0), make([]interface{}, 0))
+	if err != nil {
+		return nil, err
+	}
+	if len(conds) == 0 {
+		conds = append(conds, "id!=?")
+		args = append(args, "")
+	}
+	var whs []model.Webhook
+	err = c.s.Read(WebhookStorage, &whs, strings.Join(conds, " AND "), args...)
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []model.Webhook{}, nil
+		}
+		return nil, err
+	}
+	for i := range whs {
+		whs[i].Secret = ""
+	}
+	return whs, nil
+}
+
+// GetWebhookDeliveries returns the latest deliveries of the caller, empty
+// filters are ignored
+func (c *Core) GetWebhookDeliveries(cl *Caller, webhookID string, status string, limit int) ([]model.WebhookDelivery, error) {
+	conds, args, err := cl.didFilter("", make([]string, 0), make([]interface{}, 0))
+	if err != nil {
+		return nil, err
+	}
+	if webhookID != "" {
+		conds = append(conds, "webhook_id=?")
+		args = append(args, webhookID)
+	}
+	if status != "" {
+		conds = append(conds, "status=?")
+		args = append(args, status)
+	}
+	if len(conds) == 0 {
+		conds = append(conds, "id!=?")
+		args = append(args, "")
+	}
+	var ds []model.WebhookDelivery
+	err = c.s.Read(WebhookDeliveryStorage, &ds, strings.Join(conds, " AND "), args...)
+	if err != nil {
+		if strings.Contains(err.Error(), "no records found") {
+			return []model.WebhookDelivery{}, nil
+		}
+		return nil, err
+	}
+	sort.Slice(ds, func(i, j int) bool {
+		return ds[i].CreatedAt.After(ds[j].CreatedAt)
+	})
+	if limit <= 0 {
+		limit = webhookMaxDeliveries
+	}
+	if len(ds) > limit {
+		ds = ds[:limit]
+	}
+	return ds, nil
+}
+
+// ReplayWebhookDelivery queues the delivery again with the attempts reset
+func (c *Core) ReplayWebhookDelivery(cl *Caller, id string) error {
+	var d model.WebhookDelivery
+	err := c.s.Read(WebhookDeliveryStorage, &d, "id=?", id)
+	if err != nil || !cl.HasDID(d.DID) {
+		return fmt.Errorf("webhook delivery not found")
+	}
+	d.Status = model.WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttempt = time.Now()
+	d.LastError = ""
+	d.UpdatedAt = time.Now()
+	err = c.s.Update(WebhookDeliveryStorage, &d, "id=?", id)
+	if err != nil {
+		c.log.Error("Failed to update the webhook delivery", "err", err)
+		return fmt.Errorf("failed to replay the webhook delivery")
+	}
+	c.signalWebhooks()
+	return nil
+}
+
+func (c *Core) signalWebhooks() {
+	select {
+	case c.whSignal <- struct{}{}:
+	default:
+	}
+}
+
+// emitWebhookEvent queues the event for the webhooks of the DID
+func (c *Core) emitWebhookEvent(did string, event string, data interface{}) {
+	var whs []model.Webhook
+	err := c.s.Read(WebhookStorage, &whs, "did=?", did)
+	if err != nil {
+		return
+	}
+	queued := false
+	for _, wh := range whs {
+		if wh.Events != "" && !strings.Contains(","+wh.Events+",", ","+event+",") {
+			continue
+		}
+		now := time.Now()
+		id := uuid.New().String()
+		pb, err := json.Marshal(&model.WebhookEvent{ID: id, Event: event, DID: did, Time: now, Data: data})
+		if err != nil {
+			c.log.Error("Failed to marshal the webhook event", "err", err)
+			return
+		}
+		d := model.WebhookDelivery{
+			ID:          id,
+			WebhookID:   wh.ID,
+			DID:         did,
+			Event:       event,
+			Payload:     string(pb),
+			Status:      model.WebhookDeliveryPending,
+			NextAttempt: now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		err = c.s.Write(WebhookDeliveryStorage, &d)
+		if err != nil {
+			c.log.Error("Failed to add the webhook delivery", "event", event, "err", err)
+			continue
+		}
+		queued = true
+	}
+	if queued {
+		c.signalWebhooks()
+	}
+}
+
+// runWebhookDeliveries delivers the pending webhooks till the core is stopped
+func (c *Core) runWebhookDeliveries() {
+	t := time.NewTicker(webhookPollInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.whStop:
+			return
+		case <-c.whSignal:
+		case <-t.C:
+		}
+		c.deliverWebhooks()
+	}
+}
+
+// deliverWebhooks delivers the pending deliveries, each webhook is delivered
+// concurrently so a slow webhook does not hold the others. The deliveries of
+// a webhook are posted in order.
+func (c *Core) deliverWebhooks() {
+	var ds []model.WebhookDelivery
+	err := c.s.Read(WebhookDeliveryStorage, &ds, "status=?", model.WebhookDeliveryPending)
+	if err != nil {
+		return
+	}
+	sort.Slice(ds, func(i, j int) bool {
+		return ds[i].CreatedAt.Before(ds[j].CreatedAt)
+	})
+	hc := &http.Client{Timeout: webhookTimeout}
+	now := time.Now()
+	whds := make(map[string][]*model.WebhookDelivery)
+	for i := range ds {
+		if ds[i].NextAttempt.After(now) {
+			continue
+		}
+		whds[ds[i].WebhookID] = append(whds[ds[i].WebhookID], &ds[i])
+	}
+	var wg sync.WaitGroup
+	for _, wds := range whds {
+		wg.Add(1)
+		go func(wds []*model.WebhookDelivery) {
+			defer wg.Done()
+			for _, d := range wds {
+				select {
+				case <-c.whStop:
+					return
+				default:
+				}
+				c.deliverWebhook(hc, d)
+			}
+		}(wds)
+	}
+	wg.Wait()
+}
+
+func webhookBackoff(attempts int) time.Duration {
+	d := webhookRetryBase
+	for i := 1; i < attempts && d < webhookRetryMax; i++ {
+		d *= 2
+	}
+	if d > webhookRetryMax {
+		d = webhookRetryMax
+	}
+	return d
+}
+
+func (c *Core) deliverWebhook(hc *http.Client, d *model.WebhookDelivery) {
+	d.Attempts++
+	d.UpdatedAt = time.Now()
+	err := c.postWebhook(hc, d)
+	if err == nil {
+		d.Status = model.WebhookDeliveryDelivered
+		d.LastError = ""
+	} else {
+		d.LastError = err.Error()
+		if d.Attempts >= webhookMaxAttempts || d.ResponseCode == -1 {
+			d.Status = model.WebhookDeliveryDead
+			c.log.Error("Webhook delivery moved to dead letters", "id", d.ID, "event", d.Event, "err", err)
+		} else {
+			d.NextAttempt = time.Now().Add(webhookBackoff(d.Attempts))
+		}
+	}
+	err = c.s.Update(WebhookDeliveryStorage, d, "id=?", d.ID)
+	if err != nil {
+		c.log.Error("Failed to update the webhook delivery", "id", d.ID, "err", err)
+	}
+}
+
+// postWebhook posts the signed delivery, ResponseCode is set to -1 if the webhook is removed
+func (c *Core) postWebhook(hc *http.Client, d *model.WebhookDelivery) error {
+	var wh model.Webhook
+	err := c.s.Read(WebhookStorage, &wh, "id=?", d.WebhookID)
+	if err != nil {
+		d.ResponseCode = -1
+		return fmt.Errorf("webhook is removed")
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	body := []byte(d.Payload)
+	req, err := http.NewRequest("POST", wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, d.Event)
+	req.Header.Set(WebhookDeliveryHeader, d.ID)
+	req.Header.Set(WebhookTimestampHeader, ts)
+	req.Header.Set(WebhookSignatureHeader, WebhookSignature(wh.Secret, ts, body))
+	resp, err := hc.Do(req)
+	if err != nil {
+		d.ResponseCode = 0
+		return err
+	}
+	resp.Body.Close()
+	d.ResponseCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// blockTxnID returns the transaction id of the token chain block
+func blockTxnID(tcb []byte) string {
+	b := block.InitBlock(tcb, nil)
+	if b == nil {
+		return ""
+	}
+	return b.GetTid()
+}
+
+// emitTransferEvent queues the result of the transfer initiated by the DID
+func (c *Core) emitTransferEvent(did string, reqID string, transferType string, receiver string, br *model.BasicResponse) {
+	event := model.WebhookTransferCompleted
+	if br == nil || !br.Status {
+		event = model.WebhookTransferFailed
+	}
+	data := map[string]interface{}{
+		"request_id": reqID,
+		"type":       transferType,
+		"receiver":   receiver,
+	}
+	if br != nil {
+		data["message"] = br.Message
+	}
+	c.emitWebhookEvent(did, event, data)
+}
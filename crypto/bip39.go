@@ -145,6 +145,11 @@ func BIPDecodeMasterKey(pwd string, privKey []byte) ([]byte, error) {
 }
 
 func BIPSign(priv PrivateKey, data []byte) ([]byte, error) {
+	// same as Sign, the data is not of the digest length
+	pk, ok := priv.(*ecdsa.PrivateKey)
+	if ok {
+		return ecdsa.SignASN1(rand.Reader, pk, data)
+	}
 	return priv.(crypto.Signer).Sign(rand.Reader, data, crypto.SHA256)
 }
 
@@ -129,6 +129,12 @@ func DecodeKeyPair(pwd string, privKey []byte, pubKey []byte) (PrivateKey, Publi
 }
 
 func Sign(priv PrivateKey, data []byte) ([]byte, error) {
+	// ecdsa key signs the data as it is, the hash option makes the newer go
+	// versions reject the data which is not of the digest length
+	pk, ok := priv.(*ecdsa.PrivateKey)
+	if ok {
+		return ecdsa.SignASN1(rand.Reader, pk, data)
+	}
 	return priv.(crypto.Signer).Sign(rand.Reader, data, crypto.SHA256)
 }
 
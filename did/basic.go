@@ -43,7 +43,7 @@ func (d *DIDBasic) getPassword() (string, error) {
 			Mode: BasicDIDMode,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
@@ -44,7 +44,7 @@ func (d *DIDChild) getPassword() (string, error) {
 			Mode: BasicDIDMode,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
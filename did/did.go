@@ -32,6 +32,16 @@ type DIDChan struct {
 	Finish  chan bool
 	Req     *ensweb.Request
 	Timeout time.Duration
+	// SignRequested is called when the signature request is sent to the client
+	SignRequested func(sr *SignResponse)
+}
+
+// sendSignRequest sends the signature request to the client
+func (dc *DIDChan) sendSignRequest(sr *SignResponse) {
+	if dc.SignRequested != nil {
+		dc.SignRequested(sr)
+	}
+	dc.OutChan <- sr
 }
 
 type DID struct {
@@ -492,6 +502,23 @@ type object struct {
 
 // Calculate the hash of a directory using IPFS
 func (d *DID) getDirHash(dir string) (string, error) {
+	return d.dirHash(dir, false)
+}
+
+// CheckDIDHash verifies the public files in the directory hash to the did,
+// nothing is added to IPFS
+func (d *DID) CheckDIDHash(did string, dir string) error {
+	h, err := d.dirHash(dir, true)
+	if err != nil {
+		return err
+	}
+	if h != did {
+		return fmt.Errorf("did files do not match the did")
+	}
+	return nil
+}
+
+func (d *DID) dirHash(dir string, onlyHash bool) (string, error) {
 	// Get information about the directory
 	stat, err := os.Lstat(dir)
 	if err != nil {
@@ -517,6 +544,7 @@ func (d *DID) getDirHash(dir string) (string, error) {
 		Option("recursive", true).
 		Option("cid-version", 1).
 		Option("hash", "sha3-256").
+		Option("only-hash", onlyHash).
 		Body(reader).
 		Send(context.Background())
 	if err != nil {
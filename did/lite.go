@@ -45,7 +45,7 @@ func (d *DIDLite) getPassword() (string, error) {
 			Mode: LiteDIDMode,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
@@ -208,7 +208,7 @@ func (d *DIDLite) getSignature(hash []byte) ([]byte, error) {
 			OnlyPrivKey: true,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
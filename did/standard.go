@@ -36,7 +36,7 @@ func (d *DIDStandard) getSignature(hash []byte) ([]byte, error) {
 			Hash: hash,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
@@ -37,7 +37,7 @@ func (d *DIDWallet) getSignature(hash []byte, onlyPrivKey bool) ([]byte, []byte,
 			OnlyPrivKey: onlyPrivKey,
 		},
 	}
-	d.ch.OutChan <- sr
+	d.ch.sendSignRequest(sr)
 	var ch interface{}
 	select {
 	case ch = <-d.ch.InChan:
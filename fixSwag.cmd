@@ -1,3 +0,0 @@
-ln -s /System/Volumes/Data/Users/gokul/go/bin/darwin_arm64/swag $(go env GOPATH)/bin/swag
-ls $(go env GOPATH)/bin/swag
-swag version
@@ -10,11 +10,11 @@ import (
 )
 
 func (rn *RubixNative) GetBalance(ctx context.Context, in *emptypb.Empty) (*protos.GetBalanceRes, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
-	info, err := c.GetAccountInfo(rn.c.GetTokenDID(tkn))
+	info, err := c.GetAccountInfo(userDID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -0,0 +1,67 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func journalEntry(e *model.ConsensusJournalEntry) *protos.ConsensusJournalEntry {
+	return &protos.ConsensusJournalEntry{
+		Id:            e.ID,
+		Mode:          e.Mode,
+		State:         e.State,
+		SenderDID:     e.SenderDID,
+		ReceiverDID:   e.ReceiverDID,
+		TransactionId: e.TransactionID,
+		Tokens:        e.Tokens,
+		ReceiverAck:   e.ReceiverAck,
+		Message:       e.Message,
+		UpdatedAt:     timestamppb.New(e.UpdatedAt),
+	}
+}
+
+// GetConsensusJournal returns the journal entries which are not completed or
+// rolled back
+func (rn *RubixNative) GetConsensusJournal(ctx context.Context, in *emptypb.Empty) (*protos.ConsensusJournalResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := c.GetConsensusJournal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !jr.Status {
+		return nil, status.Errorf(codes.Internal, jr.Message)
+	}
+	resp := &protos.ConsensusJournalResp{
+		Entries: make([]*protos.ConsensusJournalEntry, 0),
+	}
+	for i := range jr.Entries {
+		resp.Entries = append(resp.Entries, journalEntry(&jr.Entries[i]))
+	}
+	return resp, nil
+}
+
+// ResolveConsensusJournal resolves the journal entry of the interrupted
+// transaction with the rollback or the rollforward action
+func (rn *RubixNative) ResolveConsensusJournal(ctx context.Context, in *protos.ResolveConsensusJournalReq) (*protos.ConsensusJournalEntry, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := c.ResolveConsensusJournal(in.Id, in.Action)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !jr.Status || jr.Entry == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, jr.Message)
+	}
+	return journalEntry(jr.Entry), nil
+}
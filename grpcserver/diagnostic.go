@@ -0,0 +1,63 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DumpTokenChain returns the token chain blocks from the block id, the
+// token type is rbt (default), ft, nft or sc. Blocks are paged by the block
+// number if the limit or the cursor is set
+func (rn *RubixNative) DumpTokenChain(ctx context.Context, in *protos.TokenChainReq) (*protos.TokenChainResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	pr := &model.PageRequest{Limit: int(in.Limit), Cursor: in.Cursor}
+	var dr *model.TCDumpReply
+	switch strings.ToLower(in.TokenType) {
+	case "", "rbt":
+		dr, err = c.DumpTokenChain(in.Token, in.BlockId, pr)
+	case "ft":
+		dr, err = c.DumpFTTokenChain(in.Token, in.BlockId, pr)
+	case "nft":
+		dr, err = c.DumpNFTTokenChain(in.Token, in.BlockId, pr)
+	case "sc":
+		dr, err = c.DumpSmartContractTokenChain(in.Token, in.BlockId, pr)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token type %s", in.TokenType)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !dr.Status {
+		return nil, status.Errorf(codes.Internal, dr.Message)
+	}
+	return &protos.TokenChainResp{Blocks: dr.Blocks, NextBlockId: dr.NextBlockID, NextCursor: dr.NextCursor}, nil
+}
+
+func (rn *RubixNative) ValidateTokenChain(ctx context.Context, in *protos.ValidateTokenChainReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.ValidateTokenchain(userDID, in.SmartContract, in.Token, int(in.BlockCount))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetNodeInfo(ctx context.Context, in *emptypb.Empty) (*protos.NodeInfo, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.NodeInfo{PeerId: rn.c.GetPeerID(), Status: c.GetNodeStatus()}, nil
+}
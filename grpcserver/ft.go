@@ -0,0 +1,71 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rn *RubixNative) CreateFT(ctx context.Context, in *protos.CreateFTReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.CreateFT(userDID, in.FtName, int(in.FtCount), int(in.TokenCount), in.IdempotencyKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) TransferFT(ctx context.Context, in *protos.TransferFTReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	rt := &model.TransferFTReq{
+		Receiver:       in.Receiver,
+		Sender:         userDID,
+		FTName:         in.FtName,
+		FTCount:        int(in.FtCount),
+		Comment:        in.Comment,
+		QuorumType:     int(in.QuorumType),
+		Password:       in.Password,
+		CreatorDID:     in.CreatorDID,
+		IdempotencyKey: in.IdempotencyKey,
+	}
+	br, err := c.TransferFT(rt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetFTInfo(ctx context.Context, in *protos.FTInfoReq) (*protos.FTInfoResp, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.GetFTInfo(userDID, pageRequest(in.Page))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !info.Status {
+		return nil, status.Errorf(codes.Internal, info.Message)
+	}
+	resp := &protos.FTInfoResp{
+		FtInfo:     make([]*protos.FTInfo, 0),
+		NextCursor: info.NextCursor,
+	}
+	for _, ft := range info.FTInfo {
+		resp.FtInfo = append(resp.FtInfo, &protos.FTInfo{
+			FtName:     ft.FTName,
+			FtCount:    int32(ft.FTCount),
+			CreatorDID: ft.CreatorDID,
+		})
+	}
+	return resp, nil
+}
@@ -136,22 +136,27 @@ func (s *ServerGRPC) Run() {
 		s.log.Panic("failed to listen", "err", err)
 	}
 	var server *grpc.Server
+	// role permissions of the methods are checked in the interceptors
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(s.unaryAuth),
+		grpc.StreamInterceptor(s.streamAuth),
+	}
 	if s.secure {
 		tlsCredentials, err := loadTLSCredentials()
 		if err != nil {
 			s.log.Panic("cannot load TLS credentials: ", err)
 		}
-		server = grpc.NewServer(grpc.Creds(tlsCredentials))
-	} else {
-		server = grpc.NewServer()
+		opts = append(opts, grpc.Creds(tlsCredentials))
 	}
-	// Creates a new gRPC server with UnaryInterceptor
+	server = grpc.NewServer(opts...)
 
 	protos.RegisterRubixServiceServer(server, s.Native)
 	s.log.Info("Running GRPC server...")
 	server.Serve(lis)
 }
 
+// getClient returns the API client with the credentials of the caller and
+// the DID acting in the request
 func (rn *RubixNative) getClient(ctx context.Context, auth bool) (*client.Client, string, error) {
 	c, err := client.NewClient(rn.cfg, rn.log.Named("grpcclient"), 10*time.Minute)
 	if err != nil {
@@ -160,12 +165,21 @@ func (rn *RubixNative) getClient(ctx context.Context, auth bool) (*client.Client
 	if !auth {
 		return c, "", nil
 	}
-	tkn, ok := getAuthToken(ctx)
-	if !ok {
-		return nil, "", status.Errorf(codes.Unauthenticated, err.Error())
+	_, did, err := getCaller(rn.c, ctx)
+	if err != nil {
+		return nil, "", err
 	}
-	c.SetAuthToken(tkn)
-	return c, tkn, nil
+	// the API server checks the caller again with the same credentials
+	if key := getMetadata(ctx, apiKeyMetadata); key != "" {
+		c.SetAPIKey(key)
+	} else {
+		tkn, _ := getAuthToken(ctx)
+		c.SetAuthToken(tkn)
+	}
+	if id := getMetadata(ctx, "x-request-id"); id != "" {
+		c.SetRequestID(id)
+	}
+	return c, did, nil
 }
 
 func (rn *RubixNative) basicResponse(br *model.BasicResponse) (*protos.BasicReponse, error) {
@@ -182,8 +196,9 @@ func (rn *RubixNative) basicResponse(br *model.BasicResponse) (*protos.BasicRepo
 	}
 	var sr did.SignReqData
 	err = json.Unmarshal(jb, &sr)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+	if err != nil || sr.ID == "" || len(sr.Hash) == 0 {
+		// result is not a signature request, ex: job id of the request
+		return resp, nil
 	}
 	resp.SignNeeded = true
 	resp.SignRequest = &protos.SignRequest{
@@ -0,0 +1,66 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func job(j *model.Job) *protos.Job {
+	return &protos.Job{
+		Id:             j.ID,
+		Type:           j.Type,
+		Did:            j.DID,
+		Status:         j.Status,
+		Result:         j.Result,
+		Error:          j.Error,
+		CreatedAt:      timestamppb.New(j.CreatedAt),
+		UpdatedAt:      timestamppb.New(j.UpdatedAt),
+		IdempotencyKey: j.IdempotencyKey,
+	}
+}
+
+// GetJobs returns the jobs of the caller DIDs, filtered by the DID, the job
+// type and the status
+func (rn *RubixNative) GetJobs(ctx context.Context, in *protos.JobsReq) (*protos.JobsResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := c.GetJobs(in.Did, in.Type, in.Status, int(in.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !jr.Status {
+		return nil, status.Errorf(codes.Internal, jr.Message)
+	}
+	resp := &protos.JobsResp{
+		Jobs: make([]*protos.Job, 0),
+	}
+	for i := range jr.Jobs {
+		resp.Jobs = append(resp.Jobs, job(&jr.Jobs[i]))
+	}
+	return resp, nil
+}
+
+func (rn *RubixNative) GetJob(ctx context.Context, in *protos.JobReq) (*protos.Job, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job id is required")
+	}
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := c.GetJob(in.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !jr.Status || jr.Job == nil {
+		return nil, status.Errorf(codes.NotFound, jr.Message)
+	}
+	return job(jr.Job), nil
+}
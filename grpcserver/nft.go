@@ -0,0 +1,127 @@
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/rubixchain/rubixgoplatform/client"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rn *RubixNative) CreateNFT(ctx context.Context, in *protos.CreateNFTReq) (*protos.BasicReponse, error) {
+	if len(in.Metadata) == 0 || len(in.Artifact) == 0 || in.ArtifactName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "metadata, artifact and artifact name are required")
+	}
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	folderName, err := rn.c.CreateTempFolder()
+	if err != nil {
+		rn.log.Error("failed to create folder")
+		return nil, status.Errorf(codes.Internal, "failed to create folder")
+	}
+	defer os.RemoveAll(folderName)
+	req := &client.CreateNFTReq{
+		DID:      userDID,
+		Metadata: filepath.Join(folderName, "metadata.json"),
+		Artifact: filepath.Join(folderName, filepath.Base(in.ArtifactName)),
+	}
+	err = createFile(req.Metadata, string(in.Metadata), false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	err = createFile(req.Artifact, string(in.Artifact), false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	br, err := c.CreateNFT(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) DeployNFT(ctx context.Context, in *protos.DeployNFTReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	dr := &model.DeployNFTRequest{
+		NFT:        in.Nft,
+		DID:        userDID,
+		QuorumType: int(in.QuorumType),
+		NFTValue:   in.NftValue,
+		NFTData:    in.NftData,
+	}
+	br, err := c.DeployNFT(dr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) ExecuteNFT(ctx context.Context, in *protos.ExecuteNFTReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	er := &model.ExecuteNFTRequest{
+		NFT:            in.Nft,
+		Owner:          userDID,
+		Receiver:       in.Receiver,
+		QuorumType:     int(in.QuorumType),
+		Comment:        in.Comment,
+		NFTValue:       in.NftValue,
+		NFTData:        in.NftData,
+		IdempotencyKey: in.IdempotencyKey,
+	}
+	br, err := c.ExecuteNFT(er)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) SubscribeNFT(ctx context.Context, in *protos.NFTReq) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.SubscribeNFT(in.Nft)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetNFTs(ctx context.Context, in *protos.NFTListReq) (*protos.NFTListResp, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	nt, err := c.GetNFTsByDid(userDID, tokenFilter(in.Status, in.MinValue, in.MaxValue, ""), pageRequest(in.Page))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !nt.Status {
+		return nil, status.Errorf(codes.Internal, nt.Message)
+	}
+	resp := &protos.NFTListResp{
+		Nfts:       make([]*protos.NFTToken, 0),
+		NextCursor: nt.NextCursor,
+	}
+	for _, t := range nt.NFTs {
+		resp.Nfts = append(resp.Nfts, &protos.NFTToken{
+			Token:       t.NFTId,
+			TokenStatus: int32(t.Status),
+			Value:       t.Value,
+			Owner:       t.Owner,
+		})
+	}
+	return resp, nil
+}
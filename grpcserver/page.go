@@ -0,0 +1,29 @@
+package grpcserver
+
+import (
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+)
+
+func pageRequest(pr *protos.PageReq) *model.PageRequest {
+	if pr == nil {
+		return nil
+	}
+	return &model.PageRequest{
+		Limit:  int(pr.Limit),
+		Cursor: pr.Cursor,
+		Sort:   pr.Sort,
+	}
+}
+
+func tokenFilter(status []int32, minValue float64, maxValue float64, tokenType string) *model.TokenFilter {
+	f := &model.TokenFilter{
+		MinValue:  minValue,
+		MaxValue:  maxValue,
+		TokenType: tokenType,
+	}
+	for _, s := range status {
+		f.Status = append(f.Status, int(s))
+	}
+	return f
+}
@@ -0,0 +1,67 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (rn *RubixNative) AddQuorum(ctx context.Context, in *protos.QuorumList) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	ql := make([]core.QuorumData, 0)
+	for _, q := range in.Quorums {
+		ql = append(ql, core.QuorumData{Type: int(q.Type), Address: q.Address})
+	}
+	msg, ok := c.AddQuorumList(ql)
+	return &protos.BasicReponse{Status: ok, Message: msg}, nil
+}
+
+func (rn *RubixNative) GetAllQuorum(ctx context.Context, in *emptypb.Empty) (*protos.QuorumAddresses, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	ql, err := c.GettAllQuorum()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !ql.Status {
+		return nil, status.Errorf(codes.Internal, ql.Message)
+	}
+	return &protos.QuorumAddresses{Addresses: ql.Result}, nil
+}
+
+func (rn *RubixNative) RemoveAllQuorum(ctx context.Context, in *emptypb.Empty) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := c.RemoveAllQuorum()
+	return &protos.BasicReponse{Status: ok, Message: msg}, nil
+}
+
+// SetupQuorum sets up the DID of the access token as the quorum of the node
+func (rn *RubixNative) SetupQuorum(ctx context.Context, in *protos.SetupQuorumReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := c.SetupQuorum(userDID, in.Password, in.PrivKeyPassword)
+	return &protos.BasicReponse{Status: ok, Message: msg}, nil
+}
+
+func (rn *RubixNative) CheckQuorumStatus(ctx context.Context, in *protos.QuorumStatusReq) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := c.CheckQuorumStatus(in.QuorumAddress)
+	return &protos.BasicReponse{Status: ok, Message: msg}, nil
+}
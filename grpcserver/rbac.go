@@ -0,0 +1,165 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata of the API key callers, the DID acting in the request is sent with
+// the API key as the methods act on the DID of the caller
+const (
+	apiKeyMetadata string = "x-api-key"
+	didMetadata    string = "did"
+)
+
+// methodPermissions is the permission required for the method, same as the
+// HTTP routes of the method. Methods act on the DID of the access token or
+// the did metadata checked against the DIDs of the API key. Empty permission
+// is a public method and methods not listed here are denied.
+var methodPermissions = map[string]string{
+	protos.RubixService_GetDIDChallenge_FullMethodName:          "",
+	protos.RubixService_GetDIDAccess_FullMethodName:             "",
+	protos.RubixService_CreateDID_FullMethodName:                "",
+	protos.RubixService_GetAllTokens_FullMethodName:             core.PermDIDRead,
+	protos.RubixService_GetBalance_FullMethodName:               core.PermDIDRead,
+	protos.RubixService_GetTransactionHistory_FullMethodName:    core.PermDIDRead,
+	protos.RubixService_StreamIncomingTxn_FullMethodName:        core.PermDIDRead,
+	protos.RubixService_TransferRBT_FullMethodName:              core.PermDIDWrite,
+	protos.RubixService_GenerateRBT_FullMethodName:              core.PermDIDWrite,
+	protos.RubixService_CreateDataToken_FullMethodName:          core.PermDIDWrite,
+	protos.RubixService_CommitDataToken_FullMethodName:          core.PermDIDWrite,
+	protos.RubixService_StreamSignature_FullMethodName:          core.PermSign,
+	protos.RubixService_SubmitSignature_FullMethodName:          core.PermSign,
+	protos.RubixService_WatchTransaction_FullMethodName:         core.PermRead,
+	protos.RubixService_CreateFT_FullMethodName:                 core.PermDIDWrite,
+	protos.RubixService_TransferFT_FullMethodName:               core.PermDIDWrite,
+	protos.RubixService_GetFTInfo_FullMethodName:                core.PermDIDRead,
+	protos.RubixService_CreateNFT_FullMethodName:                core.PermDIDWrite,
+	protos.RubixService_DeployNFT_FullMethodName:                core.PermDIDWrite,
+	protos.RubixService_ExecuteNFT_FullMethodName:               core.PermDIDWrite,
+	protos.RubixService_SubscribeNFT_FullMethodName:             core.PermDIDWrite,
+	protos.RubixService_GetNFTs_FullMethodName:                  core.PermDIDRead,
+	protos.RubixService_GenerateSmartContract_FullMethodName:    core.PermDIDWrite,
+	protos.RubixService_DeploySmartContract_FullMethodName:      core.PermDIDWrite,
+	protos.RubixService_ExecuteSmartContract_FullMethodName:     core.PermDIDWrite,
+	protos.RubixService_SubscribeSmartContract_FullMethodName:   core.PermDIDWrite,
+	protos.RubixService_GetSmartContractData_FullMethodName:     core.PermDIDRead,
+	protos.RubixService_SelfTransferRBT_FullMethodName:          core.PermDIDWrite,
+	protos.RubixService_GetPledgedTokens_FullMethodName:         core.PermAdmin,
+	protos.RubixService_RunUnpledge_FullMethodName:              core.PermAdmin,
+	protos.RubixService_GetTransaction_FullMethodName:           core.PermDIDRead,
+	protos.RubixService_AddQuorum_FullMethodName:                core.PermAdmin,
+	protos.RubixService_GetAllQuorum_FullMethodName:             core.PermAdmin,
+	protos.RubixService_RemoveAllQuorum_FullMethodName:          core.PermAdmin,
+	protos.RubixService_SetupQuorum_FullMethodName:              core.PermAdmin,
+	protos.RubixService_CheckQuorumStatus_FullMethodName:        core.PermRead,
+	protos.RubixService_DumpTokenChain_FullMethodName:           core.PermDIDRead,
+	protos.RubixService_ValidateTokenChain_FullMethodName:       core.PermRead,
+	protos.RubixService_GetNodeInfo_FullMethodName:              core.PermRead,
+	protos.RubixService_GetJobs_FullMethodName:                  core.PermRead,
+	protos.RubixService_GetJob_FullMethodName:                   core.PermRead,
+	protos.RubixService_AddWebhook_FullMethodName:               core.PermDIDWrite,
+	protos.RubixService_GetWebhooks_FullMethodName:              core.PermRead,
+	protos.RubixService_RemoveWebhook_FullMethodName:            core.PermDIDWrite,
+	protos.RubixService_GetWebhookDeliveries_FullMethodName:     core.PermRead,
+	protos.RubixService_ReplayWebhookDelivery_FullMethodName:    core.PermDIDWrite,
+	protos.RubixService_QuerySmartContractState_FullMethodName:  core.PermDIDRead,
+	protos.RubixService_GetSmartContractEvents_FullMethodName:   core.PermDIDRead,
+	protos.RubixService_WatchSmartContractEvents_FullMethodName: core.PermDIDRead,
+	protos.RubixService_UpgradeSmartContract_FullMethodName:     core.PermDIDWrite,
+	protos.RubixService_GetConsensusJournal_FullMethodName:      core.PermAdmin,
+	protos.RubixService_ResolveConsensusJournal_FullMethodName:  core.PermAdmin,
+}
+
+// ownedMethods act on the objects of the id in the request, the core checks
+// the object belongs to the caller DIDs so the request need not have the DID
+var ownedMethods = map[string]bool{
+	protos.RubixService_RemoveWebhook_FullMethodName:         true,
+	protos.RubixService_ReplayWebhookDelivery_FullMethodName: true,
+}
+
+// getMetadata returns the first value of the request metadata
+func getMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	v := md.Get(key)
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+// getCaller returns the caller and the DID acting in the request. The API
+// key callers send the DID with the did metadata, the key with a single DID
+// acts on that DID.
+func getCaller(c *core.Core, ctx context.Context) (*core.Caller, string, error) {
+	key := getMetadata(ctx, apiKeyMetadata)
+	if key != "" {
+		cl, ok := c.GetAPIKeyCaller(key)
+		if !ok {
+			return nil, "", status.Errorf(codes.Unauthenticated, "invalid api key")
+		}
+		did := getMetadata(ctx, didMetadata)
+		if did == "" && len(cl.DIDs) == 1 {
+			did = cl.DIDs[0]
+		}
+		if did != "" && !cl.HasDID(did) {
+			return nil, "", status.Errorf(codes.PermissionDenied, "access denied for the DID %s", did)
+		}
+		return cl, did, nil
+	}
+	tkn, ok := getAuthToken(ctx)
+	if !ok {
+		return nil, "", status.Errorf(codes.Unauthenticated, "access token or api key is required")
+	}
+	cl, _, ok := c.GetTokenCaller(tkn)
+	if !ok {
+		return nil, "", status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+	return cl, c.GetTokenDID(tkn), nil
+}
+
+func (s *ServerGRPC) authorize(ctx context.Context, method string) error {
+	perm, ok := methodPermissions[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "access denied")
+	}
+	if perm == "" {
+		return nil
+	}
+	cl, did, err := getCaller(s.c, ctx)
+	if err != nil {
+		return err
+	}
+	if !cl.HasPermission(perm) {
+		return status.Errorf(codes.PermissionDenied, "access denied for the role %s", cl.Role)
+	}
+	if (perm == core.PermDIDRead || perm == core.PermDIDWrite) && did == "" && !ownedMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "DID is required for the method")
+	}
+	return nil
+}
+
+func (s *ServerGRPC) unaryAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	err := s.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *ServerGRPC) streamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := s.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
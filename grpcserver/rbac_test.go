@@ -0,0 +1,33 @@
+package grpcserver
+
+import (
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/protos"
+)
+
+// TestMethodPermissions checks all the methods of the service have the
+// permission, methods not listed are denied to everyone
+func TestMethodPermissions(t *testing.T) {
+	sd := protos.RubixService_ServiceDesc
+	names := make([]string, 0)
+	for _, m := range sd.Methods {
+		names = append(names, m.MethodName)
+	}
+	for _, st := range sd.Streams {
+		names = append(names, st.StreamName)
+	}
+	for _, n := range names {
+		if _, ok := methodPermissions["/"+sd.ServiceName+"/"+n]; !ok {
+			t.Errorf("permission of the method %s is not set", n)
+		}
+	}
+	if len(methodPermissions) != len(names) {
+		t.Fatalf("%d permissions for %d methods", len(methodPermissions), len(names))
+	}
+	for m := range ownedMethods {
+		if methodPermissions[m] == "" {
+			t.Fatalf("owned method %s is public", m)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/rubixchain/rubixgoplatform/client"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rn *RubixNative) GenerateSmartContract(ctx context.Context, in *protos.GenerateSmartContractReq) (*protos.BasicReponse, error) {
+	if len(in.BinaryCode) == 0 || len(in.RawCode) == 0 || len(in.SchemaCode) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "binary code, raw code and schema are required")
+	}
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	folderName, err := rn.c.CreateTempFolder()
+	if err != nil {
+		rn.log.Error("failed to create folder")
+		return nil, status.Errorf(codes.Internal, "failed to create folder")
+	}
+	defer os.RemoveAll(folderName)
+	req := &client.SmartContractRequest{
+		BinaryCode: filepath.Join(folderName, "binaryCode.wasm"),
+		RawCode:    filepath.Join(folderName, "rawCode.rs"),
+		SchemaCode: filepath.Join(folderName, "schemaCode.json"),
+		DID:        userDID,
+	}
+	files := map[string][]byte{
+		req.BinaryCode: in.BinaryCode,
+		req.RawCode:    in.RawCode,
+		req.SchemaCode: in.SchemaCode,
+	}
+	for fn, data := range files {
+		err = createFile(fn, string(data), false)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+	br, err := c.GenerateSmartContractToken(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) DeploySmartContract(ctx context.Context, in *protos.DeploySmartContractReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	dr := &model.DeploySmartContractRequest{
+		SmartContractToken: in.SmartContractToken,
+		DeployerAddress:    userDID,
+		RBTAmount:          in.RbtAmount,
+		QuorumType:         int(in.QuorumType),
+		Comment:            in.Comment,
+	}
+	br, err := c.DeploySmartContract(dr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) ExecuteSmartContract(ctx context.Context, in *protos.ExecuteSmartContractReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	er := &model.ExecuteSmartContractRequest{
+		SmartContractToken: in.SmartContractToken,
+		ExecutorAddress:    userDID,
+		QuorumType:         int(in.QuorumType),
+		Comment:            in.Comment,
+		SmartContractData:  in.SmartContractData,
+		IdempotencyKey:     in.IdempotencyKey,
+	}
+	br, err := c.ExecuteSmartContract(er)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) SubscribeSmartContract(ctx context.Context, in *protos.SmartContractReq) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.SubscribeContract(in.SmartContractToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetSmartContractData(ctx context.Context, in *protos.SmartContractDataReq) (*protos.SmartContractDataResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := c.GetSmartContractTokenData(in.SmartContractToken, in.Latest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !dr.Status {
+		return nil, status.Errorf(codes.Internal, dr.Message)
+	}
+	resp := &protos.SmartContractDataResp{
+		Data: make([]*protos.SmartContractData, 0),
+	}
+	for _, d := range dr.SCTDataReply {
+		resp.Data = append(resp.Data, &protos.SmartContractData{
+			BlockNo:           d.BlockNo,
+			BlockId:           d.BlockId,
+			SmartContractData: d.SmartContractData,
+			Epoch:             int64(d.Epoch),
+		})
+	}
+	return resp, nil
+}
+
+// UpgradeSmartContract upgrades the smart contract deployed by the caller DID
+// to the code of the code token
+func (rn *RubixNative) UpgradeSmartContract(ctx context.Context, in *protos.UpgradeSmartContractReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	ur := &model.UpgradeSmartContractRequest{
+		SmartContractToken: in.SmartContractToken,
+		CodeToken:          in.CodeToken,
+		DeployerAddress:    userDID,
+		QuorumType:         int(in.QuorumType),
+		Comment:            in.Comment,
+		MigrationInput:     in.MigrationInput,
+	}
+	br, err := c.UpgradeSmartContract(ur)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+// QuerySmartContractState returns the value of the state key at the block,
+// the latest block is used if the block number is negative
+func (rn *RubixNative) QuerySmartContractState(ctx context.Context, in *protos.SmartContractStateReq) (*protos.SmartContractStateResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	sr, err := c.QuerySmartContractState(in.SmartContractToken, in.Key, in.BlockNumber)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !sr.Status {
+		return nil, status.Errorf(codes.Internal, sr.Message)
+	}
+	return &protos.SmartContractStateResp{
+		SmartContractToken: sr.Token,
+		Key:                sr.Key,
+		BlockNumber:        sr.BlockNumber,
+		Found:              sr.Found,
+		Value:              sr.Value,
+	}, nil
+}
+
+func scEventFilter(in *protos.SmartContractEventsReq) (*model.SmartContractEventFilter, error) {
+	if in.ToBlock != 0 && in.ToBlock < in.FromBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "toBlock is before fromBlock")
+	}
+	return &model.SmartContractEventFilter{
+		SmartContractToken: in.SmartContractToken,
+		Name:               in.Name,
+		Topic:              in.Topic,
+		FromBlock:          in.FromBlock,
+		ToBlock:            in.ToBlock,
+	}, nil
+}
+
+func scEvent(ev *model.SmartContractEventLog) *protos.SmartContractEvent {
+	return &protos.SmartContractEvent{
+		Id:                 ev.ID,
+		SmartContractToken: ev.SmartContractToken,
+		BlockNumber:        ev.BlockNumber,
+		BlockId:            ev.BlockID,
+		TransactionId:      ev.TransactionID,
+		ExecutorDID:        ev.ExecutorDID,
+		Index:              int32(ev.Index),
+		Name:               ev.Name,
+		Topics:             ev.Topics,
+		Data:               ev.Data,
+	}
+}
+
+// GetSmartContractEvents returns the page of the smart contract events
+// matching the filter
+func (rn *RubixNative) GetSmartContractEvents(ctx context.Context, in *protos.SmartContractEventsReq) (*protos.SmartContractEventsResp, error) {
+	f, err := scEventFilter(in)
+	if err != nil {
+		return nil, err
+	}
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	er, err := c.GetSmartContractEvents(f, pageRequest(in.Page))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !er.Status {
+		return nil, status.Errorf(codes.Internal, er.Message)
+	}
+	resp := &protos.SmartContractEventsResp{
+		Events:     make([]*protos.SmartContractEvent, 0),
+		NextCursor: er.NextCursor,
+	}
+	for i := range er.Events {
+		resp.Events = append(resp.Events, scEvent(&er.Events[i]))
+	}
+	return resp, nil
+}
+
+// WatchSmartContractEvents streams the smart contract events matching the
+// filter, the events from the fromBlock are sent from the index before the
+// live events
+func (rn *RubixNative) WatchSmartContractEvents(in *protos.SmartContractEventsReq, stream protos.RubixService_WatchSmartContractEventsServer) error {
+	f, err := scEventFilter(in)
+	if err != nil {
+		return err
+	}
+	// subscribe before reading the index so that no event is missed
+	ch, cancel := rn.c.SubscribeSmartContractEvents(f)
+	defer cancel()
+	// position of the last event sent for the contract
+	type pos struct {
+		block uint64
+		index int
+	}
+	sent := make(map[string]pos)
+	send := func(ev *model.SmartContractEventLog) error {
+		last, ok := sent[ev.SmartContractToken]
+		if ok && (ev.BlockNumber < last.block || (ev.BlockNumber == last.block && ev.Index <= last.index)) {
+			return nil
+		}
+		err := stream.Send(scEvent(ev))
+		if err != nil {
+			return err
+		}
+		sent[ev.SmartContractToken] = pos{block: ev.BlockNumber, index: ev.Index}
+		return nil
+	}
+	backlog := in.FromBlock > 0
+	pr := &model.PageRequest{Limit: model.MaxPageLimit}
+	for backlog {
+		er, err := rn.c.GetSmartContractEvents(f, pr)
+		if err != nil {
+			return status.Errorf(codes.Internal, err.Error())
+		}
+		for i := range er.Events {
+			err = send(&er.Events[i])
+			if err != nil {
+				return err
+			}
+		}
+		pr.Cursor = er.NextCursor
+		backlog = pr.Cursor != ""
+	}
+	for {
+		select {
+		case ev := <-ch:
+			err = send(&ev)
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
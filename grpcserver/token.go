@@ -3,18 +3,22 @@ package grpcserver
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/rubixchain/rubixgoplatform/client"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (rn *RubixNative) GenerateRBT(ctx context.Context, in *protos.GenerateReq) (*protos.BasicReponse, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
-	br, err := c.GenerateTestRBT(int(in.TokenCount), rn.c.GetTokenDID(tkn))
+	br, err := c.GenerateTestRBT(int(in.TokenCount), userDID)
 	if err != nil {
 		return nil, err
 	}
@@ -22,13 +26,13 @@ func (rn *RubixNative) GenerateRBT(ctx context.Context, in *protos.GenerateReq)
 }
 
 func (rn *RubixNative) TransferRBT(ctx context.Context, in *protos.TransferRBTReq) (*protos.BasicReponse, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
 	rt := &model.RBTTransferRequest{
 		Receiver:   in.Receiver,
-		Sender:     rn.c.GetPeerID() + "." + rn.c.GetTokenDID(tkn),
+		Sender:     rn.c.GetPeerID() + "." + userDID,
 		TokenCount: in.TokenCount,
 		Type:       int(in.Type),
 		Comment:    in.Comment,
@@ -41,12 +45,12 @@ func (rn *RubixNative) TransferRBT(ctx context.Context, in *protos.TransferRBTRe
 }
 
 func (rn *RubixNative) CreateDataToken(ctx context.Context, in *protos.DataTokenReq) (*protos.BasicReponse, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
 	rt := &client.DataTokenReq{
-		DID:          rn.c.GetTokenDID(tkn),
+		DID:          userDID,
 		UserID:       in.UserID,
 		UserInfo:     in.UserInfo,
 		FileInfo:     in.FileInfo,
@@ -61,11 +65,11 @@ func (rn *RubixNative) CreateDataToken(ctx context.Context, in *protos.DataToken
 }
 
 func (rn *RubixNative) CommitDataToken(ctx context.Context, in *protos.CommitDataTokenReq) (*protos.BasicReponse, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
-	br, err := c.CommitDataToken(rn.c.GetTokenDID(tkn), in.BatchID)
+	br, err := c.CommitDataToken(userDID, in.BatchID)
 	if err != nil {
 		return nil, err
 	}
@@ -73,11 +77,11 @@ func (rn *RubixNative) CommitDataToken(ctx context.Context, in *protos.CommitDat
 }
 
 func (rn *RubixNative) GetAllTokens(ctx context.Context, in *protos.TokenReq) (*protos.TokenResp, error) {
-	c, tkn, err := rn.getClient(ctx, true)
+	c, userDID, err := rn.getClient(ctx, true)
 	if err != nil {
 		return nil, err
 	}
-	tr, err := c.GetAllTokens(rn.c.GetTokenDID(tkn), in.TokenType)
+	tr, err := c.GetAllTokens(userDID, in.TokenType, tokenFilter(in.Status, in.MinValue, in.MaxValue, in.TokenTypeFilter), pageRequest(in.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -86,13 +90,69 @@ func (rn *RubixNative) GetAllTokens(ctx context.Context, in *protos.TokenReq) (*
 	}
 	resp := &protos.TokenResp{
 		TokenDetials: make([]*protos.TokenDetial, 0),
+		NextCursor:   tr.NextCursor,
 	}
 	for _, td := range tr.TokenDetails {
 		t := &protos.TokenDetial{
 			Token:      td.Token,
 			TokenState: int32(td.Status),
+			Value:      td.Value,
 		}
 		resp.TokenDetials = append(resp.TokenDetials, t)
 	}
 	return resp, nil
 }
+
+func (rn *RubixNative) SelfTransferRBT(ctx context.Context, in *protos.SelfTransferReq) (*protos.BasicReponse, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	rt := &model.RBTTransferRequest{
+		Sender:         userDID,
+		Type:           int(in.QuorumType),
+		IdempotencyKey: in.IdempotencyKey,
+	}
+	br, err := c.SelfTransferRBT(rt)
+	if err != nil {
+		return nil, err
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetPledgedTokens(ctx context.Context, in *emptypb.Empty) (*protos.PledgedTokensResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	tr, err := c.GetPledgedTokenDetails()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !tr.Status {
+		return nil, status.Errorf(codes.Internal, tr.Message)
+	}
+	resp := &protos.PledgedTokensResp{
+		States: make([]*protos.PledgedTokenState, 0),
+	}
+	for _, pd := range tr.PledgedTokenStateDetails {
+		ps := &protos.PledgedTokenState{
+			Did:        pd.DID,
+			TokenState: pd.TokenStateHash,
+		}
+		if pd.TokensPledged != "" {
+			ps.Tokens = strings.Split(pd.TokensPledged, ",")
+		}
+		resp.States = append(resp.States, ps)
+	}
+	return resp, nil
+}
+
+func (rn *RubixNative) RunUnpledge(ctx context.Context, in *emptypb.Empty) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := c.RunUnpledge()
+	return &protos.BasicReponse{Status: ok, Message: msg}, nil
+}
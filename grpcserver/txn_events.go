@@ -0,0 +1,138 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/did"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// missed live events are caught up from the event log at this interval
+const txnEventsPoll = 15 * time.Second
+
+func txnEvent(ev *model.TxnEvent) *protos.TxnEvent {
+	pe := &protos.TxnEvent{
+		Seq:           int32(ev.Seq),
+		ReqID:         ev.ReqID,
+		Type:          ev.Type,
+		Time:          timestamppb.New(ev.Time),
+		Status:        ev.Status,
+		Message:       ev.Message,
+		TransactionId: ev.TransactionID,
+		Quorum:        ev.Quorum,
+		Quorums:       ev.Quorums,
+	}
+	if ev.SignRequest != nil {
+		pe.SignRequest = &protos.SignRequest{
+			ReqID:       ev.SignRequest.ID,
+			Mode:        int32(ev.SignRequest.Mode),
+			Hash:        ev.SignRequest.Hash,
+			OnlyPrivKey: ev.SignRequest.OnlyPrivKey,
+		}
+	}
+	return pe
+}
+
+// WatchTransaction streams the lifecycle events of the request after the
+// sequence number till the final status. The signature requests of the
+// request are streamed with the sign request, the signature is sent with
+// SubmitSignature. The request id can be chosen by the client with the
+// x-request-id metadata of the request call to watch it from the start. Only
+// the jobs of the caller DIDs can be watched.
+func (rn *RubixNative) WatchTransaction(in *protos.TxnEventReq, stream protos.RubixService_WatchTransactionServer) error {
+	if in.ReqID == "" {
+		return status.Errorf(codes.InvalidArgument, "request id is required")
+	}
+	if in.FromSeq < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid sequence number")
+	}
+	cl, _, err := getCaller(rn.c, stream.Context())
+	if err != nil {
+		return err
+	}
+	if err := rn.c.CheckWatchAccess(cl, in.ReqID); err != nil {
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	backlog, ch, cancel := rn.c.SubscribeTxnEvents(in.ReqID, int(in.FromSeq))
+	defer cancel()
+	last := int(in.FromSeq)
+	checked := false
+	// send sends the events in order and reports whether the request is finished
+	send := func(evs []model.TxnEvent) (bool, error) {
+		for i := range evs {
+			if evs[i].Seq <= last {
+				continue
+			}
+			// job of the request not started at the subscription is checked now
+			if !checked {
+				if err := rn.c.CheckJobAccess(cl, in.ReqID); err != nil {
+					return true, status.Errorf(codes.PermissionDenied, err.Error())
+				}
+				checked = true
+			}
+			err := stream.Send(txnEvent(&evs[i]))
+			if err != nil {
+				return false, err
+			}
+			last = evs[i].Seq
+			if evs[i].Type == model.TxnEventFinalStatus {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	done, err := send(backlog)
+	t := time.NewTicker(txnEventsPoll)
+	defer t.Stop()
+	for !done && err == nil {
+		select {
+		case ev := <-ch:
+			if ev.Seq > last+1 {
+				done, err = send(rn.c.GetTxnEvents(in.ReqID, last))
+			} else {
+				done, err = send([]model.TxnEvent{ev})
+			}
+		case <-t.C:
+			done, err = send(rn.c.GetTxnEvents(in.ReqID, last))
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+	return err
+}
+
+// SubmitSignature sends the signature of the sign request, the next sign
+// request or the final status of the request is returned. The job of the
+// request should belong to the caller DIDs.
+func (rn *RubixNative) SubmitSignature(ctx context.Context, in *protos.SignResponse) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	cl, _, err := getCaller(rn.c, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := rn.c.CheckJobAccess(cl, in.ReqID); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	req := &did.SignRespData{
+		ID:       in.ReqID,
+		Mode:     int(in.Mode),
+		Password: in.Password,
+		Signature: did.DIDSignature{
+			Pixels:    in.ImgSign,
+			Signature: in.PvtSign,
+		},
+	}
+	br, err := c.SignatureResponse(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
@@ -0,0 +1,82 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func txnHistory(td *model.TxnDetails) *protos.TransactionHistory {
+	th := &protos.TransactionHistory{
+		Transactions: make([]*protos.TransactionDetails, 0),
+		NextCursor:   td.NextCursor,
+	}
+	if !td.Status {
+		th.Error = td.Message
+		return th
+	}
+	for _, t := range td.TxnDetails {
+		th.Transactions = append(th.Transactions, &protos.TransactionDetails{
+			TransactionId:   t.TransactionID,
+			TransactionType: t.TransactionType,
+			BlockId:         t.BlockID,
+			Mode:            int32(t.Mode),
+			SenderDID:       t.SenderDID,
+			ReceiverDID:     t.ReceiverDID,
+			Amount:          t.Amount,
+			TotalTime:       t.TotalTime,
+			Comment:         t.Comment,
+			DateTime:        timestamppb.New(t.DateTime),
+			Status:          t.Status,
+		})
+	}
+	return th
+}
+
+func (rn *RubixNative) GetTransactionHistory(ctx context.Context, in *protos.TxnHistoryReq) (*protos.TransactionHistory, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	f := &model.TxnFilter{
+		Role:      in.Role,
+		MinAmount: in.MinAmount,
+		MaxAmount: in.MaxAmount,
+	}
+	if in.StartDate != nil {
+		f.StartDate = in.StartDate.AsTime().Local()
+	}
+	if in.EndDate != nil {
+		f.EndDate = in.EndDate.AsTime().Local()
+	}
+	td, err := c.GetTxnByDID(userDID, f, pageRequest(in.Page))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return txnHistory(td), nil
+}
+
+// GetTransaction returns the transaction by the id or the comment
+func (rn *RubixNative) GetTransaction(ctx context.Context, in *protos.TxnReq) (*protos.TransactionHistory, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	var td *model.TxnDetails
+	switch {
+	case in.TxnId != "":
+		td, err = c.GetTxnByID(in.TxnId)
+	case in.Comment != "":
+		td, err = c.GetTxnByComment(in.Comment)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "transaction id or comment is required")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return txnHistory(td), nil
+}
@@ -0,0 +1,131 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func webhook(wh *model.Webhook) *protos.Webhook {
+	pw := &protos.Webhook{
+		Id:        wh.ID,
+		Did:       wh.DID,
+		Url:       wh.URL,
+		Secret:    wh.Secret,
+		CreatedAt: timestamppb.New(wh.CreatedAt),
+	}
+	if wh.Events != "" {
+		pw.Events = strings.Split(wh.Events, ",")
+	}
+	return pw
+}
+
+// AddWebhook adds the webhook for the events of the DID, the DID of the
+// caller is used if the DID is not set
+func (rn *RubixNative) AddWebhook(ctx context.Context, in *protos.WebhookReq) (*protos.Webhook, error) {
+	c, userDID, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	wr := &model.WebhookRequest{
+		DID:    in.Did,
+		URL:    in.Url,
+		Events: in.Events,
+		Secret: in.Secret,
+	}
+	if wr.DID == "" {
+		wr.DID = userDID
+	}
+	rp, err := c.AddWebhook(wr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !rp.Status || rp.Webhook == nil {
+		return nil, status.Errorf(codes.InvalidArgument, rp.Message)
+	}
+	return webhook(rp.Webhook), nil
+}
+
+func (rn *RubixNative) GetWebhooks(ctx context.Context, in *protos.WebhooksReq) (*protos.WebhooksResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := c.GetWebhooks(in.Did)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !rp.Status {
+		return nil, status.Errorf(codes.Internal, rp.Message)
+	}
+	resp := &protos.WebhooksResp{
+		Webhooks: make([]*protos.Webhook, 0),
+	}
+	for i := range rp.Webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhook(&rp.Webhooks[i]))
+	}
+	return resp, nil
+}
+
+func (rn *RubixNative) RemoveWebhook(ctx context.Context, in *protos.WebhookIDReq) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.RemoveWebhook(in.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
+
+func (rn *RubixNative) GetWebhookDeliveries(ctx context.Context, in *protos.WebhookDeliveriesReq) (*protos.WebhookDeliveriesResp, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := c.GetWebhookDeliveries(in.WebhookId, in.Status, int(in.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !rp.Status {
+		return nil, status.Errorf(codes.Internal, rp.Message)
+	}
+	resp := &protos.WebhookDeliveriesResp{
+		Deliveries: make([]*protos.WebhookDelivery, 0),
+	}
+	for _, d := range rp.Deliveries {
+		resp.Deliveries = append(resp.Deliveries, &protos.WebhookDelivery{
+			Id:           d.ID,
+			WebhookId:    d.WebhookID,
+			Did:          d.DID,
+			Event:        d.Event,
+			Payload:      d.Payload,
+			Status:       d.Status,
+			Attempts:     int32(d.Attempts),
+			NextAttempt:  timestamppb.New(d.NextAttempt),
+			ResponseCode: int32(d.ResponseCode),
+			LastError:    d.LastError,
+			CreatedAt:    timestamppb.New(d.CreatedAt),
+			UpdatedAt:    timestamppb.New(d.UpdatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (rn *RubixNative) ReplayWebhookDelivery(ctx context.Context, in *protos.WebhookIDReq) (*protos.BasicReponse, error) {
+	c, _, err := rn.getClient(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	br, err := c.ReplayWebhookDelivery(in.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return rn.basicResponse(br)
+}
@@ -20,18 +20,58 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RubixService_GetDIDChallenge_FullMethodName       = "/protos.RubixService/GetDIDChallenge"
-	RubixService_GetDIDAccess_FullMethodName          = "/protos.RubixService/GetDIDAccess"
-	RubixService_CreateDID_FullMethodName             = "/protos.RubixService/CreateDID"
-	RubixService_GetAllTokens_FullMethodName          = "/protos.RubixService/GetAllTokens"
-	RubixService_TransferRBT_FullMethodName           = "/protos.RubixService/TransferRBT"
-	RubixService_CreateDataToken_FullMethodName       = "/protos.RubixService/CreateDataToken"
-	RubixService_CommitDataToken_FullMethodName       = "/protos.RubixService/CommitDataToken"
-	RubixService_StreamIncomingTxn_FullMethodName     = "/protos.RubixService/StreamIncomingTxn"
-	RubixService_StreamSignature_FullMethodName       = "/protos.RubixService/StreamSignature"
-	RubixService_GenerateRBT_FullMethodName           = "/protos.RubixService/GenerateRBT"
-	RubixService_GetBalance_FullMethodName            = "/protos.RubixService/GetBalance"
-	RubixService_GetTransactionHistory_FullMethodName = "/protos.RubixService/GetTransactionHistory"
+	RubixService_GetDIDChallenge_FullMethodName          = "/protos.RubixService/GetDIDChallenge"
+	RubixService_GetDIDAccess_FullMethodName             = "/protos.RubixService/GetDIDAccess"
+	RubixService_CreateDID_FullMethodName                = "/protos.RubixService/CreateDID"
+	RubixService_GetAllTokens_FullMethodName             = "/protos.RubixService/GetAllTokens"
+	RubixService_TransferRBT_FullMethodName              = "/protos.RubixService/TransferRBT"
+	RubixService_CreateDataToken_FullMethodName          = "/protos.RubixService/CreateDataToken"
+	RubixService_CommitDataToken_FullMethodName          = "/protos.RubixService/CommitDataToken"
+	RubixService_StreamIncomingTxn_FullMethodName        = "/protos.RubixService/StreamIncomingTxn"
+	RubixService_StreamSignature_FullMethodName          = "/protos.RubixService/StreamSignature"
+	RubixService_GenerateRBT_FullMethodName              = "/protos.RubixService/GenerateRBT"
+	RubixService_GetBalance_FullMethodName               = "/protos.RubixService/GetBalance"
+	RubixService_GetTransactionHistory_FullMethodName    = "/protos.RubixService/GetTransactionHistory"
+	RubixService_CreateFT_FullMethodName                 = "/protos.RubixService/CreateFT"
+	RubixService_TransferFT_FullMethodName               = "/protos.RubixService/TransferFT"
+	RubixService_GetFTInfo_FullMethodName                = "/protos.RubixService/GetFTInfo"
+	RubixService_CreateNFT_FullMethodName                = "/protos.RubixService/CreateNFT"
+	RubixService_DeployNFT_FullMethodName                = "/protos.RubixService/DeployNFT"
+	RubixService_ExecuteNFT_FullMethodName               = "/protos.RubixService/ExecuteNFT"
+	RubixService_SubscribeNFT_FullMethodName             = "/protos.RubixService/SubscribeNFT"
+	RubixService_GetNFTs_FullMethodName                  = "/protos.RubixService/GetNFTs"
+	RubixService_GenerateSmartContract_FullMethodName    = "/protos.RubixService/GenerateSmartContract"
+	RubixService_DeploySmartContract_FullMethodName      = "/protos.RubixService/DeploySmartContract"
+	RubixService_ExecuteSmartContract_FullMethodName     = "/protos.RubixService/ExecuteSmartContract"
+	RubixService_SubscribeSmartContract_FullMethodName   = "/protos.RubixService/SubscribeSmartContract"
+	RubixService_GetSmartContractData_FullMethodName     = "/protos.RubixService/GetSmartContractData"
+	RubixService_GetPledgedTokens_FullMethodName         = "/protos.RubixService/GetPledgedTokens"
+	RubixService_RunUnpledge_FullMethodName              = "/protos.RubixService/RunUnpledge"
+	RubixService_SelfTransferRBT_FullMethodName          = "/protos.RubixService/SelfTransferRBT"
+	RubixService_GetTransaction_FullMethodName           = "/protos.RubixService/GetTransaction"
+	RubixService_AddQuorum_FullMethodName                = "/protos.RubixService/AddQuorum"
+	RubixService_GetAllQuorum_FullMethodName             = "/protos.RubixService/GetAllQuorum"
+	RubixService_RemoveAllQuorum_FullMethodName          = "/protos.RubixService/RemoveAllQuorum"
+	RubixService_SetupQuorum_FullMethodName              = "/protos.RubixService/SetupQuorum"
+	RubixService_CheckQuorumStatus_FullMethodName        = "/protos.RubixService/CheckQuorumStatus"
+	RubixService_DumpTokenChain_FullMethodName           = "/protos.RubixService/DumpTokenChain"
+	RubixService_ValidateTokenChain_FullMethodName       = "/protos.RubixService/ValidateTokenChain"
+	RubixService_GetNodeInfo_FullMethodName              = "/protos.RubixService/GetNodeInfo"
+	RubixService_WatchTransaction_FullMethodName         = "/protos.RubixService/WatchTransaction"
+	RubixService_SubmitSignature_FullMethodName          = "/protos.RubixService/SubmitSignature"
+	RubixService_GetJobs_FullMethodName                  = "/protos.RubixService/GetJobs"
+	RubixService_GetJob_FullMethodName                   = "/protos.RubixService/GetJob"
+	RubixService_AddWebhook_FullMethodName               = "/protos.RubixService/AddWebhook"
+	RubixService_GetWebhooks_FullMethodName              = "/protos.RubixService/GetWebhooks"
+	RubixService_RemoveWebhook_FullMethodName            = "/protos.RubixService/RemoveWebhook"
+	RubixService_GetWebhookDeliveries_FullMethodName     = "/protos.RubixService/GetWebhookDeliveries"
+	RubixService_ReplayWebhookDelivery_FullMethodName    = "/protos.RubixService/ReplayWebhookDelivery"
+	RubixService_QuerySmartContractState_FullMethodName  = "/protos.RubixService/QuerySmartContractState"
+	RubixService_GetSmartContractEvents_FullMethodName   = "/protos.RubixService/GetSmartContractEvents"
+	RubixService_WatchSmartContractEvents_FullMethodName = "/protos.RubixService/WatchSmartContractEvents"
+	RubixService_UpgradeSmartContract_FullMethodName     = "/protos.RubixService/UpgradeSmartContract"
+	RubixService_GetConsensusJournal_FullMethodName      = "/protos.RubixService/GetConsensusJournal"
+	RubixService_ResolveConsensusJournal_FullMethodName  = "/protos.RubixService/ResolveConsensusJournal"
 )
 
 // RubixServiceClient is the client API for RubixService service.
@@ -49,7 +89,47 @@ type RubixServiceClient interface {
 	StreamSignature(ctx context.Context, opts ...grpc.CallOption) (RubixService_StreamSignatureClient, error)
 	GenerateRBT(ctx context.Context, in *GenerateReq, opts ...grpc.CallOption) (*BasicReponse, error)
 	GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBalanceRes, error)
-	GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionHistory, error)
+	GetTransactionHistory(ctx context.Context, in *TxnHistoryReq, opts ...grpc.CallOption) (*TransactionHistory, error)
+	CreateFT(ctx context.Context, in *CreateFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	TransferFT(ctx context.Context, in *TransferFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetFTInfo(ctx context.Context, in *FTInfoReq, opts ...grpc.CallOption) (*FTInfoResp, error)
+	CreateNFT(ctx context.Context, in *CreateNFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	DeployNFT(ctx context.Context, in *DeployNFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	ExecuteNFT(ctx context.Context, in *ExecuteNFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	SubscribeNFT(ctx context.Context, in *NFTReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetNFTs(ctx context.Context, in *NFTListReq, opts ...grpc.CallOption) (*NFTListResp, error)
+	GenerateSmartContract(ctx context.Context, in *GenerateSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	DeploySmartContract(ctx context.Context, in *DeploySmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	ExecuteSmartContract(ctx context.Context, in *ExecuteSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	SubscribeSmartContract(ctx context.Context, in *SmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetSmartContractData(ctx context.Context, in *SmartContractDataReq, opts ...grpc.CallOption) (*SmartContractDataResp, error)
+	GetPledgedTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PledgedTokensResp, error)
+	RunUnpledge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BasicReponse, error)
+	SelfTransferRBT(ctx context.Context, in *SelfTransferReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetTransaction(ctx context.Context, in *TxnReq, opts ...grpc.CallOption) (*TransactionHistory, error)
+	AddQuorum(ctx context.Context, in *QuorumList, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetAllQuorum(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuorumAddresses, error)
+	RemoveAllQuorum(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BasicReponse, error)
+	SetupQuorum(ctx context.Context, in *SetupQuorumReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	CheckQuorumStatus(ctx context.Context, in *QuorumStatusReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	DumpTokenChain(ctx context.Context, in *TokenChainReq, opts ...grpc.CallOption) (*TokenChainResp, error)
+	ValidateTokenChain(ctx context.Context, in *ValidateTokenChainReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetNodeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeInfo, error)
+	WatchTransaction(ctx context.Context, in *TxnEventReq, opts ...grpc.CallOption) (RubixService_WatchTransactionClient, error)
+	SubmitSignature(ctx context.Context, in *SignResponse, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetJobs(ctx context.Context, in *JobsReq, opts ...grpc.CallOption) (*JobsResp, error)
+	GetJob(ctx context.Context, in *JobReq, opts ...grpc.CallOption) (*Job, error)
+	AddWebhook(ctx context.Context, in *WebhookReq, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhooks(ctx context.Context, in *WebhooksReq, opts ...grpc.CallOption) (*WebhooksResp, error)
+	RemoveWebhook(ctx context.Context, in *WebhookIDReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesReq, opts ...grpc.CallOption) (*WebhookDeliveriesResp, error)
+	ReplayWebhookDelivery(ctx context.Context, in *WebhookIDReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	QuerySmartContractState(ctx context.Context, in *SmartContractStateReq, opts ...grpc.CallOption) (*SmartContractStateResp, error)
+	GetSmartContractEvents(ctx context.Context, in *SmartContractEventsReq, opts ...grpc.CallOption) (*SmartContractEventsResp, error)
+	WatchSmartContractEvents(ctx context.Context, in *SmartContractEventsReq, opts ...grpc.CallOption) (RubixService_WatchSmartContractEventsClient, error)
+	UpgradeSmartContract(ctx context.Context, in *UpgradeSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error)
+	GetConsensusJournal(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConsensusJournalResp, error)
+	ResolveConsensusJournal(ctx context.Context, in *ResolveConsensusJournalReq, opts ...grpc.CallOption) (*ConsensusJournalEntry, error)
 }
 
 type rubixServiceClient struct {
@@ -204,7 +284,7 @@ func (c *rubixServiceClient) GetBalance(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
-func (c *rubixServiceClient) GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionHistory, error) {
+func (c *rubixServiceClient) GetTransactionHistory(ctx context.Context, in *TxnHistoryReq, opts ...grpc.CallOption) (*TransactionHistory, error) {
 	out := new(TransactionHistory)
 	err := c.cc.Invoke(ctx, RubixService_GetTransactionHistory_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -213,6 +293,412 @@ func (c *rubixServiceClient) GetTransactionHistory(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *rubixServiceClient) CreateFT(ctx context.Context, in *CreateFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_CreateFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) TransferFT(ctx context.Context, in *TransferFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_TransferFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetFTInfo(ctx context.Context, in *FTInfoReq, opts ...grpc.CallOption) (*FTInfoResp, error) {
+	out := new(FTInfoResp)
+	err := c.cc.Invoke(ctx, RubixService_GetFTInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) CreateNFT(ctx context.Context, in *CreateNFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_CreateNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) DeployNFT(ctx context.Context, in *DeployNFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_DeployNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) ExecuteNFT(ctx context.Context, in *ExecuteNFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_ExecuteNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) SubscribeNFT(ctx context.Context, in *NFTReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_SubscribeNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetNFTs(ctx context.Context, in *NFTListReq, opts ...grpc.CallOption) (*NFTListResp, error) {
+	out := new(NFTListResp)
+	err := c.cc.Invoke(ctx, RubixService_GetNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GenerateSmartContract(ctx context.Context, in *GenerateSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_GenerateSmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) DeploySmartContract(ctx context.Context, in *DeploySmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_DeploySmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) ExecuteSmartContract(ctx context.Context, in *ExecuteSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_ExecuteSmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) SubscribeSmartContract(ctx context.Context, in *SmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_SubscribeSmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetSmartContractData(ctx context.Context, in *SmartContractDataReq, opts ...grpc.CallOption) (*SmartContractDataResp, error) {
+	out := new(SmartContractDataResp)
+	err := c.cc.Invoke(ctx, RubixService_GetSmartContractData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetPledgedTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PledgedTokensResp, error) {
+	out := new(PledgedTokensResp)
+	err := c.cc.Invoke(ctx, RubixService_GetPledgedTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) RunUnpledge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_RunUnpledge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) SelfTransferRBT(ctx context.Context, in *SelfTransferReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_SelfTransferRBT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetTransaction(ctx context.Context, in *TxnReq, opts ...grpc.CallOption) (*TransactionHistory, error) {
+	out := new(TransactionHistory)
+	err := c.cc.Invoke(ctx, RubixService_GetTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) AddQuorum(ctx context.Context, in *QuorumList, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_AddQuorum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetAllQuorum(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuorumAddresses, error) {
+	out := new(QuorumAddresses)
+	err := c.cc.Invoke(ctx, RubixService_GetAllQuorum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) RemoveAllQuorum(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_RemoveAllQuorum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) SetupQuorum(ctx context.Context, in *SetupQuorumReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_SetupQuorum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) CheckQuorumStatus(ctx context.Context, in *QuorumStatusReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_CheckQuorumStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) DumpTokenChain(ctx context.Context, in *TokenChainReq, opts ...grpc.CallOption) (*TokenChainResp, error) {
+	out := new(TokenChainResp)
+	err := c.cc.Invoke(ctx, RubixService_DumpTokenChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) ValidateTokenChain(ctx context.Context, in *ValidateTokenChainReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_ValidateTokenChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetNodeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, RubixService_GetNodeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) WatchTransaction(ctx context.Context, in *TxnEventReq, opts ...grpc.CallOption) (RubixService_WatchTransactionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RubixService_ServiceDesc.Streams[2], RubixService_WatchTransaction_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rubixServiceWatchTransactionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RubixService_WatchTransactionClient interface {
+	Recv() (*TxnEvent, error)
+	grpc.ClientStream
+}
+
+type rubixServiceWatchTransactionClient struct {
+	grpc.ClientStream
+}
+
+func (x *rubixServiceWatchTransactionClient) Recv() (*TxnEvent, error) {
+	m := new(TxnEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rubixServiceClient) SubmitSignature(ctx context.Context, in *SignResponse, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_SubmitSignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetJobs(ctx context.Context, in *JobsReq, opts ...grpc.CallOption) (*JobsResp, error) {
+	out := new(JobsResp)
+	err := c.cc.Invoke(ctx, RubixService_GetJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetJob(ctx context.Context, in *JobReq, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, RubixService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) AddWebhook(ctx context.Context, in *WebhookReq, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, RubixService_AddWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetWebhooks(ctx context.Context, in *WebhooksReq, opts ...grpc.CallOption) (*WebhooksResp, error) {
+	out := new(WebhooksResp)
+	err := c.cc.Invoke(ctx, RubixService_GetWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) RemoveWebhook(ctx context.Context, in *WebhookIDReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_RemoveWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveriesReq, opts ...grpc.CallOption) (*WebhookDeliveriesResp, error) {
+	out := new(WebhookDeliveriesResp)
+	err := c.cc.Invoke(ctx, RubixService_GetWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) ReplayWebhookDelivery(ctx context.Context, in *WebhookIDReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_ReplayWebhookDelivery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) QuerySmartContractState(ctx context.Context, in *SmartContractStateReq, opts ...grpc.CallOption) (*SmartContractStateResp, error) {
+	out := new(SmartContractStateResp)
+	err := c.cc.Invoke(ctx, RubixService_QuerySmartContractState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetSmartContractEvents(ctx context.Context, in *SmartContractEventsReq, opts ...grpc.CallOption) (*SmartContractEventsResp, error) {
+	out := new(SmartContractEventsResp)
+	err := c.cc.Invoke(ctx, RubixService_GetSmartContractEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) WatchSmartContractEvents(ctx context.Context, in *SmartContractEventsReq, opts ...grpc.CallOption) (RubixService_WatchSmartContractEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RubixService_ServiceDesc.Streams[3], RubixService_WatchSmartContractEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rubixServiceWatchSmartContractEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RubixService_WatchSmartContractEventsClient interface {
+	Recv() (*SmartContractEvent, error)
+	grpc.ClientStream
+}
+
+type rubixServiceWatchSmartContractEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rubixServiceWatchSmartContractEventsClient) Recv() (*SmartContractEvent, error) {
+	m := new(SmartContractEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rubixServiceClient) UpgradeSmartContract(ctx context.Context, in *UpgradeSmartContractReq, opts ...grpc.CallOption) (*BasicReponse, error) {
+	out := new(BasicReponse)
+	err := c.cc.Invoke(ctx, RubixService_UpgradeSmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) GetConsensusJournal(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConsensusJournalResp, error) {
+	out := new(ConsensusJournalResp)
+	err := c.cc.Invoke(ctx, RubixService_GetConsensusJournal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubixServiceClient) ResolveConsensusJournal(ctx context.Context, in *ResolveConsensusJournalReq, opts ...grpc.CallOption) (*ConsensusJournalEntry, error) {
+	out := new(ConsensusJournalEntry)
+	err := c.cc.Invoke(ctx, RubixService_ResolveConsensusJournal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RubixServiceServer is the server API for RubixService service.
 // All implementations must embed UnimplementedRubixServiceServer
 // for forward compatibility
@@ -228,286 +714,1172 @@ type RubixServiceServer interface {
 	StreamSignature(RubixService_StreamSignatureServer) error
 	GenerateRBT(context.Context, *GenerateReq) (*BasicReponse, error)
 	GetBalance(context.Context, *emptypb.Empty) (*GetBalanceRes, error)
-	GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionHistory, error)
+	GetTransactionHistory(context.Context, *TxnHistoryReq) (*TransactionHistory, error)
+	CreateFT(context.Context, *CreateFTReq) (*BasicReponse, error)
+	TransferFT(context.Context, *TransferFTReq) (*BasicReponse, error)
+	GetFTInfo(context.Context, *FTInfoReq) (*FTInfoResp, error)
+	CreateNFT(context.Context, *CreateNFTReq) (*BasicReponse, error)
+	DeployNFT(context.Context, *DeployNFTReq) (*BasicReponse, error)
+	ExecuteNFT(context.Context, *ExecuteNFTReq) (*BasicReponse, error)
+	SubscribeNFT(context.Context, *NFTReq) (*BasicReponse, error)
+	GetNFTs(context.Context, *NFTListReq) (*NFTListResp, error)
+	GenerateSmartContract(context.Context, *GenerateSmartContractReq) (*BasicReponse, error)
+	DeploySmartContract(context.Context, *DeploySmartContractReq) (*BasicReponse, error)
+	ExecuteSmartContract(context.Context, *ExecuteSmartContractReq) (*BasicReponse, error)
+	SubscribeSmartContract(context.Context, *SmartContractReq) (*BasicReponse, error)
+	GetSmartContractData(context.Context, *SmartContractDataReq) (*SmartContractDataResp, error)
+	GetPledgedTokens(context.Context, *emptypb.Empty) (*PledgedTokensResp, error)
+	RunUnpledge(context.Context, *emptypb.Empty) (*BasicReponse, error)
+	SelfTransferRBT(context.Context, *SelfTransferReq) (*BasicReponse, error)
+	GetTransaction(context.Context, *TxnReq) (*TransactionHistory, error)
+	AddQuorum(context.Context, *QuorumList) (*BasicReponse, error)
+	GetAllQuorum(context.Context, *emptypb.Empty) (*QuorumAddresses, error)
+	RemoveAllQuorum(context.Context, *emptypb.Empty) (*BasicReponse, error)
+	SetupQuorum(context.Context, *SetupQuorumReq) (*BasicReponse, error)
+	CheckQuorumStatus(context.Context, *QuorumStatusReq) (*BasicReponse, error)
+	DumpTokenChain(context.Context, *TokenChainReq) (*TokenChainResp, error)
+	ValidateTokenChain(context.Context, *ValidateTokenChainReq) (*BasicReponse, error)
+	GetNodeInfo(context.Context, *emptypb.Empty) (*NodeInfo, error)
+	WatchTransaction(*TxnEventReq, RubixService_WatchTransactionServer) error
+	SubmitSignature(context.Context, *SignResponse) (*BasicReponse, error)
+	GetJobs(context.Context, *JobsReq) (*JobsResp, error)
+	GetJob(context.Context, *JobReq) (*Job, error)
+	AddWebhook(context.Context, *WebhookReq) (*Webhook, error)
+	GetWebhooks(context.Context, *WebhooksReq) (*WebhooksResp, error)
+	RemoveWebhook(context.Context, *WebhookIDReq) (*BasicReponse, error)
+	GetWebhookDeliveries(context.Context, *WebhookDeliveriesReq) (*WebhookDeliveriesResp, error)
+	ReplayWebhookDelivery(context.Context, *WebhookIDReq) (*BasicReponse, error)
+	QuerySmartContractState(context.Context, *SmartContractStateReq) (*SmartContractStateResp, error)
+	GetSmartContractEvents(context.Context, *SmartContractEventsReq) (*SmartContractEventsResp, error)
+	WatchSmartContractEvents(*SmartContractEventsReq, RubixService_WatchSmartContractEventsServer) error
+	UpgradeSmartContract(context.Context, *UpgradeSmartContractReq) (*BasicReponse, error)
+	GetConsensusJournal(context.Context, *emptypb.Empty) (*ConsensusJournalResp, error)
+	ResolveConsensusJournal(context.Context, *ResolveConsensusJournalReq) (*ConsensusJournalEntry, error)
 	mustEmbedUnimplementedRubixServiceServer()
 }
 
-// UnimplementedRubixServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedRubixServiceServer struct {
+// UnimplementedRubixServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRubixServiceServer struct {
+}
+
+func (UnimplementedRubixServiceServer) GetDIDChallenge(context.Context, *ChallengeReq) (*ChallengeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDChallenge not implemented")
+}
+func (UnimplementedRubixServiceServer) GetDIDAccess(context.Context, *AccessReq) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDIDAccess not implemented")
+}
+func (UnimplementedRubixServiceServer) CreateDID(context.Context, *CreateDIDReq) (*CreateDIDRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDID not implemented")
+}
+func (UnimplementedRubixServiceServer) GetAllTokens(context.Context, *TokenReq) (*TokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTokens not implemented")
+}
+func (UnimplementedRubixServiceServer) TransferRBT(context.Context, *TransferRBTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRBT not implemented")
+}
+func (UnimplementedRubixServiceServer) CreateDataToken(context.Context, *DataTokenReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDataToken not implemented")
+}
+func (UnimplementedRubixServiceServer) CommitDataToken(context.Context, *CommitDataTokenReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitDataToken not implemented")
+}
+func (UnimplementedRubixServiceServer) StreamIncomingTxn(*emptypb.Empty, RubixService_StreamIncomingTxnServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamIncomingTxn not implemented")
+}
+func (UnimplementedRubixServiceServer) StreamSignature(RubixService_StreamSignatureServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSignature not implemented")
+}
+func (UnimplementedRubixServiceServer) GenerateRBT(context.Context, *GenerateReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRBT not implemented")
+}
+func (UnimplementedRubixServiceServer) GetBalance(context.Context, *emptypb.Empty) (*GetBalanceRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedRubixServiceServer) GetTransactionHistory(context.Context, *TxnHistoryReq) (*TransactionHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedRubixServiceServer) CreateFT(context.Context, *CreateFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFT not implemented")
+}
+func (UnimplementedRubixServiceServer) TransferFT(context.Context, *TransferFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFT not implemented")
+}
+func (UnimplementedRubixServiceServer) GetFTInfo(context.Context, *FTInfoReq) (*FTInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFTInfo not implemented")
+}
+func (UnimplementedRubixServiceServer) CreateNFT(context.Context, *CreateNFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNFT not implemented")
+}
+func (UnimplementedRubixServiceServer) DeployNFT(context.Context, *DeployNFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployNFT not implemented")
+}
+func (UnimplementedRubixServiceServer) ExecuteNFT(context.Context, *ExecuteNFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteNFT not implemented")
+}
+func (UnimplementedRubixServiceServer) SubscribeNFT(context.Context, *NFTReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeNFT not implemented")
+}
+func (UnimplementedRubixServiceServer) GetNFTs(context.Context, *NFTListReq) (*NFTListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNFTs not implemented")
+}
+func (UnimplementedRubixServiceServer) GenerateSmartContract(context.Context, *GenerateSmartContractReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSmartContract not implemented")
+}
+func (UnimplementedRubixServiceServer) DeploySmartContract(context.Context, *DeploySmartContractReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploySmartContract not implemented")
+}
+func (UnimplementedRubixServiceServer) ExecuteSmartContract(context.Context, *ExecuteSmartContractReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSmartContract not implemented")
+}
+func (UnimplementedRubixServiceServer) SubscribeSmartContract(context.Context, *SmartContractReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeSmartContract not implemented")
+}
+func (UnimplementedRubixServiceServer) GetSmartContractData(context.Context, *SmartContractDataReq) (*SmartContractDataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSmartContractData not implemented")
+}
+func (UnimplementedRubixServiceServer) GetPledgedTokens(context.Context, *emptypb.Empty) (*PledgedTokensResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPledgedTokens not implemented")
+}
+func (UnimplementedRubixServiceServer) RunUnpledge(context.Context, *emptypb.Empty) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunUnpledge not implemented")
+}
+func (UnimplementedRubixServiceServer) SelfTransferRBT(context.Context, *SelfTransferReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfTransferRBT not implemented")
+}
+func (UnimplementedRubixServiceServer) GetTransaction(context.Context, *TxnReq) (*TransactionHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedRubixServiceServer) AddQuorum(context.Context, *QuorumList) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuorum not implemented")
+}
+func (UnimplementedRubixServiceServer) GetAllQuorum(context.Context, *emptypb.Empty) (*QuorumAddresses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllQuorum not implemented")
+}
+func (UnimplementedRubixServiceServer) RemoveAllQuorum(context.Context, *emptypb.Empty) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllQuorum not implemented")
+}
+func (UnimplementedRubixServiceServer) SetupQuorum(context.Context, *SetupQuorumReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupQuorum not implemented")
+}
+func (UnimplementedRubixServiceServer) CheckQuorumStatus(context.Context, *QuorumStatusReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuorumStatus not implemented")
+}
+func (UnimplementedRubixServiceServer) DumpTokenChain(context.Context, *TokenChainReq) (*TokenChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpTokenChain not implemented")
+}
+func (UnimplementedRubixServiceServer) ValidateTokenChain(context.Context, *ValidateTokenChainReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTokenChain not implemented")
+}
+func (UnimplementedRubixServiceServer) GetNodeInfo(context.Context, *emptypb.Empty) (*NodeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
+func (UnimplementedRubixServiceServer) WatchTransaction(*TxnEventReq, RubixService_WatchTransactionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransaction not implemented")
+}
+func (UnimplementedRubixServiceServer) SubmitSignature(context.Context, *SignResponse) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignature not implemented")
+}
+func (UnimplementedRubixServiceServer) GetJobs(context.Context, *JobsReq) (*JobsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (UnimplementedRubixServiceServer) GetJob(context.Context, *JobReq) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedRubixServiceServer) AddWebhook(context.Context, *WebhookReq) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (UnimplementedRubixServiceServer) GetWebhooks(context.Context, *WebhooksReq) (*WebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedRubixServiceServer) RemoveWebhook(context.Context, *WebhookIDReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedRubixServiceServer) GetWebhookDeliveries(context.Context, *WebhookDeliveriesReq) (*WebhookDeliveriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedRubixServiceServer) ReplayWebhookDelivery(context.Context, *WebhookIDReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedRubixServiceServer) QuerySmartContractState(context.Context, *SmartContractStateReq) (*SmartContractStateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySmartContractState not implemented")
+}
+func (UnimplementedRubixServiceServer) GetSmartContractEvents(context.Context, *SmartContractEventsReq) (*SmartContractEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSmartContractEvents not implemented")
+}
+func (UnimplementedRubixServiceServer) WatchSmartContractEvents(*SmartContractEventsReq, RubixService_WatchSmartContractEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSmartContractEvents not implemented")
+}
+func (UnimplementedRubixServiceServer) UpgradeSmartContract(context.Context, *UpgradeSmartContractReq) (*BasicReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeSmartContract not implemented")
+}
+func (UnimplementedRubixServiceServer) GetConsensusJournal(context.Context, *emptypb.Empty) (*ConsensusJournalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusJournal not implemented")
+}
+func (UnimplementedRubixServiceServer) ResolveConsensusJournal(context.Context, *ResolveConsensusJournalReq) (*ConsensusJournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveConsensusJournal not implemented")
+}
+func (UnimplementedRubixServiceServer) mustEmbedUnimplementedRubixServiceServer() {}
+
+// UnsafeRubixServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RubixServiceServer will
+// result in compilation errors.
+type UnsafeRubixServiceServer interface {
+	mustEmbedUnimplementedRubixServiceServer()
+}
+
+func RegisterRubixServiceServer(s grpc.ServiceRegistrar, srv RubixServiceServer) {
+	s.RegisterService(&RubixService_ServiceDesc, srv)
+}
+
+func _RubixService_GetDIDChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetDIDChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetDIDChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetDIDChallenge(ctx, req.(*ChallengeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetDIDAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetDIDAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetDIDAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetDIDAccess(ctx, req.(*AccessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CreateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CreateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CreateDID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CreateDID(ctx, req.(*CreateDIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetAllTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetAllTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetAllTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetAllTokens(ctx, req.(*TokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_TransferRBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRBTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).TransferRBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_TransferRBT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).TransferRBT(ctx, req.(*TransferRBTReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CreateDataToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CreateDataToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CreateDataToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CreateDataToken(ctx, req.(*DataTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CommitDataToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitDataTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CommitDataToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CommitDataToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CommitDataToken(ctx, req.(*CommitDataTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_StreamIncomingTxn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RubixServiceServer).StreamIncomingTxn(m, &rubixServiceStreamIncomingTxnServer{stream})
+}
+
+type RubixService_StreamIncomingTxnServer interface {
+	Send(*IncomingTxnDetails) error
+	grpc.ServerStream
+}
+
+type rubixServiceStreamIncomingTxnServer struct {
+	grpc.ServerStream
+}
+
+func (x *rubixServiceStreamIncomingTxnServer) Send(m *IncomingTxnDetails) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RubixService_StreamSignature_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RubixServiceServer).StreamSignature(&rubixServiceStreamSignatureServer{stream})
+}
+
+type RubixService_StreamSignatureServer interface {
+	Send(*BasicReponse) error
+	Recv() (*SignResponse, error)
+	grpc.ServerStream
+}
+
+type rubixServiceStreamSignatureServer struct {
+	grpc.ServerStream
+}
+
+func (x *rubixServiceStreamSignatureServer) Send(m *BasicReponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rubixServiceStreamSignatureServer) Recv() (*SignResponse, error) {
+	m := new(SignResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RubixService_GenerateRBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GenerateRBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GenerateRBT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GenerateRBT(ctx, req.(*GenerateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetBalance(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetTransactionHistory(ctx, req.(*TxnHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CreateFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CreateFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CreateFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CreateFT(ctx, req.(*CreateFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_TransferFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).TransferFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_TransferFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).TransferFT(ctx, req.(*TransferFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetFTInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FTInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetFTInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetFTInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetFTInfo(ctx, req.(*FTInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CreateNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CreateNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CreateNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CreateNFT(ctx, req.(*CreateNFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_DeployNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployNFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).DeployNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_DeployNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).DeployNFT(ctx, req.(*DeployNFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedRubixServiceServer) GetDIDChallenge(context.Context, *ChallengeReq) (*ChallengeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDIDChallenge not implemented")
+func _RubixService_ExecuteNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteNFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).ExecuteNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_ExecuteNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).ExecuteNFT(ctx, req.(*ExecuteNFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) GetDIDAccess(context.Context, *AccessReq) (*Token, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDIDAccess not implemented")
+
+func _RubixService_SubscribeNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFTReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).SubscribeNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_SubscribeNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).SubscribeNFT(ctx, req.(*NFTReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) CreateDID(context.Context, *CreateDIDReq) (*CreateDIDRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDID not implemented")
+
+func _RubixService_GetNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NFTListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetNFTs(ctx, req.(*NFTListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) GetAllTokens(context.Context, *TokenReq) (*TokenResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllTokens not implemented")
+
+func _RubixService_GenerateSmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSmartContractReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GenerateSmartContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GenerateSmartContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GenerateSmartContract(ctx, req.(*GenerateSmartContractReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) TransferRBT(context.Context, *TransferRBTReq) (*BasicReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferRBT not implemented")
+
+func _RubixService_DeploySmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploySmartContractReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).DeploySmartContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_DeploySmartContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).DeploySmartContract(ctx, req.(*DeploySmartContractReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) CreateDataToken(context.Context, *DataTokenReq) (*BasicReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDataToken not implemented")
+
+func _RubixService_ExecuteSmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSmartContractReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).ExecuteSmartContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_ExecuteSmartContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).ExecuteSmartContract(ctx, req.(*ExecuteSmartContractReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) CommitDataToken(context.Context, *CommitDataTokenReq) (*BasicReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitDataToken not implemented")
+
+func _RubixService_SubscribeSmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmartContractReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).SubscribeSmartContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_SubscribeSmartContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).SubscribeSmartContract(ctx, req.(*SmartContractReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetSmartContractData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmartContractDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetSmartContractData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetSmartContractData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetSmartContractData(ctx, req.(*SmartContractDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetPledgedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetPledgedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetPledgedTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetPledgedTokens(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_RunUnpledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).RunUnpledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_RunUnpledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).RunUnpledge(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_SelfTransferRBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).SelfTransferRBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_SelfTransferRBT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).SelfTransferRBT(ctx, req.(*SelfTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetTransaction(ctx, req.(*TxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_AddQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuorumList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).AddQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_AddQuorum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).AddQuorum(ctx, req.(*QuorumList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_GetAllQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetAllQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetAllQuorum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetAllQuorum(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_RemoveAllQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).RemoveAllQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_RemoveAllQuorum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).RemoveAllQuorum(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_SetupQuorum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupQuorumReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).SetupQuorum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_SetupQuorum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).SetupQuorum(ctx, req.(*SetupQuorumReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubixService_CheckQuorumStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuorumStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).CheckQuorumStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_CheckQuorumStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).CheckQuorumStatus(ctx, req.(*QuorumStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) StreamIncomingTxn(*emptypb.Empty, RubixService_StreamIncomingTxnServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamIncomingTxn not implemented")
+
+func _RubixService_DumpTokenChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).DumpTokenChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_DumpTokenChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).DumpTokenChain(ctx, req.(*TokenChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) StreamSignature(RubixService_StreamSignatureServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamSignature not implemented")
+
+func _RubixService_ValidateTokenChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).ValidateTokenChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_ValidateTokenChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).ValidateTokenChain(ctx, req.(*ValidateTokenChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) GenerateRBT(context.Context, *GenerateReq) (*BasicReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateRBT not implemented")
+
+func _RubixService_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetNodeInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRubixServiceServer) GetBalance(context.Context, *emptypb.Empty) (*GetBalanceRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+
+func _RubixService_WatchTransaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TxnEventReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RubixServiceServer).WatchTransaction(m, &rubixServiceWatchTransactionServer{stream})
 }
-func (UnimplementedRubixServiceServer) GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionHistory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+
+type RubixService_WatchTransactionServer interface {
+	Send(*TxnEvent) error
+	grpc.ServerStream
 }
-func (UnimplementedRubixServiceServer) mustEmbedUnimplementedRubixServiceServer() {}
 
-// UnsafeRubixServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RubixServiceServer will
-// result in compilation errors.
-type UnsafeRubixServiceServer interface {
-	mustEmbedUnimplementedRubixServiceServer()
+type rubixServiceWatchTransactionServer struct {
+	grpc.ServerStream
 }
 
-func RegisterRubixServiceServer(s grpc.ServiceRegistrar, srv RubixServiceServer) {
-	s.RegisterService(&RubixService_ServiceDesc, srv)
+func (x *rubixServiceWatchTransactionServer) Send(m *TxnEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _RubixService_GetDIDChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChallengeReq)
+func _RubixService_SubmitSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignResponse)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GetDIDChallenge(ctx, in)
+		return srv.(RubixServiceServer).SubmitSignature(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GetDIDChallenge_FullMethodName,
+		FullMethod: RubixService_SubmitSignature_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GetDIDChallenge(ctx, req.(*ChallengeReq))
+		return srv.(RubixServiceServer).SubmitSignature(ctx, req.(*SignResponse))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_GetDIDAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccessReq)
+func _RubixService_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GetDIDAccess(ctx, in)
+		return srv.(RubixServiceServer).GetJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GetDIDAccess_FullMethodName,
+		FullMethod: RubixService_GetJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GetDIDAccess(ctx, req.(*AccessReq))
+		return srv.(RubixServiceServer).GetJobs(ctx, req.(*JobsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_CreateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDIDReq)
+func _RubixService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).CreateDID(ctx, in)
+		return srv.(RubixServiceServer).GetJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_CreateDID_FullMethodName,
+		FullMethod: RubixService_GetJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).CreateDID(ctx, req.(*CreateDIDReq))
+		return srv.(RubixServiceServer).GetJob(ctx, req.(*JobReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_GetAllTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokenReq)
+func _RubixService_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GetAllTokens(ctx, in)
+		return srv.(RubixServiceServer).AddWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GetAllTokens_FullMethodName,
+		FullMethod: RubixService_AddWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GetAllTokens(ctx, req.(*TokenReq))
+		return srv.(RubixServiceServer).AddWebhook(ctx, req.(*WebhookReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_TransferRBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferRBTReq)
+func _RubixService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).TransferRBT(ctx, in)
+		return srv.(RubixServiceServer).GetWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_TransferRBT_FullMethodName,
+		FullMethod: RubixService_GetWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).TransferRBT(ctx, req.(*TransferRBTReq))
+		return srv.(RubixServiceServer).GetWebhooks(ctx, req.(*WebhooksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_CreateDataToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataTokenReq)
+func _RubixService_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).CreateDataToken(ctx, in)
+		return srv.(RubixServiceServer).RemoveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_CreateDataToken_FullMethodName,
+		FullMethod: RubixService_RemoveWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).CreateDataToken(ctx, req.(*DataTokenReq))
+		return srv.(RubixServiceServer).RemoveWebhook(ctx, req.(*WebhookIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_CommitDataToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommitDataTokenReq)
+func _RubixService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).CommitDataToken(ctx, in)
+		return srv.(RubixServiceServer).GetWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_CommitDataToken_FullMethodName,
+		FullMethod: RubixService_GetWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).CommitDataToken(ctx, req.(*CommitDataTokenReq))
+		return srv.(RubixServiceServer).GetWebhookDeliveries(ctx, req.(*WebhookDeliveriesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_StreamIncomingTxn_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _RubixService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(RubixServiceServer).StreamIncomingTxn(m, &rubixServiceStreamIncomingTxnServer{stream})
-}
-
-type RubixService_StreamIncomingTxnServer interface {
-	Send(*IncomingTxnDetails) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(RubixServiceServer).ReplayWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_ReplayWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).ReplayWebhookDelivery(ctx, req.(*WebhookIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type rubixServiceStreamIncomingTxnServer struct {
-	grpc.ServerStream
+func _RubixService_QuerySmartContractState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmartContractStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).QuerySmartContractState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_QuerySmartContractState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).QuerySmartContractState(ctx, req.(*SmartContractStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *rubixServiceStreamIncomingTxnServer) Send(m *IncomingTxnDetails) error {
-	return x.ServerStream.SendMsg(m)
+func _RubixService_GetSmartContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmartContractEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubixServiceServer).GetSmartContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubixService_GetSmartContractEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubixServiceServer).GetSmartContractEvents(ctx, req.(*SmartContractEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_StreamSignature_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RubixServiceServer).StreamSignature(&rubixServiceStreamSignatureServer{stream})
+func _RubixService_WatchSmartContractEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SmartContractEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RubixServiceServer).WatchSmartContractEvents(m, &rubixServiceWatchSmartContractEventsServer{stream})
 }
 
-type RubixService_StreamSignatureServer interface {
-	Send(*BasicReponse) error
-	Recv() (*SignResponse, error)
+type RubixService_WatchSmartContractEventsServer interface {
+	Send(*SmartContractEvent) error
 	grpc.ServerStream
 }
 
-type rubixServiceStreamSignatureServer struct {
+type rubixServiceWatchSmartContractEventsServer struct {
 	grpc.ServerStream
 }
 
-func (x *rubixServiceStreamSignatureServer) Send(m *BasicReponse) error {
+func (x *rubixServiceWatchSmartContractEventsServer) Send(m *SmartContractEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *rubixServiceStreamSignatureServer) Recv() (*SignResponse, error) {
-	m := new(SignResponse)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _RubixService_GenerateRBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateReq)
+func _RubixService_UpgradeSmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeSmartContractReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GenerateRBT(ctx, in)
+		return srv.(RubixServiceServer).UpgradeSmartContract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GenerateRBT_FullMethodName,
+		FullMethod: RubixService_UpgradeSmartContract_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GenerateRBT(ctx, req.(*GenerateReq))
+		return srv.(RubixServiceServer).UpgradeSmartContract(ctx, req.(*UpgradeSmartContractReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _RubixService_GetConsensusJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GetBalance(ctx, in)
+		return srv.(RubixServiceServer).GetConsensusJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GetBalance_FullMethodName,
+		FullMethod: RubixService_GetConsensusJournal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GetBalance(ctx, req.(*emptypb.Empty))
+		return srv.(RubixServiceServer).GetConsensusJournal(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RubixService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _RubixService_ResolveConsensusJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveConsensusJournalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RubixServiceServer).GetTransactionHistory(ctx, in)
+		return srv.(RubixServiceServer).ResolveConsensusJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RubixService_GetTransactionHistory_FullMethodName,
+		FullMethod: RubixService_ResolveConsensusJournal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RubixServiceServer).GetTransactionHistory(ctx, req.(*emptypb.Empty))
+		return srv.(RubixServiceServer).ResolveConsensusJournal(ctx, req.(*ResolveConsensusJournalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -559,6 +1931,158 @@ var RubixService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionHistory",
 			Handler:    _RubixService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "CreateFT",
+			Handler:    _RubixService_CreateFT_Handler,
+		},
+		{
+			MethodName: "TransferFT",
+			Handler:    _RubixService_TransferFT_Handler,
+		},
+		{
+			MethodName: "GetFTInfo",
+			Handler:    _RubixService_GetFTInfo_Handler,
+		},
+		{
+			MethodName: "CreateNFT",
+			Handler:    _RubixService_CreateNFT_Handler,
+		},
+		{
+			MethodName: "DeployNFT",
+			Handler:    _RubixService_DeployNFT_Handler,
+		},
+		{
+			MethodName: "ExecuteNFT",
+			Handler:    _RubixService_ExecuteNFT_Handler,
+		},
+		{
+			MethodName: "SubscribeNFT",
+			Handler:    _RubixService_SubscribeNFT_Handler,
+		},
+		{
+			MethodName: "GetNFTs",
+			Handler:    _RubixService_GetNFTs_Handler,
+		},
+		{
+			MethodName: "GenerateSmartContract",
+			Handler:    _RubixService_GenerateSmartContract_Handler,
+		},
+		{
+			MethodName: "DeploySmartContract",
+			Handler:    _RubixService_DeploySmartContract_Handler,
+		},
+		{
+			MethodName: "ExecuteSmartContract",
+			Handler:    _RubixService_ExecuteSmartContract_Handler,
+		},
+		{
+			MethodName: "SubscribeSmartContract",
+			Handler:    _RubixService_SubscribeSmartContract_Handler,
+		},
+		{
+			MethodName: "GetSmartContractData",
+			Handler:    _RubixService_GetSmartContractData_Handler,
+		},
+		{
+			MethodName: "GetPledgedTokens",
+			Handler:    _RubixService_GetPledgedTokens_Handler,
+		},
+		{
+			MethodName: "RunUnpledge",
+			Handler:    _RubixService_RunUnpledge_Handler,
+		},
+		{
+			MethodName: "SelfTransferRBT",
+			Handler:    _RubixService_SelfTransferRBT_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _RubixService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "AddQuorum",
+			Handler:    _RubixService_AddQuorum_Handler,
+		},
+		{
+			MethodName: "GetAllQuorum",
+			Handler:    _RubixService_GetAllQuorum_Handler,
+		},
+		{
+			MethodName: "RemoveAllQuorum",
+			Handler:    _RubixService_RemoveAllQuorum_Handler,
+		},
+		{
+			MethodName: "SetupQuorum",
+			Handler:    _RubixService_SetupQuorum_Handler,
+		},
+		{
+			MethodName: "CheckQuorumStatus",
+			Handler:    _RubixService_CheckQuorumStatus_Handler,
+		},
+		{
+			MethodName: "DumpTokenChain",
+			Handler:    _RubixService_DumpTokenChain_Handler,
+		},
+		{
+			MethodName: "ValidateTokenChain",
+			Handler:    _RubixService_ValidateTokenChain_Handler,
+		},
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _RubixService_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "SubmitSignature",
+			Handler:    _RubixService_SubmitSignature_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _RubixService_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _RubixService_GetJob_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _RubixService_AddWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _RubixService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _RubixService_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _RubixService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _RubixService_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "QuerySmartContractState",
+			Handler:    _RubixService_QuerySmartContractState_Handler,
+		},
+		{
+			MethodName: "GetSmartContractEvents",
+			Handler:    _RubixService_GetSmartContractEvents_Handler,
+		},
+		{
+			MethodName: "UpgradeSmartContract",
+			Handler:    _RubixService_UpgradeSmartContract_Handler,
+		},
+		{
+			MethodName: "GetConsensusJournal",
+			Handler:    _RubixService_GetConsensusJournal_Handler,
+		},
+		{
+			MethodName: "ResolveConsensusJournal",
+			Handler:    _RubixService_ResolveConsensusJournal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -572,6 +2096,16 @@ var RubixService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchTransaction",
+			Handler:       _RubixService_WatchTransaction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSmartContractEvents",
+			Handler:       _RubixService_WatchSmartContractEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rubix-native.proto",
 }
@@ -4,27 +4,13 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/core/wallet"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-type DIDPeerMapTemp struct {
-	DID     string
-	DIDType int
-	PeerID  string
-}
-
-// APIAddPeerDetails godoc
-// @Summary     Add Peer
-// @Description This API allows the user to add peer details manually
-// @Tags        Account
-// @Accept      json
-// @Produce     json
-// @Param       input body DIDPeerMapTemp true "Peer Details"
-// @Success     200 {object} model.BasicResponse
-// @Router      /api/add-peer-details [post]
 func (s *Server) APIAddPeerDetails(req *ensweb.Request) *ensweb.Result {
-	var pd DIDPeerMapTemp
+	var pd model.PeerDetails
 	var peer_detail wallet.DIDPeerMap
 	err := s.ParseJSON(req, &pd)
 	if err != nil {
@@ -0,0 +1,610 @@
+package server
+
+import (
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/config"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/did"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+// apiDoc is the documentation of the route in the API spec, Body and Resp
+// are the values of the request and the response types, the response is
+// the basic response if Resp is not set
+type apiDoc struct {
+	ID      string
+	Tag     string
+	Summary string
+	Desc    string
+	Query   []apiParam
+	Form    []apiParam
+	Body    interface{}
+	Resp    interface{}
+	// Sign is set for the requests which needs the signature of the DID
+	Sign bool
+	// Stream is set for the server-sent event stream
+	Stream bool
+}
+
+// apiParam is the query or the form param, type is the OpenAPI type or
+// file for the form file
+type apiParam struct {
+	Name     string
+	Type     string
+	Required bool
+	Desc     string
+}
+
+func params(ps ...[]apiParam) []apiParam {
+	r := make([]apiParam, 0)
+	for _, p := range ps {
+		r = append(r, p...)
+	}
+	return r
+}
+
+func pageParams(sort string) []apiParam {
+	return []apiParam{
+		{"limit", "integer", false, "Page size, max 1000. The list is not paged when neither the limit nor the cursor is sent, default 100 with the cursor"},
+		{"cursor", "string", false, "Next cursor of the previous page"},
+		{"sort", "string", false, sort},
+	}
+}
+
+func valueParams(name string) []apiParam {
+	return []apiParam{
+		{"status", "string", false, "Comma separated token status"},
+		{"min_value", "number", false, "Minimum " + name + " value"},
+		{"max_value", "number", false, "Maximum " + name + " value"},
+	}
+}
+
+// apiDocs is the documentation of the API routes, the key is the method
+// and the path of the route
+var apiDocs = map[string]apiDoc{
+	// Basic
+	"GET " + setup.APIStart: {
+		ID: "start", Tag: "Basic", Summary: "Start Core",
+		Desc: "It will setup the core if not done before",
+	},
+	"POST " + setup.APIShutdown: {
+		ID: "shutdown", Tag: "Basic", Summary: "Shutdown the node",
+	},
+	"GET " + setup.APINodeStatus: {
+		ID: "node-status", Tag: "Basic", Summary: "Check the node status",
+	},
+	"GET " + setup.APIPing: {
+		ID: "ping", Tag: "Basic", Summary: "Ping the peer",
+		Query: []apiParam{{"peerID", "string", true, "Peer ID"}},
+	},
+	"GET " + setup.APIPeerID: {
+		ID: "get-peer-id", Tag: "Basic", Summary: "Get the peer ID of the node",
+	},
+	"GET " + setup.APICheckQuorumStatus: {
+		ID: "check-quorum-status", Tag: "Basic", Summary: "Check the quorum status",
+		Query: []apiParam{{"quorumAddress", "string", true, "Quorum DID"}},
+	},
+	"GET " + setup.APIOpenAPI: {
+		ID: "openapi", Tag: "Basic", Summary: "Get the OpenAPI spec of the node API",
+		Resp: map[string]interface{}{},
+	},
+	// Configuration
+	"POST " + setup.APIAddBootStrap: {
+		ID: "add-bootstrap", Tag: "Config", Summary: "Add bootstrap peers",
+		Body: model.BootStrapPeers{},
+	},
+	"POST " + setup.APIRemoveBootStrap: {
+		ID: "remove-bootstrap", Tag: "Config", Summary: "Remove bootstrap peers",
+		Body: model.BootStrapPeers{},
+	},
+	"POST " + setup.APIRemoveAllBootStrap: {
+		ID: "remove-all-bootstrap", Tag: "Config", Summary: "Remove all bootstrap peers",
+	},
+	"GET " + setup.APIGetAllBootStrap: {
+		ID: "get-all-bootstrap", Tag: "Config", Summary: "Get all bootstrap peers",
+	},
+	"GET " + setup.APIGetAllExplorer: {
+		ID: "get-all-explorer", Tag: "Config", Summary: "Get all explorer URLs",
+	},
+	"POST " + setup.APIAddExplorer: {
+		ID: "add-explorer", Tag: "Config", Summary: "Add explorer URLs",
+		Body: model.ExplorerLinks{},
+	},
+	"POST " + setup.APIRemoveExplorer: {
+		ID: "remove-explorer", Tag: "Config", Summary: "Remove explorer URLs",
+		Body: model.ExplorerLinks{},
+	},
+	"POST " + setup.APISetupDB: {
+		ID: "setup-db", Tag: "Config", Summary: "Setup the DB",
+		Query: []apiParam{{"migrate", "boolean", false, "Migrate the data to the DB"}},
+		Body:  config.StorageConfig{},
+	},
+	"POST " + setup.APISetupService: {
+		ID: "setup-service", Tag: "Config", Summary: "Setup the service",
+		Body: config.ServiceConfig{},
+	},
+	"POST " + setup.APIAddPeerDetails: {
+		ID: "add-peer-details", Tag: "Config", Summary: "Add Peer",
+		Desc: "This API allows the user to add peer details manually",
+		Body: model.PeerDetails{},
+	},
+	"POST " + setup.APIMigrateNode: {
+		ID: "migrate-node", Tag: "Config", Summary: "Migrate the node",
+		Body: core.MigrateRequest{}, Sign: true,
+	},
+	// Quorum
+	"POST " + setup.APIAddQuorum: {
+		ID: "add-quorum", Tag: "Quorum", Summary: "Add the quorum list",
+		Body: []core.QuorumData{},
+	},
+	"GET " + setup.APIGetAllQuorum: {
+		ID: "get-all-quorum", Tag: "Quorum", Summary: "Get the quorum list",
+	},
+	"GET " + setup.APIRemoveAllQuorum: {
+		ID: "remove-all-quorum", Tag: "Quorum", Summary: "Remove the quorum list",
+	},
+	"POST " + setup.APISetupQuorum: {
+		ID: "setup-quorum", Tag: "Quorum", Summary: "Setup the DID as quorum",
+		Body: model.QuorumSetup{},
+	},
+	// DID
+	"GET " + setup.APIGetDIDChallenge: {
+		ID: "get-did-challenge", Tag: "DID", Summary: "Get the login challenge of the DID",
+		Query: []apiParam{{"did", "string", true, "DID"}},
+		Resp:  model.DIDAccessResponse{},
+	},
+	"POST " + setup.APIGetDIDAccess: {
+		ID: "login-did", Tag: "DID", Summary: "Get the access token of the DID",
+		Body: model.GetDIDAccess{}, Resp: model.DIDAccessResponse{},
+	},
+	"POST " + setup.APICreateDID: {
+		ID: "create-did", Tag: "DID", Summary: "Create DID",
+		Form: []apiParam{
+			{setup.DIDConfigField, "string", true, "DID configuration JSON"},
+			{"files", "file", false, "Image and key files of the DID"},
+		},
+		Resp: model.DIDResponse{},
+	},
+	"POST " + setup.APISetupDID: {
+		ID: "setup-did", Tag: "DID", Summary: "Setup the existing DID",
+		Form: []apiParam{
+			{setup.DIDConfigField, "string", true, "DID configuration JSON"},
+			{"files", "file", false, "Image and key files of the DID"},
+		},
+	},
+	"POST " + setup.APIRegisterDID: {
+		ID: "register-did", Tag: "DID", Summary: "Register the DID",
+		Body: map[string]interface{}{}, Sign: true,
+	},
+	"GET " + setup.APIGetAllDID: {
+		ID: "get-all-did", Tag: "DID", Summary: "Get all DIDs",
+		Query: pageParams("did"),
+		Resp:  model.GetAccountInfo{},
+	},
+	"GET " + setup.APIGetAccountInfo: {
+		ID: "get-account-info", Tag: "DID", Summary: "Check account balance",
+		Query: []apiParam{{"did", "string", true, "DID"}},
+		Resp:  model.GetAccountInfo{},
+	},
+	"POST " + setup.APISignatureResponse: {
+		ID: "signature-response", Tag: "DID", Summary: "Signature Response",
+		Body: did.SignRespData{}, Sign: true,
+	},
+	"POST " + setup.APIRequestDIDForPubKey: {
+		ID: "request-did-for-pubkey", Tag: "DID", Summary: "Returns DID for corresponding public key",
+		Body: model.DIDFromPubKeyRequest{}, Resp: model.DIDFromPubKeyResponse{},
+	},
+	"POST " + setup.APISendJWTFromWallet: {
+		ID: "send-jwt-from-wallet", Tag: "DID", Summary: "Authenticate the wallet JWT",
+		Sign: true,
+	},
+	"POST " + setup.APIAddUserAPIKey: {
+		ID: "add-user-api-key", Tag: "DID", Summary: "Add the API key of the DID",
+		Query: []apiParam{
+			{"did", "string", true, "DID"},
+			{"apiKey", "string", true, "API key"},
+		},
+	},
+	// RBT
+	"GET " + setup.APIGetAllTokens: {
+		ID: "get-all-tokens", Tag: "Tokens", Summary: "Get the tokens of the DID",
+		Query: params([]apiParam{
+			{"did", "string", true, "DID"},
+			{"type", "string", false, "Token type"},
+			{"token_type", "string", false, "Token type filter of the RBT tokens, whole or part"},
+		}, valueParams("token"), pageParams("token, value or status, prefix with - for descending order")),
+		Resp: model.TokenResponse{},
+	},
+	"POST " + setup.APIGenerateTestToken: {
+		ID: "generate-test-token", Tag: "Tokens", Summary: "Generate the test tokens",
+		Body: model.RBTGenerateRequest{}, Sign: true,
+	},
+	"POST " + setup.APIGenerateFaucetTestToken: {
+		ID: "generate-faucet-test-token", Tag: "Tokens", Summary: "Generate the faucet test tokens",
+		Body: model.FaucetRBTGenerateRequest{}, Sign: true,
+	},
+	"GET " + setup.APIFaucetTokenCheck: {
+		ID: "faucet-token-check", Tag: "Tokens", Summary: "Check the faucet token",
+		Query: []apiParam{
+			{"token", "string", true, "Token"},
+			{"did", "string", true, "DID"},
+		},
+	},
+	"POST " + setup.APIInitiateRBTTransfer: {
+		ID: "initiate-rbt-transfer", Tag: "Tokens", Summary: "Initiate RBT Transfer",
+		Desc: "This API will initiate RBT transfer to the specified dID",
+		Body: model.RBTTransferRequest{}, Sign: true,
+	},
+	"POST " + setup.APISelfTransfer: {
+		ID: "initiate-self-transfer", Tag: "Tokens", Summary: "Initiate Self Transfer",
+		Body: model.RBTTransferRequest{}, Sign: true,
+	},
+	"POST " + setup.APIInitiatePinRBT: {
+		ID: "initiate-pin-token", Tag: "Tokens", Summary: "Initiate Pin Token",
+		Desc: "This API will pin token in the Pinning node on behalf of the sender",
+		Body: model.RBTPinRequest{}, Sign: true,
+	},
+	"POST " + setup.APIRecoverRBT: {
+		ID: "recover-token", Tag: "Tokens", Summary: "Recover Token and Tokenchain from the pinning node",
+		Body: model.RBTRecoverRequest{}, Sign: true,
+	},
+	"POST " + setup.APILockTokens: {
+		ID: "lock-tokens", Tag: "Tokens", Summary: "Lock the tokens",
+		Body: []string{},
+	},
+	"GET " + setup.APIReleaseAllLockedTokens: {
+		ID: "release-all-locked-tokens", Tag: "Tokens", Summary: "Release all the locked tokens",
+	},
+	"GET " + setup.APIGetPledgedTokenDetails: {
+		ID: "get-pledged-token-details", Tag: "Tokens", Summary: "Get details about the pledged tokens",
+		Resp: model.TokenStateResponse{},
+	},
+	"DELETE " + setup.APICheckPinnedState: {
+		ID: "check-pinned-state", Tag: "Tokens", Summary: "Check for exhausted token state hash",
+		Query: []apiParam{{"tokenstatehash", "string", true, "Token State Hash"}},
+	},
+	"POST " + setup.APIRunUnpledge: {
+		ID: "run-unpledge", Tag: "Tokens", Summary: "Run Unpledge",
+	},
+	"POST " + setup.APIUnpledgePOWPledgeTokens: {
+		ID: "unpledge-pow-unpledge-tokens", Tag: "Tokens", Summary: "Unpledge POW Based pledge Tokens",
+	},
+	"GET " + setup.APIValidateToken: {
+		ID: "validate-token", Tag: "Tokens", Summary: "Validate the token",
+		Query: []apiParam{{"token", "string", true, "Token"}},
+	},
+	"POST " + setup.APIExportToken: {
+		ID: "export-token", Tag: "Tokens", Summary: "Export the token",
+		Body: model.ExportTokenRequest{}, Sign: true,
+	},
+	"POST " + setup.APIImportToken: {
+		ID: "import-token", Tag: "Tokens", Summary: "Import the token",
+		Body: model.ImportTokenRequest{},
+	},
+	// Token chain
+	"POST " + setup.APIDumpTokenChainBlock: {
+		ID: "dump-token-chain", Tag: "Token Chain", Summary: "Dump the token chain",
+		Body: model.TCDumpRequest{}, Resp: model.TCDumpReply{},
+	},
+	"POST " + setup.APIRemoveTokenChainBlock: {
+		ID: "remove-token-chain-block", Tag: "Token Chain", Summary: "Remove the token chain block",
+		Body: model.TCRemoveRequest{}, Resp: model.TCRemoveReply{},
+	},
+	"GET " + setup.APIGetTokenProof: {
+		ID: "get-token-proof", Tag: "Token Chain", Summary: "Get the inclusion proof of the token chain block",
+		Query: []apiParam{
+			{"token", "string", true, "Token"},
+			{"blockID", "string", false, "Block ID, latest block if not set"},
+		},
+		Resp: model.TokenProofReply{},
+	},
+	"GET " + setup.APIValidateTokenChain: {
+		ID: "validate-token-chain", Tag: "Token Chain", Summary: "Validate the token chain",
+		Query: []apiParam{
+			{"did", "string", false, "DID"},
+			{"token", "string", false, "Token"},
+			{"blockcount", "integer", false, "Number of blocks to validate"},
+			{"SCChainValidation", "boolean", false, "Validate the smart contract token chain"},
+		},
+	},
+	"POST " + setup.APICheckpointTokenChain: {
+		ID: "checkpoint-token-chain", Tag: "Token Chain", Summary: "Checkpoint the token chain",
+		Body: model.TokenChainCheckpointRequest{},
+	},
+	"POST " + setup.APIRestoreTokenChain: {
+		ID: "restore-token-chain", Tag: "Token Chain", Summary: "Restore the token chain from the checkpoint",
+		Query: []apiParam{{"token", "string", true, "Token"}},
+	},
+	// Transactions
+	"GET " + setup.APIGetTxnByTxnID: {
+		ID: "get-txn-details-by-id", Tag: "Transactions", Summary: "Get transaction details by Transcation ID",
+		Query: []apiParam{{"txnID", "string", true, "The ID of the transaction to retrieve"}},
+		Resp:  model.TxnDetails{},
+	},
+	"GET " + setup.APIGetTxnByDID: {
+		ID: "get-by-did", Tag: "Transactions", Summary: "Get transaction details by dID",
+		Query: params([]apiParam{
+			{"DID", "string", true, "DID"},
+			{"Role", "string", false, "Sender or Receiver"},
+			{"StartDate", "string", false, "Start date of the date range, YYYY-MM-DD"},
+			{"EndDate", "string", false, "End date of the date range, YYYY-MM-DD"},
+			{"min_amount", "number", false, "Minimum amount"},
+			{"max_amount", "number", false, "Maximum amount"},
+		}, pageParams("date or amount, prefix with - for descending order, default -date")),
+		Resp: model.TxnDetails{},
+	},
+	"GET " + setup.APIGetTxnByComment: {
+		ID: "get-by-comment", Tag: "Transactions", Summary: "Get transaction details by Transcation Comment",
+		Query: []apiParam{{"Comment", "string", true, "Transaction comment"}},
+		Resp:  model.TxnDetails{},
+	},
+	"GET " + setup.APIGetTxnByNode: {
+		ID: "get-txn-details-by-node", Tag: "Transactions", Summary: "Get count of incoming and outgoing txns of the DID ins a node",
+		Resp: model.TxnCountForDID{},
+	},
+	"GET " + setup.APITxnEvents: {
+		ID: "txn-events", Tag: "Transactions", Summary: "Stream the transaction lifecycle events",
+		Desc: "Server-sent event stream of the request, the events after the sequence number are sent and the stream ends with the final status.",
+		Query: []apiParam{
+			{"req_id", "string", true, "Request ID"},
+			{"from", "integer", false, "Sequence number of the last received event"},
+		},
+		Resp: model.TxnEvent{}, Stream: true,
+	},
+	// Consensus journal
+	"GET " + setup.APIConsensusJournal: {
+		ID: "get-consensus-journal", Tag: "Consensus Journal", Summary: "List the interrupted transactions",
+		Desc: "Journal entries of the transactions which are not completed or rolled back.",
+		Resp: model.ConsensusJournalListReply{},
+	},
+	"POST " + setup.APIResolveConsensusJournal: {
+		ID: "resolve-consensus-journal", Tag: "Consensus Journal", Summary: "Resolve the interrupted transaction",
+		Desc: "rollback releases the tokens & the quorum pledges of the transaction which is not acknowledged by the receiver, rollforward completes the acknowledged transaction.",
+		Body: model.ConsensusJournalRequest{}, Resp: model.ConsensusJournalReply{},
+	},
+	// Jobs
+	"GET " + setup.APIJobs: {
+		ID: "get-jobs", Tag: "Jobs", Summary: "List the jobs",
+		Query: []apiParam{
+			{"did", "string", false, "DID"},
+			{"type", "string", false, "Job type"},
+			{"status", "string", false, "Job status"},
+			{"limit", "integer", false, "Maximum number of jobs"},
+		},
+		Resp: model.JobListReply{},
+	},
+	"GET " + setup.APIGetJob: {
+		ID: "get-job", Tag: "Jobs", Summary: "Get the job",
+		Resp: model.JobReply{},
+	},
+	// Webhooks
+	"POST " + setup.APIWebhooks: {
+		ID: "add-webhook", Tag: "Webhooks", Summary: "Add webhook",
+		Body: model.WebhookRequest{}, Resp: model.WebhookReply{},
+	},
+	"GET " + setup.APIWebhooks: {
+		ID: "get-webhooks", Tag: "Webhooks", Summary: "List webhooks",
+		Query: []apiParam{{"did", "string", false, "DID"}},
+		Resp:  model.WebhookListReply{},
+	},
+	"DELETE " + setup.APIRemoveWebhook: {
+		ID: "remove-webhook", Tag: "Webhooks", Summary: "Remove webhook",
+	},
+	"GET " + setup.APIWebhookDeliveries: {
+		ID: "get-webhook-deliveries", Tag: "Webhooks", Summary: "List webhook deliveries",
+		Query: []apiParam{
+			{"webhook_id", "string", false, "Webhook ID"},
+			{"status", "string", false, "Delivery status"},
+			{"limit", "integer", false, "Maximum number of deliveries"},
+		},
+		Resp: model.WebhookDeliveryListReply{},
+	},
+	"POST " + setup.APIReplayWebhookDelivery: {
+		ID: "replay-webhook-delivery", Tag: "Webhooks", Summary: "Replay webhook delivery",
+	},
+	// API keys
+	"POST " + setup.APIAPIKeys: {
+		ID: "add-api-key", Tag: "API Keys", Summary: "Add API key",
+		Body: model.APIKeyRequest{}, Resp: model.APIKeyReply{},
+	},
+	"GET " + setup.APIAPIKeys: {
+		ID: "get-api-keys", Tag: "API Keys", Summary: "List API keys",
+		Resp: model.APIKeyListReply{},
+	},
+	"DELETE " + setup.APIRemoveAPIKey: {
+		ID: "remove-api-key", Tag: "API Keys", Summary: "Remove API key",
+	},
+	// Data tokens
+	"POST " + setup.APICreateDataToken: {
+		ID: "create-data-token", Tag: "Data Tokens", Summary: "Create Data Token",
+		Query: []apiParam{{"did", "string", true, "DID"}},
+		Form: []apiParam{
+			{core.DTUserIDField, "string", false, "User/Entity ID"},
+			{core.DTUserInfoField, "string", false, "User/Entity Info"},
+			{core.DTCommiterDIDField, "string", false, "Committer DID"},
+			{core.DTBatchIDField, "string", false, "Batch ID"},
+			{core.DTFileInfoField, "string", false, "File info JSON with the FileHash, FileURL and FileTransInfo"},
+			{"FileContent", "file", false, "File to be committed"},
+		},
+		Sign: true,
+	},
+	"POST " + setup.APICommitDataToken: {
+		ID: "commit-data-token", Tag: "Data Tokens", Summary: "Commit Data Token",
+		Query: []apiParam{
+			{"did", "string", true, "DID"},
+			{"batchID", "string", false, "Batch ID"},
+		},
+		Sign: true,
+	},
+	"POST " + setup.APICheckDataToken: {
+		ID: "check-data-token", Tag: "Data Tokens", Summary: "Check the data token is committed",
+		Query: []apiParam{{"data_token", "string", true, "Data token"}},
+	},
+	"GET " + setup.APIGetDataToken: {
+		ID: "get-data-token", Tag: "Data Tokens", Summary: "Get Data Token",
+		Query: []apiParam{{"did", "string", true, "DID"}},
+		Resp:  model.DataTokenResponse{},
+	},
+	// NFT
+	"POST " + setup.APICreateNFT: {
+		ID: "create-nft", Tag: "NFT", Summary: "Create NFT",
+		Form: []apiParam{
+			{"did", "string", true, "DID"},
+			{"metadata", "file", true, "JSON file which contains information about the NFT"},
+			{"artifact", "file", true, "File which is meant to be an NFT"},
+		},
+		Sign: true,
+	},
+	"POST " + setup.APIDeployNFT: {
+		ID: "deploy-nft", Tag: "NFT", Summary: "Deploy NFT",
+		Body: model.DeployNFTRequest{}, Sign: true,
+	},
+	"POST " + setup.APIExecuteNFT: {
+		ID: "execute-nft", Tag: "NFT", Summary: "Execution of NFT",
+		Desc: "Transfer the ownership of the NFT or self-execution with some data if the receiver is empty",
+		Body: model.ExecuteNFTRequest{}, Sign: true,
+	},
+	"POST " + setup.APISubscribeNFT: {
+		ID: "subscribe-nft", Tag: "NFT", Summary: "Subscribe to the NFT",
+		Body: model.NewNFTSubscription{},
+	},
+	"GET " + setup.APIGetAllNFT: {
+		ID: "list-nfts", Tag: "NFT", Summary: "Get ALL NFTs",
+		Query: params(valueParams("NFT"), pageParams("nft or value, prefix with - for descending order")),
+		Resp:  model.NFTList{},
+	},
+	"GET " + setup.APIGetNftsByDid: {
+		ID: "get-nfts-by-did", Tag: "NFT", Summary: "Get NFTs owned by the particular did",
+		Query: params([]apiParam{{"did", "string", true, "DID"}}, valueParams("NFT"), pageParams("nft or value, prefix with - for descending order")),
+		Resp:  model.NFTList{},
+	},
+	"GET " + setup.APIFetchNft: {
+		ID: "fetch-nft", Tag: "NFT", Summary: "Fetch NFT",
+		Query: []apiParam{{"nft", "string", true, "NFT"}},
+	},
+	"GET " + setup.APIGetNFTTokenChainData: {
+		ID: "get-nft-token-chain-data", Tag: "NFT", Summary: "Get NFT Token Chain Data",
+		Query: []apiParam{
+			{"nft", "string", true, "NFT"},
+			{"latest", "string", false, "Set to true if you only need the latest token block"},
+		},
+		Resp: model.NFTDataReply{},
+	},
+	"GET " + setup.APIDumpNFTTokenChain: {
+		ID: "dump-nft-token-chain", Tag: "NFT", Summary: "Dump the NFT token chain",
+		Query: params([]apiParam{
+			{"nft", "string", true, "NFT"},
+			{"blockId", "string", false, "Block ID to start from"},
+		}, pageParams("block")),
+		Resp: model.TCDumpReply{},
+	},
+	// FT
+	"POST " + setup.APICreateFT: {
+		ID: "create-ft", Tag: "FT", Summary: "Create FT",
+		Body: model.CreateFTReq{}, Sign: true,
+	},
+	"POST " + setup.APIInitiateFTTransfer: {
+		ID: "initiate-ft-transfer", Tag: "FT", Summary: "Initiate an FT transfer",
+		Body: model.TransferFTReq{}, Sign: true,
+	},
+	"GET " + setup.APIGetFTInfo: {
+		ID: "get-ft-info-by-did", Tag: "FT", Summary: "Get FT balance information for a given DID",
+		Query: params([]apiParam{{"did", "string", true, "DID"}}, pageParams("name, prefix with - for descending order")),
+		Resp:  model.GetFTInfo{},
+	},
+	"POST " + setup.APIDumpFTTokenChainBlock: {
+		ID: "dump-ft-token-chain", Tag: "FT", Summary: "Dump the FT token chain",
+		Body: model.TCDumpRequest{}, Resp: model.TCDumpReply{},
+	},
+	"GET " + setup.APIGetFTTokenchain: {
+		ID: "get-ft-token-chain", Tag: "FT", Summary: "Get FT Token Chain Data",
+		Query: []apiParam{{"tokenID", "string", true, "FT Token ID"}},
+		Resp:  model.GetFTTokenChainReply{},
+	},
+	// Smart contract
+	"POST " + setup.APIGenerateSmartContract: {
+		ID: "generate-smart-contract", Tag: "Smart Contract", Summary: "Generate Smart Contract",
+		Form: []apiParam{
+			{"did", "string", true, "DID"},
+			{"binaryCodePath", "file", true, "Binary code of the contract"},
+			{"rawCodePath", "file", true, "Raw code of the contract"},
+			{"schemaFilePath", "file", true, "Schema of the contract"},
+		},
+		Sign: true,
+	},
+	"POST " + setup.APIDeploySmartContract: {
+		ID: "deploy-smart-contract", Tag: "Smart Contract", Summary: "Deploy Smart Contract",
+		Body: model.DeploySmartContractRequest{}, Sign: true,
+	},
+	"POST " + setup.APIExecuteSmartContract: {
+		ID: "execute-smart-contract", Tag: "Smart Contract", Summary: "Execute Smart Contract",
+		Body: model.ExecuteSmartContractRequest{}, Sign: true,
+	},
+	"POST " + setup.APIUpgradeSmartContract: {
+		ID: "upgrade-smart-contract", Tag: "Smart Contract", Summary: "Upgrade Smart Contract",
+		Desc: "Upgrades the deployed smart contract to the code of the generated code token, only the deployer can upgrade. The state is kept and the migrate function of the new code is run with the migration input.",
+		Body: model.UpgradeSmartContractRequest{}, Sign: true,
+	},
+	"GET " + setup.APIGetSmartContractVersions: {
+		ID: "get-smart-contract-versions", Tag: "Smart Contract", Summary: "Get the code versions of the smart contract",
+		Query: []apiParam{{"token", "string", true, "Smart contract token"}},
+		Resp:  model.SmartContractVersionsReply{},
+	},
+	"GET " + setup.APIFetchSmartContract: {
+		ID: "fetch-smart-contract", Tag: "Smart Contract", Summary: "Fetch Smart Contract",
+		Query: []apiParam{{"smartContractToken", "string", true, "Smart contract token"}},
+	},
+	"POST " + setup.APIPublishContract: {
+		ID: "publish-smart-contract", Tag: "Smart Contract", Summary: "Publish the smart contract event",
+		Body: model.NewContractEvent{},
+	},
+	"POST " + setup.APISubscribecontract: {
+		ID: "subscribe-smart-contract", Tag: "Smart Contract", Summary: "Subscribe to the smart contract",
+		Body: model.NewSubscription{},
+	},
+	"POST " + setup.APIDumpSmartContractTokenChainBlock: {
+		ID: "dump-smart-contract-token-chain", Tag: "Smart Contract", Summary: "Dump the smart contract token chain",
+		Body: model.TCDumpRequest{}, Resp: model.TCDumpReply{},
+	},
+	"POST " + setup.APIGetSmartContractTokenData: {
+		ID: "get-smart-contract-token-chain-data", Tag: "Smart Contract", Summary: "Get Smart Contract Token Chain Data",
+		Body: model.SmartContractTokenChainDataReq{}, Resp: model.SmartContractDataReply{},
+	},
+	"GET " + setup.APIQuerySmartContractState: {
+		ID: "query-smart-contract-state", Tag: "Smart Contract", Summary: "Get the value of the smart contract state key",
+		Query: []apiParam{
+			{"token", "string", true, "Smart contract token"},
+			{"key", "string", true, "State key"},
+			{"blockNumber", "integer", false, "Block number, latest block if not set"},
+		},
+		Resp: model.SmartContractStateReply{},
+	},
+	"GET " + setup.APIGetSmartContractEvents: {
+		ID: "get-smart-contract-events", Tag: "Smart Contract", Summary: "Get the smart contract events",
+		Desc: "Lists the events emitted by the smart contracts ordered by the block, the topic matches any of the event topics.",
+		Query: params([]apiParam{
+			{"token", "string", false, "Smart contract token"},
+			{"name", "string", false, "Event name"},
+			{"topic", "string", false, "Event topic"},
+			{"fromBlock", "integer", false, "First block of the events"},
+			{"toBlock", "integer", false, "Last block of the events, latest block if not set"},
+		}, pageParams("block, prefix with - for descending order")),
+		Resp: model.SmartContractEventsReply{},
+	},
+	"GET " + setup.APISmartContractEventStream: {
+		ID: "smart-contract-event-stream", Tag: "Smart Contract", Summary: "Stream the smart contract events",
+		Desc: "Server-sent event stream of the smart contract events matching the filter. The events from fromBlock are sent before the live events, reconnecting clients resume after the Last-Event-ID.",
+		Query: []apiParam{
+			{"token", "string", false, "Smart contract token"},
+			{"name", "string", false, "Event name"},
+			{"topic", "string", false, "Event topic"},
+			{"fromBlock", "integer", false, "Send the stored events from the block"},
+			{"toBlock", "integer", false, "Last block of the events"},
+		},
+		Resp: model.SmartContractEventLog{}, Stream: true,
+	},
+	"POST " + setup.APIRegisterCallBackURL: {
+		ID: "register-callback-url", Tag: "Smart Contract", Summary: "Register the callback URL of the smart contract",
+		Body: model.RegisterCallBackUrlReq{},
+	},
+}
@@ -0,0 +1,324 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const clientJSONType string = "application/json"
+
+// initialisms are kept upper case in the generated names
+var initialisms = map[string]string{
+	"api":     "API",
+	"db":      "DB",
+	"did":     "DID",
+	"ft":      "FT",
+	"id":      "ID",
+	"jwt":     "JWT",
+	"nft":     "NFT",
+	"nfts":    "NFTs",
+	"openapi": "OpenAPI",
+	"pubkey":  "PubKey",
+	"rbt":     "RBT",
+	"url":     "URL",
+}
+
+// clientGen generates the typed client methods of the API operations
+type clientGen struct {
+	doc     *OpenAPI
+	imports map[string]string
+	buf     bytes.Buffer
+}
+
+// GenerateClient generates the typed client of the API spec for the client
+// package, operations without the JSON response are not generated
+func GenerateClient(doc *OpenAPI) ([]byte, error) {
+	g := &clientGen{doc: doc, imports: map[string]string{"time": "time"}}
+	type clientOp struct {
+		method string
+		path   string
+		op     *OpenAPIOperation
+	}
+	ops := make([]clientOp, 0)
+	for p, pi := range doc.Paths {
+		for m, op := range pi {
+			ops = append(ops, clientOp{method: strings.ToUpper(m), path: p, op: op})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].op.OperationID < ops[j].op.OperationID
+	})
+	var body bytes.Buffer
+	for _, o := range ops {
+		g.buf.Reset()
+		ok, err := g.operation(o.method, o.path, o.op)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			body.Write(g.buf.Bytes())
+		}
+	}
+	g.buf.Reset()
+	g.buf.WriteString("// Code generated by apigen from the OpenAPI spec of the node. DO NOT EDIT.\n\n")
+	g.buf.WriteString("package client\n\n")
+	g.writeImports()
+	g.buf.WriteString("// API is the typed client of the node API\n")
+	g.buf.WriteString("type API struct {\n\tc *Client\n}\n\n")
+	g.buf.WriteString("// API returns the typed client of the node API\n")
+	g.buf.WriteString("func (c *Client) API() *API {\n\treturn &API{c: c}\n}\n\n")
+	g.buf.Write(body.Bytes())
+	return format.Source(g.buf.Bytes())
+}
+
+func (g *clientGen) writeImports() {
+	std := make([]string, 0)
+	ext := make([]string, 0)
+	for p := range g.imports {
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			ext = append(ext, p)
+		} else {
+			std = append(std, p)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(ext)
+	g.buf.WriteString("import (\n")
+	for _, p := range std {
+		fmt.Fprintf(&g.buf, "\t%q\n", p)
+	}
+	if len(ext) > 0 {
+		g.buf.WriteString("\n")
+	}
+	for _, p := range ext {
+		fmt.Fprintf(&g.buf, "\t%q\n", p)
+	}
+	g.buf.WriteString(")\n\n")
+}
+
+func (g *clientGen) operation(method string, p string, op *OpenAPIOperation) (bool, error) {
+	resp, ok := op.Responses["200"].Content[clientJSONType]
+	if !ok {
+		return false, nil
+	}
+	rs := resp.Schema
+	if len(rs.OneOf) > 0 {
+		// the signature request is decoded to the basic response
+		rs = rs.OneOf[0]
+	}
+	name := goName(op.OperationID, "-")
+	if name == "" {
+		return false, fmt.Errorf("operation id is missing for %s %s", method, p)
+	}
+	out, err := g.goType(rs)
+	if err != nil {
+		return false, err
+	}
+	args := make([]string, 0)
+	pathVars := make([]OpenAPIParameter, 0)
+	query := make([]OpenAPIParameter, 0)
+	for _, pm := range op.Parameters {
+		switch pm.In {
+		case "path":
+			pathVars = append(pathVars, pm)
+			args = append(args, goArgName(pm.Name)+" string")
+		case "query":
+			query = append(query, pm)
+		}
+	}
+	paramsType := name + "Params"
+	if len(query) > 0 {
+		fmt.Fprintf(&g.buf, "// %s is the query of %s\n", paramsType, name)
+		fmt.Fprintf(&g.buf, "type %s struct {\n", paramsType)
+		for _, pm := range query {
+			if pm.Description != "" {
+				fmt.Fprintf(&g.buf, "\t// %s\n", pm.Description)
+			}
+			fmt.Fprintf(&g.buf, "\t%s %s\n", goName(pm.Name, "_"), queryGoType(pm.Schema.Type))
+		}
+		g.buf.WriteString("}\n\n")
+		args = append(args, "p *"+paramsType)
+	}
+	multipart := false
+	if op.RequestBody != nil {
+		if _, ok := op.RequestBody.Content["multipart/form-data"]; ok {
+			multipart = true
+			args = append(args, "fields map[string]string", "files map[string]string")
+		} else if mt, ok := op.RequestBody.Content[clientJSONType]; ok {
+			in, err := g.goType(mt.Schema)
+			if err != nil {
+				return false, err
+			}
+			if isStructRef(mt.Schema) {
+				in = "*" + in
+			}
+			args = append(args, "in "+in)
+		}
+	}
+	args = append(args, "timeout ...time.Duration")
+	ret := out
+	if isStructRef(rs) {
+		ret = "*" + out
+	}
+	summary := op.Summary
+	if summary != "" {
+		summary = " - " + summary
+	}
+	fmt.Fprintf(&g.buf, "// %s calls %s %s%s\n", name, method, p, summary)
+	fmt.Fprintf(&g.buf, "func (a *API) %s(%s) (%s, error) {\n", name, strings.Join(args, ", "), ret)
+	pathExpr := fmt.Sprintf("%q", p)
+	if len(pathVars) > 0 {
+		g.imports["net/url"] = "url"
+		g.imports["strings"] = "strings"
+		fmt.Fprintf(&g.buf, "\tpath := %q\n", p)
+		for _, pm := range pathVars {
+			fmt.Fprintf(&g.buf, "\tpath = strings.Replace(path, %q, url.PathEscape(%s), 1)\n", "{"+pm.Name+"}", goArgName(pm.Name))
+		}
+		pathExpr = "path"
+	}
+	queryExpr := "nil"
+	if len(query) > 0 {
+		queryExpr = "q"
+		g.buf.WriteString("\tq := make(map[string]string)\n\tif p != nil {\n")
+		for _, pm := range query {
+			g.queryValue(pm)
+		}
+		g.buf.WriteString("\t}\n")
+	}
+	fmt.Fprintf(&g.buf, "\tvar out %s\n", out)
+	if multipart {
+		fmt.Fprintf(&g.buf, "\terr := a.c.sendMutiFormRequest(%q, %s, %s, fields, files, &out, timeout...)\n", method, pathExpr, queryExpr)
+	} else {
+		in := "nil"
+		for _, arg := range args {
+			if strings.HasPrefix(arg, "in ") {
+				in = "in"
+			}
+		}
+		fmt.Fprintf(&g.buf, "\terr := a.c.sendJSONRequest(%q, %s, %s, %s, &out, timeout...)\n", method, pathExpr, queryExpr, in)
+	}
+	if isStructRef(rs) {
+		g.buf.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\treturn &out, nil\n}\n\n")
+	} else {
+		g.buf.WriteString("\treturn out, err\n}\n\n")
+	}
+	return true, nil
+}
+
+func (g *clientGen) queryValue(pm OpenAPIParameter) {
+	field := "p." + goName(pm.Name, "_")
+	switch pm.Schema.Type {
+	case "integer":
+		g.imports["strconv"] = "strconv"
+		fmt.Fprintf(&g.buf, "\t\tif %s != 0 {\n\t\t\tq[%q] = strconv.Itoa(%s)\n\t\t}\n", field, pm.Name, field)
+	case "number":
+		g.imports["strconv"] = "strconv"
+		fmt.Fprintf(&g.buf, "\t\tif %s != 0 {\n\t\t\tq[%q] = strconv.FormatFloat(%s, 'f', -1, 64)\n\t\t}\n", field, pm.Name, field)
+	case "boolean":
+		fmt.Fprintf(&g.buf, "\t\tif %s {\n\t\t\tq[%q] = \"true\"\n\t\t}\n", field, pm.Name)
+	default:
+		fmt.Fprintf(&g.buf, "\t\tif %s != \"\" {\n\t\t\tq[%q] = %s\n\t\t}\n", field, pm.Name, field)
+	}
+}
+
+func isStructRef(s *OpenAPISchema) bool {
+	return s.Ref != ""
+}
+
+// goType returns the Go type of the schema, the types of the package are
+// qualified by the package name and added to the imports
+func (g *clientGen) goType(s *OpenAPISchema) (string, error) {
+	if s.Ref != "" {
+		cs, ok := g.doc.Components.Schemas[strings.TrimPrefix(s.Ref, openAPIRefPath)]
+		if !ok {
+			return "", fmt.Errorf("schema %s is not found", s.Ref)
+		}
+		return g.qualify(cs.GoType)
+	}
+	if s.GoType != "" {
+		return g.qualify(s.GoType)
+	}
+	switch s.Type {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		et, err := g.goType(s.Items)
+		return "[]" + et, err
+	case "object":
+		if s.AdditionalProperties == nil {
+			return "map[string]interface{}", nil
+		}
+		et, err := g.goType(s.AdditionalProperties)
+		return "map[string]" + et, err
+	default:
+		return "interface{}", nil
+	}
+}
+
+func (g *clientGen) qualify(goType string) (string, error) {
+	i := strings.LastIndex(goType, ".")
+	if i < 0 {
+		return "", fmt.Errorf("invalid Go type %s", goType)
+	}
+	pkg := goType[:i]
+	alias := path.Base(pkg)
+	for p, a := range g.imports {
+		if a == alias && p != pkg {
+			return "", fmt.Errorf("package name %s is used by %s and %s", alias, p, pkg)
+		}
+	}
+	g.imports[pkg] = alias
+	return alias + "." + goType[i+1:], nil
+}
+
+func queryGoType(t string) string {
+	switch t {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// goName returns the exported Go name of the separated name
+func goName(name string, sep string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, sep) {
+		if part == "" {
+			continue
+		}
+		if v, ok := initialisms[strings.ToLower(part)]; ok && strings.ToLower(part) == part {
+			sb.WriteString(v)
+			continue
+		}
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		sb.WriteString(string(r))
+	}
+	return sb.String()
+}
+
+func goArgName(name string) string {
+	n := goName(name, "_")
+	if v, ok := initialisms[strings.ToLower(n)]; ok && v == n {
+		return strings.ToLower(n)
+	}
+	r := []rune(n)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
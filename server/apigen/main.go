@@ -0,0 +1,54 @@
+// apigen generates the typed client of the node API from the OpenAPI spec,
+// the spec is built from the routes of the server or fetched from the node
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/rubixchain/rubixgoplatform/server"
+)
+
+func loadSpec(url string) (*server.OpenAPI, error) {
+	if url == "" {
+		return server.BuildOpenAPI()
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the spec, status %d", resp.StatusCode)
+	}
+	var doc server.OpenAPI
+	err = json.NewDecoder(resp.Body).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func main() {
+	out := flag.String("o", "api_gen.go", "Output file of the client")
+	url := flag.String("url", "", "URL of the OpenAPI spec of the node")
+	flag.Parse()
+	doc, err := loadSpec(*url)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load the spec, %v\n", err)
+		os.Exit(1)
+	}
+	b, err := server.GenerateClient(doc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to generate the client, %v\n", err)
+		os.Exit(1)
+	}
+	err = os.WriteFile(*out, b, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write the client, %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+func (s *Server) APIAddAPIKey(req *ensweb.Request) *ensweb.Result {
+	var ar model.APIKeyRequest
+	err := s.ParseJSON(req, &ar)
+	if err != nil {
+		return s.BasicResponse(req, false, "invalid input request", nil)
+	}
+	ak, key, err := s.c.AddAPIKey(&ar)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	rp := model.APIKeyReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "API key added",
+		},
+		APIKey: ak,
+		Key:    key,
+	}
+	return s.RenderJSON(req, &rp, http.StatusOK)
+}
+
+func (s *Server) APIGetAPIKeys(req *ensweb.Request) *ensweb.Result {
+	aks, err := s.c.GetAPIKeys()
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to get api keys", nil)
+	}
+	rp := model.APIKeyListReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the api keys",
+		},
+		APIKeys: aks,
+	}
+	return s.RenderJSON(req, &rp, http.StatusOK)
+}
+
+func (s *Server) APIRemoveAPIKey(req *ensweb.Request) *ensweb.Result {
+	err := s.c.RemoveAPIKey(s.GetRouteVar(req, "id"))
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	return s.BasicResponse(req, true, "API key removed", nil)
+}
@@ -141,7 +141,8 @@ func (s *Server) APISetupDB(req *ensweb.Request) *ensweb.Result {
 	if err != nil {
 		return s.BasicResponse(req, false, "invlid input request", nil)
 	}
-	err = s.c.SetupDB(&sc)
+	migrate := s.GetQuerry(req, "migrate") == "true"
+	err = s.c.SetupDB(&sc, migrate)
 	if err != nil {
 		return s.BasicResponse(req, false, "Failed to setup DB, "+err.Error(), nil)
 	}
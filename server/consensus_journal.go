@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+func (s *Server) APIGetConsensusJournal(req *ensweb.Request) *ensweb.Result {
+	el, err := s.c.GetConsensusJournalEntries()
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to get consensus journal, "+err.Error(), nil)
+	}
+	jr := model.ConsensusJournalListReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the consensus journal",
+		},
+		Entries: el,
+	}
+	return s.RenderJSON(req, &jr, http.StatusOK)
+}
+
+func (s *Server) APIResolveConsensusJournal(req *ensweb.Request) *ensweb.Result {
+	var jr model.ConsensusJournalRequest
+	err := s.ParseJSON(req, &jr)
+	if err != nil {
+		return s.BasicResponse(req, false, "invalid input request", nil)
+	}
+	e, err := s.c.ResolveConsensusJournal(jr.ID, jr.Action)
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to resolve consensus journal, "+err.Error(), nil)
+	}
+	rr := model.ConsensusJournalReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Consensus journal resolved",
+		},
+		Entry: e,
+	}
+	return s.RenderJSON(req, &rr, http.StatusOK)
+}
@@ -10,20 +10,6 @@ import (
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-// ShowAccount godoc
-// @Summary      Create Data Token
-// @Description  This API will create data token
-// @Tags         Data Tokens
-// @Accept       mpfd
-// @Produce      mpfd
-// @Param        UserID      	   formData      string  false  "User/Entity ID"
-// @Param        UserInfo      	   formData      string  false  "User/Entity Info"
-// @Param        CommitterDID  	   formData      string  false  "Committer DID"
-// @Param        BacthID    	   formData      string  false  "Batch ID"
-// @Param        FileInfo    	   formData      string  false  "File Info is json string {"FileHash" : "asja", "FileURL": "ass", "FileTransInfo": "asas"}"
-// @Param        FileContent       formData      file    false  "File to be committed"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/create-data-token [post]
 func (s *Server) APICreateDataToken(req *ensweb.Request) *ensweb.Result {
 	var dr core.DataTokenReq
 	var err error
@@ -48,22 +34,18 @@ func (s *Server) APICreateDataToken(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, dr.DID) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	// committer signs the data token so it should be accessible too
+	if cdid, ok := dr.Fields[core.DTCommiterDIDField]; ok && len(cdid) > 0 && !s.validateDIDAccess(req, cdid[0]) {
+		return s.BasicResponse(req, false, "Committer DID does not have an access", nil)
+	}
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.CreateDataToken(req.ID, &dr)
 	return s.didResponse(req, req.ID)
 
 }
 
-// ShowAccount godoc
-// @Summary      Create Data Token
-// @Description  This API will create data token
-// @Tags         Data Tokens
-// @Accept       json
-// @Produce      json
-// @Param        did        	   query      string  true   "DID"
-// @Param        batchID      	   query      string  false  "Batch ID"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/commit-data-token [post]
 func (s *Server) APICommitDataToken(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "did")
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(did)
@@ -78,7 +60,9 @@ func (s *Server) APICommitDataToken(req *ensweb.Request) *ensweb.Result {
 	if batchID == "" {
 		batchID = did
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.CommitDataToken(req.ID, did, batchID)
 	return s.didResponse(req, req.ID)
 }
@@ -95,15 +79,6 @@ func (s *Server) APICheckDataToken(req *ensweb.Request) *ensweb.Result {
 	return s.BasicResponse(req, true, "Data token is valid", nil)
 }
 
-// ShowAccount godoc
-// @Summary      Get Data Token
-// @Description  This API will get all data token belong to the did
-// @Tags         Data Tokens
-// @Accept       json
-// @Produce      json
-// @Param        did        	   query      string  true   "DID"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/get-data-token [get]
 func (s *Server) APIGetDataToken(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "did")
 	if did == "" {
@@ -114,6 +89,9 @@ func (s *Server) APIGetDataToken(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid DID")
 		return s.BasicResponse(req, false, "Invalid DID", nil)
 	}
+	if !s.validateDIDAccess(req, did) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
 	dt := s.c.GetDataTokens(did)
 	resp := model.DataTokenResponse{
 		BasicResponse: model.BasicResponse{
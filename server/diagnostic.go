@@ -25,6 +25,19 @@ func (s *Server) APIDumpTokenChainBlock(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, drep, http.StatusOK)
 }
 
+// APIGetTokenProof returns the merkle inclusion proof of the token in the block
+func (s *Server) APIGetTokenProof(req *ensweb.Request) *ensweb.Result {
+	token := s.GetQuerry(req, "token")
+	blockID := s.GetQuerry(req, "blockID")
+	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(token)
+	if len(token) != 46 || !strings.HasPrefix(token, "Qm") || !is_alphanumeric {
+		s.log.Error("Invalid token")
+		return s.BasicResponse(req, false, "Invalid token", nil)
+	}
+	pr := s.c.GetTokenProof(token, blockID)
+	return s.RenderJSON(req, pr, http.StatusOK)
+}
+
 func (s *Server) APIDumpFTTokenChainBlock(req *ensweb.Request) *ensweb.Result {
 	var dr model.TCDumpRequest
 	err := s.ParseJSON(req, &dr)
@@ -35,15 +48,6 @@ func (s *Server) APIDumpFTTokenChainBlock(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, drep, http.StatusOK)
 }
 
-// SmartContract godoc
-// @Summary      Get FT Token Chain Data
-// @Description  This API returns FT token chain data for a given FT token ID.
-// @Tags         FT
-// @Accept       json
-// @Produce      json
-// @Param        tokenID	query	string	true	"FT Token ID"
-// @Success      200  {object}  model.GetFTTokenChainReply "Successful response with token chain data"
-// @Router       /api/get-ft-token-chain [get]
 func (s *Server) APIGetFTTokenchain(req *ensweb.Request) *ensweb.Result {
 	TokenID := s.GetQuerry(req, "tokenID")
 	if TokenID == "" {
@@ -85,29 +89,20 @@ func (s *Server) APIDumpNFTTokenChain(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid NFT")
 		return s.BasicResponse(req, false, "Invalid NFT", nil)
 	}
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	dr := model.TCDumpRequest{
 		Token:   nft,
 		BlockID: blockId,
+		Limit:   pr.Limit,
+		Cursor:  pr.Cursor,
 	}
 	drep := s.c.DumpNFTTokenChain(&dr)
 	return s.RenderJSON(req, drep, http.StatusOK)
 }
 
-type GetSmartContractTokenChainDataSwaggoInput struct {
-	Token  string `json:"token"`
-	Latest bool   `json:"latest"`
-}
-
-// SmartContract godoc
-// @Summary      Get Smart Contract Token Chain Data
-// @Description  This API will return smart contract token chain data
-// @Tags         Smart Contract
-// @ID 			 get-smart-contract-token-chain-data
-// @Accept       json
-// @Produce      json
-// @Param		 input body GetSmartContractTokenChainDataSwaggoInput true "Returns Smart contract token chain Execution Data"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/get-smart-contract-token-chain-data [post]
 func (s *Server) APIGetSmartContractTokenChainData(req *ensweb.Request) *ensweb.Result {
 	var getReq model.SmartContractTokenChainDataReq
 	err := s.ParseJSON(req, &getReq)
@@ -118,22 +113,28 @@ func (s *Server) APIGetSmartContractTokenChainData(req *ensweb.Request) *ensweb.
 	return s.RenderJSON(req, sctdataReply, http.StatusOK)
 }
 
-type GetNFTTokenChainDataSwaggoInput struct {
-	Token  string `json:"token"`
-	Latest bool   `json:"latest"`
+// APIQuerySmartContractState returns the value of the smart contract state key
+// at the block, latest block is used if the block number is not set
+func (s *Server) APIQuerySmartContractState(req *ensweb.Request) *ensweb.Result {
+	token := s.GetQuerry(req, "token")
+	key := s.GetQuerry(req, "key")
+	if token == "" || key == "" {
+		return s.BasicResponse(req, false, "Smart contract token and key are required", nil)
+	}
+	var bn uint64
+	latest := true
+	if v := s.GetQuerry(req, "blockNumber"); v != "" {
+		var err error
+		bn, err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return s.BasicResponse(req, false, "Invalid block number", nil)
+		}
+		latest = false
+	}
+	sr := s.c.QuerySmartContractState(token, key, bn, latest)
+	return s.RenderJSON(req, sr, http.StatusOK)
 }
 
-// NFT godoc
-// @Summary      Get NFT Token Chain Data
-// @Description  This API will return nft token chain data
-// @Tags         NFT
-// @ID           get-nft-token-chain-data
-// @Accept       json
-// @Produce      json
-// @Param        nft     query string true  "NFT token id"
-// @Param        latest  query string false "Set to true if you only need the latest token block"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/get-nft-token-chain-data [get]
 func (s *Server) APIGetNFTTokenChainData(req *ensweb.Request) *ensweb.Result {
 	nft := s.GetQuerry(req, "nft")
 	if nft == "" {
@@ -160,21 +161,6 @@ func (s *Server) APIGetNFTTokenChainData(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, nftDataReply, http.StatusOK)
 }
 
-type RegisterCallBackURLSwaggoInput struct {
-	Token       string `json:"SmartContractToken"`
-	CallBackURL string `json:"CallBackURL"`
-}
-
-// SmartContract godoc
-// @Summary      Get Smart Contract Token Chain Data
-// @Description  This API will register call back url for when updated come for smart contract token
-// @Tags         Smart Contract
-// @ID 			 register-callback-url
-// @Accept       json
-// @Produce      json
-// @Param		 input body RegisterCallBackURLSwaggoInput true "Register call back URL"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/register-callback-url [post]
 func (s *Server) APIRegisterCallbackURL(req *ensweb.Request) *ensweb.Result {
 	var registerReq model.RegisterCallBackUrlReq
 	err := s.ParseJSON(req, &registerReq)
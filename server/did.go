@@ -117,13 +117,21 @@ func (s *Server) APIGetAllDID(req *ensweb.Request) *ensweb.Result {
 			dir = token.DID
 		}
 	}
-	dt := s.c.GetDIDs(dir)
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	dt, next, err := s.c.GetDIDsPage(dir, pr)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	ai := model.GetAccountInfo{
 		BasicResponse: model.BasicResponse{
 			Status:  true,
 			Message: "Got all DIDs",
 		},
 		AccountInfo: make([]model.DIDAccountInfo, 0),
+		NextCursor:  next,
 	}
 	for _, d := range dt {
 		a, err := s.c.GetAccountInfo(d.DID)
@@ -140,9 +148,16 @@ func (s *Server) APIGetAllDID(req *ensweb.Request) *ensweb.Result {
 
 func (s *Server) validateDIDAccess(req *ensweb.Request, did string) bool {
 	if s.cfg.EnableAuth {
-		// always expect client token to present
-		token := req.ClientToken.Model.(*setup.BearerToken)
-		return s.c.IsDIDExist(token.DID, did)
+		did = didOfAddress(did)
+		if req.ClientToken.Verified {
+			token, ok := req.ClientToken.Model.(*setup.BearerToken)
+			if !ok {
+				return false
+			}
+			return s.c.IsDIDExist(token.DID, did)
+		}
+		cl := s.requestCaller(req)
+		return cl != nil && cl.HasDID(did)
 	} else {
 		return true
 	}
@@ -183,7 +198,9 @@ func (s *Server) APIRegisterDID(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid DID")
 		return s.BasicResponse(req, false, "Invalid DID", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 
 	go s.c.RegisterDID(req.ID, didStr)
 	return s.didResponse(req, req.ID)
@@ -254,21 +271,7 @@ func (s *Server) APISetupDID(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, br, http.StatusOK)
 }
 
-type DIDFromPubKeySwaggoRequest struct {
-	PubKey string `json:"public_key"`
-}
-
 // APICreateDIDFromPubKey creates a DID from the provided public key
-
-// @Summary     Returns DID for corresponding public key
-// @Description This API will returns DID for corresponding public key
-// @Tags        Account
-// @ID 			request-did-for-pubkey
-// @Accept      json
-// @Produce     json
-// @Param 		input body DIDFromPubKeySwaggoRequest true "Get DID from Public Key"
-// @Success 200 {object} model.DIDFromPubKeyResponse
-// @Router /api/request-did-for-pubkey [post]
 func (s *Server) APICreateDIDFromPubKey(req *ensweb.Request) *ensweb.Result {
 	var didReq model.DIDFromPubKeyRequest
 	err := s.ParseJSON(req, &didReq)
@@ -5,38 +5,12 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/rubixchain/rubixgoplatform/core"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/util"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-type CreateFTReqSwaggoInput struct {
-	DID        string `json:"did"`
-	FTName     string `json:"ft_name"`
-	FTCount    int    `json:"ft_count"`
-	TokenCount int    `json:"token_count"`
-}
-
-type TransferFTReqSwaggoInput struct {
-	Receiver   string `json:"receiver"`
-	Sender     string `json:"sender"`
-	FTName     string `json:"ft_name"`
-	FTCount    int    `json:"ft_count"`
-	Comment    string `json:"comment"`
-	QuorumType int    `json:"quorum_type"`
-	Password   string `json:"password"`
-	CreatorDID string `json:"creatorDID"`
-}
-
-// ShowAccount godoc
-// @Summary      Create FT
-// @Description  This API endpoint will create FTs.
-// @Tags         FT
-// @Accept       json
-// @Produce      json
-// @Param        input body CreateFTReqSwaggoInput true "Create FT"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/create-ft [post]
 func (s *Server) APICreateFT(req *ensweb.Request) *ensweb.Result {
 	var createFTReq model.CreateFTReq
 	err := s.ParseJSON(req, &createFTReq)
@@ -46,21 +20,19 @@ func (s *Server) APICreateFT(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, createFTReq.DID) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, createFTReq.DID, createFTReq.IdempotencyKey, core.JobCreateFT, &createFTReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 	rbtAmount := int(createFTReq.TokenCount)
 	go s.c.CreateFTs(req.ID, createFTReq.DID, createFTReq.FTCount, createFTReq.FTName, rbtAmount)
 	return s.didResponse(req, req.ID)
 }
 
-// ShowAccount godoc
-// @Summary      Initiate an FT transfer
-// @Description  This API endpoint will initiate transfer of FTs.
-// @Tags         FT
-// @Accept       json
-// @Produce      json
-// @Param        input body TransferFTReqSwaggoInput true "Transfer FT"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/initiate-ft-transfer [post]
 func (s *Server) APIInitiateFTTransfer(req *ensweb.Request) *ensweb.Result {
 	var rbtReq model.TransferFTReq
 	err := s.ParseJSON(req, &rbtReq)
@@ -74,20 +46,18 @@ func (s *Server) APIInitiateFTTransfer(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, did, rbtReq.IdempotencyKey, core.JobFTTransfer, &rbtReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 	go s.c.InitiateFTTransfer(req.ID, &rbtReq)
 	return s.didResponse(req, req.ID)
 }
 
-// ShowAccount godoc
-// @Summary      Get FT balance information for a given DID
-// @Description  This API endpoint retrieves the names and count of FTs of a given DID.
-// @Tags         FT
-// @Accept       json
-// @Produce      json
-// @Param        did      	   query      string  true  "User DID"
-// @Success      200  {object}  model.GetFTInfo
-// @Router       /api/get-ft-info-by-did [get]
 func (s *Server) APIGetFTInfo(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "did")
 	if !s.validateDIDAccess(req, did) {
@@ -98,7 +68,11 @@ func (s *Server) APIGetFTInfo(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid DID")
 		return s.BasicResponse(req, false, "Invalid DID", nil)
 	}
-	info, err := s.c.GetFTInfo(did)
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	info, next, err := s.c.GetFTInfoPage(did, pr)
 	if err != nil {
 		return s.BasicResponse(req, false, err.Error(), nil)
 	}
@@ -107,7 +81,8 @@ func (s *Server) APIGetFTInfo(req *ensweb.Request) *ensweb.Result {
 			Status:  true,
 			Message: "Got FT info successfully",
 		},
-		FTInfo: info,
+		FTInfo:     info,
+		NextCursor: next,
 	}
 	if len(info) == 0 {
 		ac.Message = "No FTs found"
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+const (
+	IdempotencyKeyHeader string = "Idempotency-Key"
+	maxIdempotencyKeyLen int    = 255
+)
+
+// requestHash is the hash of the request without the password and the
+// idempotency key, so the retry is matched even if the key moves to the header
+func requestHash(input interface{}) (string, error) {
+	jb, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+	m := make(map[string]interface{})
+	err = json.Unmarshal(jb, &m)
+	if err != nil {
+		return "", err
+	}
+	for _, k := range []string{"password", "idempotency_key"} {
+		delete(m, k)
+	}
+	jb, err = json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(jb)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// idempotentRequest records the idempotency key of the request, the key is
+// taken from the header if it is not in the input. If the request is a retry,
+// the result of the earlier request is rendered and returned.
+func (s *Server) idempotentRequest(req *ensweb.Request, did string, key string, jobType string, input interface{}) *ensweb.Result {
+	if key == "" {
+		key = req.Headers.Get(IdempotencyKeyHeader)
+	}
+	if key == "" {
+		return nil
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return s.BasicResponse(req, false, "Idempotency key is too long", nil)
+	}
+	hash, err := requestHash(input)
+	if err != nil {
+		s.log.Error("Failed to get the request hash", "err", err)
+		return s.BasicResponse(req, false, "Invalid input", nil)
+	}
+	br, used := s.c.ClaimIdempotencyKey(req.ID, did, key, jobType, hash)
+	if !used {
+		return nil
+	}
+	return s.RenderJSON(req, br, http.StatusOK)
+}
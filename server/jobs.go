@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+func (s *Server) APIGetJobs(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	limit := 0
+	l := s.GetQuerry(req, "limit")
+	if l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			return s.BasicResponse(req, false, "invalid limit", nil)
+		}
+	}
+	jobs, err := s.c.GetJobs(cl, s.GetQuerry(req, "did"), s.GetQuerry(req, "type"), s.GetQuerry(req, "status"), limit)
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to get jobs, "+err.Error(), nil)
+	}
+	jr := model.JobListReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the jobs",
+		},
+		Jobs: jobs,
+	}
+	return s.RenderJSON(req, &jr, http.StatusOK)
+}
+
+func (s *Server) APIGetJob(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	job, err := s.c.GetJob(cl, s.GetRouteVar(req, "id"))
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	jr := model.JobReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the job",
+		},
+		Job: job,
+	}
+	return s.RenderJSON(req, &jr, http.StatusOK)
+}
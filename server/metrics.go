@@ -0,0 +1,50 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+	"github.com/rubixchain/rubixgoplatform/wrapper/metrics"
+)
+
+// APIMetrics writes the node metrics in the Prometheus text format, the
+// metrics have the series of all the DIDs so the callers scoped to the DIDs
+// are denied
+func (s *Server) APIMetrics(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	if cl.Scoped() {
+		return s.RenderJSON(req, &model.BasicResponse{Status: false, Message: "access denied for the DID scoped caller"}, http.StatusForbidden)
+	}
+	w := req.GetHTTPWritter()
+	w.Header().Set("Content-Type", metrics.ContentType)
+	w.WriteHeader(http.StatusOK)
+	err := s.c.Metrics().Write(w)
+	if err != nil {
+		s.log.Error("Failed to write the metrics", "err", err)
+	}
+	return &ensweb.Result{Status: http.StatusOK, Done: true}
+}
+
+// observeLatency records the latency of the route by the path template, so
+// that the path variables do not add the series
+func (s *Server) observeLatency(method string, path string, hf ensweb.HandlerFunc) ensweb.HandlerFunc {
+	if s.apiLatency == nil {
+		return hf
+	}
+	return func(req *ensweb.Request) *ensweb.Result {
+		st := time.Now()
+		res := hf(req)
+		code := 0
+		if res != nil {
+			code = res.Status
+		}
+		s.apiLatency.Observe(time.Since(st).Seconds(), method, path, strconv.Itoa(code))
+		return res
+	}
+}
@@ -24,7 +24,9 @@ func (s *Server) APIMigrateNode(req *ensweb.Request) *ensweb.Result {
 		token := req.ClientToken.Model.(*setup.BearerToken)
 		didDir = token.DID
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.MigrateNode(req.ID, &m, didDir)
 	return s.didResponse(req, req.ID)
 }
@@ -14,17 +14,6 @@ import (
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-// NFT godoc
-// @Summary      Create NFT
-// @Description  This API will create new NFT
-// @Tags         NFT
-// @Accept       mpfd
-// @Produce      mpfd
-// @Param        did        	   formData      string  true   "DID"
-// @Param        metadata       formData      file  true  "JSON file which contains information about the NFT"
-// @Param        artifact       formData      file    true  "File which is meant to be an NFT"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/create-nft [post]
 func (s *Server) APICreateNFT(req *ensweb.Request) *ensweb.Result {
 	var createNFT core.NFTReq
 	var err error
@@ -97,30 +86,14 @@ func (s *Server) APICreateNFT(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, createNFT.DID) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.CreateNFTRequest(req.ID, createNFT)
 	return s.didResponse(req, req.ID)
 
 }
 
-type DeployNFTSwaggoInput struct {
-	NFT        string  `json:"nft"`
-	DID        string  `json:"did"`
-	QuorumType int     `json:"quorum_type"`
-	NFTValue   float64 `json:"nft_value"`
-	NFTData    string  `json:"nft_data"`
-}
-
-// NFT godoc
-// @Summary      Deploy NFT
-// @Description  This API will deploy the NFT
-// @Tags         NFT
-// @ID 			 deploy-nft
-// @Accept       json
-// @Produce      json
-// @Param		 input body DeployNFTSwaggoInput true "Deploy nft"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/deploy-nft [post]
 func (s *Server) APIDeployNFT(req *ensweb.Request) *ensweb.Result {
 	var deployReq model.DeployNFTRequest
 	err := s.ParseJSON(req, &deployReq)
@@ -146,81 +119,66 @@ func (s *Server) APIDeployNFT(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid quorum type")
 		return s.BasicResponse(req, false, "Invalid quorum type", nil)
 	}
+	if deployReq.RoyaltyPercent < 0 || deployReq.RoyaltyPercent > 100 {
+		return s.BasicResponse(req, false, "Invalid royalty percent", nil)
+	}
+	if deployReq.RoyaltyRecipient != "" {
+		_, rd, ok := util.ParseAddress(deployReq.RoyaltyRecipient)
+		if !ok || !strings.HasPrefix(rd, "bafybmi") || len(rd) != 59 || !regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(rd) {
+			return s.BasicResponse(req, false, "Invalid royalty recipient", nil)
+		}
+	}
 
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.DeployNFT(req.ID, deployReq)
 	return s.didResponse(req, req.ID)
 }
 
-// ShowAccount godoc
-// @Summary      Get ALL NFTs
-// @Description  This API will get all NFTs deployed on the node
-// @Tags         NFT
-// @Accept       json
-// @Produce      json
-// @Success      200  {object}  model.NFTList
-// @Router       /api/list-nfts [get]
 func (s *Server) APIGetAllNFT(req *ensweb.Request) *ensweb.Result {
-	resp := s.c.GetAllNFT()
+	f, pr, err := s.nftPage(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	resp := s.c.GetAllNFT(f, pr)
 	return s.RenderJSON(req, resp, http.StatusOK)
 }
 
-type GetNFTSwaggoInput struct {
-	Did string `json:"did"`
+func (s *Server) nftPage(req *ensweb.Request) (*model.TokenFilter, *model.PageRequest, error) {
+	f, err := s.tokenFilter(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, pr, nil
 }
 
-// ShowAccount godoc
-// @Summary      Get NFTs owned by the particular did
-// @Description  This API will get all NFTs owned by the particular did
-// @Tags         NFT
-// @Accept       json
-// @Produce      json
-// @Param        input query GetNFTSwaggoInput true "Get nfts by did"
-// @Success      200  {object}  model.NFTList
-// @Router       /api/get-nfts-by-did [get]
 func (s *Server) APIGetNFTsByDid(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "did")
-	resp := s.c.GetNFTsByDid(did)
+	f, pr, err := s.nftPage(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	if !s.validateDIDAccess(req, did) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	resp := s.c.GetNFTsByDid(did, f, pr)
 	return s.RenderJSON(req, resp, http.StatusOK)
 }
 
-// ShowAccount godoc
-// @Summary      Add NFTs
-// @Description  This API will put NFTs for sale
-// @Tags         NFT
-// @Accept       json
-// @Produce      json
-// @Param        data      	   body      core.NFTSaleReq  true  "NFT Detials"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/addnftsale [post]
 // func (s *Server) APIAddNFTSale(req *ensweb.Request) *ensweb.Result {
 // 	did := s.GetQuerry(req, "did")
 // 	resp := s.c.GetAllNFT(did)
 // 	return s.RenderJSON(req, resp, http.StatusOK)
 // }
 
-type ExecuteNFTSwaggoInput struct {
-	NFT        string  `json:"nft"`
-	Owner      string  `json:"owner"`
-	Receiver   string  `json:"receiver"`
-	QuorumType int     `json:"quorum_type"`
-	Comment    string  `json:"comment"`
-	NFTValue   float64 `json:"nft_value"`
-	NFTData    string  `json:"nft_data"`
-}
-
-// NFT godoc
-// @Summary      Execution of NFT
-// @Description  This API will add a new block which indicates either transfer of ownership of NFT or internal state change through self-execution
-// @Tags         NFT
-// @Accept       json
-// @Produce      json
-// @Param		 input body ExecuteNFTSwaggoInput true "Transfer the ownership of particular NFT or self-execution with some data if 'receiver' is empty "
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/execute-nft [post]
 func (s *Server) APIExecuteNFT(req *ensweb.Request) *ensweb.Result {
 	var executeReq model.ExecuteNFTRequest
 	err := s.ParseJSON(req, &executeReq)
@@ -249,24 +207,18 @@ func (s *Server) APIExecuteNFT(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, did, executeReq.IdempotencyKey, core.JobExecuteNFT, &executeReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 	go s.c.ExecuteNFT(req.ID, &executeReq)
 	return s.didResponse(req, req.ID)
 }
 
-type NewNFTSwaggoInput struct {
-	NFT string `json:"nft"`
-}
-
-// NFT godoc
-// @Summary      Subscribe to NFT
-// @Description  This API endpoint allows subscribing to a NFT.
-// @Tags         NFT
-// @Accept       json
-// @Produce      json
-// @Param        input body NewNFTSwaggoInput true "Subscribe to input nft"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/subscribe-nft [post]
 func (s *Server) APISubscribeNFT(request *ensweb.Request) *ensweb.Result {
 	var newSubscription model.NewNFTSubscription
 	err := s.ParseJSON(request, &newSubscription)
@@ -279,21 +231,13 @@ func (s *Server) APISubscribeNFT(request *ensweb.Request) *ensweb.Result {
 		return s.BasicResponse(request, false, "Invalid NFT", nil)
 	}
 	topic := newSubscription.NFT
-	s.c.AddWebReq(request)
+	if err := s.c.AddWebReq(request); err != nil {
+		return s.BasicResponse(request, false, err.Error(), nil)
+	}
 	go s.c.SubscribeNFTSetup(request.ID, topic)
 	return s.BasicResponse(request, true, "NFT subscribed successfully", nil)
 }
 
-// NFT godoc
-// @Summary      Fetch NFT
-// @Description  This API will Fetch NFT
-// @Tags         NFT
-// @ID   	     fetch-nft
-// @Accept       json
-// @Produce      json
-// @Param        input query NewNFTSwaggoInput true "Fetch nft"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/fetch-nft [get]
 func (s *Server) APIFetchNft(req *ensweb.Request) *ensweb.Result {
 	var fetchNft core.FetchNFTRequest
 	var err error
@@ -325,7 +269,9 @@ func (s *Server) APIFetchNft(req *ensweb.Request) *ensweb.Result {
 		}
 	}
 
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go func() {
 		basicResponse := s.c.FetchNFT(req.ID, &fetchNft)
 		fmt.Printf("Basic Response server:  %+v\n", basicResponse.Message)
@@ -0,0 +1,386 @@
+package server
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/did"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
+)
+
+const (
+	openAPIVersion string = "3.0.3"
+	openAPIRefPath string = "#/components/schemas/"
+)
+
+// OpenAPI is the OpenAPI 3 document of the node API
+type OpenAPI struct {
+	OpenAPI    string                 `json:"openapi"`
+	Info       OpenAPIInfo            `json:"info"`
+	Paths      map[string]OpenAPIPath `json:"paths"`
+	Components OpenAPIComponents      `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIPath is the operations of the path by the lower case method
+type OpenAPIPath map[string]*OpenAPIOperation
+
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+}
+
+type OpenAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *OpenAPISchema `json:"schema"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPISchema is the schema of the type, GoType is the Go type the schema
+// is derived from and it is used by the client generator
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	OneOf                []*OpenAPISchema          `json:"oneOf,omitempty"`
+	GoType               string                    `json:"x-go-type,omitempty"`
+}
+
+type apiRoute struct {
+	Method string
+	Path   string
+}
+
+// AddRoute adds the route to the server and records it for the API spec
+func (s *Server) AddRoute(path string, method string, hf ensweb.HandlerFunc) {
+	s.routes = append(s.routes, apiRoute{Method: method, Path: path})
+	s.Server.AddRoute(path, method, s.observeLatency(method, path, hf))
+}
+
+// BuildOpenAPI builds the API spec from the routes without running the node
+func BuildOpenAPI() (*OpenAPI, error) {
+	log := logger.New(&logger.LoggerOptions{
+		Color:  []logger.ColorOption{logger.ColorOff},
+		Output: []io.Writer{io.Discard},
+	})
+	s := &Server{cfg: &Config{}, log: log}
+	var err error
+	s.Server, err = ensweb.NewServer(&s.cfg.Config, nil, log)
+	if err != nil {
+		return nil, err
+	}
+	s.RegisterRoutes()
+	return s.OpenAPI()
+}
+
+// OpenAPI returns the API spec of the registered routes, every API route
+// should be documented in the apiDocs
+func (s *Server) OpenAPI() (*OpenAPI, error) {
+	routes := make([]apiRoute, len(s.routes))
+	copy(routes, s.routes)
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	doc := &OpenAPI{
+		OpenAPI: openAPIVersion,
+		Info: OpenAPIInfo{
+			Title:       "Rubix Core",
+			Description: "Rubix core API to control & manage the node.",
+			Version:     "0.9",
+		},
+		Paths: make(map[string]OpenAPIPath),
+	}
+	b := newSchemaBuilder()
+	for _, r := range routes {
+		if !strings.HasPrefix(r.Path, "/api/") {
+			continue
+		}
+		d, ok := apiDocs[r.Method+" "+r.Path]
+		if !ok {
+			return nil, fmt.Errorf("API doc is missing for the route %s %s", r.Method, r.Path)
+		}
+		if doc.Paths[r.Path] == nil {
+			doc.Paths[r.Path] = make(OpenAPIPath)
+		}
+		doc.Paths[r.Path][strings.ToLower(r.Method)] = b.operation(r, &d)
+	}
+	doc.Components.Schemas = b.schemas
+	return doc, nil
+}
+
+// APIOpenAPI returns the OpenAPI spec of the node API
+func (s *Server) APIOpenAPI(req *ensweb.Request) *ensweb.Result {
+	s.specOnce.Do(func() {
+		var doc *OpenAPI
+		var b []byte
+		doc, s.specErr = s.OpenAPI()
+		if s.specErr == nil {
+			b, s.specErr = json.Marshal(doc)
+		}
+		if s.specErr == nil {
+			s.specErr = json.Unmarshal(b, &s.spec)
+		}
+	})
+	if s.specErr != nil {
+		s.log.Error("Failed to build the API spec", "err", s.specErr)
+		return s.BasicResponse(req, false, "Failed to build the API spec", nil)
+	}
+	return s.RenderJSON(req, s.spec, http.StatusOK)
+}
+
+// pathVars returns the variables of the route path template
+func pathVars(p string) []string {
+	vars := make([]string, 0)
+	for _, seg := range strings.Split(p, "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			vars = append(vars, strings.TrimSuffix(strings.TrimPrefix(seg, "{"), "}"))
+		}
+	}
+	return vars
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaBuilder derives the schemas from the Go types as encoded by the
+// encoding/json, the named structs are added to the components
+type schemaBuilder struct {
+	schemas map[string]*OpenAPISchema
+	names   map[reflect.Type]string
+	types   map[string]reflect.Type
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		schemas: make(map[string]*OpenAPISchema),
+		names:   make(map[reflect.Type]string),
+		types:   make(map[string]reflect.Type),
+	}
+}
+
+func (b *schemaBuilder) operation(r apiRoute, d *apiDoc) *OpenAPIOperation {
+	op := &OpenAPIOperation{
+		OperationID: d.ID,
+		Summary:     d.Summary,
+		Description: d.Desc,
+		Tags:        []string{d.Tag},
+		Responses:   make(map[string]OpenAPIResponse),
+	}
+	for _, v := range pathVars(r.Path) {
+		op.Parameters = append(op.Parameters, OpenAPIParameter{Name: v, In: "path", Required: true, Schema: &OpenAPISchema{Type: "string"}})
+	}
+	for _, p := range d.Query {
+		op.Parameters = append(op.Parameters, OpenAPIParameter{Name: p.Name, In: "query", Description: p.Desc, Required: p.Required, Schema: paramSchema(p.Type)})
+	}
+	if d.Form != nil {
+		fs := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+		for _, p := range d.Form {
+			ps := paramSchema(p.Type)
+			ps.Description = p.Desc
+			fs.Properties[p.Name] = ps
+			if p.Required {
+				fs.Required = append(fs.Required, p.Name)
+			}
+		}
+		op.RequestBody = &OpenAPIRequestBody{Required: true, Content: map[string]OpenAPIMediaType{"multipart/form-data": {Schema: fs}}}
+	} else if d.Body != nil {
+		op.RequestBody = &OpenAPIRequestBody{Required: true, Content: map[string]OpenAPIMediaType{"application/json": {Schema: b.schema(reflect.TypeOf(d.Body))}}}
+	}
+	resp := d.Resp
+	if resp == nil {
+		resp = model.BasicResponse{}
+	}
+	rs := b.schema(reflect.TypeOf(resp))
+	desc := "Response of the request"
+	if d.Sign {
+		// signature request is replied till the DID signs the request
+		rs = &OpenAPISchema{OneOf: []*OpenAPISchema{rs, b.schema(reflect.TypeOf(did.SignResponse{}))}}
+		desc = "Response of the request or the signature request for the DID"
+	}
+	ct := "application/json"
+	if d.Stream {
+		ct = "text/event-stream"
+		desc = "Stream of the events"
+	}
+	op.Responses["200"] = OpenAPIResponse{Description: desc, Content: map[string]OpenAPIMediaType{ct: {Schema: rs}}}
+	return op
+}
+
+func paramSchema(t string) *OpenAPISchema {
+	if t == "file" {
+		return &OpenAPISchema{Type: "string", Format: "binary"}
+	}
+	return &OpenAPISchema{Type: t}
+}
+
+func goTypeName(t reflect.Type) string {
+	return t.PkgPath() + "." + t.Name()
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct && t.Name() != "":
+		return &OpenAPISchema{Ref: openAPIRefPath + b.component(t)}
+	}
+	var sc *OpenAPISchema
+	pt := reflect.PtrTo(t)
+	switch {
+	case pt.Implements(jsonMarshalerType):
+		sc = &OpenAPISchema{}
+	case pt.Implements(textMarshalerType):
+		sc = &OpenAPISchema{Type: "string"}
+	default:
+		sc = b.kindSchema(t)
+	}
+	if t.Name() != "" && t.PkgPath() != "" {
+		sc.GoType = goTypeName(t)
+	}
+	return sc
+}
+
+func (b *schemaBuilder) kindSchema(t reflect.Type) *OpenAPISchema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: b.schema(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: b.schema(t.Elem())}
+	case reflect.Struct:
+		sc := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+		b.fields(t, sc, false)
+		return sc
+	default:
+		// interfaces can hold any value
+		return &OpenAPISchema{}
+	}
+}
+
+// component adds the named struct to the components and returns its name,
+// the structs with the same name in the other packages are prefixed with
+// the package name
+func (b *schemaBuilder) component(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if ot, ok := b.types[name]; ok && ot != t {
+		pkg := path.Base(t.PkgPath())
+		name = strings.ToUpper(pkg[:1]) + pkg[1:] + name
+	}
+	b.names[t] = name
+	b.types[name] = t
+	sc := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema), GoType: goTypeName(t)}
+	b.schemas[name] = sc
+	b.fields(t, sc, false)
+	return name
+}
+
+// fields adds the fields of the struct to the schema, fields of the
+// embedded structs are promoted unless the outer struct has the field
+func (b *schemaBuilder) fields(t reflect.Type, sc *OpenAPISchema, embedded bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		if f.Anonymous && name == "" {
+			et := f.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct {
+				b.fields(et, sc, true)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if _, ok := sc.Properties[name]; ok && embedded {
+			continue
+		}
+		fs := b.schema(f.Type)
+		for _, o := range opts[1:] {
+			if o == "string" {
+				fs = &OpenAPISchema{Type: "string"}
+			}
+		}
+		sc.Properties[name] = fs
+	}
+}
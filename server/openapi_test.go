@@ -0,0 +1,381 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/did"
+)
+
+func TestOpenAPIRoutes(t *testing.T) {
+	doc, err := BuildOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make(map[string]string)
+	for p, pi := range doc.Paths {
+		for m, op := range pi {
+			route := strings.ToUpper(m) + " " + p
+			if op.OperationID == "" {
+				t.Errorf("%s: operation id is missing", route)
+			}
+			if r, ok := ids[op.OperationID]; ok {
+				t.Errorf("%s: operation id %s is used by %s", route, op.OperationID, r)
+			}
+			ids[op.OperationID] = route
+			if _, ok := op.Responses["200"]; !ok {
+				t.Errorf("%s: response is missing", route)
+			}
+		}
+	}
+	for k := range apiDocs {
+		if ids[apiDocs[k].ID] != k {
+			t.Errorf("%s: documented route is not registered", k)
+		}
+	}
+	// every reference should be in the components
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var refs func(v interface{})
+	refs = func(v interface{}) {
+		switch vt := v.(type) {
+		case map[string]interface{}:
+			if r, ok := vt["$ref"].(string); ok {
+				if _, ok := doc.Components.Schemas[strings.TrimPrefix(r, openAPIRefPath)]; !ok {
+					t.Errorf("schema %s is not found", r)
+				}
+			}
+			for _, e := range vt {
+				refs(e)
+			}
+		case []interface{}:
+			for _, e := range vt {
+				refs(e)
+			}
+		}
+	}
+	var m interface{}
+	json.Unmarshal(b, &m)
+	refs(m)
+}
+
+func TestOpenAPIClient(t *testing.T) {
+	doc, err := BuildOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := GenerateClient(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb, err := os.ReadFile(filepath.Join("..", "client", "api_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, cb) {
+		t.Fatal("client/api_gen.go is not generated from the current spec, run go generate ./client")
+	}
+}
+
+// TestOpenAPIHandlers checks the query params, the request body and the
+// responses of the handlers with the spec from the source of the handlers
+func TestOpenAPIHandlers(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command is not found")
+	}
+	hs, err := analyzeHandlers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	basic := reflectKey(reflect.TypeOf(model.BasicResponse{}))
+	sign := reflectKey(reflect.TypeOf(did.SignResponse{}))
+	for route, h := range hs {
+		if !strings.HasPrefix(strings.SplitN(route, " ", 2)[1], "/api/") {
+			continue
+		}
+		d, ok := apiDocs[route]
+		if !ok {
+			t.Errorf("%s: route is not documented", route)
+			continue
+		}
+		query := make(map[string]bool)
+		for _, p := range d.Query {
+			query[p.Name] = true
+		}
+		if !reflect.DeepEqual(keys(query), keys(h.query)) {
+			t.Errorf("%s: handler %s reads the query %v, spec has %v", route, h.name, keys(h.query), keys(query))
+		}
+		vars := make(map[string]bool)
+		for _, v := range pathVars(strings.SplitN(route, " ", 2)[1]) {
+			vars[v] = true
+		}
+		if !reflect.DeepEqual(keys(vars), keys(h.pathVars)) {
+			t.Errorf("%s: handler %s reads the path vars %v", route, h.name, keys(h.pathVars))
+		}
+		switch {
+		case d.Form != nil:
+			if !h.multipart || len(h.body) != 0 {
+				t.Errorf("%s: handler %s does not parse the multipart form", route, h.name)
+			}
+		case d.Body != nil:
+			if len(h.body) != 1 || h.body[0] != reflectKey(reflect.TypeOf(d.Body)) {
+				t.Errorf("%s: handler %s parses the body %v, spec has %s", route, h.name, h.body, reflectKey(reflect.TypeOf(d.Body)))
+			}
+		default:
+			if h.multipart || len(h.body) != 0 {
+				t.Errorf("%s: handler %s parses the body, spec has none", route, h.name)
+			}
+		}
+		resp := basic
+		if d.Resp != nil {
+			resp = reflectKey(reflect.TypeOf(d.Resp))
+		}
+		if !d.Stream && !h.rendered[resp] {
+			t.Errorf("%s: handler %s does not render %s", route, h.name, resp)
+		}
+		if d.Sign != h.rendered[sign] {
+			t.Errorf("%s: handler %s signature request does not match the spec", route, h.name)
+		}
+		for r := range h.rendered {
+			if r != resp && r != basic && r != sign {
+				t.Errorf("%s: handler %s renders %s, spec has %s", route, h.name, r, resp)
+			}
+		}
+	}
+}
+
+type handlerInfo struct {
+	name      string
+	query     map[string]bool
+	pathVars  map[string]bool
+	body      []string
+	multipart bool
+	rendered  map[string]bool
+}
+
+func keys(m map[string]bool) []string {
+	r := make([]string, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+	return r
+}
+
+// reflectKey and typeKey return the same key for the reflect and the
+// go/types type, named types are qualified by the package path
+func reflectKey(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() != "" && t.PkgPath() != "" {
+		return t.PkgPath() + "." + t.Name()
+	}
+	return strings.ReplaceAll(t.String(), " ", "")
+}
+
+func typeKey(t types.Type) string {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	if n, ok := t.(*types.Named); ok && n.Obj().Pkg() != nil {
+		return n.Obj().Pkg().Path() + "." + n.Obj().Name()
+	}
+	s := types.TypeString(t, func(p *types.Package) string { return p.Name() })
+	return strings.ReplaceAll(strings.ReplaceAll(s, " ", ""), "any", "interface{}")
+}
+
+type listPackage struct {
+	ImportPath string
+	Dir        string
+	Export     string
+	GoFiles    []string
+}
+
+// loadServer type checks the server package with the export data of the
+// dependencies from the go command
+func loadServer() (*types.Package, *types.Info, []*ast.File, error) {
+	out, err := exec.Command("go", "list", "-export", "-deps", "-json", ".").Output()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	exports := make(map[string]string)
+	var lp listPackage
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var p listPackage
+		err := dec.Decode(&p)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		exports[p.ImportPath] = p.Export
+		lp = p
+	}
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0)
+	for _, f := range lp.GoFiles {
+		af, err := parser.ParseFile(fset, filepath.Join(lp.Dir, f), nil, 0)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		files = append(files, af)
+	}
+	imp := importer.ForCompiler(fset, "gc", func(p string) (io.ReadCloser, error) {
+		return os.Open(exports[p])
+	})
+	info := &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Defs:  make(map[*ast.Ident]types.Object),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	pkg, err := (&types.Config{Importer: imp}).Check(lp.ImportPath, fset, files, info)
+	return pkg, info, files, err
+}
+
+// analyzeHandlers finds the handlers of the routes and what they read and
+// render, the functions of the package called by the handler are included
+func analyzeHandlers() (map[string]*handlerInfo, error) {
+	pkg, info, files, err := loadServer()
+	if err != nil {
+		return nil, err
+	}
+	funcs := make(map[types.Object]*ast.FuncDecl)
+	for _, f := range files {
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok && fd.Body != nil {
+				funcs[info.Defs[fd.Name]] = fd
+			}
+		}
+	}
+	str := func(e ast.Expr) (string, bool) {
+		tv := info.Types[e]
+		if tv.Value != nil && tv.Value.Kind() == constant.String {
+			return constant.StringVal(tv.Value), true
+		}
+		return "", false
+	}
+	callee := func(ce *ast.CallExpr) types.Object {
+		switch fn := ce.Fun.(type) {
+		case *ast.SelectorExpr:
+			return info.Uses[fn.Sel]
+		case *ast.Ident:
+			return info.Uses[fn]
+		}
+		return nil
+	}
+	// helpers reading the query key from the param, like floatQuerry
+	queryArgs := make(map[types.Object]int)
+	for obj, fd := range funcs {
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			ce, ok := n.(*ast.CallExpr)
+			if !ok || len(ce.Args) < 2 || callee(ce) == nil || callee(ce).Name() != "GetQuerry" {
+				return true
+			}
+			id, ok := ce.Args[1].(*ast.Ident)
+			if !ok {
+				return true
+			}
+			i := 0
+			for _, f := range fd.Type.Params.List {
+				for _, pn := range f.Names {
+					if info.Defs[pn] == info.Uses[id] {
+						queryArgs[obj] = i
+					}
+					i++
+				}
+			}
+			return true
+		})
+	}
+	var visit func(obj types.Object, h *handlerInfo, seen map[types.Object]bool)
+	visit = func(obj types.Object, h *handlerInfo, seen map[types.Object]bool) {
+		fd, ok := funcs[obj]
+		if !ok || seen[obj] {
+			return
+		}
+		seen[obj] = true
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			ce, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn := callee(ce)
+			if fn == nil {
+				return true
+			}
+			switch fn.Name() {
+			case "GetQuerry":
+				if k, ok := str(ce.Args[1]); ok {
+					h.query[k] = true
+				}
+			case "GetRouteVar":
+				if k, ok := str(ce.Args[1]); ok {
+					h.pathVars[k] = true
+				}
+			case "ParseJSON":
+				h.body = append(h.body, typeKey(info.TypeOf(ce.Args[1])))
+			case "ParseMultiPartForm", "ParseMultiPartFormFile":
+				h.multipart = true
+			case "RenderJSON":
+				h.rendered[typeKey(info.TypeOf(ce.Args[1]))] = true
+			}
+			if i, ok := queryArgs[fn]; ok && i < len(ce.Args) {
+				if k, ok := str(ce.Args[i]); ok {
+					h.query[k] = true
+				}
+			}
+			if fn.Pkg() == pkg {
+				visit(fn, h, seen)
+			}
+			return true
+		})
+	}
+	hs := make(map[string]*handlerInfo)
+	var rr *ast.FuncDecl
+	for _, fd := range funcs {
+		if fd.Name.Name == "RegisterRoutes" {
+			rr = fd
+		}
+	}
+	ast.Inspect(rr.Body, func(n ast.Node) bool {
+		ce, ok := n.(*ast.CallExpr)
+		if !ok || callee(ce) == nil || callee(ce).Name() != "AddRoute" {
+			return true
+		}
+		p, _ := str(ce.Args[0])
+		m, _ := str(ce.Args[1])
+		hf := ce.Args[2]
+		if ah, ok := hf.(*ast.CallExpr); ok {
+			// handler wrapped by the AuthHandle
+			hf = ah.Args[0]
+		}
+		sel := hf.(*ast.SelectorExpr)
+		h := &handlerInfo{
+			name:     sel.Sel.Name,
+			query:    make(map[string]bool),
+			pathVars: make(map[string]bool),
+			rendered: make(map[string]bool),
+		}
+		visit(info.Uses[sel.Sel], h, make(map[types.Object]bool))
+		hs[m+" "+p] = h
+		return false
+	})
+	return hs, nil
+}
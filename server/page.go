@@ -0,0 +1,65 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+// pageRequest returns the page of the list from the limit, cursor and sort query params
+func (s *Server) pageRequest(req *ensweb.Request) (*model.PageRequest, error) {
+	pr := &model.PageRequest{
+		Cursor: s.GetQuerry(req, "cursor"),
+		Sort:   s.GetQuerry(req, "sort"),
+	}
+	limit := s.GetQuerry(req, "limit")
+	if limit != "" {
+		var err error
+		pr.Limit, err = strconv.Atoi(limit)
+		if err != nil || pr.Limit < 0 {
+			return nil, fmt.Errorf("invalid limit")
+		}
+	}
+	return pr, nil
+}
+
+func (s *Server) floatQuerry(req *ensweb.Request, key string) (float64, error) {
+	v := s.GetQuerry(req, key)
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid %s", key)
+	}
+	return f, nil
+}
+
+// tokenFilter returns the token filter from the status, min_value and
+// max_value query params, status is the comma separated token states
+func (s *Server) tokenFilter(req *ensweb.Request) (*model.TokenFilter, error) {
+	f := &model.TokenFilter{}
+	status := s.GetQuerry(req, "status")
+	if status != "" {
+		for _, st := range strings.Split(status, ",") {
+			v, err := strconv.Atoi(strings.TrimSpace(st))
+			if err != nil {
+				return nil, fmt.Errorf("invalid status")
+			}
+			f.Status = append(f.Status, v)
+		}
+	}
+	var err error
+	f.MinValue, err = s.floatQuerry(req, "min_value")
+	if err != nil {
+		return nil, err
+	}
+	f.MaxValue, err = s.floatQuerry(req, "max_value")
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
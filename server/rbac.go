@@ -0,0 +1,192 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+// routePermissions overrides the permission derived from the route flags,
+// the key is the method and the path of the route
+var routePermissions = map[string]string{
+	"POST " + setup.APIRemoveTokenChainBlock:            core.PermAdmin,
+	"POST " + setup.APISelfTransfer:                     core.PermDIDWrite,
+	"GET " + setup.APIReleaseAllLockedTokens:            core.PermOperate,
+	"GET " + setup.APIAddNFTSale:                        core.PermDIDWrite,
+	"POST " + setup.APISignatureResponse:                core.PermSign,
+	"POST " + setup.APIDumpTokenChainBlock:              core.PermDIDRead,
+	"POST " + setup.APIDumpFTTokenChainBlock:            core.PermDIDRead,
+	"POST " + setup.APIDumpSmartContractTokenChainBlock: core.PermDIDRead,
+	"POST " + setup.APIGetSmartContractTokenData:        core.PermDIDRead,
+	"POST " + setup.APICheckDataToken:                   core.PermDIDRead,
+	"POST " + setup.APIWebhooks:                         core.PermDIDWrite,
+	"DELETE " + setup.APIRemoveWebhook:                  core.PermDIDWrite,
+	"POST " + setup.APIReplayWebhookDelivery:            core.PermDIDWrite,
+	"GET " + setup.APIMetrics:                           core.PermOperate,
+}
+
+// ownedRoutes act on the objects of the id in the path, the core checks the
+// object belongs to the caller DIDs so the request need not have the DID
+var ownedRoutes = map[string]bool{
+	"DELETE " + setup.APIRemoveWebhook:       true,
+	"POST " + setup.APIReplayWebhookDelivery: true,
+}
+
+// didFields are the request fields with the DID acting in the request, the
+// receiver is not checked. This is only a pre-filter, the handlers check the
+// DIDs they act on with validateDIDAccess
+var didFields = map[string]bool{
+	"did":          true,
+	"sender":       true,
+	"deployeraddr": true,
+	"executoraddr": true,
+	"executordid":  true,
+	"creatordid":   true,
+	"creator_did":  true,
+	"ownerdid":     true,
+	"owner_did":    true,
+	"owner":        true,
+	"committerdid": true,
+	"comiter_did":  true,
+}
+
+// routePermission returns the permission required for the route, root
+// routes need admin, DID routes need the DID permissions and rest of the
+// routes are reads for GET and node operations otherwise
+func routePermission(method string, path string, did bool, root bool) string {
+	if p, ok := routePermissions[method+" "+path]; ok {
+		return p
+	}
+	switch {
+	case root:
+		return core.PermAdmin
+	case did && method == http.MethodGet:
+		return core.PermDIDRead
+	case did:
+		return core.PermDIDWrite
+	case method == http.MethodGet:
+		return core.PermRead
+	default:
+		return core.PermOperate
+	}
+}
+
+func didOfAddress(addr string) string {
+	// address can be peer id and DID joined with "."
+	if i := strings.LastIndex(addr, "."); i >= 0 {
+		return addr[i+1:]
+	}
+	return addr
+}
+
+// requestDIDs returns the DIDs acting in the request from the query, the
+// multipart form and the JSON body
+func (s *Server) requestDIDs(req *ensweb.Request) []string {
+	dids := make([]string, 0)
+	add := func(v string) {
+		v = didOfAddress(strings.TrimSpace(v))
+		if v != "" {
+			dids = append(dids, v)
+		}
+	}
+	for _, k := range []string{"did", "DID"} {
+		if v := s.GetQuerry(req, k); v != "" {
+			add(v)
+		}
+	}
+	if req.Method == http.MethodGet {
+		return dids
+	}
+	if fv := s.PeekMultiPartForm(req); fv != nil {
+		for k, vs := range fv {
+			if didFields[strings.ToLower(k)] {
+				for _, v := range vs {
+					add(v)
+				}
+			}
+		}
+		return dids
+	}
+	var m map[string]interface{}
+	if s.PeekJSON(req, &m) != nil {
+		return dids
+	}
+	for k, v := range m {
+		if str, ok := v.(string); ok && didFields[strings.ToLower(k)] {
+			add(str)
+		}
+	}
+	return dids
+}
+
+// getCaller authenticates the caller with the API key or the DID access token
+func (s *Server) getCaller(req *ensweb.Request) (*core.Caller, bool) {
+	key := s.GetReqHeader(req, ensweb.APIKeyHeader)
+	if key != "" {
+		if key == s.cfg.APIKey {
+			req.ClientToken.APIKeyVerified = true
+			return &core.Caller{Role: model.RoleAdmin}, true
+		}
+		cl, ok := s.c.GetAPIKeyCaller(key)
+		if ok {
+			req.ClientToken.APIKeyVerified = true
+		}
+		return cl, ok
+	}
+	cl, bt, ok := s.c.GetTokenCaller(req.ClientToken.Token)
+	if !ok {
+		return nil, false
+	}
+	req.ClientToken.Model = bt
+	req.ClientToken.Verified = true
+	return cl, true
+}
+
+// RBACHandle checks the role and the DIDs of the caller before the handler
+func (s *Server) RBACHandle(hf ensweb.HandlerFunc, did bool, ef ensweb.HandlerFunc, root bool) ensweb.HandlerFunc {
+	return ensweb.HandlerFunc(func(req *ensweb.Request) *ensweb.Result {
+		cl, ok := s.getCaller(req)
+		if !ok {
+			if ef != nil {
+				return ef(req)
+			}
+			return s.RenderJSON(req, &model.BasicResponse{Status: false, Message: "unauthorized access"}, http.StatusUnauthorized)
+		}
+		route := s.GetRouteTemplate(req)
+		perm := routePermission(req.Method, route, did, root)
+		if !cl.HasPermission(perm) {
+			s.log.Debug("Access denied", "role", cl.Role, "perm", perm, "path", req.Path)
+			return s.RenderJSON(req, &model.BasicResponse{Status: false, Message: "access denied for the role " + cl.Role}, http.StatusForbidden)
+		}
+		if cl.Scoped() && (perm == core.PermDIDRead || perm == core.PermDIDWrite || perm == core.PermRead) {
+			dids := s.requestDIDs(req)
+			if perm == core.PermDIDWrite && len(dids) == 0 && !ownedRoutes[req.Method+" "+route] {
+				return s.RenderJSON(req, &model.BasicResponse{Status: false, Message: "DID is required for the scoped access"}, http.StatusForbidden)
+			}
+			for _, d := range dids {
+				if !cl.HasDID(d) {
+					return s.RenderJSON(req, &model.BasicResponse{Status: false, Message: "access denied for the DID " + d}, http.StatusForbidden)
+				}
+			}
+		}
+		req.Model = cl
+		return hf(req)
+	})
+}
+
+// requestCaller returns the caller of the request, the node owner acts as
+// admin when the authentication is disabled
+func (s *Server) requestCaller(req *ensweb.Request) *core.Caller {
+	if !s.cfg.EnableAuth {
+		return &core.Caller{Role: model.RoleAdmin}
+	}
+	cl, ok := req.Model.(*core.Caller)
+	if !ok {
+		return nil
+	}
+	return cl
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rubixchain/rubixgoplatform/core"
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/setup"
+)
+
+func TestRoutePermission(t *testing.T) {
+	cases := []struct {
+		method string
+		path   string
+		did    bool
+		root   bool
+		perm   string
+	}{
+		{http.MethodGet, setup.APIGetAllTokens, true, false, core.PermDIDRead},
+		{http.MethodPost, setup.APIInitiateRBTTransfer, true, false, core.PermDIDWrite},
+		{http.MethodGet, setup.APIJobs, false, false, core.PermRead},
+		{http.MethodPost, setup.APIWebhooks, false, false, core.PermDIDWrite},
+		{http.MethodGet, setup.APIConsensusJournal, false, true, core.PermAdmin},
+		// metrics have the series of all the DIDs
+		{http.MethodGet, setup.APIMetrics, false, false, core.PermOperate},
+	}
+	for _, tc := range cases {
+		if p := routePermission(tc.method, tc.path, tc.did, tc.root); p != tc.perm {
+			t.Errorf("%s %s: expected %s permission, got %s", tc.method, tc.path, tc.perm, p)
+		}
+	}
+	for role, allowed := range map[string]bool{model.RoleAdmin: true, model.RoleOperator: true, model.RoleDIDOwner: false, model.RoleReadOnly: false} {
+		cl := &core.Caller{Role: role}
+		if cl.HasPermission(routePermission(http.MethodGet, setup.APIMetrics, false, false)) != allowed {
+			t.Errorf("metrics access of the role %s mismatch, expected %v", role, allowed)
+		}
+	}
+}
@@ -1,24 +1,11 @@
 package server
 
 import (
+	"github.com/rubixchain/rubixgoplatform/core"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 )
 
-type RBTSelfTransferRequestSwaggoInput struct {
-	Sender     string  `json:"sender"`
-	Type       int     `json:"type"`
-}
-
-// @Summary     Initiate Self Transfer
-// @Description This API will initiate self RBT transfer for a specific DID
-// @Tags        Account
-// @ID 			initiate-self-transfer
-// @Accept      json
-// @Produce     json
-// @Param 		input body RBTSelfTransferRequestSwaggoInput true "Intitate Self RBT transfer"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/initiate-self-transfer [post]
 func (s *Server) SelfTransferHandle(req *ensweb.Request) *ensweb.Result {
 	var selfTransferReq model.RBTTransferRequest
 	err := s.ParseJSON(req, &selfTransferReq)
@@ -35,7 +22,14 @@ func (s *Server) SelfTransferHandle(req *ensweb.Request) *ensweb.Result {
 	
 	// Make receiver to be same as sender for Self Transfer
 	selfTransferReq.Receiver = selfTransferReq.Sender
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, senderDID, selfTransferReq.IdempotencyKey, core.JobRBTTransfer, &selfTransferReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 
 	go s.c.InitiateRBTTransfer(req.ID, &selfTransferReq)
 	return s.didResponse(req, req.ID)
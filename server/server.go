@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gorilla/sessions"
@@ -14,6 +15,7 @@ import (
 	ccfg "github.com/rubixchain/rubixgoplatform/wrapper/config"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 	"github.com/rubixchain/rubixgoplatform/wrapper/logger"
+	"github.com/rubixchain/rubixgoplatform/wrapper/metrics"
 )
 
 // Server defines server handle
@@ -24,6 +26,13 @@ type Server struct {
 	c    *core.Core
 	sc   chan bool
 	grpc *grpcserver.ServerGRPC
+	// routes and the API spec built from them
+	routes   []apiRoute
+	specOnce sync.Once
+	spec     map[string]interface{}
+	specErr  error
+	// latency of the API requests
+	apiLatency *metrics.HistogramVec
 }
 
 // NewServer create new server instances
@@ -53,6 +62,7 @@ func NewServer(c *core.Core, cfg *Config, log logger.Logger, start bool, sc chan
 		if cfg.APIKey == "" {
 			cfg.APIKey = "TestAPIKey"
 		}
+		s.SetAPIKey(cfg.APIKey)
 		if cfg.AuthMethod == "" {
 			cfg.AuthMethod = BasicAuthMethod
 		}
@@ -98,6 +108,7 @@ func NewServer(c *core.Core, cfg *Config, log logger.Logger, start bool, sc chan
 		return nil, err
 	}
 	go s.grpc.Run()
+	s.apiLatency = c.Metrics().NewHistogramVec("rubix_api_request_duration_seconds", "Latency of the API requests", nil, "method", "path", "code")
 	s.RegisterRoutes()
 	return s, nil
 }
@@ -128,6 +139,7 @@ func (s *Server) RegisterRoutes() {
 	s.AddRoute(setup.APIGetAccountInfo, "GET", s.AuthHandle(s.APIGetAccountInfo, true, s.AuthError, false))
 	s.AddRoute(setup.APISignatureResponse, "POST", s.AuthHandle(s.APISignatureResponse, true, s.AuthError, false))
 	s.AddRoute(setup.APIDumpTokenChainBlock, "POST", s.AuthHandle(s.APIDumpTokenChainBlock, true, s.AuthError, false))
+	s.AddRoute(setup.APIGetTokenProof, "GET", s.AuthHandle(s.APIGetTokenProof, false, s.AuthError, false))
 	s.AddRoute(setup.APIRegisterDID, "POST", s.AuthHandle(s.APIRegisterDID, true, s.AuthError, false))
 	s.AddRoute(setup.APISetupDID, "POST", s.AuthHandle(s.APISetupDID, true, s.AuthError, false))
 	s.AddRoute(setup.APIMigrateNode, "POST", s.APIMigrateNode)
@@ -152,7 +164,12 @@ func (s *Server) RegisterRoutes() {
 	s.AddRoute(setup.APISubscribecontract, "POST", s.AuthHandle(s.APISubscribecontract, true, s.AuthError, false))
 	s.AddRoute(setup.APIDumpSmartContractTokenChainBlock, "POST", s.AuthHandle(s.APIDumpSmartContractTokenChainBlock, true, s.AuthError, false))
 	s.AddRoute(setup.APIExecuteSmartContract, "POST", s.AuthHandle(s.APIExecuteSmartContract, true, s.AuthError, false))
+	s.AddRoute(setup.APIUpgradeSmartContract, "POST", s.AuthHandle(s.APIUpgradeSmartContract, true, s.AuthError, false))
+	s.AddRoute(setup.APIGetSmartContractVersions, "GET", s.AuthHandle(s.APIGetSmartContractVersions, true, s.AuthError, false))
 	s.AddRoute(setup.APIGetSmartContractTokenData, "POST", s.AuthHandle(s.APIGetSmartContractTokenChainData, true, s.AuthError, false))
+	s.AddRoute(setup.APIQuerySmartContractState, "GET", s.AuthHandle(s.APIQuerySmartContractState, true, s.AuthError, false))
+	s.AddRoute(setup.APIGetSmartContractEvents, "GET", s.AuthHandle(s.APIGetSmartContractEvents, true, s.AuthError, false))
+	s.AddRoute(setup.APISmartContractEventStream, "GET", s.AuthHandle(s.APISmartContractEventStream, true, s.AuthError, false))
 	s.AddRoute(setup.APIRegisterCallBackURL, "POST", s.AuthHandle(s.APIRegisterCallbackURL, true, s.AuthError, false))
 	s.AddRoute(setup.APIGetTxnByNode, "GET", s.AuthHandle(s.APIGetTxnByNode, true, s.AuthError, false))
 	s.AddRoute(setup.APIRemoveTokenChainBlock, "POST", s.AuthHandle(s.APIRemoveTokenChainBlock, true, s.AuthError, false))
@@ -171,6 +188,23 @@ func (s *Server) RegisterRoutes() {
 	s.AddRoute(setup.APIInitiatePinRBT, "POST", s.AuthHandle(s.APIInitiatePinRBT, true, s.AuthError, false))
 	s.AddRoute(setup.APIRecoverRBT, "POST", s.AuthHandle(s.APIRecoverRBT, true, s.AuthError, false))
 	s.AddRoute(setup.APIValidateTokenChain, "GET", s.AuthHandle(s.APIValidateTokenChain, false, s.AuthError, false))
+	s.AddRoute(setup.APICheckpointTokenChain, "POST", s.AuthHandle(s.APICheckpointTokenChain, true, s.AuthError, false))
+	s.AddRoute(setup.APIRestoreTokenChain, "POST", s.AuthHandle(s.APIRestoreTokenChain, false, s.AuthError, false))
+	s.AddRoute(setup.APIExportToken, "POST", s.AuthHandle(s.APIExportToken, true, s.AuthError, false))
+	s.AddRoute(setup.APIImportToken, "POST", s.AuthHandle(s.APIImportToken, true, s.AuthError, false))
+	s.AddRoute(setup.APITxnEvents, "GET", s.AuthHandle(s.APITxnEvents, false, s.AuthError, false))
+	s.AddRoute(setup.APIConsensusJournal, "GET", s.AuthHandle(s.APIGetConsensusJournal, false, s.AuthError, true))
+	s.AddRoute(setup.APIResolveConsensusJournal, "POST", s.AuthHandle(s.APIResolveConsensusJournal, false, s.AuthError, true))
+	s.AddRoute(setup.APIJobs, "GET", s.AuthHandle(s.APIGetJobs, false, s.AuthError, false))
+	s.AddRoute(setup.APIGetJob, "GET", s.AuthHandle(s.APIGetJob, false, s.AuthError, false))
+	s.AddRoute(setup.APIWebhooks, "POST", s.AuthHandle(s.APIAddWebhook, false, s.AuthError, false))
+	s.AddRoute(setup.APIWebhooks, "GET", s.AuthHandle(s.APIGetWebhooks, false, s.AuthError, false))
+	s.AddRoute(setup.APIRemoveWebhook, "DELETE", s.AuthHandle(s.APIRemoveWebhook, false, s.AuthError, false))
+	s.AddRoute(setup.APIWebhookDeliveries, "GET", s.AuthHandle(s.APIGetWebhookDeliveries, false, s.AuthError, false))
+	s.AddRoute(setup.APIReplayWebhookDelivery, "POST", s.AuthHandle(s.APIReplayWebhookDelivery, false, s.AuthError, false))
+	s.AddRoute(setup.APIAPIKeys, "POST", s.AuthHandle(s.APIAddAPIKey, false, s.AuthError, true))
+	s.AddRoute(setup.APIAPIKeys, "GET", s.AuthHandle(s.APIGetAPIKeys, false, s.AuthError, true))
+	s.AddRoute(setup.APIRemoveAPIKey, "DELETE", s.AuthHandle(s.APIRemoveAPIKey, false, s.AuthError, true))
 	s.AddRoute(setup.APIGenerateFaucetTestToken, "POST", s.AuthHandle(s.APIGenerateFaucetTestToken, true, s.AuthError, false))
 	s.AddRoute(setup.APIFaucetTokenCheck, "GET", s.AuthHandle(s.APIFaucetTokenCheck, false, s.AuthError, false))
 	s.AddRoute(setup.APICreateFT, "POST", s.AuthHandle(s.APICreateFT, true, s.AuthError, false))
@@ -188,6 +222,8 @@ func (s *Server) RegisterRoutes() {
 	s.AddRoute(setup.APIRequestDIDForPubKey, "POST", s.APICreateDIDFromPubKey)
 	s.AddRoute(setup.APISendJWTFromWallet, "POST", s.APIAuthenticateWalletJWT)
 	s.AddRoute(setup.APIAddUserAPIKey, "POST", s.AuthHandle(s.APIAddUserAPIKey, false, s.AuthError, true))
+	s.AddRoute(setup.APIOpenAPI, "GET", s.APIOpenAPI)
+	s.AddRoute(setup.APIMetrics, "GET", s.AuthHandle(s.APIMetrics, false, s.AuthError, false))
 }
 
 func (s *Server) ExitFunc() error {
@@ -203,11 +239,7 @@ func (s *Server) AuthHandle(hf ensweb.HandlerFunc, did bool, ef ensweb.HandlerFu
 	if s.cfg.EnableAuth {
 		switch s.cfg.AuthMethod {
 		case BasicAuthMethod:
-			if did {
-				return s.DIDAuthHandle(hf, nil, ef, root)
-			} else {
-				return s.APIKeyAuthHandle(hf, ef)
-			}
+			return s.RBACHandle(hf, did, ef, root)
 		// case SessionAuthMethod:
 		// 	return s.SessionAuthHandle(&setup.BearerToken{}, s.cfg.SessionName, s.cfg.SessionKey, hf, ef)
 		// case BasicAuthMethod:
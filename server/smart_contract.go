@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -21,32 +22,6 @@ type InitSmartContractToken struct {
 	genesisBlock   string
 }
 
-type FetchSmartContractSwaggoInput struct {
-	SmartContractToken string `json:"smartContractToken"`
-}
-
-type NewSubscriptionSwaggoInput struct {
-	SmartContractToken string `json:"smartContractToken"`
-}
-
-type DeploySmartContractSwaggoInput struct {
-	SmartContractToken string  `json:"smartContractToken"`
-	DeployerAddress    string  `json:"deployerAddr"`
-	RBTAmount          float64 `json:"rbtAmount"`
-	QuorumType         int     `json:"quorumType"`
-	Comment            string  `json:"comment"`
-}
-
-// SmartContract godoc
-// @Summary      Deploy Smart Contract
-// @Description  This API will deploy smart contract Token
-// @Tags         Smart Contract
-// @ID 			 deploy-smart-contract
-// @Accept       json
-// @Produce      json
-// @Param		 input body DeploySmartContractSwaggoInput true "Deploy smart contract"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/deploy-smart-contract [post]
 func (s *Server) APIDeploySmartContract(req *ensweb.Request) *ensweb.Result {
 	var deployReq model.DeploySmartContractRequest
 	err := s.ParseJSON(req, &deployReq)
@@ -81,23 +56,13 @@ func (s *Server) APIDeploySmartContract(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.DeploySmartContractToken(req.ID, &deployReq)
 	return s.didResponse(req, req.ID)
 }
 
-// SmartContract godoc
-// @Summary      Generate Smart Contract
-// @Description  This API will Generate smart contract Token
-// @Tags         Smart Contract
-// @Accept       mpfd
-// @Produce      mpfd
-// @Param        did        	   formData      string  true   "DID"
-// @Param 		 binaryCodePath	   formData      file    true  "location of binary code hash"
-// @Param 		 rawCodePath	   formData      file    true  "location of raw code hash"
-// @Param 		 schemaFilePath	   formData      file    true  "location of schema code hash"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/generate-smart-contract [post]
 func (s *Server) APIGenerateSmartContract(req *ensweb.Request) *ensweb.Result {
 	var deploySC core.GenerateSmartContractRequest
 	var err error
@@ -221,7 +186,9 @@ func (s *Server) APIGenerateSmartContract(req *ensweb.Request) *ensweb.Result {
 		return s.BasicResponse(req, false, "Ensure you enter the correct DID", nil)
 	}
 
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.GenerateSmartContractToken(req.ID, &deploySC)
 
 	return s.didResponse(req, req.ID)
@@ -272,16 +239,6 @@ func moveFile(src, dst string) error {
 	return nil
 }
 
-// SmartContract godoc
-// @Summary      Fetch Smart Contract
-// @Description  This API will Fetch smart contract
-// @Tags         Smart Contract
-// @ID   	     fetch-smart-contract
-// @Accept       json
-// @Produce      json
-// @Param        input query FetchSmartContractSwaggoInput true "Fetch smart contract"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/fetch-smart-contract [get]
 func (s *Server) APIFetchSmartContract(req *ensweb.Request) *ensweb.Result {
 	var fetchSC core.FetchSmartContractRequest
 	var err error
@@ -313,7 +270,9 @@ func (s *Server) APIFetchSmartContract(req *ensweb.Request) *ensweb.Result {
 		}
 	}
 
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go func() {
 		basicResponse := s.c.FetchSmartContract(req.ID, &fetchSC)
 		fmt.Printf("Basic Response server:  %+v\n", *&basicResponse.Message)
@@ -346,19 +305,13 @@ func (s *Server) APIPublishContract(request *ensweb.Request) *ensweb.Result {
 		return s.BasicResponse(request, false, "Invalid publish type", nil)
 	}
 
+	if !s.validateDIDAccess(request, newEvent.Did) {
+		return s.BasicResponse(request, false, "DID does not have an access", nil)
+	}
 	go s.c.PublishNewEvent(&newEvent)
 	return s.BasicResponse(request, true, "Smart contract published successfully", nil)
 }
 
-// SmartContract godoc
-// @Summary      Subscribe to Smart Contract
-// @Description  This API endpoint allows subscribing to a smart contract.
-// @Tags         Smart Contract
-// @Accept       json
-// @Produce      json
-// @Param        input body NewSubscriptionSwaggoInput true "Subscribe to input contract"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/subscribe-smart-contract [post]
 func (s *Server) APISubscribecontract(request *ensweb.Request) *ensweb.Result {
 	var newSubscription model.NewSubscription
 	err := s.ParseJSON(request, &newSubscription)
@@ -371,28 +324,13 @@ func (s *Server) APISubscribecontract(request *ensweb.Request) *ensweb.Result {
 		return s.BasicResponse(request, false, "Invalid smart contract token", nil)
 	}
 	topic := newSubscription.SmartContractToken
-	s.c.AddWebReq(request)
+	if err := s.c.AddWebReq(request); err != nil {
+		return s.BasicResponse(request, false, err.Error(), nil)
+	}
 	go s.c.SubsribeContractSetup(request.ID, topic)
 	return s.BasicResponse(request, true, "Smart contract subscribed successfully", nil)
 }
 
-type ExecuteSmartContractSwaggoInput struct {
-	SmartContractToken string `json:"smartContractToken"`
-	ExecutorAddress    string `json:"executorAddr"`
-	QuorumType         int    `json:"quorumType"`
-	Comment            string `json:"comment"`
-	SmartContractData  string `json:"smartContractData"`
-}
-
-// SmartContract godoc
-// @Summary      Execute Smart Contract
-// @Description  This API will Execute smart contract Token
-// @Tags         Smart Contract
-// @Accept       json
-// @Produce      json
-// @Param		 input body ExecuteSmartContractSwaggoInput true "Execute smart contrct and add details to chain"
-// @Success      200  {object}  model.BasicResponse
-// @Router       /api/execute-smart-contract [post]
 func (s *Server) APIExecuteSmartContract(req *ensweb.Request) *ensweb.Result {
 	var executeReq model.ExecuteSmartContractRequest
 	err := s.ParseJSON(req, &executeReq)
@@ -421,7 +359,62 @@ func (s *Server) APIExecuteSmartContract(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, did, executeReq.IdempotencyKey, core.JobExecuteSmartContract, &executeReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 	go s.c.ExecuteSmartContractToken(req.ID, &executeReq)
 	return s.didResponse(req, req.ID)
 }
+
+func (s *Server) APIUpgradeSmartContract(req *ensweb.Request) *ensweb.Result {
+	var upgradeReq model.UpgradeSmartContractRequest
+	err := s.ParseJSON(req, &upgradeReq)
+	if err != nil {
+		return s.BasicResponse(req, false, "Invalid input", nil)
+	}
+	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`)
+	for _, t := range []string{upgradeReq.SmartContractToken, upgradeReq.CodeToken} {
+		if len(t) != 46 || !strings.HasPrefix(t, "Qm") || !is_alphanumeric.MatchString(t) {
+			s.log.Error("Invalid smart contract token")
+			return s.BasicResponse(req, false, "Invalid smart contract token", nil)
+		}
+	}
+	_, did, ok := util.ParseAddress(upgradeReq.DeployerAddress)
+	if !ok {
+		return s.BasicResponse(req, false, "Invalid Deployer address", nil)
+	}
+	if !strings.HasPrefix(did, "bafybmi") || len(did) != 59 || !is_alphanumeric.MatchString(did) {
+		s.log.Error("Invalid deployer DID")
+		return s.BasicResponse(req, false, "Invalid deployer DID", nil)
+	}
+	if upgradeReq.QuorumType < 1 || upgradeReq.QuorumType > 2 {
+		s.log.Error("Invalid quorum type")
+		return s.BasicResponse(req, false, "Invalid quorum type", nil)
+	}
+	if !s.validateDIDAccess(req, did) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	go s.c.UpgradeSmartContractToken(req.ID, &upgradeReq)
+	return s.didResponse(req, req.ID)
+}
+
+// APIGetSmartContractVersions returns the code versions of the smart contract
+func (s *Server) APIGetSmartContractVersions(req *ensweb.Request) *ensweb.Result {
+	token := s.GetQuerry(req, "token")
+	if token == "" {
+		return s.BasicResponse(req, false, "Smart contract token is required", nil)
+	}
+	vr, err := s.c.GetSmartContractVersions(token)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	return s.RenderJSON(req, vr, http.StatusOK)
+}
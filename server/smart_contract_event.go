@@ -0,0 +1,154 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+func (s *Server) blockQuerry(req *ensweb.Request, key string) (uint64, error) {
+	v := s.GetQuerry(req, key)
+	if v == "" {
+		return 0, nil
+	}
+	bn, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s", key)
+	}
+	return bn, nil
+}
+
+// scEventFilter returns the filter from the token, name, topic, fromBlock and
+// toBlock query params
+func (s *Server) scEventFilter(req *ensweb.Request) (*model.SmartContractEventFilter, error) {
+	f := &model.SmartContractEventFilter{
+		SmartContractToken: s.GetQuerry(req, "token"),
+		Name:               s.GetQuerry(req, "name"),
+		Topic:              s.GetQuerry(req, "topic"),
+	}
+	var err error
+	f.FromBlock, err = s.blockQuerry(req, "fromBlock")
+	if err != nil {
+		return nil, err
+	}
+	f.ToBlock, err = s.blockQuerry(req, "toBlock")
+	if err != nil {
+		return nil, err
+	}
+	if f.ToBlock != 0 && f.ToBlock < f.FromBlock {
+		return nil, fmt.Errorf("toBlock is before fromBlock")
+	}
+	return f, nil
+}
+
+// APIGetSmartContractEvents returns the page of the smart contract events
+// matching the filter
+func (s *Server) APIGetSmartContractEvents(req *ensweb.Request) *ensweb.Result {
+	f, err := s.scEventFilter(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	er, err := s.c.GetSmartContractEvents(f, pr)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	return s.RenderJSON(req, er, http.StatusOK)
+}
+
+// scEventPos is the position of the last event sent for the contract
+type scEventPos struct {
+	block uint64
+	index int
+}
+
+// parseSCEventID returns the contract and the position from the event id
+func parseSCEventID(id string) (string, scEventPos, bool) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 {
+		return "", scEventPos{}, false
+	}
+	bn, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return "", scEventPos{}, false
+	}
+	idx, err := strconv.ParseInt(parts[2], 16, 32)
+	if err != nil {
+		return "", scEventPos{}, false
+	}
+	return parts[0], scEventPos{block: bn, index: int(idx)}, true
+}
+
+// APISmartContractEventStream streams the smart contract events matching the
+// filter, the events from the fromBlock are sent from the index before the
+// live events. The reconnecting clients resume after the last event id.
+func (s *Server) APISmartContractEventStream(req *ensweb.Request) *ensweb.Result {
+	f, err := s.scEventFilter(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	sent := make(map[string]scEventPos)
+	backlog := s.GetQuerry(req, "fromBlock") != ""
+	if token, pos, ok := parseSCEventID(req.Headers.Get("Last-Event-ID")); ok {
+		sent[token] = pos
+		if !backlog {
+			f.FromBlock = pos.block
+			backlog = true
+		}
+	}
+	// subscribe before reading the index so that no event is missed
+	ch, cancel := s.c.SubscribeSmartContractEvents(f)
+	defer cancel()
+	es, err := s.NewEventStream(req)
+	if err != nil {
+		s.log.Error("Failed to start the event stream", "err", err)
+		return s.BasicResponse(req, false, "failed to start the event stream", nil)
+	}
+	send := func(ev *model.SmartContractEventLog) error {
+		last, ok := sent[ev.SmartContractToken]
+		if ok && (ev.BlockNumber < last.block || (ev.BlockNumber == last.block && ev.Index <= last.index)) {
+			return nil
+		}
+		err := es.Send(ev.ID, ev.Name, ev)
+		if err != nil {
+			return err
+		}
+		sent[ev.SmartContractToken] = scEventPos{block: ev.BlockNumber, index: ev.Index}
+		return nil
+	}
+	pr := &model.PageRequest{Limit: model.MaxPageLimit}
+	for backlog && err == nil {
+		var er *model.SmartContractEventsReply
+		er, err = s.c.GetSmartContractEvents(f, pr)
+		if err != nil {
+			break
+		}
+		for i := 0; i < len(er.Events) && err == nil; i++ {
+			err = send(&er.Events[i])
+		}
+		pr.Cursor = er.NextCursor
+		backlog = pr.Cursor != ""
+	}
+	t := time.NewTicker(txnEventsKeepAlive)
+	defer t.Stop()
+	for err == nil {
+		select {
+		case ev := <-ch:
+			err = send(&ev)
+		case <-t.C:
+			err = es.KeepAlive()
+		case <-es.Done():
+			return es.Result()
+		}
+	}
+	s.log.Debug("Event stream closed", "err", err)
+	return es.Result()
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/rubixchain/rubixgoplatform/core"
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/did"
 	"github.com/rubixchain/rubixgoplatform/util"
@@ -21,9 +22,22 @@ func (s *Server) APIGetAllTokens(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid DID")
 		return s.BasicResponse(req, false, "Invalid DID", nil)
 	}
-	tr, err := s.c.GetAllTokens(did, tokenType)
+	f, err := s.tokenFilter(req)
 	if err != nil {
-		return s.BasicResponse(req, false, "Failed to get tokens", nil)
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	// only the RBT tokens can be split into the part tokens
+	f.TokenType = s.GetQuerry(req, "token_type")
+	pr, err := s.pageRequest(req)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	if !s.validateDIDAccess(req, did) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	tr, err := s.c.GetAllTokens(did, tokenType, f, pr)
+	if err != nil {
+		return s.BasicResponse(req, false, "Failed to get tokens, "+err.Error(), nil)
 	}
 	return s.RenderJSON(req, tr, http.StatusOK)
 }
@@ -47,30 +61,13 @@ func (s *Server) APIGenerateTestToken(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, tr.DID) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.GenerateTestTokens(req.ID, tr.NumberOfTokens, tr.DID)
 	return s.didResponse(req, req.ID)
 }
 
-type RBTTransferRequestSwaggoInput struct {
-	Receiver   string  `json:"receiver"`
-	Sender     string  `json:"sender"`
-	TokenCount float64 `json:"tokenCOunt"`
-	Comment    string  `json:"comment"`
-	Type       int     `json:"type"`
-}
-
-// ShowAccount godoc
-
-// @Summary     Initiate RBT Transfer
-// @Description This API will initiate RBT transfer to the specified dID
-// @Tags        Account
-// @ID 			initiate-rbt-transfer
-// @Accept      json
-// @Produce     json
-// @Param 		input body RBTTransferRequestSwaggoInput true "Intitate RBT transfer"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/initiate-rbt-transfer [post]
 func (s *Server) APIInitiateRBTTransfer(req *ensweb.Request) *ensweb.Result {
 	var rbtReq model.RBTTransferRequest
 	err := s.ParseJSON(req, &rbtReq)
@@ -109,31 +106,20 @@ func (s *Server) APIInitiateRBTTransfer(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, rbtReq.Sender) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	res := s.idempotentRequest(req, rbtReq.Sender, rbtReq.IdempotencyKey, core.JobRBTTransfer, &rbtReq)
+	if res != nil {
+		s.c.RemoveWebReq(req.ID)
+		return res
+	}
 	go s.c.InitiateRBTTransfer(req.ID, &rbtReq)
 	return s.didResponse(req, req.ID)
 }
 
 // function for Pinning RBT as service
 
-type RBTPinRequestSwaggoInput struct {
-	PinningNode string  `json:"pinningNode"`
-	Sender      string  `json:"sender"`
-	TokenCount  float64 `json:"tokenCOunt"`
-	Comment     string  `json:"comment"`
-	Type        int     `json:"type"`
-}
-
-// ShowAccount godoc
-// @Summary     Initiate Pin Token
-// @Description This API will pin token in the Pinning node on behalf of the sender
-// @Tags        Account
-// @ID 			initiate-pin-token
-// @Accept      json
-// @Produce     json
-// @Param 		input body RBTPinRequestSwaggoInput true "Intitate Pin Token"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/initiate-pin-token [post]
 func (s *Server) APIInitiatePinRBT(req *ensweb.Request) *ensweb.Result {
 	var rbtReq model.RBTPinRequest
 	err := s.ParseJSON(req, &rbtReq)
@@ -147,28 +133,13 @@ func (s *Server) APIInitiatePinRBT(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.InitiatePinRBT(req.ID, &rbtReq)
 	return s.didResponse(req, req.ID)
 }
 
-type RBTRecoverRequestSwaggoInput struct {
-	PinningNode string  `json:"pinningNode"`
-	Sender      string  `json:"sender"`
-	TokenCount  float64 `json:"tokenCOunt"`
-	Password    string  `json:"password"`
-}
-
-// ShowAccount godoc
-// @Summary     Recover Token and Tokenchain from the pinning node
-// @Description This API will recover token and tokenchain from the Pinning node to the node which has pinned the token
-// @Tags        Account
-// @ID 			recover-token
-// @Accept      json
-// @Produce     json
-// @Param 		input body RBTRecoverRequestSwaggoInput true "Recover-Token"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/recover-token [post]
 func (s *Server) APIRecoverRBT(req *ensweb.Request) *ensweb.Result {
 	var rbtReq model.RBTRecoverRequest
 	err := s.ParseJSON(req, &rbtReq)
@@ -182,20 +153,13 @@ func (s *Server) APIRecoverRBT(req *ensweb.Request) *ensweb.Result {
 	if !s.validateDIDAccess(req, did) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.InitiateRecoverRBT(req.ID, &rbtReq)
 	return s.didResponse(req, req.ID)
 }
 
-// ShowAccount godoc
-// @Summary      Check account balance
-// @Description  For a mentioned DID, check the account balance
-// @Tags         Account
-// @Accept       json
-// @Produce      json
-// @Param        did      	   query      string  true  "User DID"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/get-account-info [get]
 func (s *Server) APIGetAccountInfo(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "did")
 	if !s.validateDIDAccess(req, did) {
@@ -223,22 +187,6 @@ func (s *Server) APIGetAccountInfo(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, ac, http.StatusOK)
 }
 
-type SignatureResponseSwaggoInput struct {
-	ID       string `json:"id"`
-	Mode     int    `json:"mode"`
-	Password string `json:"password"`
-}
-
-// ShowAccount godoc
-// @Summary     Signature Response
-// @Description This API is used to supply the password for the node along with the ID generated when Initiate RBT transfer is called.
-// @Tags        Account
-// @ID 			signature-response
-// @Accept      json
-// @Produce     json
-// @Param 		input body SignatureResponseSwaggoInput true "Send input for requested signature"
-// @Success 	200		{object}	model.BasicResponse
-// @Router /api/signature-response [post]
 func (s *Server) APISignatureResponse(req *ensweb.Request) *ensweb.Result {
 	var resp did.SignRespData
 	err := s.ParseJSON(req, &resp)
@@ -249,18 +197,18 @@ func (s *Server) APISignatureResponse(req *ensweb.Request) *ensweb.Result {
 	if dc == nil {
 		return s.BasicResponse(req, false, "Invalid request ID", nil)
 	}
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	if s.c.CheckJobAccess(cl, resp.ID) != nil {
+		return s.BasicResponse(req, false, "Invalid request ID", nil)
+	}
 	s.c.UpateWebReq(resp.ID, req)
 	dc.InChan <- resp
 	return s.didResponse(req, resp.ID)
 }
 
-// APIGetPledgedTokenDetails godoc
-// @Summary     Get details about the pledged tokens
-// @Description This API allows the user to get details about the tokens the quorums have pledged i.e. which token is pledged for which token state
-// @Tags        Account
-// @Produce     json
-// @Success     200 {object} model.TokenStateResponse
-// @Router      /api/get-pledgedtoken-details [get]
 func (s *Server) APIGetPledgedTokenDetails(req *ensweb.Request) *ensweb.Result {
 	pledgedTokenInfo, err := s.c.GetPledgedInfo()
 	if err != nil {
@@ -277,15 +225,6 @@ func (s *Server) APIGetPledgedTokenDetails(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, tokenstateresponse, http.StatusOK)
 }
 
-// APICheckPinnedState godoc
-// @Summary     Check for exhausted token state hash
-// @Description This API is used to check if the token state for which the token is pledged is exhausted or not.
-// @Tags        Account
-// @Accept      json
-// @Produce     json
-// @Param       tokenstatehash	query	string	true	"Token State Hash"
-// @Success 	200		{object}	model.BasicResponse
-// @Router /api/check-pinned-state [delete]
 func (s *Server) APICheckPinnedState(req *ensweb.Request) *ensweb.Result {
 	tokenstatehash := s.GetQuerry(req, "tokenstatehash")
 
@@ -348,6 +287,70 @@ func (s *Server) APIValidateTokenChain(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, br, http.StatusOK)
 }
 
+// APICheckpointTokenChain will checkpoint the token chain and prune the older blocks
+func (s *Server) APICheckpointTokenChain(req *ensweb.Request) *ensweb.Result {
+	var cr model.TokenChainCheckpointRequest
+	err := s.ParseJSON(req, &cr)
+	if err != nil {
+		return s.BasicResponse(req, false, "Invalid input", nil)
+	}
+	if cr.DID == "" || cr.Token == "" {
+		return s.BasicResponse(req, false, "did and token are required", nil)
+	}
+	if !s.validateDIDAccess(req, cr.DID) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	br := s.c.CheckpointTokenChain(&cr)
+	return s.RenderJSON(req, br, http.StatusOK)
+}
+
+// APIRestoreTokenChain will restore the archived blocks of the token chain
+func (s *Server) APIRestoreTokenChain(req *ensweb.Request) *ensweb.Result {
+	token := s.GetQuerry(req, "token")
+	if token == "" {
+		return s.BasicResponse(req, false, "token is required", nil)
+	}
+	br := s.c.RestoreTokenChain(token)
+	return s.RenderJSON(req, br, http.StatusOK)
+}
+
+// APIExportToken will write the signed bundle of the token chain into the file
+func (s *Server) APIExportToken(req *ensweb.Request) *ensweb.Result {
+	var er model.ExportTokenRequest
+	err := s.ParseJSON(req, &er)
+	if err != nil {
+		return s.BasicResponse(req, false, "Invalid input", nil)
+	}
+	if er.DID == "" || er.Token == "" {
+		return s.BasicResponse(req, false, "did and token are required", nil)
+	}
+	if !s.validateDIDAccess(req, er.DID) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	go s.c.ExportToken(req.ID, &er)
+	return s.didResponse(req, req.ID)
+}
+
+// APIImportToken will validate the token bundle and add it into the wallet
+func (s *Server) APIImportToken(req *ensweb.Request) *ensweb.Result {
+	var ir model.ImportTokenRequest
+	err := s.ParseJSON(req, &ir)
+	if err != nil {
+		return s.BasicResponse(req, false, "Invalid input", nil)
+	}
+	if ir.DID == "" || len(ir.Bundle) == 0 {
+		return s.BasicResponse(req, false, "did and bundle are required", nil)
+	}
+	if !s.validateDIDAccess(req, ir.DID) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
+	}
+	br := s.c.ImportToken(&ir)
+	return s.RenderJSON(req, br, http.StatusOK)
+}
+
 func (s *Server) APIGenerateFaucetTestToken(req *ensweb.Request) *ensweb.Result {
 	var tr model.FaucetRBTGenerateRequest
 	err := s.ParseJSON(req, &tr)
@@ -367,7 +370,9 @@ func (s *Server) APIGenerateFaucetTestToken(req *ensweb.Request) *ensweb.Result
 	if !s.validateDIDAccess(req, tr.DID) {
 		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.GenerateFaucetTestTokens(req.ID, tr.TokenCount, tr.DID)
 	return s.didResponse(req, req.ID)
 }
@@ -413,7 +418,9 @@ func (s *Server) TxnReqFromWallet(txnReq *model.RBTTransferRequest, req *ensweb.
 	if !s.validateDIDAccess(req, txnReq.Sender) {
 		return s.BasicResponse(req, false, "invalid sender DID access", nil)
 	}
-	s.c.AddWebReq(req)
+	if err := s.c.AddWebReq(req); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
 	go s.c.InitiateRBTTransfer(req.ID, txnReq)
 	return s.didResponse(req, req.ID)
 }
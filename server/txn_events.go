@@ -0,0 +1,96 @@
+package server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+const txnEventsKeepAlive = 15 * time.Second
+
+func (s *Server) APITxnEvents(req *ensweb.Request) *ensweb.Result {
+	reqID := s.GetQuerry(req, "req_id")
+	if reqID == "" {
+		return s.BasicResponse(req, false, "request id is required", nil)
+	}
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	if err := s.c.CheckWatchAccess(cl, reqID); err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	from := 0
+	seq := s.GetQuerry(req, "from")
+	if seq == "" {
+		// reconnecting clients send the last event id
+		seq = req.Headers.Get("Last-Event-ID")
+	}
+	if seq != "" {
+		var err error
+		from, err = strconv.Atoi(seq)
+		if err != nil || from < 0 {
+			return s.BasicResponse(req, false, "invalid sequence number", nil)
+		}
+	}
+	backlog, ch, cancel := s.c.SubscribeTxnEvents(reqID, from)
+	defer cancel()
+	es, err := s.NewEventStream(req)
+	if err != nil {
+		s.log.Error("Failed to start the event stream", "err", err)
+		return s.BasicResponse(req, false, "failed to start the event stream", nil)
+	}
+	last := from
+	checked := false
+	// send sends the events in order and reports whether the request is finished
+	send := func(evs []model.TxnEvent) (bool, error) {
+		for _, ev := range evs {
+			if ev.Seq <= last {
+				continue
+			}
+			// job of the request not started at the subscription is checked now
+			if !checked {
+				if err := s.c.CheckJobAccess(cl, reqID); err != nil {
+					return true, err
+				}
+				checked = true
+			}
+			err := es.Send(strconv.Itoa(ev.Seq), ev.Type, ev)
+			if err != nil {
+				return false, err
+			}
+			last = ev.Seq
+			if ev.Type == model.TxnEventFinalStatus {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	done, err := send(backlog)
+	t := time.NewTicker(txnEventsKeepAlive)
+	defer t.Stop()
+	for !done && err == nil {
+		select {
+		case ev := <-ch:
+			if ev.Seq > last+1 {
+				// live events were missed, catch up from the log
+				done, err = send(s.c.GetTxnEvents(reqID, last))
+			} else {
+				done, err = send([]model.TxnEvent{ev})
+			}
+		case <-t.C:
+			err = es.KeepAlive()
+			if err == nil {
+				done, err = send(s.c.GetTxnEvents(reqID, last))
+			}
+		case <-es.Done():
+			return es.Result()
+		}
+	}
+	if err != nil {
+		s.log.Debug("Event stream closed", "err", err)
+	}
+	return es.Result()
+}
@@ -1,24 +1,17 @@
 package server
 
 import (
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/rubixchain/rubixgoplatform/core/model"
 	"github.com/rubixchain/rubixgoplatform/setup"
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-// @Summary Get transaction details by Transcation ID
-// @Description Retrieves the details of a transaction based on its ID.
-// @ID get-txn-details-by-id
-// @Tags         Account
-// @Accept json
-// @Produce json
-// @Param txnID query string true "The ID of the transaction to retrieve"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/get-by-txnId [get]
 func (s *Server) APIGetTxnByTxnID(req *ensweb.Request) *ensweb.Result {
 	txnID := s.GetQuerry(req, "txnID")
 	res, err := s.c.GetTxnDetailsByID(txnID)
@@ -56,18 +49,6 @@ func (s *Server) APIGetTxnByTxnID(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, &td, http.StatusOK)
 }
 
-// @Summary Get transaction details by dID
-// @Description Retrieves the details of a transaction based on dID and date range.
-// @ID get-by-did
-// @Tags Account
-// @Accept json
-// @Produce json
-// @Param DID query string true "DID of sender/receiver"
-// @Param Role query string false "Filter by role as sender or receiver"
-// @Param StartDate query string false "Start date of the date range (format: YYYY-MM-DD"
-// @Param EndDate query string false "End date of the date range (format: YYYY-MM-DD)"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/get-by-did [get]
 func (s *Server) APIGetTxnByDID(req *ensweb.Request) *ensweb.Result {
 	did := s.GetQuerry(req, "DID")
 	is_alphanumeric := regexp.MustCompile(`^[a-zA-Z0-9]*$`).MatchString(did)
@@ -75,70 +56,74 @@ func (s *Server) APIGetTxnByDID(req *ensweb.Request) *ensweb.Result {
 		s.log.Error("Invalid DID")
 		return s.BasicResponse(req, false, "Invalid DID", nil)
 	}
-	role := s.GetQuerry(req, "Role")
-	startDate := s.GetQuerry(req, "StartDate")
-	endDate := s.GetQuerry(req, "EndDate")
-
-	if (startDate != "" || endDate != "") && role != "" {
-		td := model.TxnDetails{
-			BasicResponse: model.BasicResponse{
-				Status:  false,
-				Message: "Either use Date range or Role for filter",
-				Result:  "",
-			},
-			TxnDetails: make([]model.TransactionDetails, 0),
-		}
+	td := model.TxnDetails{
+		BasicResponse: model.BasicResponse{
+			Status: false,
+			Result: "No data found",
+		},
+		TxnDetails: make([]model.TransactionDetails, 0),
+	}
+	f, err := s.txnFilter(req)
+	if err != nil {
+		td.Message = err.Error()
 		return s.RenderJSON(req, &td, http.StatusOK)
 	}
-
-	res, err := s.c.GetTxnDetailsByDID(did, role, startDate, endDate)
+	pr, err := s.pageRequest(req)
 	if err != nil {
-		if err.Error() == "no records found" {
-			s.log.Info("There are no records present for this DID " + did)
-			td := model.TxnDetails{
-				BasicResponse: model.BasicResponse{
-					Status:  true,
-					Message: "no records present for this DID : " + did,
-					Result:  "No data found",
-				},
-				TxnDetails: make([]model.TransactionDetails, 0),
-			}
-			return s.RenderJSON(req, &td, http.StatusOK)
-		}
-		//s.log.Error("err", err)
-		td := model.TxnDetails{
-			BasicResponse: model.BasicResponse{
-				Status:  false,
-				Message: err.Error(),
-				Result:  "No data found",
-			},
-			TxnDetails: make([]model.TransactionDetails, 0),
-		}
+		td.Message = err.Error()
 		return s.RenderJSON(req, &td, http.StatusOK)
 	}
-	td := model.TxnDetails{
-		BasicResponse: model.BasicResponse{
-			Status:  true,
-			Message: "Retrieved Txn Details",
-			Result:  "Successful",
-		},
-		TxnDetails: make([]model.TransactionDetails, 0),
+	if !s.validateDIDAccess(req, did) {
+		return s.BasicResponse(req, false, "DID does not have an access", nil)
 	}
-
+	res, next, err := s.c.GetTxnDetailsByDID(did, f, pr)
+	if err != nil {
+		td.Message = err.Error()
+		return s.RenderJSON(req, &td, http.StatusOK)
+	}
+	td.Status = true
+	if len(res) == 0 {
+		s.log.Info("There are no records present for this DID " + did)
+		td.Message = "no records present for this DID : " + did
+		return s.RenderJSON(req, &td, http.StatusOK)
+	}
+	td.Message = "Retrieved Txn Details"
+	td.Result = "Successful"
 	td.TxnDetails = append(td.TxnDetails, res...)
-
+	td.NextCursor = next
 	return s.RenderJSON(req, &td, http.StatusOK)
 }
 
-// @Summary Get transaction details by Transcation Comment
-// @Description Retrieves the details of a transaction based on its comment.
-// @Tags         Account
-// @ID get-by-comment
-// @Accept json
-// @Produce json
-// @Param Comment query string true "Comment to identify the transaction"
-// @Success 200 {object} model.BasicResponse
-// @Router /api/get-by-comment [get]
+func (s *Server) txnFilter(req *ensweb.Request) (*model.TxnFilter, error) {
+	f := &model.TxnFilter{
+		Role: s.GetQuerry(req, "Role"),
+	}
+	var err error
+	startDate := s.GetQuerry(req, "StartDate")
+	if startDate != "" {
+		f.StartDate, err = time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid StartDate format")
+		}
+	}
+	endDate := s.GetQuerry(req, "EndDate")
+	if endDate != "" {
+		f.EndDate, err = time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EndDate format")
+		}
+	}
+	f.MinAmount, err = s.floatQuerry(req, "min_amount")
+	if err != nil {
+		return nil, err
+	}
+	f.MaxAmount, err = s.floatQuerry(req, "max_amount")
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 func (s *Server) APIGetTxnByComment(req *ensweb.Request) *ensweb.Result {
 	comment := s.GetQuerry(req, "Comment")
 	res, err := s.c.GetTxnDetailsByComment(comment)
@@ -179,14 +164,6 @@ func (s *Server) APIGetTxnByComment(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, &td, http.StatusOK)
 }
 
-// @Summary Get count of incoming and outgoing txns of the DID ins a node
-// @Description Get count of incoming and outgoing txns of the DID ins a node.
-// @ID get-txn-details-by-node
-// @Tags         Account
-// @Accept json
-// @Produce json
-// @Success 200 {object} model.TxnCountForDID
-// @Router /api/get-by-node [get]
 func (s *Server) APIGetTxnByNode(req *ensweb.Request) *ensweb.Result {
 	dir, ok := s.validateAccess(req)
 	if !ok {
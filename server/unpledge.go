@@ -9,14 +9,6 @@ import (
 	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
 )
 
-// @Summary     Run Unpledge
-// @Description This API will unpledge all Pledged RBT tokens
-// @Tags        Account
-// @ID 			run-unpledge
-// @Accept      json
-// @Produce     json
-// @Success 200 {object} model.BasicResponse
-// @Router /api/run-unpledge [post]
 func (s *Server) RunUnpledgeHandle(req *ensweb.Request) *ensweb.Result {
 	var resp model.BasicResponse
 
@@ -34,14 +26,6 @@ func (s *Server) RunUnpledgeHandle(req *ensweb.Request) *ensweb.Result {
 	return s.RenderJSON(req, resp, http.StatusOK)
 }
 
-// @Summary     Unpledge POW Based pledge Tokens
-// @Description This API will unpledge all PoW based pledge tokens and drop the unpledgequeue table
-// @Tags        Account
-// @ID 			unpledge-pow-unpledge-tokens
-// @Accept      json
-// @Produce     json
-// @Success 200 {object} model.BasicResponse
-// @Router 		/api/unpledge-pow-unpledge-tokens [post]
 func (s *Server) UnpledgePoWBasedPledgedTokens(req *ensweb.Request) *ensweb.Result {
 	var resp model.BasicResponse
 
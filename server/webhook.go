@@ -0,0 +1,104 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rubixchain/rubixgoplatform/core/model"
+	"github.com/rubixchain/rubixgoplatform/wrapper/ensweb"
+)
+
+func (s *Server) APIAddWebhook(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	var wr model.WebhookRequest
+	err := s.ParseJSON(req, &wr)
+	if err != nil {
+		return s.BasicResponse(req, false, "invalid input request", nil)
+	}
+	wh, err := s.c.AddWebhook(cl, &wr)
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	rp := model.WebhookReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Webhook added",
+		},
+		Webhook: wh,
+	}
+	return s.RenderJSON(req, &rp, http.StatusOK)
+}
+
+func (s *Server) APIGetWebhooks(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	whs, err := s.c.GetWebhooks(cl, s.GetQuerry(req, "did"))
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to get webhooks, "+err.Error(), nil)
+	}
+	rp := model.WebhookListReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the webhooks",
+		},
+		Webhooks: whs,
+	}
+	return s.RenderJSON(req, &rp, http.StatusOK)
+}
+
+func (s *Server) APIRemoveWebhook(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	err := s.c.RemoveWebhook(cl, s.GetRouteVar(req, "id"))
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	return s.BasicResponse(req, true, "Webhook removed", nil)
+}
+
+func (s *Server) APIGetWebhookDeliveries(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	limit := 0
+	l := s.GetQuerry(req, "limit")
+	if l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil {
+			return s.BasicResponse(req, false, "invalid limit", nil)
+		}
+	}
+	ds, err := s.c.GetWebhookDeliveries(cl, s.GetQuerry(req, "webhook_id"), s.GetQuerry(req, "status"), limit)
+	if err != nil {
+		return s.BasicResponse(req, false, "failed to get webhook deliveries", nil)
+	}
+	rp := model.WebhookDeliveryListReply{
+		BasicResponse: model.BasicResponse{
+			Status:  true,
+			Message: "Got the webhook deliveries",
+		},
+		Deliveries: ds,
+	}
+	return s.RenderJSON(req, &rp, http.StatusOK)
+}
+
+func (s *Server) APIReplayWebhookDelivery(req *ensweb.Request) *ensweb.Result {
+	cl := s.requestCaller(req)
+	if cl == nil {
+		return s.AuthError(req)
+	}
+	err := s.c.ReplayWebhookDelivery(cl, s.GetRouteVar(req, "id"))
+	if err != nil {
+		return s.BasicResponse(req, false, err.Error(), nil)
+	}
+	return s.BasicResponse(req, true, "Webhook delivery queued", nil)
+}
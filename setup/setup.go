@@ -35,6 +35,7 @@ const (
 	APIGetAccountInfo                   string = "/api/get-account-info"
 	APISignatureResponse                string = "/api/signature-response"
 	APIDumpTokenChainBlock              string = "/api/dump-token-chain"
+	APIGetTokenProof                    string = "/api/get-token-proof"
 	APIRegisterDID                      string = "/api/register-did"
 	APISetupDID                         string = "/api/setup-did"
 	APIMigrateNode                      string = "/api/migrate-node"
@@ -52,12 +53,17 @@ const (
 	APIAddNFTSale                       string = "/api/addnftsale"
 	APIDeploySmartContract              string = "/api/deploy-smart-contract"
 	APIExecuteSmartContract             string = "/api/execute-smart-contract"
+	APIUpgradeSmartContract             string = "/api/upgrade-smart-contract"
+	APIGetSmartContractVersions         string = "/api/get-smart-contract-versions"
 	APIGenerateSmartContract            string = "/api/generate-smart-contract"
 	APIFetchSmartContract               string = "/api/fetch-smart-contract"
 	APIPublishContract                  string = "/api/publish-smart-contract"
 	APISubscribecontract                string = "/api/subscribe-smart-contract"
 	APIDumpSmartContractTokenChainBlock string = "/api/dump-smart-contract-token-chain"
 	APIGetSmartContractTokenData        string = "/api/get-smart-contract-token-chain-data"
+	APIQuerySmartContractState          string = "/api/query-smart-contract-state"
+	APIGetSmartContractEvents           string = "/api/get-smart-contract-events"
+	APISmartContractEventStream         string = "/api/smart-contract-event-stream"
 	APIRegisterCallBackURL              string = "/api/register-callback-url"
 	APIGetTxnByNode                     string = "/api/get-by-node"
 	APIRemoveTokenChainBlock            string = "/api/remove-token-chain-block"
@@ -76,6 +82,21 @@ const (
 	APIInitiatePinRBT                   string = "/api/initiate-pin-token"
 	APIRecoverRBT                       string = "/api/recover-token"
 	APIValidateTokenChain               string = "/api/validate-token-chain"
+	APICheckpointTokenChain             string = "/api/checkpoint-token-chain"
+	APIRestoreTokenChain                string = "/api/restore-token-chain"
+	APIExportToken                      string = "/api/export-token"
+	APIImportToken                      string = "/api/import-token"
+	APITxnEvents                        string = "/api/txn-events"
+	APIConsensusJournal                 string = "/api/consensus-journal"
+	APIResolveConsensusJournal          string = "/api/consensus-journal/resolve"
+	APIJobs                             string = "/api/jobs"
+	APIGetJob                           string = "/api/jobs/{id}"
+	APIWebhooks                         string = "/api/webhooks"
+	APIRemoveWebhook                    string = "/api/webhooks/{id}"
+	APIWebhookDeliveries                string = "/api/webhook-deliveries"
+	APIReplayWebhookDelivery            string = "/api/webhook-deliveries/{id}/replay"
+	APIAPIKeys                          string = "/api/api-keys"
+	APIRemoveAPIKey                     string = "/api/api-keys/{id}"
 	APIGenerateFaucetTestToken          string = "/api/generate-faucettest-token"
 	APIFaucetTokenCheck                 string = "/api/faucet-token-check"
 	APICreateFT                         string = "/api/create-ft"
@@ -94,6 +115,8 @@ const (
 	APIGetFTTokenchain                  string = "/api/get-ft-token-chain"
 	APIRequestDIDForPubKey              string = "/api/request-did-for-pubkey"
 	APISendJWTFromWallet                string = "/api/send-jwt-from-wallet"
+	APIOpenAPI                          string = "/api/openapi.json"
+	APIMetrics                          string = "/metrics"
 )
 
 // jwt.RegisteredClaims
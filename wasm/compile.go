@@ -0,0 +1,202 @@
+package wasm
+
+import (
+	"fmt"
+)
+
+// instr is the decoded instruction, the immediates depend on the opcode
+//   - block, loop and if: params and results of the block type, b is the
+//     index of the end and c is the index of the else
+//   - else: b is the index of the end
+//   - br, br_if: a is the label depth
+//   - br_table: a is the start of the labels in the table and b is the count
+//   - call: a is the function index, call_indirect: a is the type index
+//   - local, global: a is the index
+//   - load, store: a is the offset
+//   - const: a is the bits of the value
+//   - memory.init, data.drop: a is the data index
+type instr struct {
+	op      uint16
+	params  uint16
+	results uint16
+	a       uint64
+	b       uint32
+	c       uint32
+}
+
+// function is the compiled function defined by the module
+type function struct {
+	typ      *FuncType
+	locals   []ValueType
+	code     []instr
+	brTables []uint32
+}
+
+// compile decodes the code of the function, the block boundaries are
+// resolved so that the branches do not scan the code
+func (m *Module) compile(idx uint32) (*function, error) {
+	body := m.bodies[idx]
+	f := &function{
+		typ:    &m.types[m.funcTypes[idx]],
+		locals: body.locals,
+		code:   make([]instr, 0, len(body.code)/2),
+	}
+	nLocals := uint64(len(f.typ.Params) + len(f.locals))
+	nFuncs := uint64(m.numImport) + uint64(len(m.funcTypes))
+	r := &reader{b: body.code}
+	// open blocks, the index of the block instruction
+	ctrl := make([]int, 0, 16)
+	for {
+		pos := len(f.code)
+		op := r.byte()
+		in := instr{op: uint16(op)}
+		switch op {
+		case opUnreachable, opNop, opReturn, opDrop, opSelect:
+		case opBlock, opLoop, opIf:
+			p, res, err := m.blockType(r)
+			if err != nil {
+				return nil, err
+			}
+			in.params = p
+			in.results = res
+			ctrl = append(ctrl, pos)
+		case opElse:
+			if len(ctrl) == 0 || f.code[ctrl[len(ctrl)-1]].op != uint16(opIf) || f.code[ctrl[len(ctrl)-1]].c != 0 {
+				return nil, fmt.Errorf("else without if")
+			}
+			f.code[ctrl[len(ctrl)-1]].c = uint32(pos)
+		case opEnd:
+			if len(ctrl) == 0 {
+				f.code = append(f.code, in)
+				if !r.eof() {
+					return nil, fmt.Errorf("code after the end of the function")
+				}
+				return f, nil
+			}
+			st := ctrl[len(ctrl)-1]
+			ctrl = ctrl[:len(ctrl)-1]
+			f.code[st].b = uint32(pos)
+			if f.code[st].c != 0 {
+				f.code[f.code[st].c].b = uint32(pos)
+			}
+		case opBr, opBrIf:
+			in.a = uint64(r.u32())
+			if in.a > uint64(len(ctrl)) {
+				return nil, fmt.Errorf("invalid branch depth")
+			}
+		case opBrTable:
+			n := r.u32()
+			if n > uint32(len(body.code)) {
+				return nil, fmt.Errorf("invalid branch table")
+			}
+			in.a = uint64(len(f.brTables))
+			in.b = n + 1
+			for i := uint32(0); i <= n; i++ {
+				d := r.u32()
+				if d > uint32(len(ctrl)) {
+					return nil, fmt.Errorf("invalid branch depth")
+				}
+				f.brTables = append(f.brTables, d)
+			}
+		case opCall:
+			in.a = uint64(r.u32())
+			if in.a >= nFuncs {
+				return nil, fmt.Errorf("invalid function index %d", in.a)
+			}
+		case opCallIndirect:
+			in.a = uint64(r.u32())
+			if in.a >= uint64(len(m.types)) {
+				return nil, fmt.Errorf("invalid type index %d", in.a)
+			}
+			if r.u32() != 0 || m.table == nil {
+				return nil, fmt.Errorf("invalid table index")
+			}
+		case opSelectT:
+			if len(r.valueTypes()) != 1 {
+				return nil, fmt.Errorf("invalid select type")
+			}
+			in.op = uint16(opSelect)
+		case opLocalGet, opLocalSet, opLocalTee:
+			in.a = uint64(r.u32())
+			if in.a >= nLocals {
+				return nil, fmt.Errorf("invalid local index %d", in.a)
+			}
+		case opGlobalGet, opGlobalSet:
+			in.a = uint64(r.u32())
+			if in.a >= uint64(len(m.globals)) {
+				return nil, fmt.Errorf("invalid global index %d", in.a)
+			}
+			if op == opGlobalSet && !m.globals[in.a].mut {
+				return nil, fmt.Errorf("global %d is immutable", in.a)
+			}
+		case opMemorySize, opMemoryGrow:
+			if r.byte() != 0 || m.memory == nil {
+				return nil, fmt.Errorf("invalid memory index")
+			}
+		case opI32Const:
+			in.a = uint64(uint32(r.sleb(32)))
+		case opI64Const:
+			in.a = uint64(r.sleb(64))
+		case opF32Const:
+			in.a = uint64(r.u32le())
+		case opF64Const:
+			in.a = r.u64le()
+		case opPrefixFC:
+			sub := r.u32()
+			in.op = 0x100 + uint16(sub)
+			switch in.op {
+			case opI32TruncSatF32S, opI32TruncSatF32U, opI32TruncSatF64S, opI32TruncSatF64U,
+				opI64TruncSatF32S, opI64TruncSatF32U, opI64TruncSatF64S, opI64TruncSatF64U:
+			case opMemoryInit:
+				in.a = uint64(r.u32())
+				if r.byte() != 0 || m.memory == nil {
+					return nil, fmt.Errorf("invalid memory index")
+				}
+			case opDataDrop:
+				in.a = uint64(r.u32())
+			case opMemoryCopy:
+				if r.byte() != 0 || r.byte() != 0 || m.memory == nil {
+					return nil, fmt.Errorf("invalid memory index")
+				}
+			case opMemoryFill:
+				if r.byte() != 0 || m.memory == nil {
+					return nil, fmt.Errorf("invalid memory index")
+				}
+			default:
+				return nil, fmt.Errorf("unsupported instruction 0xfc %d", sub)
+			}
+		default:
+			switch {
+			case op >= opI32Load && op <= opI64Store32:
+				if m.memory == nil {
+					return nil, fmt.Errorf("memory access without memory")
+				}
+				r.u32()
+				in.a = uint64(r.u32())
+			case op >= opI32Eqz && op <= opI64Extend32S:
+			default:
+				return nil, fmt.Errorf("unsupported instruction 0x%x", op)
+			}
+		}
+		f.code = append(f.code, in)
+	}
+}
+
+// blockType returns the number of the params and the results of the block
+func (m *Module) blockType(r *reader) (uint16, uint16, error) {
+	b := r.b[r.p]
+	switch ValueType(b) {
+	case 0x40:
+		r.p++
+		return 0, 0, nil
+	case I32, I64, F32, F64, FuncRef, ExternRef:
+		r.p++
+		return 0, 1, nil
+	}
+	ti := r.sleb(33)
+	if ti < 0 || ti >= int64(len(m.types)) {
+		return 0, 0, fmt.Errorf("invalid block type")
+	}
+	ft := &m.types[ti]
+	return uint16(len(ft.Params)), uint16(len(ft.Results)), nil
+}
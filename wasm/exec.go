@@ -0,0 +1,654 @@
+package wasm
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// run executes the function with the args on the stack
+func (inst *Instance) run(f *function) {
+	np := len(f.typ.Params)
+	nr := len(f.typ.Results)
+	s := inst.stack
+	locals := make([]uint64, np+len(f.locals))
+	copy(locals, s[len(s)-np:])
+	s = s[:len(s)-np]
+	base := len(s)
+	lbase := len(inst.labels)
+	code := f.code
+	le := binary.LittleEndian
+	pc := 0
+	for {
+		if inst.fuel == 0 {
+			panic(ErrOutOfFuel)
+		}
+		inst.fuel--
+		in := &code[pc]
+		pc++
+		n := len(s)
+		switch in.op {
+		case uint16(opUnreachable):
+			trap("unreachable")
+		case uint16(opNop):
+		case uint16(opBlock):
+			inst.labels = append(inst.labels, label{height: n - int(in.params), arity: int(in.results), cont: int(in.b) + 1})
+		case uint16(opLoop):
+			if n > inst.cfg.MaxStack {
+				trap("value stack exhausted")
+			}
+			inst.labels = append(inst.labels, label{height: n - int(in.params), arity: int(in.params), cont: pc - 1})
+		case uint16(opIf):
+			cond := uint32(s[n-1])
+			s = s[:n-1]
+			inst.labels = append(inst.labels, label{height: n - 1 - int(in.params), arity: int(in.results), cont: int(in.b) + 1})
+			if cond == 0 {
+				if in.c != 0 {
+					pc = int(in.c) + 1
+				} else {
+					pc = int(in.b)
+				}
+			}
+		case uint16(opElse):
+			pc = int(in.b)
+		case uint16(opEnd):
+			if len(inst.labels) > lbase {
+				inst.labels = inst.labels[:len(inst.labels)-1]
+				continue
+			}
+			copy(s[base:], s[n-nr:])
+			inst.stack = s[:base+nr]
+			return
+		case uint16(opBr), uint16(opBrIf), uint16(opBrTable):
+			var d int
+			switch in.op {
+			case uint16(opBr):
+				d = int(in.a)
+			case uint16(opBrIf):
+				cond := uint32(s[n-1])
+				s = s[:n-1]
+				n--
+				if cond == 0 {
+					continue
+				}
+				d = int(in.a)
+			default:
+				i := uint32(s[n-1])
+				s = s[:n-1]
+				n--
+				if i >= in.b-1 {
+					i = in.b - 1
+				}
+				d = int(f.brTables[in.a+uint64(i)])
+			}
+			if d == len(inst.labels)-lbase {
+				copy(s[base:], s[n-nr:])
+				inst.labels = inst.labels[:lbase]
+				inst.stack = s[:base+nr]
+				return
+			}
+			l := inst.labels[len(inst.labels)-1-d]
+			copy(s[l.height:], s[n-l.arity:])
+			s = s[:l.height+l.arity]
+			inst.labels = inst.labels[:len(inst.labels)-1-d]
+			pc = l.cont
+		case uint16(opReturn):
+			copy(s[base:], s[n-nr:])
+			inst.labels = inst.labels[:lbase]
+			inst.stack = s[:base+nr]
+			return
+		case uint16(opCall):
+			if n > inst.cfg.MaxStack {
+				trap("value stack exhausted")
+			}
+			inst.stack = s
+			inst.invoke(uint32(in.a))
+			s = inst.stack
+		case uint16(opCallIndirect):
+			i := uint32(s[n-1])
+			s = s[:n-1]
+			if int(i) >= len(inst.table) {
+				trap("undefined element")
+			}
+			fi := inst.table[i]
+			if fi < 0 {
+				trap("uninitialized element")
+			}
+			if !inst.m.funcType(uint32(fi)).equal(&inst.m.types[in.a]) {
+				trap("indirect call type mismatch")
+			}
+			if n > inst.cfg.MaxStack {
+				trap("value stack exhausted")
+			}
+			inst.stack = s
+			inst.invoke(uint32(fi))
+			s = inst.stack
+		case uint16(opDrop):
+			s = s[:n-1]
+		case uint16(opSelect):
+			if uint32(s[n-1]) == 0 {
+				s[n-3] = s[n-2]
+			}
+			s = s[:n-2]
+		case uint16(opLocalGet):
+			s = append(s, locals[in.a])
+		case uint16(opLocalSet):
+			locals[in.a] = s[n-1]
+			s = s[:n-1]
+		case uint16(opLocalTee):
+			locals[in.a] = s[n-1]
+		case uint16(opGlobalGet):
+			s = append(s, inst.globals[in.a])
+		case uint16(opGlobalSet):
+			inst.globals[in.a] = s[n-1]
+			s = s[:n-1]
+
+		// memory
+		case uint16(opI32Load), uint16(opF32Load):
+			s[n-1] = uint64(le.Uint32(inst.mem[inst.ea(s[n-1], in.a, 4):]))
+		case uint16(opI64Load), uint16(opF64Load):
+			s[n-1] = le.Uint64(inst.mem[inst.ea(s[n-1], in.a, 8):])
+		case uint16(opI32Load8S):
+			s[n-1] = uint64(uint32(int8(inst.mem[inst.ea(s[n-1], in.a, 1)])))
+		case uint16(opI32Load8U), uint16(opI64Load8U):
+			s[n-1] = uint64(inst.mem[inst.ea(s[n-1], in.a, 1)])
+		case uint16(opI32Load16S):
+			s[n-1] = uint64(uint32(int16(le.Uint16(inst.mem[inst.ea(s[n-1], in.a, 2):]))))
+		case uint16(opI32Load16U), uint16(opI64Load16U):
+			s[n-1] = uint64(le.Uint16(inst.mem[inst.ea(s[n-1], in.a, 2):]))
+		case uint16(opI64Load8S):
+			s[n-1] = uint64(int64(int8(inst.mem[inst.ea(s[n-1], in.a, 1)])))
+		case uint16(opI64Load16S):
+			s[n-1] = uint64(int64(int16(le.Uint16(inst.mem[inst.ea(s[n-1], in.a, 2):]))))
+		case uint16(opI64Load32S):
+			s[n-1] = uint64(int64(int32(le.Uint32(inst.mem[inst.ea(s[n-1], in.a, 4):]))))
+		case uint16(opI64Load32U):
+			s[n-1] = uint64(le.Uint32(inst.mem[inst.ea(s[n-1], in.a, 4):]))
+		case uint16(opI32Store), uint16(opF32Store), uint16(opI64Store32):
+			le.PutUint32(inst.mem[inst.ea(s[n-2], in.a, 4):], uint32(s[n-1]))
+			s = s[:n-2]
+		case uint16(opI64Store), uint16(opF64Store):
+			le.PutUint64(inst.mem[inst.ea(s[n-2], in.a, 8):], s[n-1])
+			s = s[:n-2]
+		case uint16(opI32Store8), uint16(opI64Store8):
+			inst.mem[inst.ea(s[n-2], in.a, 1)] = byte(s[n-1])
+			s = s[:n-2]
+		case uint16(opI32Store16), uint16(opI64Store16):
+			le.PutUint16(inst.mem[inst.ea(s[n-2], in.a, 2):], uint16(s[n-1]))
+			s = s[:n-2]
+		case uint16(opMemorySize):
+			s = append(s, uint64(uint32(len(inst.mem))/PageSize))
+		case uint16(opMemoryGrow):
+			s[n-1] = inst.grow(uint32(s[n-1]))
+		case opMemoryInit:
+			cnt, src, dst := uint64(uint32(s[n-1])), uint64(uint32(s[n-2])), uint64(uint32(s[n-3]))
+			s = s[:n-3]
+			if in.a >= uint64(len(inst.m.datas)) {
+				trap("invalid data segment")
+			}
+			data := inst.m.datas[in.a].init
+			if inst.dropped[in.a] {
+				data = nil
+			}
+			if src+cnt > uint64(len(data)) || dst+cnt > uint64(len(inst.mem)) {
+				trap("out of bounds memory access")
+			}
+			inst.charge(cnt / byteFuelDiv)
+			copy(inst.mem[dst:], data[src:src+cnt])
+		case opDataDrop:
+			if in.a >= uint64(len(inst.m.datas)) {
+				trap("invalid data segment")
+			}
+			inst.dropped[in.a] = true
+		case opMemoryCopy:
+			cnt, src, dst := uint64(uint32(s[n-1])), uint64(uint32(s[n-2])), uint64(uint32(s[n-3]))
+			s = s[:n-3]
+			if src+cnt > uint64(len(inst.mem)) || dst+cnt > uint64(len(inst.mem)) {
+				trap("out of bounds memory access")
+			}
+			inst.charge(cnt / byteFuelDiv)
+			copy(inst.mem[dst:dst+cnt], inst.mem[src:src+cnt])
+		case opMemoryFill:
+			cnt, val, dst := uint64(uint32(s[n-1])), byte(s[n-2]), uint64(uint32(s[n-3]))
+			s = s[:n-3]
+			if dst+cnt > uint64(len(inst.mem)) {
+				trap("out of bounds memory access")
+			}
+			inst.charge(cnt / byteFuelDiv)
+			m := inst.mem[dst : dst+cnt]
+			for i := range m {
+				m[i] = val
+			}
+
+		// constants
+		case uint16(opI32Const), uint16(opI64Const), uint16(opF32Const), uint16(opF64Const):
+			s = append(s, in.a)
+
+		// i32 comparison
+		case uint16(opI32Eqz):
+			s[n-1] = b2u(uint32(s[n-1]) == 0)
+		case uint16(opI32Eq):
+			s[n-2] = b2u(uint32(s[n-2]) == uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Ne):
+			s[n-2] = b2u(uint32(s[n-2]) != uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32LtS):
+			s[n-2] = b2u(int32(s[n-2]) < int32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32LtU):
+			s[n-2] = b2u(uint32(s[n-2]) < uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32GtS):
+			s[n-2] = b2u(int32(s[n-2]) > int32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32GtU):
+			s[n-2] = b2u(uint32(s[n-2]) > uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32LeS):
+			s[n-2] = b2u(int32(s[n-2]) <= int32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32LeU):
+			s[n-2] = b2u(uint32(s[n-2]) <= uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32GeS):
+			s[n-2] = b2u(int32(s[n-2]) >= int32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32GeU):
+			s[n-2] = b2u(uint32(s[n-2]) >= uint32(s[n-1]))
+			s = s[:n-1]
+
+		// i64 comparison
+		case uint16(opI64Eqz):
+			s[n-1] = b2u(s[n-1] == 0)
+		case uint16(opI64Eq):
+			s[n-2] = b2u(s[n-2] == s[n-1])
+			s = s[:n-1]
+		case uint16(opI64Ne):
+			s[n-2] = b2u(s[n-2] != s[n-1])
+			s = s[:n-1]
+		case uint16(opI64LtS):
+			s[n-2] = b2u(int64(s[n-2]) < int64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI64LtU):
+			s[n-2] = b2u(s[n-2] < s[n-1])
+			s = s[:n-1]
+		case uint16(opI64GtS):
+			s[n-2] = b2u(int64(s[n-2]) > int64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI64GtU):
+			s[n-2] = b2u(s[n-2] > s[n-1])
+			s = s[:n-1]
+		case uint16(opI64LeS):
+			s[n-2] = b2u(int64(s[n-2]) <= int64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI64LeU):
+			s[n-2] = b2u(s[n-2] <= s[n-1])
+			s = s[:n-1]
+		case uint16(opI64GeS):
+			s[n-2] = b2u(int64(s[n-2]) >= int64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI64GeU):
+			s[n-2] = b2u(s[n-2] >= s[n-1])
+			s = s[:n-1]
+
+		// float comparison
+		case uint16(opF32Eq):
+			s[n-2] = b2u(f32(s[n-2]) == f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Ne):
+			s[n-2] = b2u(f32(s[n-2]) != f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Lt):
+			s[n-2] = b2u(f32(s[n-2]) < f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Gt):
+			s[n-2] = b2u(f32(s[n-2]) > f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Le):
+			s[n-2] = b2u(f32(s[n-2]) <= f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Ge):
+			s[n-2] = b2u(f32(s[n-2]) >= f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Eq):
+			s[n-2] = b2u(f64(s[n-2]) == f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Ne):
+			s[n-2] = b2u(f64(s[n-2]) != f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Lt):
+			s[n-2] = b2u(f64(s[n-2]) < f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Gt):
+			s[n-2] = b2u(f64(s[n-2]) > f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Le):
+			s[n-2] = b2u(f64(s[n-2]) <= f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Ge):
+			s[n-2] = b2u(f64(s[n-2]) >= f64(s[n-1]))
+			s = s[:n-1]
+
+		// i32 arithmetic
+		case uint16(opI32Clz):
+			s[n-1] = uint64(bits.LeadingZeros32(uint32(s[n-1])))
+		case uint16(opI32Ctz):
+			s[n-1] = uint64(bits.TrailingZeros32(uint32(s[n-1])))
+		case uint16(opI32Popcnt):
+			s[n-1] = uint64(bits.OnesCount32(uint32(s[n-1])))
+		case uint16(opI32Add):
+			s[n-2] = uint64(uint32(s[n-2]) + uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Sub):
+			s[n-2] = uint64(uint32(s[n-2]) - uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Mul):
+			s[n-2] = uint64(uint32(s[n-2]) * uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32DivS):
+			a, b := int32(s[n-2]), int32(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			if a == math.MinInt32 && b == -1 {
+				trap("integer overflow")
+			}
+			s[n-2] = uint64(uint32(a / b))
+			s = s[:n-1]
+		case uint16(opI32DivU):
+			a, b := uint32(s[n-2]), uint32(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			s[n-2] = uint64(a / b)
+			s = s[:n-1]
+		case uint16(opI32RemS):
+			a, b := int32(s[n-2]), int32(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			if b == -1 {
+				s[n-2] = 0
+			} else {
+				s[n-2] = uint64(uint32(a % b))
+			}
+			s = s[:n-1]
+		case uint16(opI32RemU):
+			a, b := uint32(s[n-2]), uint32(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			s[n-2] = uint64(a % b)
+			s = s[:n-1]
+		case uint16(opI32And):
+			s[n-2] = uint64(uint32(s[n-2]) & uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Or):
+			s[n-2] = uint64(uint32(s[n-2]) | uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Xor):
+			s[n-2] = uint64(uint32(s[n-2]) ^ uint32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opI32Shl):
+			s[n-2] = uint64(uint32(s[n-2]) << (uint32(s[n-1]) & 31))
+			s = s[:n-1]
+		case uint16(opI32ShrS):
+			s[n-2] = uint64(uint32(int32(s[n-2]) >> (uint32(s[n-1]) & 31)))
+			s = s[:n-1]
+		case uint16(opI32ShrU):
+			s[n-2] = uint64(uint32(s[n-2]) >> (uint32(s[n-1]) & 31))
+			s = s[:n-1]
+		case uint16(opI32Rotl):
+			s[n-2] = uint64(bits.RotateLeft32(uint32(s[n-2]), int(uint32(s[n-1])&31)))
+			s = s[:n-1]
+		case uint16(opI32Rotr):
+			s[n-2] = uint64(bits.RotateLeft32(uint32(s[n-2]), -int(uint32(s[n-1])&31)))
+			s = s[:n-1]
+
+		// i64 arithmetic
+		case uint16(opI64Clz):
+			s[n-1] = uint64(bits.LeadingZeros64(s[n-1]))
+		case uint16(opI64Ctz):
+			s[n-1] = uint64(bits.TrailingZeros64(s[n-1]))
+		case uint16(opI64Popcnt):
+			s[n-1] = uint64(bits.OnesCount64(s[n-1]))
+		case uint16(opI64Add):
+			s[n-2] = s[n-2] + s[n-1]
+			s = s[:n-1]
+		case uint16(opI64Sub):
+			s[n-2] = s[n-2] - s[n-1]
+			s = s[:n-1]
+		case uint16(opI64Mul):
+			s[n-2] = s[n-2] * s[n-1]
+			s = s[:n-1]
+		case uint16(opI64DivS):
+			a, b := int64(s[n-2]), int64(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			if a == math.MinInt64 && b == -1 {
+				trap("integer overflow")
+			}
+			s[n-2] = uint64(a / b)
+			s = s[:n-1]
+		case uint16(opI64DivU):
+			if s[n-1] == 0 {
+				trap("integer divide by zero")
+			}
+			s[n-2] = s[n-2] / s[n-1]
+			s = s[:n-1]
+		case uint16(opI64RemS):
+			a, b := int64(s[n-2]), int64(s[n-1])
+			if b == 0 {
+				trap("integer divide by zero")
+			}
+			if b == -1 {
+				s[n-2] = 0
+			} else {
+				s[n-2] = uint64(a % b)
+			}
+			s = s[:n-1]
+		case uint16(opI64RemU):
+			if s[n-1] == 0 {
+				trap("integer divide by zero")
+			}
+			s[n-2] = s[n-2] % s[n-1]
+			s = s[:n-1]
+		case uint16(opI64And):
+			s[n-2] = s[n-2] & s[n-1]
+			s = s[:n-1]
+		case uint16(opI64Or):
+			s[n-2] = s[n-2] | s[n-1]
+			s = s[:n-1]
+		case uint16(opI64Xor):
+			s[n-2] = s[n-2] ^ s[n-1]
+			s = s[:n-1]
+		case uint16(opI64Shl):
+			s[n-2] = s[n-2] << (s[n-1] & 63)
+			s = s[:n-1]
+		case uint16(opI64ShrS):
+			s[n-2] = uint64(int64(s[n-2]) >> (s[n-1] & 63))
+			s = s[:n-1]
+		case uint16(opI64ShrU):
+			s[n-2] = s[n-2] >> (s[n-1] & 63)
+			s = s[:n-1]
+		case uint16(opI64Rotl):
+			s[n-2] = bits.RotateLeft64(s[n-2], int(s[n-1]&63))
+			s = s[:n-1]
+		case uint16(opI64Rotr):
+			s[n-2] = bits.RotateLeft64(s[n-2], -int(s[n-1]&63))
+			s = s[:n-1]
+
+		// f32 arithmetic, abs, neg and copysign only change the sign bit
+		case uint16(opF32Abs):
+			s[n-1] = uint64(uint32(s[n-1]) &^ signBit32)
+		case uint16(opF32Neg):
+			s[n-1] = uint64(uint32(s[n-1]) ^ signBit32)
+		case uint16(opF32Ceil):
+			s[n-1] = fromF32(float32(math.Ceil(float64(f32(s[n-1])))))
+		case uint16(opF32Floor):
+			s[n-1] = fromF32(float32(math.Floor(float64(f32(s[n-1])))))
+		case uint16(opF32Trunc):
+			s[n-1] = fromF32(float32(math.Trunc(float64(f32(s[n-1])))))
+		case uint16(opF32Nearest):
+			s[n-1] = fromF32(float32(math.RoundToEven(float64(f32(s[n-1])))))
+		case uint16(opF32Sqrt):
+			s[n-1] = fromF32(float32(math.Sqrt(float64(f32(s[n-1])))))
+		case uint16(opF32Add):
+			s[n-2] = fromF32(f32(s[n-2]) + f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Sub):
+			s[n-2] = fromF32(f32(s[n-2]) - f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Mul):
+			s[n-2] = fromF32(f32(s[n-2]) * f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Div):
+			s[n-2] = fromF32(f32(s[n-2]) / f32(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF32Min):
+			s[n-2] = fromF32(float32(fmin(float64(f32(s[n-2])), float64(f32(s[n-1])))))
+			s = s[:n-1]
+		case uint16(opF32Max):
+			s[n-2] = fromF32(float32(fmax(float64(f32(s[n-2])), float64(f32(s[n-1])))))
+			s = s[:n-1]
+		case uint16(opF32Copysign):
+			s[n-2] = uint64(uint32(s[n-2])&^signBit32 | uint32(s[n-1])&signBit32)
+			s = s[:n-1]
+
+		// f64 arithmetic
+		case uint16(opF64Abs):
+			s[n-1] = s[n-1] &^ signBit64
+		case uint16(opF64Neg):
+			s[n-1] = s[n-1] ^ signBit64
+		case uint16(opF64Ceil):
+			s[n-1] = fromF64(math.Ceil(f64(s[n-1])))
+		case uint16(opF64Floor):
+			s[n-1] = fromF64(math.Floor(f64(s[n-1])))
+		case uint16(opF64Trunc):
+			s[n-1] = fromF64(math.Trunc(f64(s[n-1])))
+		case uint16(opF64Nearest):
+			s[n-1] = fromF64(math.RoundToEven(f64(s[n-1])))
+		case uint16(opF64Sqrt):
+			s[n-1] = fromF64(math.Sqrt(f64(s[n-1])))
+		case uint16(opF64Add):
+			s[n-2] = fromF64(f64(s[n-2]) + f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Sub):
+			s[n-2] = fromF64(f64(s[n-2]) - f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Mul):
+			s[n-2] = fromF64(f64(s[n-2]) * f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Div):
+			s[n-2] = fromF64(f64(s[n-2]) / f64(s[n-1]))
+			s = s[:n-1]
+		case uint16(opF64Min):
+			s[n-2] = fromF64(fmin(f64(s[n-2]), f64(s[n-1])))
+			s = s[:n-1]
+		case uint16(opF64Max):
+			s[n-2] = fromF64(fmax(f64(s[n-2]), f64(s[n-1])))
+			s = s[:n-1]
+		case uint16(opF64Copysign):
+			s[n-2] = s[n-2]&^signBit64 | s[n-1]&signBit64
+			s = s[:n-1]
+
+		// conversion
+		case uint16(opI32WrapI64):
+			s[n-1] = uint64(uint32(s[n-1]))
+		case uint16(opI32TruncF32S):
+			s[n-1] = truncI32(float64(f32(s[n-1])))
+		case uint16(opI32TruncF32U):
+			s[n-1] = truncU32(float64(f32(s[n-1])))
+		case uint16(opI32TruncF64S):
+			s[n-1] = truncI32(f64(s[n-1]))
+		case uint16(opI32TruncF64U):
+			s[n-1] = truncU32(f64(s[n-1]))
+		case uint16(opI64ExtendI32S):
+			s[n-1] = uint64(int64(int32(s[n-1])))
+		case uint16(opI64ExtendI32U):
+			s[n-1] = uint64(uint32(s[n-1]))
+		case uint16(opI64TruncF32S):
+			s[n-1] = truncI64(float64(f32(s[n-1])))
+		case uint16(opI64TruncF32U):
+			s[n-1] = truncU64(float64(f32(s[n-1])))
+		case uint16(opI64TruncF64S):
+			s[n-1] = truncI64(f64(s[n-1]))
+		case uint16(opI64TruncF64U):
+			s[n-1] = truncU64(f64(s[n-1]))
+		case uint16(opF32ConvertI32S):
+			s[n-1] = fromF32(float32(int32(s[n-1])))
+		case uint16(opF32ConvertI32U):
+			s[n-1] = fromF32(float32(uint32(s[n-1])))
+		case uint16(opF32ConvertI64S):
+			s[n-1] = fromF32(float32(int64(s[n-1])))
+		case uint16(opF32ConvertI64U):
+			s[n-1] = fromF32(u64ToF32(s[n-1]))
+		case uint16(opF32DemoteF64):
+			s[n-1] = fromF32(float32(f64(s[n-1])))
+		case uint16(opF64ConvertI32S):
+			s[n-1] = fromF64(float64(int32(s[n-1])))
+		case uint16(opF64ConvertI32U):
+			s[n-1] = fromF64(float64(uint32(s[n-1])))
+		case uint16(opF64ConvertI64S):
+			s[n-1] = fromF64(float64(int64(s[n-1])))
+		case uint16(opF64ConvertI64U):
+			s[n-1] = fromF64(u64ToF64(s[n-1]))
+		case uint16(opF64PromoteF32):
+			s[n-1] = fromF64(float64(f32(s[n-1])))
+		case uint16(opI32ReinterpretF32), uint16(opF32ReinterpretI32):
+			s[n-1] = uint64(uint32(s[n-1]))
+		case uint16(opI64ReinterpretF64), uint16(opF64ReinterpretI64):
+		case uint16(opI32Extend8S):
+			s[n-1] = uint64(uint32(int32(int8(s[n-1]))))
+		case uint16(opI32Extend16S):
+			s[n-1] = uint64(uint32(int32(int16(s[n-1]))))
+		case uint16(opI64Extend8S):
+			s[n-1] = uint64(int64(int8(s[n-1])))
+		case uint16(opI64Extend16S):
+			s[n-1] = uint64(int64(int16(s[n-1])))
+		case uint16(opI64Extend32S):
+			s[n-1] = uint64(int64(int32(s[n-1])))
+		case opI32TruncSatF32S:
+			s[n-1] = truncSatI32(float64(f32(s[n-1])))
+		case opI32TruncSatF32U:
+			s[n-1] = truncSatU32(float64(f32(s[n-1])))
+		case opI32TruncSatF64S:
+			s[n-1] = truncSatI32(f64(s[n-1]))
+		case opI32TruncSatF64U:
+			s[n-1] = truncSatU32(f64(s[n-1]))
+		case opI64TruncSatF32S:
+			s[n-1] = truncSatI64(float64(f32(s[n-1])))
+		case opI64TruncSatF32U:
+			s[n-1] = truncSatU64(float64(f32(s[n-1])))
+		case opI64TruncSatF64S:
+			s[n-1] = truncSatI64(f64(s[n-1]))
+		case opI64TruncSatF64U:
+			s[n-1] = truncSatU64(f64(s[n-1]))
+		default:
+			trap("unsupported instruction 0x%x", in.op)
+		}
+	}
+}
+
+// ea returns the effective address of the memory access
+func (inst *Instance) ea(base uint64, offset uint64, size uint64) uint64 {
+	a := uint64(uint32(base)) + offset
+	if a+size > uint64(len(inst.mem)) {
+		trap("out of bounds memory access")
+	}
+	return a
+}
+
+// grow grows the memory by the pages, it returns the old size in pages or
+// -1 if the memory can not grow
+func (inst *Instance) grow(delta uint32) uint64 {
+	old := uint32(len(inst.mem)) / PageSize
+	if uint64(old)+uint64(delta) > uint64(inst.maxPages) {
+		return uint64(math.MaxUint32)
+	}
+	inst.charge(uint64(delta) * growPageFuel)
+	inst.mem = append(inst.mem, make([]byte, int(delta)*int(PageSize))...)
+	return uint64(old)
+}
@@ -0,0 +1,311 @@
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+)
+
+// Default limits of the instance
+const (
+	DefaultMaxPages     uint32 = 256
+	DefaultMaxCallDepth int    = 512
+	DefaultMaxStack     int    = 1 << 20
+)
+
+// fuel charged in addition to the instruction
+const (
+	growPageFuel uint64 = 1024
+	byteFuelDiv  uint64 = 64
+)
+
+// ErrOutOfFuel is returned when the execution uses all the fuel
+var ErrOutOfFuel = errors.New("wasm execution is out of fuel")
+
+// Trap is the runtime error of the WASM code
+type Trap struct {
+	Msg string
+}
+
+func (t *Trap) Error() string {
+	return "wasm trap, " + t.Msg
+}
+
+func trap(format string, args ...interface{}) {
+	panic(&Trap{Msg: fmt.Sprintf(format, args...)})
+}
+
+// hostError carries the error of the host function through the panic
+type hostError struct {
+	err error
+}
+
+// Config is the limits of the instance, the zero values use the defaults.
+// Fuel is the number of the instructions the instance can execute, the
+// execution does not depend on the time so that all nodes get the same result
+type Config struct {
+	Fuel         uint64
+	MaxPages     uint32
+	MaxCallDepth int
+	MaxStack     int
+}
+
+// HostFunc is the function provided by the host to the module
+type HostFunc struct {
+	Type FuncType
+	Func func(inst *Instance, args []uint64) ([]uint64, error)
+}
+
+// Imports are the host functions by the module name and the function name
+type Imports map[string]map[string]*HostFunc
+
+// Instance is the instantiated module, the instance is not safe for the
+// concurrent use
+type Instance struct {
+	m        *Module
+	host     []*HostFunc
+	mem      []byte
+	maxPages uint32
+	globals  []uint64
+	table    []int64
+	dropped  []bool
+	stack    []uint64
+	labels   []label
+	depth    int
+	fuel     uint64
+	limit    uint64
+	cfg      Config
+}
+
+// label is the branch target of the block
+type label struct {
+	height int
+	arity  int
+	cont   int
+}
+
+// Instantiate creates the instance of the module with the host functions,
+// the active segments are applied and the start function is executed
+func (m *Module) Instantiate(imports Imports, cfg Config) (inst *Instance, err error) {
+	if cfg.MaxPages == 0 {
+		cfg.MaxPages = DefaultMaxPages
+	}
+	if cfg.MaxCallDepth == 0 {
+		cfg.MaxCallDepth = DefaultMaxCallDepth
+	}
+	if cfg.MaxStack == 0 {
+		cfg.MaxStack = DefaultMaxStack
+	}
+	inst = &Instance{
+		m:       m,
+		host:    make([]*HostFunc, len(m.imports)),
+		dropped: make([]bool, len(m.datas)),
+		stack:   make([]uint64, 0, 1024),
+		labels:  make([]label, 0, 64),
+		fuel:    cfg.Fuel,
+		limit:   cfg.Fuel,
+		cfg:     cfg,
+	}
+	if cfg.Fuel == 0 {
+		inst.fuel = math.MaxUint64
+		inst.limit = math.MaxUint64
+	}
+	for i, ie := range m.imports {
+		hf, ok := imports[ie.module][ie.name]
+		if !ok || hf == nil {
+			return nil, fmt.Errorf("unknown import %s.%s", ie.module, ie.name)
+		}
+		if !hf.Type.equal(&m.types[ie.typeIdx]) {
+			return nil, fmt.Errorf("import %s.%s type mismatch, expected %v", ie.module, ie.name, m.types[ie.typeIdx])
+		}
+		inst.host[i] = hf
+	}
+	if m.memory != nil {
+		inst.maxPages = cfg.MaxPages
+		if m.memory.hasMax && m.memory.max < inst.maxPages {
+			inst.maxPages = m.memory.max
+		}
+		if m.memory.min > inst.maxPages {
+			return nil, fmt.Errorf("module memory %d pages exceeds the limit %d", m.memory.min, inst.maxPages)
+		}
+		inst.mem = make([]byte, int(m.memory.min)*int(PageSize))
+	}
+	err = inst.protect(func() {
+		inst.init()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+func (inst *Instance) init() {
+	m := inst.m
+	inst.globals = make([]uint64, 0, len(m.globals))
+	for _, g := range m.globals {
+		inst.globals = append(inst.globals, evalConst(g.init, inst.globals))
+	}
+	if m.table != nil {
+		inst.table = make([]int64, m.table.min)
+		for i := range inst.table {
+			inst.table[i] = -1
+		}
+	}
+	for _, es := range m.elems {
+		if es.passive {
+			continue
+		}
+		off := uint64(uint32(evalConst(es.offset, inst.globals)))
+		if off+uint64(len(es.funcs)) > uint64(len(inst.table)) {
+			trap("element segment is out of bounds")
+		}
+		for i, f := range es.funcs {
+			inst.table[off+uint64(i)] = int64(f)
+		}
+	}
+	for i, ds := range m.datas {
+		if ds.passive {
+			continue
+		}
+		off := uint64(uint32(evalConst(ds.offset, inst.globals)))
+		if off+uint64(len(ds.init)) > uint64(len(inst.mem)) {
+			trap("data segment is out of bounds")
+		}
+		copy(inst.mem[off:], ds.init)
+		inst.dropped[i] = true
+	}
+	if m.start >= 0 {
+		inst.invoke(uint32(m.start))
+	}
+}
+
+// protect runs the function and converts the panic of the execution to the error
+func (inst *Instance) protect(fn func()) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		inst.stack = inst.stack[:0]
+		inst.labels = inst.labels[:0]
+		inst.depth = 0
+		switch e := r.(type) {
+		case *Trap:
+			err = e
+		case hostError:
+			err = e.err
+		case error:
+			if e == ErrOutOfFuel {
+				err = e
+				return
+			}
+			if _, ok := e.(runtime.Error); ok {
+				// the code is not validated, the invalid code fails here
+				err = &Trap{Msg: "invalid code, " + e.Error()}
+				return
+			}
+			panic(r)
+		default:
+			panic(r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+// Call calls the exported function with the args, the values are the bits
+// of the WASM values, i32 values are zero extended
+func (inst *Instance) Call(name string, args ...uint64) ([]uint64, error) {
+	e, ok := inst.m.exports[name]
+	if !ok || e.kind != ExternFunc {
+		return nil, fmt.Errorf("function %s is not exported", name)
+	}
+	ft := inst.m.funcType(e.idx)
+	if len(args) != len(ft.Params) {
+		return nil, fmt.Errorf("function %s expects %d args, got %d", name, len(ft.Params), len(args))
+	}
+	var res []uint64
+	err := inst.protect(func() {
+		inst.stack = append(inst.stack[:0], args...)
+		inst.invoke(e.idx)
+		res = append([]uint64{}, inst.stack[len(inst.stack)-len(ft.Results):]...)
+		inst.stack = inst.stack[:0]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// FuelUsed returns the fuel used by the instance
+func (inst *Instance) FuelUsed() uint64 {
+	return inst.limit - inst.fuel
+}
+
+// UseFuel charges the fuel, host functions charge the fuel for their work
+func (inst *Instance) UseFuel(n uint64) error {
+	if n > inst.fuel {
+		inst.fuel = 0
+		return ErrOutOfFuel
+	}
+	inst.fuel -= n
+	return nil
+}
+
+func (inst *Instance) charge(n uint64) {
+	if inst.UseFuel(n) != nil {
+		panic(ErrOutOfFuel)
+	}
+}
+
+// Memory returns the memory of the instance, the slice is valid until the
+// memory grows
+func (inst *Instance) Memory() []byte {
+	return inst.mem
+}
+
+// Read returns the copy of the memory
+func (inst *Instance) Read(ptr uint32, n uint32) ([]byte, error) {
+	if uint64(ptr)+uint64(n) > uint64(len(inst.mem)) {
+		return nil, &Trap{Msg: "out of bounds memory access"}
+	}
+	return append([]byte{}, inst.mem[ptr:ptr+n]...), nil
+}
+
+// Write writes the data to the memory
+func (inst *Instance) Write(ptr uint32, data []byte) error {
+	if uint64(ptr)+uint64(len(data)) > uint64(len(inst.mem)) {
+		return &Trap{Msg: "out of bounds memory access"}
+	}
+	copy(inst.mem[ptr:], data)
+	return nil
+}
+
+// invoke calls the function with the args on the stack, the results are
+// left on the stack
+func (inst *Instance) invoke(idx uint32) {
+	m := inst.m
+	if idx < m.numImport {
+		hf := inst.host[idx]
+		np := len(hf.Type.Params)
+		n := len(inst.stack)
+		args := append([]uint64{}, inst.stack[n-np:]...)
+		inst.stack = inst.stack[:n-np]
+		res, err := hf.Func(inst, args)
+		if err != nil {
+			panic(hostError{err: err})
+		}
+		if len(res) != len(hf.Type.Results) {
+			trap("host function %s.%s returned %d results", m.imports[idx].module, m.imports[idx].name, len(res))
+		}
+		inst.stack = append(inst.stack, res...)
+		return
+	}
+	inst.depth++
+	if inst.depth > inst.cfg.MaxCallDepth {
+		trap("call stack exhausted")
+	}
+	inst.run(m.funcs[idx-m.numImport])
+	inst.depth--
+}
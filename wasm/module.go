@@ -0,0 +1,654 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ValueType is the type of the WASM value
+type ValueType byte
+
+// Value types, the reference types are only used by the table
+const (
+	I32       ValueType = 0x7F
+	I64       ValueType = 0x7E
+	F32       ValueType = 0x7D
+	F64       ValueType = 0x7C
+	FuncRef   ValueType = 0x70
+	ExternRef ValueType = 0x6F
+)
+
+const (
+	wasmMagic   uint32 = 0x6d736100
+	wasmVersion uint32 = 1
+	// PageSize is the size of the memory page
+	PageSize uint32 = 65536
+	maxPages uint32 = 65536
+)
+
+// Section ids
+const (
+	customSection byte = iota
+	typeSection
+	importSection
+	functionSection
+	tableSection
+	memorySection
+	globalSection
+	exportSection
+	startSection
+	elementSection
+	codeSection
+	dataSection
+	dataCountSection
+)
+
+// External kinds of the imports and the exports
+const (
+	ExternFunc   byte = 0
+	ExternTable  byte = 1
+	ExternMemory byte = 2
+	ExternGlobal byte = 3
+)
+
+const (
+	maxFuncLocals  uint32 = 50000
+	maxTableSize   uint32 = 1 << 20
+	maxSectionSize uint32 = 1 << 26
+)
+
+// FuncType is the signature of the function
+type FuncType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+func (ft *FuncType) equal(o *FuncType) bool {
+	return bytes.Equal(valueBytes(ft.Params), valueBytes(o.Params)) && bytes.Equal(valueBytes(ft.Results), valueBytes(o.Results))
+}
+
+func (ft FuncType) String() string {
+	return fmt.Sprintf("%v -> %v", ft.Params, ft.Results)
+}
+
+func (vt ValueType) String() string {
+	switch vt {
+	case I32:
+		return "i32"
+	case I64:
+		return "i64"
+	case F32:
+		return "f32"
+	case F64:
+		return "f64"
+	case FuncRef:
+		return "funcref"
+	case ExternRef:
+		return "externref"
+	default:
+		return fmt.Sprintf("0x%x", byte(vt))
+	}
+}
+
+func valueBytes(vts []ValueType) []byte {
+	b := make([]byte, len(vts))
+	for i, vt := range vts {
+		b[i] = byte(vt)
+	}
+	return b
+}
+
+type limits struct {
+	min    uint32
+	max    uint32
+	hasMax bool
+}
+
+type importEntry struct {
+	module  string
+	name    string
+	kind    byte
+	typeIdx uint32
+}
+
+type globalDef struct {
+	vt   ValueType
+	mut  bool
+	init []byte
+}
+
+type exportEntry struct {
+	kind byte
+	idx  uint32
+}
+
+type elemSegment struct {
+	passive bool
+	table   uint32
+	offset  []byte
+	funcs   []uint32
+}
+
+type dataSegment struct {
+	passive bool
+	offset  []byte
+	init    []byte
+}
+
+type funcBody struct {
+	locals []ValueType
+	code   []byte
+}
+
+// Module is the decoded WASM module, the function bodies are compiled when
+// the module is decoded and the module can be instantiated many times
+type Module struct {
+	types     []FuncType
+	imports   []importEntry
+	funcTypes []uint32
+	table     *limits
+	memory    *limits
+	globals   []globalDef
+	exports   map[string]exportEntry
+	start     int64
+	elems     []elemSegment
+	datas     []dataSegment
+	bodies    []funcBody
+	funcs     []*function
+	numImport uint32
+}
+
+// Decode decodes and compiles the WASM binary
+func Decode(b []byte) (m *Module, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			m = nil
+			err = fmt.Errorf("invalid wasm module, %v", r)
+		}
+	}()
+	m = &Module{exports: make(map[string]exportEntry), start: -1}
+	r := &reader{b: b}
+	if r.u32le() != wasmMagic {
+		return nil, fmt.Errorf("invalid wasm module, magic number mismatch")
+	}
+	if v := r.u32le(); v != wasmVersion {
+		return nil, fmt.Errorf("unsupported wasm version %d", v)
+	}
+	last := byte(0)
+	for !r.eof() {
+		id := r.byte()
+		size := r.u32()
+		if size > maxSectionSize {
+			return nil, fmt.Errorf("invalid wasm module, section %d is too large", id)
+		}
+		sr := &reader{b: r.bytes(size)}
+		if id != customSection {
+			// data count section comes before the code section
+			order := id
+			if id == dataCountSection {
+				order = codeSection
+			} else if id >= codeSection {
+				order = id + 1
+			}
+			if order <= last {
+				return nil, fmt.Errorf("invalid wasm module, section %d is out of order", id)
+			}
+			last = order
+		}
+		err = m.decodeSection(id, sr)
+		if err != nil {
+			return nil, err
+		}
+		if !sr.eof() && id != customSection {
+			return nil, fmt.Errorf("invalid wasm module, section %d size mismatch", id)
+		}
+	}
+	if len(m.bodies) != len(m.funcTypes) {
+		return nil, fmt.Errorf("invalid wasm module, function and code count mismatch")
+	}
+	m.funcs = make([]*function, len(m.bodies))
+	for i := range m.bodies {
+		m.funcs[i], err = m.compile(uint32(i))
+		if err != nil {
+			return nil, fmt.Errorf("invalid wasm module, function %d, %v", i, err)
+		}
+	}
+	m.bodies = nil
+	err = m.check()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Module) decodeSection(id byte, r *reader) error {
+	switch id {
+	case customSection:
+	case typeSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			if r.byte() != 0x60 {
+				return fmt.Errorf("invalid wasm module, invalid function type")
+			}
+			ft := FuncType{Params: r.valueTypes(), Results: r.valueTypes()}
+			m.types = append(m.types, ft)
+		}
+	case importSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			ie := importEntry{module: r.name(), name: r.name(), kind: r.byte()}
+			if ie.kind != ExternFunc {
+				return fmt.Errorf("unsupported import %s.%s, only functions can be imported", ie.module, ie.name)
+			}
+			ie.typeIdx = r.u32()
+			if ie.typeIdx >= uint32(len(m.types)) {
+				return fmt.Errorf("invalid wasm module, invalid type of import %s.%s", ie.module, ie.name)
+			}
+			m.imports = append(m.imports, ie)
+		}
+		m.numImport = uint32(len(m.imports))
+	case functionSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			ti := r.u32()
+			if ti >= uint32(len(m.types)) {
+				return fmt.Errorf("invalid wasm module, invalid function type index")
+			}
+			m.funcTypes = append(m.funcTypes, ti)
+		}
+	case tableSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			if i > 0 {
+				return fmt.Errorf("unsupported wasm module, multiple tables")
+			}
+			if ValueType(r.byte()) != FuncRef {
+				return fmt.Errorf("unsupported wasm module, table of extern references")
+			}
+			l := r.limits()
+			if l.min > maxTableSize {
+				return fmt.Errorf("unsupported wasm module, table is too large")
+			}
+			m.table = &l
+		}
+	case memorySection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			if i > 0 {
+				return fmt.Errorf("unsupported wasm module, multiple memories")
+			}
+			l := r.limits()
+			if l.min > maxPages || (l.hasMax && (l.max > maxPages || l.max < l.min)) {
+				return fmt.Errorf("invalid wasm module, invalid memory limits")
+			}
+			m.memory = &l
+		}
+	case globalSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			g := globalDef{vt: ValueType(r.byte())}
+			g.mut = r.byte() == 1
+			g.init = r.constExpr()
+			m.globals = append(m.globals, g)
+		}
+	case exportSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			name := r.name()
+			e := exportEntry{kind: r.byte(), idx: r.u32()}
+			if _, ok := m.exports[name]; ok {
+				return fmt.Errorf("invalid wasm module, duplicate export %s", name)
+			}
+			m.exports[name] = e
+		}
+	case startSection:
+		m.start = int64(r.u32())
+	case elementSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			var es elemSegment
+			flags := r.u32()
+			switch flags {
+			case 0:
+				es.offset = r.constExpr()
+			case 1, 3:
+				es.passive = true
+				if r.byte() != 0x00 {
+					return fmt.Errorf("unsupported wasm module, element kind")
+				}
+			case 2:
+				es.table = r.u32()
+				es.offset = r.constExpr()
+				if r.byte() != 0x00 {
+					return fmt.Errorf("unsupported wasm module, element kind")
+				}
+			default:
+				return fmt.Errorf("unsupported wasm module, element segment flags %d", flags)
+			}
+			cnt := r.u32()
+			for j := uint32(0); j < cnt; j++ {
+				es.funcs = append(es.funcs, r.u32())
+			}
+			m.elems = append(m.elems, es)
+		}
+	case codeSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			size := r.u32()
+			br := &reader{b: r.bytes(size)}
+			var fb funcBody
+			ln := br.u32()
+			total := uint32(0)
+			for j := uint32(0); j < ln; j++ {
+				cnt := br.u32()
+				vt := ValueType(br.byte())
+				total += cnt
+				if total > maxFuncLocals {
+					return fmt.Errorf("unsupported wasm module, too many locals")
+				}
+				for k := uint32(0); k < cnt; k++ {
+					fb.locals = append(fb.locals, vt)
+				}
+			}
+			fb.code = br.b[br.p:]
+			m.bodies = append(m.bodies, fb)
+		}
+	case dataSection:
+		n := r.u32()
+		for i := uint32(0); i < n; i++ {
+			var ds dataSegment
+			flags := r.u32()
+			switch flags {
+			case 0:
+				ds.offset = r.constExpr()
+			case 1:
+				ds.passive = true
+			case 2:
+				if r.u32() != 0 {
+					return fmt.Errorf("unsupported wasm module, multiple memories")
+				}
+				ds.offset = r.constExpr()
+			default:
+				return fmt.Errorf("unsupported wasm module, data segment flags %d", flags)
+			}
+			ds.init = r.bytes(r.u32())
+			m.datas = append(m.datas, ds)
+		}
+	case dataCountSection:
+		r.u32()
+	default:
+		return fmt.Errorf("invalid wasm module, unknown section %d", id)
+	}
+	return nil
+}
+
+// check validates the indices used by the module outside of the code
+func (m *Module) check() error {
+	nf := m.numImport + uint32(len(m.funcTypes))
+	for name, e := range m.exports {
+		switch e.kind {
+		case ExternFunc:
+			if e.idx >= nf {
+				return fmt.Errorf("invalid wasm module, invalid export %s", name)
+			}
+		case ExternMemory:
+			if m.memory == nil || e.idx != 0 {
+				return fmt.Errorf("invalid wasm module, invalid export %s", name)
+			}
+		case ExternTable:
+			if m.table == nil || e.idx != 0 {
+				return fmt.Errorf("invalid wasm module, invalid export %s", name)
+			}
+		case ExternGlobal:
+			if e.idx >= uint32(len(m.globals)) {
+				return fmt.Errorf("invalid wasm module, invalid export %s", name)
+			}
+		default:
+			return fmt.Errorf("invalid wasm module, invalid export %s", name)
+		}
+	}
+	if m.start >= 0 {
+		if uint32(m.start) >= nf {
+			return fmt.Errorf("invalid wasm module, invalid start function")
+		}
+		ft := m.funcType(uint32(m.start))
+		if len(ft.Params) != 0 || len(ft.Results) != 0 {
+			return fmt.Errorf("invalid wasm module, invalid start function type")
+		}
+	}
+	for _, es := range m.elems {
+		if !es.passive && (m.table == nil || es.table != 0) {
+			return fmt.Errorf("invalid wasm module, element segment without table")
+		}
+		for _, f := range es.funcs {
+			if f >= nf {
+				return fmt.Errorf("invalid wasm module, invalid function in element segment")
+			}
+		}
+	}
+	for _, ds := range m.datas {
+		if !ds.passive && m.memory == nil {
+			return fmt.Errorf("invalid wasm module, data segment without memory")
+		}
+	}
+	return nil
+}
+
+func (m *Module) funcType(idx uint32) *FuncType {
+	if idx < m.numImport {
+		return &m.types[m.imports[idx].typeIdx]
+	}
+	return &m.types[m.funcTypes[idx-m.numImport]]
+}
+
+// ExportedFunc returns the type of the exported function
+func (m *Module) ExportedFunc(name string) (*FuncType, bool) {
+	e, ok := m.exports[name]
+	if !ok || e.kind != ExternFunc {
+		return nil, false
+	}
+	return m.funcType(e.idx), true
+}
+
+// Imports returns the module and the name of the imported functions
+func (m *Module) Imports() [][2]string {
+	imps := make([][2]string, 0, len(m.imports))
+	for _, ie := range m.imports {
+		imps = append(imps, [2]string{ie.module, ie.name})
+	}
+	return imps
+}
+
+// reader reads the binary format, it panics on the malformed input and the
+// panic is recovered by the decoder
+type reader struct {
+	b []byte
+	p int
+}
+
+func (r *reader) eof() bool {
+	return r.p >= len(r.b)
+}
+
+func (r *reader) byte() byte {
+	if r.p >= len(r.b) {
+		panic("unexpected end")
+	}
+	v := r.b[r.p]
+	r.p++
+	return v
+}
+
+func (r *reader) bytes(n uint32) []byte {
+	if uint64(r.p)+uint64(n) > uint64(len(r.b)) {
+		panic("unexpected end")
+	}
+	v := r.b[r.p : r.p+int(n)]
+	r.p += int(n)
+	return v
+}
+
+func (r *reader) u32le() uint32 {
+	return binary.LittleEndian.Uint32(r.bytes(4))
+}
+
+func (r *reader) u64le() uint64 {
+	return binary.LittleEndian.Uint64(r.bytes(8))
+}
+
+func (r *reader) u32() uint32 {
+	v := r.uleb(32)
+	return uint32(v)
+}
+
+func (r *reader) uleb(bits uint) uint64 {
+	var v uint64
+	var shift uint
+	for {
+		b := r.byte()
+		if shift >= bits || (shift+7 > bits && uint64(b&0x7f)>>(bits-shift) != 0) {
+			panic("integer is too large")
+		}
+		v |= uint64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			return v
+		}
+	}
+}
+
+func (r *reader) sleb(bits uint) int64 {
+	var v int64
+	var shift uint
+	var b byte
+	for {
+		b = r.byte()
+		if shift >= bits {
+			panic("integer is too large")
+		}
+		v |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if shift < 64 && b&0x40 != 0 {
+		v |= -1 << shift
+	}
+	if bits < 64 && (v < -(1<<(bits-1)) || v >= 1<<(bits-1)) {
+		panic("integer is too large")
+	}
+	return v
+}
+
+func (r *reader) name() string {
+	b := r.bytes(r.u32())
+	if !utf8.Valid(b) {
+		panic("invalid name")
+	}
+	return string(b)
+}
+
+func (r *reader) valueTypes() []ValueType {
+	n := r.u32()
+	vts := make([]ValueType, 0, n)
+	for i := uint32(0); i < n; i++ {
+		vt := ValueType(r.byte())
+		switch vt {
+		case I32, I64, F32, F64, FuncRef, ExternRef:
+		default:
+			panic(fmt.Sprintf("invalid value type 0x%x", byte(vt)))
+		}
+		vts = append(vts, vt)
+	}
+	return vts
+}
+
+func (r *reader) limits() limits {
+	var l limits
+	switch r.byte() {
+	case 0x00:
+		l.min = r.u32()
+	case 0x01:
+		l.min = r.u32()
+		l.max = r.u32()
+		l.hasMax = true
+	default:
+		panic("invalid limits")
+	}
+	return l
+}
+
+// constExpr returns the constant expression without the end opcode
+func (r *reader) constExpr() []byte {
+	st := r.p
+	for {
+		op := r.byte()
+		switch op {
+		case opEnd:
+			return r.b[st : r.p-1]
+		case opI32Const:
+			r.sleb(32)
+		case opI64Const:
+			r.sleb(64)
+		case opF32Const:
+			r.bytes(4)
+		case opF64Const:
+			r.bytes(8)
+		case opGlobalGet:
+			r.u32()
+		case opI32Add, opI32Sub, opI32Mul, opI64Add, opI64Sub, opI64Mul:
+		default:
+			panic(fmt.Sprintf("unsupported constant expression opcode 0x%x", op))
+		}
+	}
+}
+
+// evalConst evaluates the constant expression with the globals
+func evalConst(expr []byte, globals []uint64) uint64 {
+	r := &reader{b: expr}
+	stack := make([]uint64, 0, 2)
+	pop := func() uint64 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v
+	}
+	for !r.eof() {
+		switch op := r.byte(); op {
+		case opI32Const:
+			stack = append(stack, uint64(uint32(r.sleb(32))))
+		case opI64Const:
+			stack = append(stack, uint64(r.sleb(64)))
+		case opF32Const:
+			stack = append(stack, uint64(r.u32le()))
+		case opF64Const:
+			stack = append(stack, r.u64le())
+		case opGlobalGet:
+			stack = append(stack, globals[r.u32()])
+		case opI32Add:
+			b, a := pop(), pop()
+			stack = append(stack, uint64(uint32(a)+uint32(b)))
+		case opI32Sub:
+			b, a := pop(), pop()
+			stack = append(stack, uint64(uint32(a)-uint32(b)))
+		case opI32Mul:
+			b, a := pop(), pop()
+			stack = append(stack, uint64(uint32(a)*uint32(b)))
+		case opI64Add:
+			b, a := pop(), pop()
+			stack = append(stack, a+b)
+		case opI64Sub:
+			b, a := pop(), pop()
+			stack = append(stack, a-b)
+		case opI64Mul:
+			b, a := pop(), pop()
+			stack = append(stack, a*b)
+		}
+	}
+	if len(stack) != 1 {
+		panic("invalid constant expression")
+	}
+	return stack[0]
+}
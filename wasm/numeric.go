@@ -0,0 +1,186 @@
+package wasm
+
+import (
+	"math"
+)
+
+// NaN results are canonical so that the result does not depend on the
+// hardware
+const (
+	canonNaN32 uint32 = 0x7fc00000
+	canonNaN64 uint64 = 0x7ff8000000000000
+	signBit32  uint32 = 0x80000000
+	signBit64  uint64 = 0x8000000000000000
+)
+
+func f32(v uint64) float32 {
+	return math.Float32frombits(uint32(v))
+}
+
+func f64(v uint64) float64 {
+	return math.Float64frombits(v)
+}
+
+func fromF32(x float32) uint64 {
+	if x != x {
+		return uint64(canonNaN32)
+	}
+	return uint64(math.Float32bits(x))
+}
+
+func fromF64(x float64) uint64 {
+	if x != x {
+		return canonNaN64
+	}
+	return math.Float64bits(x)
+}
+
+func b2u(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func fmin(a, b float64) float64 {
+	switch {
+	case a != a || b != b:
+		return math.NaN()
+	case a == b:
+		// -0 is less than +0
+		if math.Signbit(a) {
+			return a
+		}
+		return b
+	case a < b:
+		return a
+	default:
+		return b
+	}
+}
+
+func fmax(a, b float64) float64 {
+	switch {
+	case a != a || b != b:
+		return math.NaN()
+	case a == b:
+		if math.Signbit(a) {
+			return b
+		}
+		return a
+	case a > b:
+		return a
+	default:
+		return b
+	}
+}
+
+// truncation of the float to the integer, it traps for the NaN and the
+// values out of the range
+func truncI32(x float64) uint64 {
+	if x != x {
+		trap("invalid conversion to integer")
+	}
+	t := math.Trunc(x)
+	if t < math.MinInt32 || t > math.MaxInt32 {
+		trap("integer overflow")
+	}
+	return uint64(uint32(int32(t)))
+}
+
+func truncU32(x float64) uint64 {
+	if x != x {
+		trap("invalid conversion to integer")
+	}
+	t := math.Trunc(x)
+	if t <= -1 || t > math.MaxUint32 {
+		trap("integer overflow")
+	}
+	return uint64(uint32(t))
+}
+
+func truncI64(x float64) uint64 {
+	if x != x {
+		trap("invalid conversion to integer")
+	}
+	t := math.Trunc(x)
+	if t < math.MinInt64 || t >= 9223372036854775808.0 {
+		trap("integer overflow")
+	}
+	return uint64(int64(t))
+}
+
+func truncU64(x float64) uint64 {
+	if x != x {
+		trap("invalid conversion to integer")
+	}
+	t := math.Trunc(x)
+	if t <= -1 || t >= 18446744073709551616.0 {
+		trap("integer overflow")
+	}
+	return uint64(t)
+}
+
+// saturating truncation of the float to the integer
+func truncSatI32(x float64) uint64 {
+	switch {
+	case x != x:
+		return 0
+	case x <= math.MinInt32:
+		return uint64(signBit32)
+	case x >= math.MaxInt32:
+		return uint64(uint32(math.MaxInt32))
+	}
+	return uint64(uint32(int32(x)))
+}
+
+func truncSatU32(x float64) uint64 {
+	switch {
+	case x != x || x <= 0:
+		return 0
+	case x >= math.MaxUint32:
+		return uint64(math.MaxUint32)
+	}
+	return uint64(uint32(x))
+}
+
+func truncSatI64(x float64) uint64 {
+	switch {
+	case x != x:
+		return 0
+	case x <= math.MinInt64:
+		return signBit64
+	case x >= 9223372036854775808.0:
+		return uint64(math.MaxInt64)
+	}
+	return uint64(int64(x))
+}
+
+func truncSatU64(x float64) uint64 {
+	switch {
+	case x != x || x <= 0:
+		return 0
+	case x >= 18446744073709551616.0:
+		return math.MaxUint64
+	}
+	return uint64(x)
+}
+
+// u64ToF32 converts with the single rounding, the conversion through the
+// float64 would round twice
+func u64ToF32(v uint64) float32 {
+	if v < 1<<63 {
+		return float32(int64(v))
+	}
+	// keep the lost bit as the sticky bit for the rounding
+	h := v>>1 | v&1
+	return float32(int64(h)) * 2
+}
+
+func u64ToF64(v uint64) float64 {
+	if v < 1<<63 {
+		return float64(int64(v))
+	}
+	h := v>>1 | v&1
+	return float64(int64(h)) * 2
+}
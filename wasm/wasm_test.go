@@ -0,0 +1,317 @@
+package wasm
+
+import (
+	"errors"
+	"testing"
+)
+
+// the test modules are assembled by hand
+
+func uleb(v uint64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+		if v == 0 {
+			return b
+		}
+	}
+}
+
+func sleb(v int64) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func vec(items ...[]byte) []byte {
+	b := uleb(uint64(len(items)))
+	for _, it := range items {
+		b = append(b, it...)
+	}
+	return b
+}
+
+func str(s string) []byte {
+	return append(uleb(uint64(len(s))), s...)
+}
+
+func cat(bs ...[]byte) []byte {
+	var b []byte
+	for _, x := range bs {
+		b = append(b, x...)
+	}
+	return b
+}
+
+func i32c(v int32) []byte {
+	return append([]byte{opI32Const}, sleb(int64(v))...)
+}
+
+func i64c(v int64) []byte {
+	return append([]byte{opI64Const}, sleb(v)...)
+}
+
+func ftype(params, results []ValueType) []byte {
+	return cat([]byte{0x60}, vec(vtBytes(params)...), vec(vtBytes(results)...))
+}
+
+func vtBytes(vts []ValueType) [][]byte {
+	b := make([][]byte, 0, len(vts))
+	for _, vt := range vts {
+		b = append(b, []byte{byte(vt)})
+	}
+	return b
+}
+
+// body builds the function body with the i64 locals
+func body(nI64 int, code ...[]byte) []byte {
+	var locals []byte
+	if nI64 > 0 {
+		locals = vec(cat(uleb(uint64(nI64)), []byte{byte(I64)}))
+	} else {
+		locals = vec()
+	}
+	b := cat(locals, cat(code...), []byte{opEnd})
+	return append(uleb(uint64(len(b))), b...)
+}
+
+type testModule struct {
+	types   [][]byte
+	imports [][]byte
+	funcs   [][]byte
+	bodies  [][]byte
+	memory  []byte
+	table   []byte
+	elems   [][]byte
+	exports [][]byte
+	datas   [][]byte
+}
+
+func (tm *testModule) bytes() []byte {
+	b := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	sec := func(id byte, content []byte) {
+		b = append(b, id)
+		b = append(b, uleb(uint64(len(content)))...)
+		b = append(b, content...)
+	}
+	sec(typeSection, vec(tm.types...))
+	if len(tm.imports) > 0 {
+		sec(importSection, vec(tm.imports...))
+	}
+	sec(functionSection, vec(tm.funcs...))
+	if tm.table != nil {
+		sec(tableSection, vec(tm.table))
+	}
+	if tm.memory != nil {
+		sec(memorySection, vec(tm.memory))
+	}
+	sec(exportSection, vec(tm.exports...))
+	if len(tm.elems) > 0 {
+		sec(elementSection, vec(tm.elems...))
+	}
+	sec(codeSection, vec(tm.bodies...))
+	if len(tm.datas) > 0 {
+		sec(dataSection, vec(tm.datas...))
+	}
+	return b
+}
+
+func export(name string, kind byte, idx uint32) []byte {
+	return cat(str(name), []byte{kind}, uleb(uint64(idx)))
+}
+
+func newInstance(t *testing.T, tm *testModule, imports Imports, cfg Config) *Instance {
+	m, err := Decode(tm.bytes())
+	if err != nil {
+		t.Fatalf("failed to decode the module, %v", err)
+	}
+	inst, err := m.Instantiate(imports, cfg)
+	if err != nil {
+		t.Fatalf("failed to instantiate the module, %v", err)
+	}
+	return inst
+}
+
+func TestExecution(t *testing.T) {
+	i64 := []ValueType{I64}
+	i32 := []ValueType{I32}
+	tm := &testModule{
+		types: [][]byte{ftype(i64, i64), ftype(i32, i32)},
+		funcs: [][]byte{{0}, {0}, {0}, {1}, {1}},
+		exports: [][]byte{
+			export("fact", ExternFunc, 0),
+			export("fib", ExternFunc, 1),
+			export("div", ExternFunc, 2),
+			export("table", ExternFunc, 3),
+			export("indirect", ExternFunc, 4),
+		},
+		table: cat([]byte{byte(FuncRef), 0x00}, uleb(2)),
+		elems: [][]byte{cat(uleb(0), i32c(0), []byte{opEnd}, vec(uleb(3), uleb(4)))},
+	}
+	tm.bodies = [][]byte{
+		// fact, the loop multiplies the result until n is zero
+		body(1,
+			i64c(1), []byte{opLocalSet, 1},
+			[]byte{opBlock, 0x40, opLoop, 0x40},
+			[]byte{opLocalGet, 0, opI64Eqz, opBrIf, 1},
+			[]byte{opLocalGet, 1, opLocalGet, 0, opI64Mul, opLocalSet, 1},
+			[]byte{opLocalGet, 0}, i64c(1), []byte{opI64Sub, opLocalSet, 0},
+			[]byte{opBr, 0, opEnd, opEnd},
+			[]byte{opLocalGet, 1},
+		),
+		// fib, recursive with if else
+		body(0,
+			[]byte{opLocalGet, 0}, i64c(2), []byte{opI64LtS},
+			[]byte{opIf, byte(I64), opLocalGet, 0, opElse},
+			[]byte{opLocalGet, 0}, i64c(1), []byte{opI64Sub, opCall, 1},
+			[]byte{opLocalGet, 0}, i64c(2), []byte{opI64Sub, opCall, 1},
+			[]byte{opI64Add, opEnd},
+		),
+		// div, 100 / n
+		body(0, i64c(100), []byte{opLocalGet, 0, opI64DivS}),
+		// table, br_table with the default
+		body(0,
+			[]byte{opBlock, 0x40, opBlock, 0x40, opBlock, 0x40},
+			[]byte{opLocalGet, 0, opBrTable}, vec([]byte{0}, []byte{1}), []byte{2},
+			[]byte{opEnd}, i32c(10), []byte{opReturn},
+			[]byte{opEnd}, i32c(11), []byte{opReturn},
+			[]byte{opEnd}, i32c(12),
+		),
+		// indirect, calls the table through the table
+		body(0, []byte{opLocalGet, 0, opLocalGet, 0, opCallIndirect, 1, 0}),
+	}
+	inst := newInstance(t, tm, nil, Config{})
+	cases := []struct {
+		fn  string
+		arg uint64
+		exp uint64
+	}{
+		{"fact", 0, 1},
+		{"fact", 20, 2432902008176640000},
+		{"fib", 20, 6765},
+		{"div", uint64(0xFFFFFFFFFFFFFFFB), uint64(0xFFFFFFFFFFFFFFEC)},
+		{"table", 0, 10},
+		{"table", 1, 11},
+		{"table", 7, 12},
+		{"indirect", 0, 10},
+	}
+	for _, c := range cases {
+		res, err := inst.Call(c.fn, c.arg)
+		if err != nil {
+			t.Fatalf("%s(%d) failed, %v", c.fn, c.arg, err)
+		}
+		if len(res) != 1 || res[0] != c.exp {
+			t.Fatalf("%s(%d) expected %d, got %v", c.fn, c.arg, c.exp, res)
+		}
+	}
+	_, err := inst.Call("div", 0)
+	var tr *Trap
+	if !errors.As(err, &tr) || tr.Msg != "integer divide by zero" {
+		t.Fatalf("expected divide by zero trap, got %v", err)
+	}
+	// the instance is usable after the trap
+	res, err := inst.Call("div", 5)
+	if err != nil || res[0] != 20 {
+		t.Fatalf("failed to call after the trap, %v %v", res, err)
+	}
+	_, err = inst.Call("indirect", 2)
+	if !errors.As(err, &tr) || tr.Msg != "undefined element" {
+		t.Fatalf("expected undefined element trap, got %v", err)
+	}
+}
+
+func TestHostAndMemory(t *testing.T) {
+	tm := &testModule{
+		types: [][]byte{
+			ftype([]ValueType{I32, I32}, nil),
+			ftype(nil, []ValueType{I32}),
+		},
+		imports: [][]byte{cat(str("env"), str("log"), []byte{ExternFunc}, uleb(0))},
+		funcs:   [][]byte{{1}, {1}},
+		memory:  []byte{0x01, 0x01, 0x02},
+		exports: [][]byte{
+			export("memory", ExternMemory, 0),
+			export("run", ExternFunc, 1),
+			export("grow", ExternFunc, 2),
+		},
+		datas: [][]byte{cat(uleb(0), i32c(16), []byte{opEnd}, str("hello"))},
+	}
+	tm.bodies = [][]byte{
+		// run, upper cases the first byte and logs the data
+		body(0,
+			i32c(16), i32c(16), []byte{opI32Load8U, 0, 0}, i32c(32), []byte{opI32Sub, opI32Store8, 0, 0},
+			i32c(16), i32c(5), []byte{opCall, 0},
+			[]byte{opMemorySize, 0},
+		),
+		// grow, the second grow is over the limit
+		body(0, i32c(1), []byte{opMemoryGrow, 0, opDrop}, i32c(1), []byte{opMemoryGrow, 0}),
+	}
+	var logged string
+	imports := Imports{
+		"env": {
+			"log": &HostFunc{
+				Type: FuncType{Params: []ValueType{I32, I32}},
+				Func: func(inst *Instance, args []uint64) ([]uint64, error) {
+					b, err := inst.Read(uint32(args[0]), uint32(args[1]))
+					if err != nil {
+						return nil, err
+					}
+					logged = string(b)
+					return nil, nil
+				},
+			},
+		},
+	}
+	inst := newInstance(t, tm, imports, Config{})
+	res, err := inst.Call("run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logged != "Hello" || res[0] != 1 {
+		t.Fatalf("unexpected result %q %v", logged, res)
+	}
+	res, err = inst.Call("grow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint32(res[0]) != 0xFFFFFFFF || len(inst.Memory()) != 2*int(PageSize) {
+		t.Fatalf("unexpected grow result %v, memory %d", res, len(inst.Memory()))
+	}
+	m, _ := Decode(tm.bytes())
+	_, err = m.Instantiate(nil, Config{})
+	if err == nil {
+		t.Fatal("expected the unknown import error")
+	}
+}
+
+func TestFuel(t *testing.T) {
+	tm := &testModule{
+		types:   [][]byte{ftype(nil, nil)},
+		funcs:   [][]byte{{0}},
+		exports: [][]byte{export("spin", ExternFunc, 0)},
+	}
+	tm.bodies = [][]byte{body(0, []byte{opLoop, 0x40, opBr, 0, opEnd})}
+	inst := newInstance(t, tm, nil, Config{Fuel: 10000})
+	_, err := inst.Call("spin")
+	if err != ErrOutOfFuel {
+		t.Fatalf("expected out of fuel, got %v", err)
+	}
+	if inst.FuelUsed() != 10000 {
+		t.Fatalf("expected all the fuel to be used, got %d", inst.FuelUsed())
+	}
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x02, 0x00, 0x00, 0x00})
+	if err == nil {
+		t.Fatal("expected the version error")
+	}
+}
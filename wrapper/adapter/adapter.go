@@ -3,6 +3,8 @@ package adapter
 import (
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/rubixchain/rubixgoplatform/wrapper/config"
 	"github.com/rubixchain/rubixgoplatform/wrapper/uuid"
@@ -41,15 +43,29 @@ func NewAdapter(cfg *config.Config) (*Adapter, error) {
 		dsn := fmt.Sprintf("sqlserver://%s@%s:%s?database=%s", userPwd, cfg.DBAddress, cfg.DBPort, cfg.DBName)
 		db, err = gorm.Open(sqlserver.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
 	case postgressDB:
-		dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=disable", cfg.DBAddress, cfg.DBPort, cfg.DBUserName, cfg.DBName, cfg.DBPassword)
+		sslMode := cfg.DBSSLMode
+		if sslMode == "" {
+			sslMode = "disable"
+		}
+		dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s", cfg.DBAddress, cfg.DBPort, cfg.DBUserName, cfg.DBName, cfg.DBPassword, sslMode)
 		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
 	case sqlite3:
-		db, err = gorm.Open(sqlite.Open(cfg.DBAddress), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+		dsn := cfg.DBAddress
+		// wait for the lock held by the other transactions instead of failing
+		if !strings.Contains(dsn, "?") {
+			dsn = dsn + "?_busy_timeout=5000"
+		}
+		db, err = gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
 	default:
-		dsn := fmt.Sprintf("sqlserver://%s:%s@%s:%s?database=%s", cfg.DBUserName, cfg.DBPassword, cfg.DBAddress, cfg.DBPort, cfg.DBName)
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.DBUserName, cfg.DBPassword, cfg.DBAddress, cfg.DBPort, cfg.DBName)
 		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
 	}
 
+	if err != nil {
+		fmt.Printf("DB Adpater Error : %v", err)
+		return nil, err
+	}
+	err = setupConnPool(db, cfg)
 	if err != nil {
 		fmt.Printf("DB Adpater Error : %v", err)
 		return nil, err
@@ -62,6 +78,32 @@ func NewAdapter(cfg *config.Config) (*Adapter, error) {
 	return adapter, err
 }
 
+// setupConnPool configures the connection pool of the DB
+func setupConnPool(db *gorm.DB, cfg *config.Config) error {
+	sdb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if cfg.MaxOpenConns > 0 {
+		sdb.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		sdb.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnLifetime > 0 {
+		sdb.SetConnMaxLifetime(time.Duration(cfg.ConnLifetime) * time.Second)
+	}
+	return nil
+}
+
+// Transaction runs the function in a DB transaction, the adapter passed
+// to the function should be used for all the operations of the transaction
+func (adapter *Adapter) Transaction(fn func(ad *Adapter) error) error {
+	return adapter.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&Adapter{db: tx, dbType: adapter.dbType})
+	})
+}
+
 func (adapter *Adapter) GetDB() *gorm.DB {
 	return adapter.db
 }
@@ -198,6 +240,24 @@ func (adapter *Adapter) FindWithOffset(tenantID interface{}, tableName string, f
 	}
 }
 
+// FindPage finds upto limit rows in the order, no rows is not an error
+func (adapter *Adapter) FindPage(tenantID interface{}, tableName string, format string, item interface{}, order string, limit int, value ...interface{}) error {
+	if tenantID != uuid.Nil {
+		format = format + " AND " + TenantIDStr + " =?"
+		value = append(value, tenantID)
+	}
+	return adapter.db.Table(tableName).Where(format, value...).Order(order).Limit(limit).Find(item).Error
+}
+
+// FindPageWithOffset finds upto limit rows in the order starting from the offset
+func (adapter *Adapter) FindPageWithOffset(tenantID interface{}, tableName string, format string, item interface{}, order string, offset int, limit int, value ...interface{}) error {
+	if tenantID != uuid.Nil {
+		format = format + " AND " + TenantIDStr + " =?"
+		value = append(value, tenantID)
+	}
+	return adapter.db.Table(tableName).Where(format, value...).Order(order).Offset(offset).Limit(limit).Find(item).Error
+}
+
 // FindMult function finds the value from the table
 func (adapter *Adapter) FindMult(tenantID interface{}, tableName string, format1 string, format2 string, value1 interface{}, value2 interface{}, item interface{}) error {
 	if tenantID != uuid.Nil {
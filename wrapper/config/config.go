@@ -14,6 +14,10 @@ type Config struct {
 	DBType        string `json:"db_type"`        // DBType is type of database to use
 	DBUserName    string `json:"db_user_name"`   // DBUserName is the user name for the DB
 	DBPassword    string `json:"db_password"`    // DBPassword is the password  for the user
+	DBSSLMode     string `json:"db_ssl_mode"`    // DBSSLMode is the ssl mode for the PostgreSQL connection
+	MaxOpenConns  int    `json:"max_open_conns"` // MaxOpenConns is the maximum number of open connections to the DB
+	MaxIdleConns  int    `json:"max_idle_conns"` // MaxIdleConns is the maximum number of idle connections to the DB
+	ConnLifetime  int    `json:"conn_lifetime"`  // ConnLifetime is the maximum life time of the connection in seconds
 	HostAddress   string `json:"host_address"`   // HostAddress is the address to listen for connections on.
 	HostPort      string `json:"host_port"`      // HostPort is the port to listen on.
 	ServerAddress string `json:"server_address"` // HostAddress is the address to listen for connections on.
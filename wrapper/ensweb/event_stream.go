@@ -0,0 +1,86 @@
+package ensweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// EventStream writes the server-sent events to the client
+type EventStream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+	r  *http.Request
+}
+
+// NewEventStream starts the server-sent event stream on the request, the
+// write timeout of the server is not applied to the stream
+func (s *Server) NewEventStream(req *Request) (*EventStream, error) {
+	if s.debugMode {
+		enableCors(&req.w)
+	}
+	rc := http.NewResponseController(req.w)
+	err := rc.SetWriteDeadline(time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("streaming is not supported, %v", err)
+	}
+	req.w.Header().Set("Content-Type", "text/event-stream")
+	req.w.Header().Set("Cache-Control", "no-cache")
+	req.w.Header().Set("Connection", "keep-alive")
+	req.w.WriteHeader(http.StatusOK)
+	es := &EventStream{
+		w:  req.w,
+		rc: rc,
+		r:  req.r,
+	}
+	err = es.rc.Flush()
+	if err != nil {
+		return nil, fmt.Errorf("streaming is not supported, %v", err)
+	}
+	return es, nil
+}
+
+// Send sends the event with the model as the json data, id and event are optional
+func (es *EventStream) Send(id string, event string, model interface{}) error {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	if id != "" {
+		sb.WriteString("id: " + id + "\n")
+	}
+	if event != "" {
+		sb.WriteString("event: " + event + "\n")
+	}
+	sb.WriteString("data: " + string(data) + "\n\n")
+	_, err = es.w.Write([]byte(sb.String()))
+	if err != nil {
+		return err
+	}
+	return es.rc.Flush()
+}
+
+// KeepAlive sends the comment line to keep the connection open
+func (es *EventStream) KeepAlive() error {
+	_, err := es.w.Write([]byte(": keepalive\n\n"))
+	if err != nil {
+		return err
+	}
+	return es.rc.Flush()
+}
+
+// Done is closed when the client goes away
+func (es *EventStream) Done() <-chan struct{} {
+	return es.r.Context().Done()
+}
+
+// Result returns the result of the streamed request
+func (es *EventStream) Result() *Result {
+	return &Result{
+		Status: http.StatusOK,
+		Done:   true,
+	}
+}
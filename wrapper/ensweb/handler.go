@@ -3,6 +3,7 @@ package ensweb
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/rubixchain/rubixgoplatform/wrapper/helper/jsonutil"
@@ -233,6 +234,34 @@ func (s *Server) ParseJSON(req *Request, model interface{}) error {
 	return err
 }
 
+// PeekJSON parses the JSON body and restores it, so the handler can parse it again
+func (s *Server) PeekJSON(req *Request, model interface{}) error {
+	if req.r.Body == nil {
+		return io.EOF
+	}
+	b, err := ioutil.ReadAll(req.r.Body)
+	req.r.Body.Close()
+	req.r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, model)
+}
+
+// PeekMultiPartForm returns the values of the multipart form, the parsed
+// form is kept in the request for the handler
+func (s *Server) PeekMultiPartForm(req *Request) map[string][]string {
+	mediatype, _, err := mime.ParseMediaType(req.r.Header.Get("Content-Type"))
+	if err != nil || mediatype != "multipart/form-data" {
+		return nil
+	}
+	err = req.r.ParseMultipartForm(52428800)
+	if err != nil || req.r.MultipartForm == nil {
+		return nil
+	}
+	return req.r.MultipartForm.Value
+}
+
 func (s *Server) ParseFORM(req *Request) (map[string]interface{}, error) {
 	formData, err := parseFormRequest(req.r)
 	if err != nil {
@@ -13,6 +13,8 @@ import (
 
 const (
 	APIKeyHeader string = "X-API-Key"
+	// RequestIDHeader lets the client choose the request id, it should be an uuid
+	RequestIDHeader string = "X-Request-ID"
 )
 
 // Operation is an enum that is used to specify the type
@@ -180,6 +182,9 @@ func basicRequestFunc(s *Server, w http.ResponseWriter, r *http.Request) *Reques
 	path := r.URL.Path
 
 	requestId := uuid.New().String()
+	if id, err := uuid.Parse(r.Header.Get(RequestIDHeader)); err == nil {
+		requestId = id.String()
+	}
 
 	req := &Request{
 		ID:          requestId,
@@ -21,10 +21,11 @@ func (s *Server) AddPrefixRoute(prefix string, dirpath string, hf HandlerFunc) {
 	s.mux.PathPrefix(prefix).Handler(basicHandleFunc(s, hf))
 }
 
-func (s *Server) EnableSWagger(url string) {
+// EnableSWagger serves the swagger UI of the API spec at the URL
+func (s *Server) EnableSWagger(specURL string) {
 
 	s.mux.PathPrefix("/swagger/").Handler(httpSwagger.Handler(
-		httpSwagger.URL(url+"/swagger/doc.json"),
+		httpSwagger.URL(specURL),
 		httpSwagger.DeepLinking(true),
 		httpSwagger.DocExpansion("none"),
 		httpSwagger.DomID("swagger-ui"))).Methods(http.MethodGet)
@@ -45,3 +46,16 @@ func (s *Server) GetRouteVar(req *Request, key string) string {
 	vars := mux.Vars(req.r)
 	return vars[key]
 }
+
+// GetRouteTemplate returns the path template of the matched route
+func (s *Server) GetRouteTemplate(req *Request) string {
+	r := mux.CurrentRoute(req.r)
+	if r == nil {
+		return req.Path
+	}
+	t, err := r.GetPathTemplate()
+	if err != nil {
+		return req.Path
+	}
+	return t
+}
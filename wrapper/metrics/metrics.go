@@ -0,0 +1,323 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the content type of the Prometheus text format
+const ContentType string = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefBuckets are the default histogram buckets in seconds
+var DefBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+const (
+	counterType   string = "counter"
+	gaugeType     string = "gauge"
+	histogramType string = "histogram"
+)
+
+// Registry holds the metrics and writes them in the Prometheus text format
+type Registry struct {
+	lock       sync.Mutex
+	metrics    map[string]metric
+	collectors []func()
+}
+
+type metric interface {
+	write(w *bufio.Writer)
+}
+
+// NewRegistry creates the empty registry
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]metric)}
+}
+
+func (r *Registry) register(name string, m metric) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.metrics[name]; ok {
+		panic(fmt.Sprintf("metric %s is already registered", name))
+	}
+	r.metrics[name] = m
+}
+
+// AddCollector adds the function called before writing the metrics, it is
+// used to set the gauges read at the scrape time
+func (r *Registry) AddCollector(f func()) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.collectors = append(r.collectors, f)
+}
+
+// Write runs the collectors and writes all the metrics sorted by the name
+func (r *Registry) Write(w io.Writer) error {
+	r.lock.Lock()
+	collectors := append([]func(){}, r.collectors...)
+	r.lock.Unlock()
+	for _, f := range collectors {
+		f()
+	}
+	r.lock.Lock()
+	names := make([]string, 0, len(r.metrics))
+	for n := range r.metrics {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	ms := make([]metric, 0, len(names))
+	for _, n := range names {
+		ms = append(ms, r.metrics[n])
+	}
+	r.lock.Unlock()
+	bw := bufio.NewWriter(w)
+	for _, m := range ms {
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+// desc is the name, the help and the label names of the metric
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+func (d *desc) writeHeader(w *bufio.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", d.name, d.typ)
+}
+
+func (d *desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", d.name, len(d.labels), len(values)))
+	}
+	return strings.Join(values, "\xff")
+}
+
+// labelString returns the labels of the series with the extra label
+func (d *desc) labelString(values []string, extra ...string) string {
+	if len(d.labels) == 0 && len(extra) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(d.labels)+1)
+	for i, l := range d.labels {
+		pairs = append(pairs, l+"=\""+escapeLabel(values[i])+"\"")
+	}
+	if len(extra) == 2 {
+		pairs = append(pairs, extra[0]+"=\""+escapeLabel(extra[1])+"\"")
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// series is the value of the metric for the label values
+type series struct {
+	values []string
+	value  float64
+}
+
+// vec is the metric with the single value per series, the counter and the gauge
+type vec struct {
+	desc
+	lock   sync.Mutex
+	series map[string]*series
+}
+
+func (v *vec) get(values []string) *series {
+	k := v.key(values)
+	s, ok := v.series[k]
+	if !ok {
+		s = &series{values: append([]string{}, values...)}
+		v.series[k] = s
+	}
+	return s
+}
+
+func (v *vec) write(w *bufio.Writer) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.writeHeader(w)
+	for _, k := range sortedKeys(v.series) {
+		s := v.series[k]
+		fmt.Fprintf(w, "%s%s %s\n", v.name, v.labelString(s.values), formatFloat(s.value))
+	}
+}
+
+// CounterVec is the counter partitioned by the labels
+type CounterVec struct {
+	vec
+}
+
+// NewCounterVec registers the counter with the label names
+func (r *Registry) NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	c := &CounterVec{vec{desc: desc{name: name, help: help, typ: counterType, labels: labels}, series: make(map[string]*series)}}
+	r.register(name, c)
+	return c
+}
+
+// Inc increments the counter of the label values
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add adds the value to the counter of the label values, the value should not be negative
+func (c *CounterVec) Add(val float64, values ...string) {
+	if val < 0 {
+		panic(fmt.Sprintf("counter %s can not decrease", c.name))
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.get(values).value += val
+}
+
+// GaugeVec is the gauge partitioned by the labels
+type GaugeVec struct {
+	vec
+}
+
+// NewGaugeVec registers the gauge with the label names
+func (r *Registry) NewGaugeVec(name string, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{vec{desc: desc{name: name, help: help, typ: gaugeType, labels: labels}, series: make(map[string]*series)}}
+	r.register(name, g)
+	return g
+}
+
+// Set sets the gauge of the label values
+func (g *GaugeVec) Set(val float64, values ...string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.get(values).value = val
+}
+
+// Add adds the value to the gauge of the label values
+func (g *GaugeVec) Add(val float64, values ...string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.get(values).value += val
+}
+
+// Reset removes all the series of the gauge, collectors reset the gauge
+// before setting it so that the removed label values are not reported
+func (g *GaugeVec) Reset() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.series = make(map[string]*series)
+}
+
+// histSeries is the observations of the histogram for the label values
+type histSeries struct {
+	values []string
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// HistogramVec is the histogram partitioned by the labels
+type HistogramVec struct {
+	desc
+	buckets []float64
+	lock    sync.Mutex
+	series  map[string]*histSeries
+}
+
+// NewHistogramVec registers the histogram with the bucket upper bounds and
+// the label names, the default buckets are used if buckets is empty
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	b := append([]float64{}, buckets...)
+	sort.Float64s(b)
+	h := &HistogramVec{
+		desc:    desc{name: name, help: help, typ: histogramType, labels: labels},
+		buckets: b,
+		series:  make(map[string]*histSeries),
+	}
+	r.register(name, h)
+	return h
+}
+
+// Observe adds the observation to the histogram of the label values
+func (h *HistogramVec) Observe(val float64, values ...string) {
+	k := h.key(values)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	s, ok := h.series[k]
+	if !ok {
+		s = &histSeries{values: append([]string{}, values...), counts: make([]uint64, len(h.buckets))}
+		h.series[k] = s
+	}
+	for i, b := range h.buckets {
+		if val <= b {
+			s.counts[i]++
+			break
+		}
+	}
+	s.sum += val
+	s.count++
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.writeHeader(w)
+	for _, k := range sortedHistKeys(h.series) {
+		s := h.series[k]
+		cum := uint64(0)
+		for i, b := range h.buckets {
+			cum += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelString(s.values, "le", formatFloat(b)), cum)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelString(s.values, "le", "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, h.labelString(s.values), formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, h.labelString(s.values), s.count)
+	}
+}
+
+func sortedKeys(m map[string]*series) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedHistKeys(m map[string]*histSeries) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var helpReplacer = strings.NewReplacer("\\", "\\\\", "\n", "\\n")
+var labelReplacer = strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\"", "\\\"")
+
+func escapeHelp(s string) string {
+	return helpReplacer.Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return labelReplacer.Replace(s)
+}
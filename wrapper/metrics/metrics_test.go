@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("test_total", "Test counter", "result")
+	g := r.NewGaugeVec("test_up", "Test gauge")
+	h := r.NewHistogramVec("test_seconds", "Test histogram", []float64{1, 0.5}, "path")
+	c.Inc("success")
+	c.Add(2, "success")
+	c.Inc("fail\"ed")
+	r.AddCollector(func() {
+		g.Set(1)
+	})
+	h.Observe(0.2, "/api")
+	h.Observe(0.7, "/api")
+	h.Observe(3, "/api")
+	var buf bytes.Buffer
+	err := r.Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := `# HELP test_seconds Test histogram
+# TYPE test_seconds histogram
+test_seconds_bucket{path="/api",le="0.5"} 1
+test_seconds_bucket{path="/api",le="1"} 2
+test_seconds_bucket{path="/api",le="+Inf"} 3
+test_seconds_sum{path="/api"} 3.9
+test_seconds_count{path="/api"} 3
+# HELP test_total Test counter
+# TYPE test_total counter
+test_total{result="fail\"ed"} 1
+test_total{result="success"} 3
+# HELP test_up Test gauge
+# TYPE test_up gauge
+test_up 1
+`
+	if buf.String() != exp {
+		t.Fatalf("unexpected output\n%s", buf.String())
+	}
+}